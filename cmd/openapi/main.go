@@ -0,0 +1,25 @@
+// Command openapi prints the OpenAPI description of the HTTP API, generated from the
+// same route table the server registers:
+//
+//	go run ./cmd/openapi > openapi.json
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+
+	"inventory-system/internal/buildinfo"
+	"inventory-system/internal/router"
+)
+
+func main() {
+	// Handlers are never invoked here; the zero value is enough to describe the routes.
+	doc := router.OpenAPI(router.Routes(router.Handlers{}), buildinfo.Version)
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		log.Fatalf("FATAL: Could not write OpenAPI document: %v", err)
+	}
+}
@@ -0,0 +1,29 @@
+// Package buildinfo holds version metadata injected at build time, e.g.:
+//
+//	go build -ldflags "\
+//	  -X inventory-system/internal/buildinfo.Version=$(git describe --tags --always) \
+//	  -X inventory-system/internal/buildinfo.Commit=$(git rev-parse --short HEAD) \
+//	  -X inventory-system/internal/buildinfo.BuildDate=$(date -u +%Y-%m-%dT%H:%M:%SZ)" \
+//	  ./cmd/server
+//
+// Without ldflags the values fall back to "dev"/"unknown".
+package buildinfo
+
+// These are variables (not constants) so the linker can overwrite them.
+var (
+	Version   = "dev"
+	Commit    = "unknown"
+	BuildDate = "unknown"
+)
+
+// Info is the JSON-friendly view of the build metadata.
+type Info struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildDate string `json:"build_date"`
+}
+
+// Get returns the build metadata of the running binary.
+func Get() Info {
+	return Info{Version: Version, Commit: Commit, BuildDate: BuildDate}
+}
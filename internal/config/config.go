@@ -1,20 +1,65 @@
 package config
 
 import (
+	"fmt"
 	"log"
 	"os"
-	
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 // Config holds all configuration for the application
 type Config struct {
-	DBSource       string
-	ServerPort     string
-	MigrationURL   string // For file-based migrations: "file://./migrations"
-	FrontendURL    string // URL for the frontend
+	DBSource     string
+	ServerPort   string
+	AdminPort    string        // Separate listener for admin, metrics, pprof and health; empty serves admin routes on ServerPort
+	MigrationURL string        // For file-based migrations: "file://./migrations"
+	FrontendURL  string        // URL for the frontend
+	EditLockTTL  time.Duration // How long an item edit lock lives without a heartbeat
+
+	CORS map[string]CORSPolicy // Cross-origin policy by name: "public", "api" and "admin" (see router.CORSPolicy)
+
+	StockUpdateWindow time.Duration // WebSocket stock updates per item are merged within this window (0 sends each one)
+
+	WSCompression      bool // Negotiate permessage-deflate with WebSocket clients that offer it
+	WSCompressionLevel int  // flate level for WebSocket messages, -2 (Huffman only) to 9; 1 is fastest
+
+	SlowQueryThreshold   time.Duration // Log DB queries slower than this (0 disables)
+	SlowRequestThreshold time.Duration // HTTP requests slower than this are always logged
+	LogSampleRate        float64       // Fraction (0..1) of successful requests written to the access log
+
+	DBHealthCheckInterval time.Duration // How often the database is pinged to detect outages (0 disables degraded mode)
+	DegradedCacheSize     int           // GET responses kept for serving reads while the database is down
+
+	ItemCacheTTL  time.Duration // How long items looked up by ID or SKU stay cached (0 disables the cache)
+	ItemCacheSize int           // Maximum number of cached items
+
+	AnomalyDetectionInterval time.Duration  // How often stock adjustments are scanned for anomalies (0 disables the job)
+	AnomalyBusinessHours     [2]int         // Start and end hour of the working day; adjustments outside it are flagged
+	AnomalyLocation          *time.Location // Time zone of AnomalyBusinessHours
+	AnomalyNotifyUsers       []string       // Users notified of every new anomaly
+
+	LedgerCheckInterval time.Duration // How often quantities are checked against the movement ledger (0 disables the job)
+	LedgerNotifyUsers   []string      // Users notified of every new ledger discrepancy
+
+	WorkflowFile string // JSON file of workflow definitions replacing the built-in ones (see workflow.Registry.LoadFile); empty keeps them
+
+	ChaosEnabled    bool   // Dev-only fault injection; never enable in production
+	ChaosConfigPath string // JSON file with chaos rules (see middleware.ChaosRule)
 	// Add other configurations like JWT secret, etc.
+
+	envPath string // Directory of the .env file, re-read on reload
+}
+
+// CORSPolicy is the cross-origin access allowed to one group of routes.
+type CORSPolicy struct {
+	AllowOrigins     []string      // "*" allows any origin; empty allows no cross-origin requests
+	AllowCredentials bool          // Cookies and auth headers on cross-origin requests; not allowed with "*"
+	MaxAge           time.Duration // How long browsers may cache a preflight response
 }
 
 // LoadConfig loads configuration from environment variables
@@ -36,16 +81,112 @@ func LoadConfig(path string) (*Config, error) {
 	dbSource := "postgresql://" + dbUser + ":" + dbPassword + "@" + dbHost + ":" + dbPort + "/" + dbName + "?sslmode=" + dbSSLMode
 
 	serverPort := getEnv("SERVER_PORT", "8080")
+	adminPort := getEnv("ADMIN_PORT", "")                          // e.g. "9090"; keep it firewalled from the public network
 	migrationURL := getEnv("MIGRATION_URL", "file://./migrations") // Default to local file system migrations
+	editLockTTL := getEnvDuration("EDIT_LOCK_TTL", 2*time.Minute)
+	stockUpdateWindow := getEnvDuration("STOCK_UPDATE_COALESCE_WINDOW", 0) // e.g. "250ms"
+	wsCompression := getEnv("WS_COMPRESSION", "false") == "true"
+	wsCompressionLevel := getEnvInt("WS_COMPRESSION_LEVEL", 1)
+	tunables := loadTunables()
+	dbHealthCheckInterval := getEnvDuration("DB_HEALTH_CHECK_INTERVAL", 5*time.Second)
+	degradedCacheSize := getEnvInt("DEGRADED_CACHE_SIZE", 1000)
+	itemCacheTTL := getEnvDuration("ITEM_CACHE_TTL", 0) // e.g. "30s"; bounds staleness from writes by other instances
+	itemCacheSize := getEnvInt("ITEM_CACHE_SIZE", 10000)
+	anomalyDetectionInterval := getEnvDuration("ANOMALY_DETECTION_INTERVAL", time.Hour)
+	anomalyBusinessHours := [2]int{getEnvInt("ANOMALY_BUSINESS_HOURS_START", 6), getEnvInt("ANOMALY_BUSINESS_HOURS_END", 22)}
+	if h := anomalyBusinessHours; h[0] < 0 || h[0] >= h[1] || h[1] > 24 {
+		return nil, fmt.Errorf("ANOMALY_BUSINESS_HOURS_START and _END must satisfy 0 <= start < end <= 24, got %d and %d", h[0], h[1])
+	}
+	anomalyLocation, err := time.LoadLocation(getEnv("ANOMALY_TIMEZONE", "UTC"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid ANOMALY_TIMEZONE: %w", err)
+	}
+	anomalyNotifyUsers := getEnvList("ANOMALY_NOTIFY_USERS") // e.g. "loss-prevention,store-manager"
+	ledgerCheckInterval := getEnvDuration("LEDGER_CHECK_INTERVAL", 24*time.Hour)
+	ledgerNotifyUsers := getEnvList("LEDGER_NOTIFY_USERS")
+	workflowFile := getEnv("WORKFLOW_FILE", "") // e.g. "./workflows.json", to require roles for purchase order steps
+	chaosEnabled := getEnv("CHAOS_ENABLED", "false") == "true"
+	chaosConfigPath := getEnv("CHAOS_CONFIG_PATH", "./chaos.json")
+
+	// CORS_<NAME>_ORIGINS (comma-separated), CORS_<NAME>_CREDENTIALS and CORS_<NAME>_MAX_AGE.
+	// Local dev servers are not allowed by default; list them in CORS_API_ORIGINS when needed.
+	cors := make(map[string]CORSPolicy)
+	for name, def := range map[string]CORSPolicy{
+		"public": {AllowOrigins: []string{"*"}, MaxAge: time.Hour},
+		"api":    {AllowOrigins: []string{frontendURL}, MaxAge: 10 * time.Minute},
+		"admin":  {MaxAge: 10 * time.Minute},
+	} {
+		policy, err := loadCORSPolicy(name, def)
+		if err != nil {
+			return nil, err
+		}
+		cors[name] = policy
+	}
 
 	return &Config{
 		DBSource:     dbSource,
 		ServerPort:   serverPort,
+		AdminPort:    adminPort,
 		MigrationURL: migrationURL,
-		FrontendURL:   frontendURL,
+		FrontendURL:  frontendURL,
+		EditLockTTL:  editLockTTL,
+
+		CORS: cors,
+
+		StockUpdateWindow: stockUpdateWindow,
+
+		WSCompression:      wsCompression,
+		WSCompressionLevel: wsCompressionLevel,
+
+		SlowQueryThreshold:   tunables.SlowQueryThreshold,
+		SlowRequestThreshold: tunables.SlowRequestThreshold,
+		LogSampleRate:        tunables.LogSampleRate,
+
+		DBHealthCheckInterval: dbHealthCheckInterval,
+		DegradedCacheSize:     degradedCacheSize,
+
+		ItemCacheTTL:  itemCacheTTL,
+		ItemCacheSize: itemCacheSize,
+
+		AnomalyDetectionInterval: anomalyDetectionInterval,
+		AnomalyBusinessHours:     anomalyBusinessHours,
+		AnomalyLocation:          anomalyLocation,
+		AnomalyNotifyUsers:       anomalyNotifyUsers,
+
+		LedgerCheckInterval: ledgerCheckInterval,
+		LedgerNotifyUsers:   ledgerNotifyUsers,
+
+		WorkflowFile: workflowFile,
+
+		ChaosEnabled:    chaosEnabled,
+		ChaosConfigPath: chaosConfigPath,
+
+		envPath: path,
 	}, nil
 }
 
+// loadCORSPolicy reads the CORS_<NAME>_* variables, falling back to def.
+func loadCORSPolicy(name string, def CORSPolicy) (CORSPolicy, error) {
+	prefix := "CORS_" + strings.ToUpper(name) + "_"
+	policy := def
+	if value, ok := os.LookupEnv(prefix + "ORIGINS"); ok {
+		policy.AllowOrigins = nil
+		for _, origin := range strings.Split(value, ",") {
+			if origin = strings.TrimSpace(origin); origin != "" {
+				policy.AllowOrigins = append(policy.AllowOrigins, origin)
+			}
+		}
+	}
+	policy.AllowCredentials = getEnv(prefix+"CREDENTIALS", strconv.FormatBool(def.AllowCredentials)) == "true"
+	policy.MaxAge = getEnvDuration(prefix+"MAX_AGE", def.MaxAge)
+	if policy.AllowCredentials && slices.Contains(policy.AllowOrigins, "*") {
+		// Browsers refuse credentials with a wildcard, and echoing every origin instead
+		// would let any site act as the signed-in user.
+		return CORSPolicy{}, fmt.Errorf("%sCREDENTIALS cannot be enabled when %sORIGINS allows any origin", prefix, prefix)
+	}
+	return policy, nil
+}
+
 // Helper function to get an environment variable or return a default value
 func getEnv(key, defaultValue string) string {
 	value, exists := os.LookupEnv(key)
@@ -55,4 +196,55 @@ func getEnv(key, defaultValue string) string {
 	return value
 }
 
+// Helper function to get a comma-separated list from the environment; blank entries are dropped
+func getEnvList(key string) []string {
+	var list []string
+	for _, entry := range strings.Split(getEnv(key, ""), ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			list = append(list, entry)
+		}
+	}
+	return list
+}
+
+// Helper function to get a duration (e.g. "90s", "2m") from the environment or return a default value
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultValue
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		log.Printf("Invalid duration %q for %s, using default %s", value, key, defaultValue)
+		return defaultValue
+	}
+	return d
+}
+
+// Helper function to get an integer from the environment or return a default value
+func getEnvInt(key string, defaultValue int) int {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultValue
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("Invalid integer %q for %s, using default %d", value, key, defaultValue)
+		return defaultValue
+	}
+	return n
+}
 
+// Helper function to get a float from the environment or return a default value
+func getEnvFloat(key string, defaultValue float64) float64 {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultValue
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		log.Printf("Invalid number %q for %s, using default %v", value, key, defaultValue)
+		return defaultValue
+	}
+	return f
+}
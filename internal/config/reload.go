@@ -0,0 +1,184 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+// Tunables are the settings that can change while the server is running.
+// Everything else in Config needs a restart.
+type Tunables struct {
+	LogSampleRate        float64
+	SlowRequestThreshold time.Duration
+	SlowQueryThreshold   time.Duration
+}
+
+func loadTunables() Tunables {
+	return Tunables{
+		LogSampleRate:        getEnvFloat("LOG_SAMPLE_RATE", 1.0), // Log everything by default
+		SlowRequestThreshold: getEnvDuration("SLOW_REQUEST_THRESHOLD", time.Second),
+		SlowQueryThreshold:   getEnvDuration("SLOW_QUERY_THRESHOLD", 200*time.Millisecond),
+	}
+}
+
+// Live holds the current Tunables and notifies subscribers when they are reloaded.
+type Live struct {
+	cfg       *Config
+	current   atomic.Pointer[Tunables]
+	mu        sync.Mutex // Serialises reloads and guards listeners
+	listeners []func(Tunables)
+}
+
+// NewLive starts from the tunables cfg was loaded with.
+func NewLive(cfg *Config) *Live {
+	l := &Live{cfg: cfg}
+	l.current.Store(&Tunables{
+		LogSampleRate:        cfg.LogSampleRate,
+		SlowRequestThreshold: cfg.SlowRequestThreshold,
+		SlowQueryThreshold:   cfg.SlowQueryThreshold,
+	})
+	return l
+}
+
+// Get returns the tunables in effect. It is safe for concurrent use.
+func (l *Live) Get() Tunables {
+	return *l.current.Load()
+}
+
+// OnChange registers fn to be called with the new tunables after every reload.
+func (l *Live) OnChange(fn func(Tunables)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.listeners = append(l.listeners, fn)
+}
+
+// Reload re-reads the .env file and the environment. Values in .env replace the ones
+// loaded at startup, so edit the file and send SIGHUP to apply a change.
+func (l *Live) Reload() Tunables {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.cfg.envPath != "" {
+		if err := godotenv.Overload(l.cfg.envPath + "/.env"); err != nil && !os.IsNotExist(err) {
+			log.Printf("Config reload: could not read .env, keeping environment values: %v", err)
+		}
+	}
+	t := loadTunables()
+	if old := l.current.Swap(&t); *old != t {
+		log.Printf("Config reloaded: %+v", t)
+	}
+	for _, fn := range l.listeners {
+		fn(t)
+	}
+	return t
+}
+
+// WatchSignals reloads on every SIGHUP until ctx is cancelled.
+// It must be run in a separate goroutine.
+func (l *Live) WatchSignals(ctx context.Context) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			l.Reload()
+		}
+	}
+}
+
+// Effective describes the running configuration for operators. Credentials are left out
+// and durations are rendered like "1.5s".
+type Effective struct {
+	Tunables struct {
+		LogSampleRate        float64 `json:"log_sample_rate"`
+		SlowRequestThreshold string  `json:"slow_request_threshold"`
+		SlowQueryThreshold   string  `json:"slow_query_threshold"`
+	} `json:"tunables"`
+	Static struct {
+		ServerPort            string                   `json:"server_port"`
+		AdminPort             string                   `json:"admin_port"`
+		FrontendURL           string                   `json:"frontend_url"`
+		MigrationURL          string                   `json:"migration_url"`
+		EditLockTTL           string                   `json:"edit_lock_ttl"`
+		CORS                  map[string]EffectiveCORS `json:"cors"`
+		StockUpdateWindow     string                   `json:"stock_update_coalesce_window"`
+		WSCompression         bool                     `json:"ws_compression"`
+		WSCompressionLevel    int                      `json:"ws_compression_level"`
+		DBHealthCheckInterval string                   `json:"db_health_check_interval"`
+		DegradedCacheSize     int                      `json:"degraded_cache_size"`
+		ItemCacheTTL          string                   `json:"item_cache_ttl"`
+		ItemCacheSize         int                      `json:"item_cache_size"`
+		AnomalyDetection      EffectiveAnomalies       `json:"anomaly_detection"`
+		LedgerCheck           EffectiveLedgerCheck     `json:"ledger_check"`
+		WorkflowFile          string                   `json:"workflow_file"`
+		ChaosEnabled          bool                     `json:"chaos_enabled"`
+	} `json:"static"`
+}
+
+// EffectiveCORS describes one CORS policy.
+type EffectiveCORS struct {
+	AllowOrigins     []string `json:"allow_origins"`
+	AllowCredentials bool     `json:"allow_credentials"`
+	MaxAge           string   `json:"max_age"`
+}
+
+// EffectiveAnomalies describes the anomaly detection job.
+type EffectiveAnomalies struct {
+	Interval      string   `json:"interval"`
+	BusinessHours string   `json:"business_hours"`
+	NotifyUsers   []string `json:"notify_users"`
+}
+
+// EffectiveLedgerCheck describes the ledger consistency check job.
+type EffectiveLedgerCheck struct {
+	Interval    string   `json:"interval"`
+	NotifyUsers []string `json:"notify_users"`
+}
+
+// Effective returns the configuration currently in force.
+func (l *Live) Effective() Effective {
+	var e Effective
+	t := l.Get()
+	e.Tunables.LogSampleRate = t.LogSampleRate
+	e.Tunables.SlowRequestThreshold = t.SlowRequestThreshold.String()
+	e.Tunables.SlowQueryThreshold = t.SlowQueryThreshold.String()
+	e.Static.ServerPort = l.cfg.ServerPort
+	e.Static.AdminPort = l.cfg.AdminPort
+	e.Static.FrontendURL = l.cfg.FrontendURL
+	e.Static.MigrationURL = l.cfg.MigrationURL
+	e.Static.EditLockTTL = l.cfg.EditLockTTL.String()
+	e.Static.CORS = make(map[string]EffectiveCORS, len(l.cfg.CORS))
+	for name, p := range l.cfg.CORS {
+		e.Static.CORS[name] = EffectiveCORS{AllowOrigins: p.AllowOrigins, AllowCredentials: p.AllowCredentials, MaxAge: p.MaxAge.String()}
+	}
+	e.Static.StockUpdateWindow = l.cfg.StockUpdateWindow.String()
+	e.Static.WSCompression = l.cfg.WSCompression
+	e.Static.WSCompressionLevel = l.cfg.WSCompressionLevel
+	e.Static.DBHealthCheckInterval = l.cfg.DBHealthCheckInterval.String()
+	e.Static.DegradedCacheSize = l.cfg.DegradedCacheSize
+	e.Static.ItemCacheTTL = l.cfg.ItemCacheTTL.String()
+	e.Static.ItemCacheSize = l.cfg.ItemCacheSize
+	e.Static.AnomalyDetection = EffectiveAnomalies{
+		Interval:      l.cfg.AnomalyDetectionInterval.String(),
+		BusinessHours: fmt.Sprintf("%02d:00-%02d:00 %s", l.cfg.AnomalyBusinessHours[0], l.cfg.AnomalyBusinessHours[1], l.cfg.AnomalyLocation),
+		NotifyUsers:   l.cfg.AnomalyNotifyUsers,
+	}
+	e.Static.LedgerCheck = EffectiveLedgerCheck{
+		Interval:    l.cfg.LedgerCheckInterval.String(),
+		NotifyUsers: l.cfg.LedgerNotifyUsers,
+	}
+	e.Static.WorkflowFile = l.cfg.WorkflowFile
+	e.Static.ChaosEnabled = l.cfg.ChaosEnabled
+	return e
+}
@@ -0,0 +1,66 @@
+package database
+
+import (
+	"context"
+	"log"
+	"sync/atomic"
+	"time"
+)
+
+// Pinger is the part of a connection pool the health monitor needs.
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// HealthMonitor pings the database periodically and remembers whether it is reachable.
+// Other components consult Healthy() to degrade gracefully during an outage; recovery is
+// picked up automatically by the next successful ping.
+type HealthMonitor struct {
+	db       Pinger
+	interval time.Duration
+	healthy  atomic.Bool
+}
+
+// NewHealthMonitor creates a monitor that considers the database healthy until a ping fails.
+func NewHealthMonitor(db Pinger, interval time.Duration) *HealthMonitor {
+	m := &HealthMonitor{db: db, interval: interval}
+	m.healthy.Store(true) // The server only starts after a successful connection
+	return m
+}
+
+// Healthy reports the result of the most recent ping. It is safe for concurrent use.
+func (m *HealthMonitor) Healthy() bool {
+	return m.healthy.Load()
+}
+
+// Run pings the database every interval until ctx is cancelled.
+// It must be run in a separate goroutine.
+func (m *HealthMonitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.check(ctx)
+		}
+	}
+}
+
+func (m *HealthMonitor) check(ctx context.Context) {
+	// Never wait longer than one interval so a hanging database is noticed promptly.
+	pingCtx, cancel := context.WithTimeout(ctx, m.interval)
+	defer cancel()
+	err := m.db.Ping(pingCtx)
+
+	healthy := err == nil
+	if m.healthy.Swap(healthy) == healthy {
+		return
+	}
+	if healthy {
+		log.Println("Database is reachable again; leaving degraded read-only mode.")
+	} else {
+		log.Printf("WARNING: Database unreachable (%v); entering degraded read-only mode.", err)
+	}
+}
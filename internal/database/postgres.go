@@ -12,8 +12,10 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-// ConnectPostgres establishes a connection pool to PostgreSQL
-func ConnectPostgres(dbSourceURL string) (*pgxpool.Pool, error) {
+// ConnectPostgres establishes a connection pool to PostgreSQL.
+// Queries slower than slowQueryThreshold are logged; a zero threshold disables slow-query logging.
+// The threshold can be changed later with SetSlowQueryThreshold.
+func ConnectPostgres(dbSourceURL string, slowQueryThreshold time.Duration) (*pgxpool.Pool, error) {
 	config, err := pgxpool.ParseConfig(dbSourceURL)
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse database_url: %w", err)
@@ -26,6 +28,7 @@ func ConnectPostgres(dbSourceURL string) (*pgxpool.Pool, error) {
 	config.MaxConnIdleTime = 30 * time.Minute
 	config.HealthCheckPeriod = time.Minute
 	config.ConnConfig.ConnectTimeout = 5 * time.Second
+	config.ConnConfig.Tracer = NewSlowQueryTracer(slowQueryThreshold)
 
 	pool, err := pgxpool.NewWithConfig(context.Background(), config)
 	if err != nil {
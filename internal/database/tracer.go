@@ -0,0 +1,95 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"inventory-system/pkg/requestid"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// slowQueryTracer is a pgx.QueryTracer that logs queries taking longer than a threshold.
+// Query parameters are never logged, only their types, so customer data and secrets
+// don't end up in the logs.
+type slowQueryTracer struct {
+	threshold atomic.Int64 // Nanoseconds; zero or less disables logging
+}
+
+type queryTraceKey struct{}
+
+type queryTrace struct {
+	sql   string
+	args  []any
+	start time.Time
+}
+
+// NewSlowQueryTracer creates a tracer that logs queries slower than threshold.
+func NewSlowQueryTracer(threshold time.Duration) pgx.QueryTracer {
+	t := &slowQueryTracer{}
+	t.threshold.Store(int64(threshold))
+	return t
+}
+
+// SetSlowQueryThreshold changes the slow-query threshold of a pool created by
+// ConnectPostgres while it is in use. Zero disables slow-query logging.
+func SetSlowQueryThreshold(pool *pgxpool.Pool, threshold time.Duration) {
+	if t, ok := pool.Config().ConnConfig.Tracer.(*slowQueryTracer); ok {
+		t.threshold.Store(int64(threshold))
+	}
+}
+
+// TraceQueryStart remembers the query and when it started.
+func (t *slowQueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	if t.threshold.Load() <= 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, queryTraceKey{}, &queryTrace{sql: data.SQL, args: data.Args, start: time.Now()})
+}
+
+// TraceQueryEnd logs the query if it exceeded the threshold.
+func (t *slowQueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	trace, ok := ctx.Value(queryTraceKey{}).(*queryTrace)
+	if !ok {
+		return
+	}
+	elapsed := time.Since(trace.start)
+	threshold := time.Duration(t.threshold.Load())
+	if threshold <= 0 || elapsed < threshold {
+		return
+	}
+
+	status := "ok"
+	if data.Err != nil {
+		status = "error: " + data.Err.Error()
+	}
+	log.Printf("Slow query (%s, threshold %s, %s, request_id=%s): %s args=%s",
+		elapsed.Round(time.Microsecond), threshold, status, requestIDOrDash(ctx), compactSQL(trace.sql), redactArgs(trace.args))
+}
+
+// requestIDOrDash names the request that issued a query; background work has none.
+func requestIDOrDash(ctx context.Context) string {
+	if id := requestid.FromContext(ctx); id != "" {
+		return id
+	}
+	return "-"
+}
+
+// compactSQL collapses the whitespace of multi-line queries so they fit on one log line.
+func compactSQL(sql string) string {
+	return strings.Join(strings.Fields(sql), " ")
+}
+
+// redactArgs describes query arguments by position and type only, e.g. [$1=string $2=int].
+func redactArgs(args []any) string {
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		parts[i] = fmt.Sprintf("$%d=%T", i+1, arg)
+	}
+	return "[" + strings.Join(parts, " ") + "]"
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Kinds of anomaly flagged on stock movements.
+const (
+	AnomalyKindLargeWriteOff = "large_write_off" // Write-off far above the item's usual write-offs
+	AnomalyKindAfterHours    = "after_hours"     // Stock moved by a user outside business hours
+	AnomalyKindUserVolume    = "user_volume"     // A user moved stock far more often in a day than they usually do
+)
+
+// AnomalyEntityType is the entity type of notifications about an anomaly.
+const AnomalyEntityType = "anomaly"
+
+// Anomaly is a stock movement, or a user's movements over a day, that loss prevention
+// should look at. Anomalies are flagged by the detection job and acknowledged by a reviewer.
+type Anomaly struct {
+	ID             string     `json:"id" db:"id"`
+	Kind           string     `json:"kind" db:"kind"`
+	MovementID     *string    `json:"movement_id,omitempty" db:"movement_id"` // Nil for user_volume
+	ItemID         *string    `json:"item_id,omitempty" db:"item_id"`         // Nil for user_volume
+	UserID         string     `json:"user_id" db:"user_id"`                   // Who moved the stock; empty if the movement did not carry a user
+	Score          float64    `json:"score" db:"score"`                       // Standard deviations above the usual; 0 for after_hours
+	Details        string     `json:"details" db:"details"`
+	DetectedAt     time.Time  `json:"detected_at" db:"detected_at"`
+	AcknowledgedAt *time.Time `json:"acknowledged_at,omitempty" db:"acknowledged_at"`
+	AcknowledgedBy *string    `json:"acknowledged_by,omitempty" db:"acknowledged_by"`
+
+	// DedupeKey identifies what was flagged, so later runs over the same movements
+	// do not flag it again.
+	DedupeKey string `json:"-" db:"dedupe_key"`
+}
+
+// AdjustmentStats summarises a series of observations, e.g. the quantities removed from one item.
+type AdjustmentStats struct {
+	Count  int
+	Mean   float64
+	StdDev float64 // Population standard deviation
+}
+
+// ListAnomaliesQuery defines the query parameters for listing anomalies.
+type ListAnomaliesQuery struct {
+	Kind           string `query:"kind" validate:"omitempty,oneof=large_write_off after_hours user_volume"`
+	Unacknowledged bool   `query:"unacknowledged"` // Only return anomalies nobody has acknowledged
+	Limit          int    `query:"limit" validate:"min=1,max=200"`
+}
+
+// AnomalyDetectionResult reports one run of the detection job.
+type AnomalyDetectionResult struct {
+	Scanned int        `json:"scanned"` // Stock movements looked at
+	Flagged []*Anomaly `json:"flagged"` // Anomalies not flagged by an earlier run
+}
+
+// AnomalyRepository defines storage operations for anomalies.
+type AnomalyRepository interface {
+	// Create stores a. It returns false, without error, when an anomaly with the same
+	// DedupeKey was already stored.
+	Create(ctx context.Context, a *Anomaly) (bool, error)
+	List(ctx context.Context, q ListAnomaliesQuery) ([]*Anomaly, error)
+	Acknowledge(ctx context.Context, id, userID string) (*Anomaly, error)
+}
+
+// AnomalyService flags unusual stock movements and lets reviewers work through them.
+type AnomalyService interface {
+	// Detect scans the stock movements of the day before now against the weeks before that.
+	Detect(ctx context.Context, now time.Time) (*AnomalyDetectionResult, error)
+	ListAnomalies(ctx context.Context, q ListAnomaliesQuery) ([]*Anomaly, error)
+	Acknowledge(ctx context.Context, id, userID string) (*Anomaly, error)
+}
@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Statuses of an assembly order. Orders start open and are closed exactly once.
+const (
+	AssemblyStatusOpen      = "open"
+	AssemblyStatusCompleted = "completed" // Components consumed, assembled items received
+	AssemblyStatusCancelled = "cancelled" // Closed without touching stock
+)
+
+// Reasons of the movements written when an assembly order is completed. Both carry the
+// order ID as their reference, so the consumption and the output can be traced together.
+const (
+	MovementReasonAssemblyConsume = "assembly_consume"
+	MovementReasonAssemblyProduce = "assembly_produce"
+)
+
+// Component is a line of a bill of materials: how many units of another item go into one
+// unit of the assembled item. On assembly order lines, Quantity is the total for the order.
+type Component struct {
+	ComponentID string `json:"component_id" db:"component_id" validate:"required,uuid"`
+	Quantity    int    `json:"quantity" db:"quantity" validate:"required,min=1"`
+}
+
+// SetComponentsRequest defines the payload for replacing an item's bill of materials.
+type SetComponentsRequest struct {
+	Components []Component `json:"components" validate:"max=200,dive"` // Empty to clear it
+}
+
+// AssemblyOrder assembles Quantity units of an item out of its components.
+type AssemblyOrder struct {
+	ID        string      `json:"id" db:"id"`
+	Number    string      `json:"number" db:"number"` // E.g. AO-0001; see DocumentSequence
+	ItemID    string      `json:"item_id" db:"item_id"`
+	Quantity  int         `json:"quantity" db:"quantity"`
+	Status    string      `json:"status" db:"status"`
+	Lines     []Component `json:"lines" db:"-"` // Components to consume, fixed when the order is created
+	CreatedBy string      `json:"created_by" db:"created_by"`
+	CreatedAt time.Time   `json:"created_at" db:"created_at"`
+	ClosedBy  *string     `json:"closed_by,omitempty" db:"closed_by"`
+	ClosedAt  *time.Time  `json:"closed_at,omitempty" db:"closed_at"`
+}
+
+// CreateAssemblyOrderRequest defines the payload for creating an assembly order.
+type CreateAssemblyOrderRequest struct {
+	ItemID   string `json:"item_id" validate:"required,uuid"`
+	Quantity int    `json:"quantity" validate:"required,min=1,max=100000"`
+}
+
+// ListAssemblyOrdersQuery defines the query parameters for listing assembly orders.
+type ListAssemblyOrdersQuery struct {
+	Status string `query:"status" validate:"omitempty,oneof=open completed cancelled"`
+	Limit  int    `query:"limit" validate:"min=1,max=200"`
+}
+
+// CompleteAssemblyResult is a completed order with the items it changed and the ledger rows
+// recording the changes.
+type CompleteAssemblyResult struct {
+	Order     *AssemblyOrder   `json:"order"`
+	Items     []*Item          `json:"items"`
+	Movements []*StockMovement `json:"movements"`
+}
+
+// AssemblyRepository defines storage operations for bills of materials and assembly orders.
+type AssemblyRepository interface {
+	SetComponents(ctx context.Context, itemID string, components []Component) error
+	ListComponents(ctx context.Context, itemID string) ([]Component, error)
+	// CreateOrder stores o with its lines computed from the item's current bill of materials.
+	// It returns ErrNoComponents if the item has none.
+	CreateOrder(ctx context.Context, o *AssemblyOrder) (*AssemblyOrder, error)
+	GetOrder(ctx context.Context, id string) (*AssemblyOrder, error)
+	ListOrders(ctx context.Context, q ListAssemblyOrdersQuery) ([]*AssemblyOrder, error) // Newest first
+	// Complete consumes the components of an open order and receives the assembled items, in
+	// one transaction. It returns ErrInsufficientStock, and changes nothing, if a component
+	// is short.
+	Complete(ctx context.Context, id, userID string) (*CompleteAssemblyResult, error)
+	Cancel(ctx context.Context, id, userID string) (*AssemblyOrder, error)
+}
+
+// AssemblyService defines business logic for assembling items out of components.
+type AssemblyService interface {
+	SetComponents(ctx context.Context, itemID string, req *SetComponentsRequest) ([]Component, error)
+	GetComponents(ctx context.Context, itemID string) ([]Component, error)
+	CreateOrder(ctx context.Context, req *CreateAssemblyOrderRequest, userID string) (*AssemblyOrder, error)
+	GetOrder(ctx context.Context, id string) (*AssemblyOrder, error)
+	ListOrders(ctx context.Context, q ListAssemblyOrdersQuery) ([]*AssemblyOrder, error)
+	CompleteOrder(ctx context.Context, id, userID string) (*CompleteAssemblyResult, error)
+	CancelOrder(ctx context.Context, id, userID string) (*AssemblyOrder, error)
+}
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Category groups items. Categories form a tree through ParentID; a category without a
+// parent is a root.
+type Category struct {
+	ID        string      `json:"id" db:"id"`
+	Name      string      `json:"name" db:"name"`
+	ParentID  *string     `json:"parent_id" db:"parent_id"`  // Nil for roots
+	Children  []*Category `json:"children,omitempty" db:"-"` // Direct children, filled in when a single category is read
+	CreatedAt time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at" db:"updated_at"`
+}
+
+// CreateCategoryRequest defines the payload for creating a category.
+type CreateCategoryRequest struct {
+	Name     string  `json:"name" validate:"required,max=255"`
+	ParentID *string `json:"parent_id,omitempty" validate:"omitempty,uuid"` // Omit to create a root
+}
+
+// UpdateCategoryRequest defines the payload for renaming or moving a category.
+// It replaces both fields: omitting parent_id moves the category to the root.
+type UpdateCategoryRequest struct {
+	Name     string  `json:"name" validate:"required,max=255"`
+	ParentID *string `json:"parent_id,omitempty" validate:"omitempty,uuid"`
+}
+
+// AssignCategoryRequest defines the payload for putting an item into a category.
+type AssignCategoryRequest struct {
+	CategoryID *string `json:"category_id" validate:"omitempty,uuid"` // Null removes the item from its category
+}
+
+// CategoryRepository defines storage operations for categories.
+type CategoryRepository interface {
+	Create(ctx context.Context, c *Category) (*Category, error)
+	GetByID(ctx context.Context, id string) (*Category, error) // With its direct children
+	List(ctx context.Context) ([]*Category, error)             // Every category, ordered by name
+	// Update renames and moves a category. It returns ErrCategoryCycle, and changes nothing,
+	// if the new parent is the category itself or one of its descendants.
+	Update(ctx context.Context, c *Category) (*Category, error)
+	// Delete removes a category that has no children and takes its items out of it, recording
+	// a revision of each as changed by userID. It returns the IDs of those items.
+	Delete(ctx context.Context, id, userID string) ([]string, error)
+	// AssignItem puts an item into a category, or takes it out of its category if categoryID is
+	// nil, recording a revision of the item as changed by userID.
+	AssignItem(ctx context.Context, itemID string, categoryID *string, userID string) error
+}
+
+// CategoryService defines business logic for categories.
+type CategoryService interface {
+	CreateCategory(ctx context.Context, req *CreateCategoryRequest) (*Category, error)
+	GetCategory(ctx context.Context, id string) (*Category, error)
+	ListCategories(ctx context.Context) ([]*Category, error)
+	UpdateCategory(ctx context.Context, id string, req *UpdateCategoryRequest) (*Category, error)
+	DeleteCategory(ctx context.Context, id, userID string) error
+	AssignItem(ctx context.Context, itemID string, req *AssignCategoryRequest, userID string) error
+}
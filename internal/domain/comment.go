@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Entity types that can carry comments. The comments table is polymorphic,
+// so new document types only need a constant here and a route.
+const (
+	CommentEntityItem = "item"
+)
+
+// Comment is a free-text note attached to an entity (item, order, ...).
+type Comment struct {
+	ID         string    `json:"id" db:"id"`
+	EntityType string    `json:"entity_type" db:"entity_type"`
+	EntityID   string    `json:"entity_id" db:"entity_id"`
+	Author     string    `json:"author" db:"author"`
+	Body       string    `json:"body" db:"body"`
+	Mentions   []string  `json:"mentions" db:"mentions"` // Users @mentioned in the body
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
+
+// CreateCommentRequest defines the payload for posting a comment.
+type CreateCommentRequest struct {
+	Body string `json:"body" validate:"required,max=5000"`
+}
+
+// CommentRepository defines storage operations for comments.
+type CommentRepository interface {
+	Create(ctx context.Context, comment *Comment) (*Comment, error)
+	GetByID(ctx context.Context, id string) (*Comment, error)
+	ListByEntity(ctx context.Context, entityType, entityID string) ([]*Comment, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// CommentService defines business logic for comments.
+type CommentService interface {
+	AddComment(ctx context.Context, entityType, entityID, author string, req *CreateCommentRequest) (*Comment, error)
+	ListComments(ctx context.Context, entityType, entityID string) ([]*Comment, error)
+	DeleteComment(ctx context.Context, id, requester string) error
+}
+
+// MentionNotifier is notified whenever a comment mentions one or more users.
+// It is implemented by the notification pipeline.
+type MentionNotifier interface {
+	NotifyMentions(ctx context.Context, comment *Comment)
+}
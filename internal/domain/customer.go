@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Customer is someone stock is sold to.
+type Customer struct {
+	ID        string    `json:"id" db:"id"`
+	Name      string    `json:"name" db:"name"`
+	Email     *string   `json:"email,omitempty" db:"email"` // Unique, ignoring case
+	PriceTier string    `json:"price_tier" db:"price_tier"` // One of the PriceTier* values
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// CreateCustomerRequest defines the payload for creating a customer.
+type CreateCustomerRequest struct {
+	Name      string  `json:"name" validate:"required,max=255"`
+	Email     *string `json:"email,omitempty" validate:"omitempty,email,max=255"`
+	PriceTier string  `json:"price_tier,omitempty" validate:"omitempty,oneof=retail wholesale contract"` // Defaults to retail
+}
+
+// UpdateCustomerRequest defines the payload for updating a customer. It replaces every field:
+// omitting email clears it, omitting price_tier puts the customer back in retail.
+type UpdateCustomerRequest struct {
+	Name      string  `json:"name" validate:"required,max=255"`
+	Email     *string `json:"email,omitempty" validate:"omitempty,email,max=255"`
+	PriceTier string  `json:"price_tier,omitempty" validate:"omitempty,oneof=retail wholesale contract"`
+}
+
+// ListCustomersQuery defines the query parameters for listing customers.
+type ListCustomersQuery struct {
+	Page  int `query:"page" validate:"min=1"`
+	Limit int `query:"limit" validate:"min=1,max=100"`
+}
+
+// CustomerRepository defines storage operations for customers.
+type CustomerRepository interface {
+	Create(ctx context.Context, c *Customer) (*Customer, error)
+	GetByID(ctx context.Context, id string) (*Customer, error)
+	List(ctx context.Context, page, limit int) ([]*Customer, error) // Ordered by name
+	Update(ctx context.Context, c *Customer) (*Customer, error)
+}
+
+// CustomerService defines business logic for customers.
+type CustomerService interface {
+	CreateCustomer(ctx context.Context, req *CreateCustomerRequest) (*Customer, error)
+	GetCustomer(ctx context.Context, id string) (*Customer, error)
+	ListCustomers(ctx context.Context, q ListCustomersQuery) ([]*Customer, error)
+	UpdateCustomer(ctx context.Context, id string, req *UpdateCustomerRequest) (*Customer, error)
+}
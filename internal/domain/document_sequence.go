@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Document types with a numbering sequence.
+const (
+	DocumentTypePurchaseOrder = "purchase_order"
+	DocumentTypeSalesOrder    = "sales_order"
+	DocumentTypeAssemblyOrder = "assembly_order"
+)
+
+// DocumentSequence configures how documents of a type are numbered: Prefix, the year if
+// Yearly, and a counter of at least Padding digits, e.g. PO-2024-0001 or AO-0001. Numbers are
+// handed out in the transaction that creates the document, so they have no gaps.
+type DocumentSequence struct {
+	DocType   string    `json:"doc_type" db:"doc_type"`
+	Prefix    string    `json:"prefix" db:"prefix"`
+	Yearly    bool      `json:"yearly" db:"yearly"` // The counter restarts from 1 every year
+	Padding   int       `json:"padding" db:"padding"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// SetDocumentSequenceRequest defines the payload for configuring a numbering sequence.
+type SetDocumentSequenceRequest struct {
+	Prefix  string `json:"prefix" validate:"required,alphanumdash,max=20"`
+	Yearly  bool   `json:"yearly"`
+	Padding int    `json:"padding" validate:"required,min=1,max=12"`
+}
+
+// DocumentSequenceRepository defines storage operations for numbering sequences. Numbers
+// themselves are drawn by the repositories of the documents.
+type DocumentSequenceRepository interface {
+	List(ctx context.Context) ([]*DocumentSequence, error) // Ordered by document type
+	Update(ctx context.Context, seq *DocumentSequence) (*DocumentSequence, error)
+}
+
+// DocumentSequenceService defines business logic for numbering sequences.
+type DocumentSequenceService interface {
+	ListSequences(ctx context.Context) ([]*DocumentSequence, error)
+	SetSequence(ctx context.Context, docType string, req *SetDocumentSequenceRequest) (*DocumentSequence, error)
+}
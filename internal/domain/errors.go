@@ -7,7 +7,6 @@ import "errors"
 var (
 	ErrRepositoryNotFound       = errors.New("repository: resource not found")
 	ErrRepositoryDuplicateEntry = errors.New("repository: duplicate entry")
-	// Add more specific repository errors if needed, e.g., ErrOptimisticLockFailed
 )
 
 // --- Service Errors ---
@@ -21,4 +20,129 @@ var (
 	ErrUpdateNoChanges   = errors.New("no changes provided for update")
 	ErrInsufficientStock = errors.New("insufficient stock for operation")
 	ErrOperationFailed   = errors.New("operation failed") // Generic service operation failure
+	ErrMissingUser       = errors.New("user identity is required")
+	ErrInvalidCursor     = errors.New("invalid change feed cursor")
+	ErrVersionConflict   = errors.New("item was changed since it was read") // Optimistic lock failed; the client should reload
+	ErrItemInUse         = errors.New("item is referenced by orders, assemblies or the ledger")
+)
+
+// --- Workflow Errors ---
+// Returned by the workflow engine when a requested transition cannot be applied.
+var (
+	ErrInvalidTransition   = errors.New("transition not allowed from current state")
+	ErrTransitionForbidden = errors.New("caller lacks the role required for this transition")
+	ErrUnknownWorkflow     = errors.New("no workflow configured for document type")
+)
+
+// --- Stock Adjustment Errors ---
+var (
+	ErrBatchRejected = errors.New("adjustment batch rejected") // At least one line failed; nothing was applied
+)
+
+// --- Rebuild Errors ---
+var (
+	ErrDerivedStoreNotFound = errors.New("derived store not found")
+	ErrRebuildRunning       = errors.New("a rebuild of this store is already running")
+	ErrRebuildJobNotFound   = errors.New("rebuild job not found")
+)
+
+// --- Category Errors ---
+var (
+	ErrCategoryNotFound    = errors.New("category not found")
+	ErrCategoryNameTaken   = errors.New("a sibling category already has this name")
+	ErrCategoryHasChildren = errors.New("category has subcategories")            // Move or delete them first
+	ErrCategoryCycle       = errors.New("category cannot be moved below itself") // New parent is the category or a descendant
+)
+
+// --- Customer Errors ---
+var (
+	ErrCustomerNotFound   = errors.New("customer not found")
+	ErrCustomerEmailTaken = errors.New("another customer already has this email address")
+)
+
+// --- Price Tier Errors ---
+var (
+	ErrTierPriceNotFound = errors.New("item has no price rule for this tier")
+)
+
+// --- Purchasing Errors ---
+var (
+	ErrSupplierNotFound      = errors.New("supplier not found")
+	ErrSupplierNameTaken     = errors.New("another supplier already has this name")
+	ErrPurchaseOrderNotFound = errors.New("purchase order not found")
+	ErrPurchaseOrderStatus   = errors.New("purchase order status does not allow this") // E.g. receiving a draft
+	ErrOverReceipt           = errors.New("receipt exceeds the quantity outstanding")  // Or the item is not on the order
+)
+
+// --- Sales Order Errors ---
+var (
+	ErrSalesOrderNotFound = errors.New("sales order not found")
+	ErrSalesOrderClosed   = errors.New("sales order is no longer open")
+)
+
+// --- Ledger Errors ---
+var (
+	ErrDiscrepancyNotFound = errors.New("ledger discrepancy not found")
+	ErrDiscrepancyResolved = errors.New("ledger discrepancy is already resolved")
+)
+
+// --- Label Errors ---
+var (
+	ErrLabelJobNotFound = errors.New("label job not found")
+	ErrLabelJobStatus   = errors.New("label job is not being printed")
+)
+
+// --- Document Sequence Errors ---
+var (
+	ErrDocumentSequenceNotFound = errors.New("no numbering sequence for this document type")
+)
+
+// --- Import Errors ---
+var (
+	ErrImportRejected = errors.New("import rejected") // At least one row failed; nothing was imported
+)
+
+// --- Tax Errors ---
+var (
+	ErrTaxRateNotFound = errors.New("no tax rate for this tax code and jurisdiction")
+)
+
+// --- Assembly Errors ---
+var (
+	ErrAssemblyOrderNotFound = errors.New("assembly order not found")
+	ErrAssemblyOrderClosed   = errors.New("assembly order is no longer open")
+	ErrNoComponents          = errors.New("item has no components to assemble it from")
+)
+
+// --- Anomaly Errors ---
+var (
+	ErrAnomalyNotFound = errors.New("anomaly not found")
+)
+
+// --- Feature Flag Errors ---
+var (
+	ErrFeatureFlagNotFound = errors.New("feature flag not found")
+)
+
+// --- Comment Errors ---
+var (
+	ErrCommentNotFound       = errors.New("comment not found")
+	ErrNotCommentAuthor      = errors.New("only the author can delete this comment")
+	ErrUnsupportedEntityType = errors.New("unsupported entity type")
+)
+
+// --- Promotion Errors ---
+var (
+	ErrPromotionNotFound = errors.New("promotion not found")
+)
+
+// --- Notification Errors ---
+var (
+	ErrNotificationNotFound = errors.New("notification not found")
+)
+
+// --- Edit Lock Errors ---
+var (
+	ErrItemLocked  = errors.New("item is locked by another user")
+	ErrLockNotHeld = errors.New("lock is not held by this user")
 )
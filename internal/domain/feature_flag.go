@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// FeatureFlag gates a feature that ships dark. A flag is evaluated per subject (the calling
+// user): an override for the subject decides outright; otherwise the flag must be enabled and
+// the subject must fall within the first RolloutPercent of a stable hash of flag and subject.
+type FeatureFlag struct {
+	Key            string          `json:"key" db:"key"`
+	Description    string          `json:"description" db:"description"`
+	Enabled        bool            `json:"enabled" db:"enabled"` // Master switch for the rollout; overrides still apply when off
+	RolloutPercent int             `json:"rollout_percent" db:"rollout_percent"`
+	Overrides      map[string]bool `json:"overrides" db:"overrides"` // Subject -> forced decision
+	UpdatedBy      string          `json:"updated_by" db:"updated_by"`
+	UpdatedAt      time.Time       `json:"updated_at" db:"updated_at"`
+}
+
+// SetFeatureFlagRequest defines the payload for creating or replacing a feature flag.
+type SetFeatureFlagRequest struct {
+	Description    string          `json:"description" validate:"max=500"`
+	Enabled        bool            `json:"enabled"`
+	RolloutPercent int             `json:"rollout_percent" validate:"min=0,max=100"`
+	Overrides      map[string]bool `json:"overrides" validate:"max=1000,dive,keys,required,max=255,endkeys"`
+}
+
+// FeatureFlagRepository defines storage operations for feature flags.
+type FeatureFlagRepository interface {
+	List(ctx context.Context) ([]*FeatureFlag, error)
+	Upsert(ctx context.Context, flag *FeatureFlag) (*FeatureFlag, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// FeatureFlagService defines business logic for feature flags.
+type FeatureFlagService interface {
+	// Enabled reports whether the feature is on for subject. Unknown flags are off.
+	Enabled(ctx context.Context, key, subject string) bool
+	ListFlags(ctx context.Context) ([]*FeatureFlag, error)
+	SetFlag(ctx context.Context, key string, req *SetFeatureFlagRequest, updatedBy string) (*FeatureFlag, error)
+	DeleteFlag(ctx context.Context, key string) error
+}
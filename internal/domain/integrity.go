@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Names of the integrity checks.
+const (
+	IntegrityOrphanedComments      = "orphaned_comments"
+	IntegrityOrphanedNotifications = "orphaned_notifications"
+	IntegrityLedgerMismatches      = "ledger_mismatches"
+	IntegrityUncategorizedItems    = "uncategorized_items"
+	IntegrityStaleReservations     = "stale_reservations"
+	IntegrityTrashedReservations   = "reservations_on_trashed_items"
+)
+
+// IntegrityCheck is the result of one integrity check: how many records it found wanting.
+type IntegrityCheck struct {
+	Name        string `json:"name"` // One of the Integrity* names
+	Description string `json:"description"`
+	Count       int    `json:"count"`
+	Link        string `json:"link,omitempty"` // API path listing the records, where the API has one
+}
+
+// IntegrityReport gathers the results of every integrity check.
+type IntegrityReport struct {
+	CheckedAt time.Time        `json:"checked_at"`
+	Healthy   bool             `json:"healthy"` // No check found anything
+	Checks    []IntegrityCheck `json:"checks"`
+}
+
+// IntegrityCounts holds the raw numbers the integrity checks report.
+type IntegrityCounts struct {
+	OrphanedComments      int // Comments on items that were purged
+	OrphanedNotifications int // Notifications about items, anomalies or discrepancies that no longer exist
+	LedgerMismatches      int // Open ledger discrepancies, as of the last ledger check
+	UncategorizedItems    int
+	StaleReservations     int // Open sales orders placed before the stale cutoff
+	TrashedReservations   int // Open sales orders with a line on a trashed item
+}
+
+// IntegrityQuery defines the query parameters of the integrity report.
+// Fields hold the defaults until bound from the request.
+type IntegrityQuery struct {
+	StaleDays int `query:"stale_days" validate:"min=1,max=365"` // Age from which an open sales order's reservation is stale
+}
+
+// IntegrityRepository counts the records the integrity checks look for.
+type IntegrityRepository interface {
+	Count(ctx context.Context, staleBefore time.Time) (*IntegrityCounts, error)
+}
+
+// IntegrityService defines the interface for the data integrity report.
+type IntegrityService interface {
+	Report(ctx context.Context, q IntegrityQuery) (*IntegrityReport, error)
+}
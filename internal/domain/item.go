@@ -7,15 +7,21 @@ import (
 
 // Item represents an inventory item in the database.
 type Item struct {
-	ID                string    `json:"id" db:"id"`
-	SKU               string    `json:"sku" db:"sku"`
-	Name              string    `json:"name" db:"name"`
-	Description       *string   `json:"description,omitempty" db:"description"` // Pointer for nullable
-	Quantity          int       `json:"quantity" db:"quantity"`
-	Price             float64   `json:"price" db:"price"`                       // Consider using decimal types for money in real apps
-	LowStockThreshold *int      `json:"low_stock_threshold,omitempty" db:"low_stock_threshold"` // Pointer for nullable
-	CreatedAt         time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt         time.Time `json:"updated_at" db:"updated_at"`
+	ID                string           `json:"id" db:"id"`
+	SKU               string           `json:"sku" db:"sku"`
+	Name              string           `json:"name" db:"name"`
+	Description       *string          `json:"description,omitempty" db:"description"` // Pointer for nullable
+	Quantity          int              `json:"quantity" db:"quantity"`
+	Price             float64          `json:"price" db:"price"`                                       // Consider using decimal types for money in real apps
+	LowStockThreshold *int             `json:"low_stock_threshold,omitempty" db:"low_stock_threshold"` // Pointer for nullable
+	CreatedAt         time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt         time.Time        `json:"updated_at" db:"updated_at"`
+	Version           int              `json:"version" db:"version"`                   // Bumped by every write to the item; see UpdateItemRequest.Version
+	CategoryID        *string          `json:"category_id,omitempty" db:"category_id"` // Nil when uncategorized
+	Lock              *EditLock        `json:"lock,omitempty" db:"-"`                  // Current advisory edit lock, filled in by the API layer
+	Promotion         *ActivePromotion `json:"promotion,omitempty" db:"-"`             // Running promotion, filled in by the service layer
+	Match             *SearchMatch     `json:"match,omitempty" db:"-"`                 // How the item matched a search; only in search results
+	DeletedAt         *time.Time       `json:"deleted_at,omitempty" db:"deleted_at"`   // When the item was moved to the trash; only in trash listings
 }
 
 // CreateItemRequest defines the payload for creating a new item.
@@ -38,15 +44,164 @@ type UpdateItemRequest struct {
 	Quantity          *int     `json:"quantity,omitempty" validate:"omitempty,gte=0"`
 	Price             *float64 `json:"price,omitempty" validate:"omitempty,gt=0"`
 	LowStockThreshold *int     `json:"low_stock_threshold,omitempty" validate:"omitempty,gte=0"`
+	// Version is the version of the item the edit is based on. It is required, either here or
+	// as an If-Match header; the update fails with ErrVersionConflict if the item changed since.
+	Version *int `json:"version,omitempty" validate:"omitempty,gte=1"`
 }
 
-// ItemRepository defines the interface for item data storage operations.
+// AdjustQuantityRequest defines the payload for changing an item's quantity by a delta.
+type AdjustQuantityRequest struct {
+	Delta int `json:"delta" validate:"required"` // Positive to add stock, negative to remove it; never zero
+}
+
+// ListItemsQuery defines the query parameters for listing items.
+// Fields hold the defaults until bound from the request.
+type ListItemsQuery struct {
+	Page         int      `query:"page" validate:"min=1"`
+	Limit        int      `query:"limit" validate:"min=1,max=100"`
+	Category     string   `query:"category" validate:"omitempty,uuid"` // Only items in this category or its descendants
+	Supplier     string   `query:"supplier" validate:"omitempty,uuid"` // Only items ever ordered from this supplier
+	MinPrice     *float64 `query:"min_price" validate:"omitempty,gte=0"`
+	MaxPrice     *float64 `query:"max_price" validate:"omitempty,gte=0"`
+	MinQuantity  *int     `query:"min_quantity"`
+	MaxQuantity  *int     `query:"max_quantity"`
+	CreatedSince string   `query:"created_since"`           // RFC 3339 time
+	Sort         string   `query:"sort" validate:"max=200"` // Comma-separated ItemSortFields, each optionally prefixed with - for descending
+	// Search is a full-text search of SKU, name and description in web search syntax: words,
+	// "quoted phrases", or, and -excluded words. Matches come best first unless Sort is given.
+	Search    string `query:"search" validate:"omitempty,max=200"`
+	Highlight bool   `query:"highlight"` // With Search: mark the matches in Item.Match
+}
+
+// ItemSortFields are the fields items can be sorted by.
+var ItemSortFields = []string{"sku", "name", "price", "quantity", "created_at", "updated_at"}
+
+// ItemSort is one key of a sort order.
+type ItemSort struct {
+	Field string // One of ItemSortFields
+	Desc  bool
+}
+
+// ItemFilter restricts and orders an item listing. Zero fields do not filter.
+type ItemFilter struct {
+	CategoryID   string // Items in this category or its descendants
+	SupplierID   string // Items on a purchase order of this supplier
+	MinPrice     *float64
+	MaxPrice     *float64
+	MinQuantity  *int
+	MaxQuantity  *int
+	CreatedSince *time.Time
+	Sort         []ItemSort // Newest first when empty (best match first for searches)
+}
+
+// ItemSearch is a full-text search of items; see ListItemsQuery.Search.
+type ItemSearch struct {
+	Text      string
+	Highlight bool // Fill in SearchMatch.Name and SearchMatch.Description
+}
+
+// SearchMatch tells how well an item matched a search and, if asked for, where. Marked text
+// is HTML-escaped, and the marks are its only markup, so it can be rendered as HTML as is.
+type SearchMatch struct {
+	Rank        float64 `json:"rank"`                  // Higher is better; SKU matches weigh most, then name, then description
+	Name        *string `json:"name,omitempty"`        // Name with the matched words wrapped in <mark></mark>
+	Description *string `json:"description,omitempty"` // Best fragments of the description, marked likewise
+}
+
+// Formats of an item export.
+const (
+	ExportFormatCSV  = "csv"
+	ExportFormatXLSX = "xlsx"
+)
+
+// ExportItemsQuery defines the query parameters for exporting items. The filters, sort order
+// and search are those of ListItemsQuery; its Page, Limit and Highlight are not used.
+type ExportItemsQuery struct {
+	ListItemsQuery
+	Format string `query:"format" validate:"oneof=csv xlsx"`
+}
+
+// LowStockQuery defines the query parameters for the low stock report.
+type LowStockQuery struct {
+	GlobalThreshold int `query:"global_threshold" validate:"gte=0"` // Used for items without their own threshold
+}
+
+// MostValuableQuery defines the query parameters for the most valuable items report.
+type MostValuableQuery struct {
+	Limit int `query:"limit" validate:"min=1,max=50"`
+}
+
+// ListTrashQuery defines the query parameters for listing trashed items.
+type ListTrashQuery struct {
+	Page  int `query:"page" validate:"min=1"`
+	Limit int `query:"limit" validate:"min=1,max=100"`
+}
+
+// ItemChangesQuery defines the query parameters of the item change feed.
+// A sync starts from Since (or from the beginning) and then follows NextCursor.
+type ItemChangesQuery struct {
+	Since  string `query:"since"`  // RFC 3339 time; only used without a cursor
+	Cursor string `query:"cursor"` // NextCursor of the previous page
+	Limit  int    `query:"limit" validate:"min=1,max=1000"`
+}
+
+// ItemChangesPage is one page of the item change feed: items changed after the cursor,
+// in (updated_at, id) order, with their running promotions.
+type ItemChangesPage struct {
+	Items      []*Item `json:"items"`
+	NextCursor string  `json:"next_cursor"` // Store it, and resume from it after a restart
+	HasMore    bool    `json:"has_more"`    // More changes are ready; fetch again now rather than waiting
+}
+
+// ItemChangeCursor is the position of a sync in the change feed: the last item it received.
+type ItemChangeCursor struct {
+	UpdatedAt time.Time
+	ID        string
+}
+
+// ItemOption is the minimal view of an item used to populate select inputs.
+type ItemOption struct {
+	ID   string `json:"id"`
+	SKU  string `json:"sku"`
+	Name string `json:"name"`
+}
+
+// ItemRepository defines the interface for item data storage operations. Every method that
+// writes an item records an ItemRevision of it in the same transaction; userID, which may
+// be empty, is who made the change.
 type ItemRepository interface {
-	Create(ctx context.Context, item *Item) (*Item, error)
+	Create(ctx context.Context, item *Item, userID string) (*Item, error)
 	GetByID(ctx context.Context, id string) (*Item, error)
-	GetAll(ctx context.Context, page, limit int) ([]*Item, int, error) // Returns items and total count for pagination
-	Update(ctx context.Context, id string, item *Item) (*Item, error)
-	Delete(ctx context.Context, id string) error
+	GetBySKU(ctx context.Context, sku string) (*Item, error)
+	// GetAll returns a page of the items passing filter, in its order, and their total count.
+	GetAll(ctx context.Context, filter ItemFilter, page, limit int) ([]*Item, int, error)
+	// StreamAll calls fn with every item, newest first, without loading them all at once.
+	// An error from fn stops the scan and is returned unwrapped.
+	StreamAll(ctx context.Context, fn func(*Item) error) error
+	// StreamMatching is StreamAll restricted to the items passing filter and, unless search is
+	// empty, matching the full-text search, in the order GetAll or Search would list them.
+	StreamMatching(ctx context.Context, search string, filter ItemFilter, fn func(*Item) error) error
+	// Search is GetAll restricted to the items matching a full-text search, with Match filled
+	// in. Without a sort order in filter, the best match comes first.
+	Search(ctx context.Context, search ItemSearch, filter ItemFilter, page, limit int) ([]*Item, int, error)
+	// Update writes the changed fields of item if the stored item is still at item.Version,
+	// and returns ErrVersionConflict otherwise. A changed quantity is also recorded in the
+	// movement ledger.
+	Update(ctx context.Context, id string, item *Item, userID string) (*Item, error)
+	// Delete moves an item to the trash, after which no other method sees it but ListTrash,
+	// Restore and Purge.
+	Delete(ctx context.Context, id, userID string) error
+	ListTrash(ctx context.Context, page, limit int) ([]*Item, int, error) // Most recently deleted first, with the total count
+	Restore(ctx context.Context, id, userID string) (*Item, error)        // Takes an item out of the trash
+	Purge(ctx context.Context, id string) error                           // Deletes a trashed item and its history for good; ErrItemInUse if still referenced
+	// AdjustQuantity atomically adds delta to the quantity, recording it in the movement ledger
+	// as moved by userID. It returns ErrInsufficientStock, and changes
+	// nothing, if the result would be negative or below what open sales orders reserve.
+	AdjustQuantity(ctx context.Context, id string, delta int, userID string) (*Item, error)
+	ListOptions(ctx context.Context) ([]ItemOption, error) // Every item, ordered by SKU
+	// GetChangedAfter returns up to limit items positioned after the cursor in (updated_at, id)
+	// order, leaving out changes younger than settle, whose transactions may still be committing.
+	GetChangedAfter(ctx context.Context, after ItemChangeCursor, settle time.Duration, limit int) ([]*Item, error)
 	// For analytics (can be in a separate repository or here for simplicity)
 	GetTotalStockValue(ctx context.Context) (float64, error)
 	GetLowStockItems(ctx context.Context, globalThreshold int) ([]*Item, error)
@@ -55,11 +210,27 @@ type ItemRepository interface {
 
 // ItemService defines the interface for item business logic.
 type ItemService interface {
-	CreateItem(ctx context.Context, req *CreateItemRequest) (*Item, error)
+	CreateItem(ctx context.Context, req *CreateItemRequest, userID string) (*Item, error) // userID may be empty; it is only recorded in the history
 	GetItemByID(ctx context.Context, id string) (*Item, error)
-	GetItems(ctx context.Context, page, limit int) ([]*Item, int, error)
-	UpdateItem(ctx context.Context, id string, req *UpdateItemRequest) (*Item, error)
-	DeleteItem(ctx context.Context, id string) error
+	GetItemBySKU(ctx context.Context, sku string) (*Item, error)
+	GetItems(ctx context.Context, query ListItemsQuery) ([]*Item, int, error)                  // Filtered, sorted or searched as the query says
+	StreamItems(ctx context.Context, fn func(*Item) error) error                               // Every item, in GetItems order
+	StreamMatchingItems(ctx context.Context, query ListItemsQuery, fn func(*Item) error) error // Every page GetItems would list; Page, Limit and Highlight do not apply
+	GetItemChanges(ctx context.Context, query ItemChangesQuery) (*ItemChangesPage, error)
+	UpdateItem(ctx context.Context, id string, req *UpdateItemRequest, userID string) (*Item, error)
+	DeleteItem(ctx context.Context, id, userID string) error // Moves the item to the trash
+	ListTrash(ctx context.Context, query ListTrashQuery) ([]*Item, int, error)
+	RestoreItem(ctx context.Context, id, userID string) (*Item, error)
+	PurgeItem(ctx context.Context, id string) error // Only trashed items can be purged
+	AdjustQuantity(ctx context.Context, id string, delta int, userID string) (*Item, error)
+	ListItemOptions(ctx context.Context) ([]ItemOption, error)
+	GetItemHistory(ctx context.Context, id string) ([]*ItemRevision, error) // Oldest first
+}
+
+// ItemChangePublisher announces that items were changed outside the item repository
+// (e.g. by bulk repricing or stock adjustment batches), so caches of them can be dropped.
+type ItemChangePublisher interface {
+	PublishItemChanged(ctx context.Context, itemIDs ...string)
 }
 
 // AnalyticsService defines the interface for analytics logic.
@@ -71,12 +242,30 @@ type AnalyticsService interface {
 
 // WebSocketMessage for real-time updates
 type WebSocketMessage struct {
-	Type    string      `json:"type"`
-	Payload interface{} `json:"payload"`
+	Type      string      `json:"type"`
+	Payload   interface{} `json:"payload"`
+	RequestID string      `json:"request_id,omitempty"` // HTTP request that caused the event, for tracing
+
+	// SchemaVersion is the version of Payload's schema in internal/eventschema, stamped by the
+	// hub on everything it sends. Clients may leave it out of the messages they send.
+	SchemaVersion int `json:"schema_version,omitempty"`
 }
 
 const (
-	StockUpdateMessageType = "STOCK_UPDATE"
+	StockUpdateMessageType      = "STOCK_UPDATE"
+	StockBatchUpdateMessageType = "STOCK_BATCH_UPDATE" // Payload is a StockBatchUpdatePayload; one per adjustment batch
+	NotificationMessageType     = "NOTIFICATION"       // Payload is a Notification; sent only to its recipient
+	PresenceMessageType         = "PRESENCE"           // Client -> server: payload is a PresenceReport
+	PresenceUpdateMessageType   = "PRESENCE_UPDATE"    // Server -> clients: payload is a PresenceUpdatePayload
+	LockHeartbeatMessageType    = "LOCK_HEARTBEAT"     // Client -> server: payload is a LockHeartbeat
+	HeartbeatMessageType        = "HEARTBEAT"          // Server -> client: payload is a Heartbeat; answer with HEARTBEAT_ACK
+	HeartbeatAckMessageType     = "HEARTBEAT_ACK"      // Client -> server: payload is a HeartbeatAck
+)
+
+// Presence modes reported by clients.
+const (
+	PresenceModeViewing = "viewing"
+	PresenceModeEditing = "editing"
 )
 
 type StockUpdatePayload struct {
@@ -84,3 +273,60 @@ type StockUpdatePayload struct {
 	SKU         string `json:"sku"`
 	NewQuantity int    `json:"new_quantity"`
 }
+
+// StockBatchUpdatePayload carries the new quantity of every item changed by one adjustment batch.
+type StockBatchUpdatePayload struct {
+	BatchID string               `json:"batch_id"`
+	Updates []StockUpdatePayload `json:"updates"`
+}
+
+// PresenceReport is sent by a client to announce which item it is looking at.
+// An empty ItemID means the client left the item it was on.
+type PresenceReport struct {
+	ItemID string `json:"item_id"`
+	Mode   string `json:"mode"` // PresenceModeViewing or PresenceModeEditing
+}
+
+// PresenceEntry describes one user currently on an item.
+type PresenceEntry struct {
+	UserID string    `json:"user_id"`
+	Mode   string    `json:"mode"`
+	Since  time.Time `json:"since"`
+}
+
+// PresenceUpdatePayload is broadcast whenever the set of users on an item changes.
+type PresenceUpdatePayload struct {
+	ItemID string          `json:"item_id"`
+	Users  []PresenceEntry `json:"users"`
+}
+
+// LockHeartbeat is sent by a client holding an edit lock to keep it alive.
+type LockHeartbeat struct {
+	ItemID string `json:"item_id"`
+}
+
+// Heartbeat is sent periodically to every client. Clients echo Seq back in a HeartbeatAck
+// as soon as they process it, which measures the round trip through their event loop
+// (protocol-level pings are answered by the browser and miss a busy or throttled tab).
+type Heartbeat struct {
+	Seq int64 `json:"seq"`
+}
+
+// HeartbeatAck answers the Heartbeat with the same Seq.
+type HeartbeatAck struct {
+	Seq int64 `json:"seq"`
+}
+
+// WebSocketClient describes one open WebSocket connection, for operators.
+// Latency fields are absent until the client has answered a heartbeat.
+type WebSocketClient struct {
+	RemoteAddr     string     `json:"remote_addr"`
+	UserID         string     `json:"user_id,omitempty"`
+	Subprotocol    string     `json:"subprotocol,omitempty"`
+	ConnectedAt    time.Time  `json:"connected_at"`
+	QueuedMessages int        `json:"queued_messages"`       // Waiting in the client's send queue
+	LastRTTMillis  *float64   `json:"last_rtt_ms,omitempty"` // Most recent heartbeat round trip
+	AvgRTTMillis   *float64   `json:"avg_rtt_ms,omitempty"`  // Moving average, weighted to recent heartbeats
+	LastAckAt      *time.Time `json:"last_heartbeat_ack_at,omitempty"`
+	MissedAcks     int        `json:"missed_heartbeat_acks"` // Heartbeats superseded before being answered
+}
@@ -0,0 +1,75 @@
+package domain
+
+import "context"
+
+// Modes of an item import.
+const (
+	ImportModeInsert = "insert" // Every row creates an item; a row with a known SKU is rejected
+	ImportModeUpsert = "upsert" // A row with a known SKU updates that item
+)
+
+// MaxImportRows is the largest number of rows accepted in one import file.
+const MaxImportRows = 10000
+
+// MovementReasonImport is recorded for quantities set by an item import. The movements carry
+// the import ID as their reference.
+const MovementReasonImport = "import"
+
+// ImportItemsQuery defines the query parameters of an item import.
+type ImportItemsQuery struct {
+	Mode string `query:"mode" validate:"oneof=insert upsert"`
+}
+
+// ImportRow is a row of an import file that passed validation.
+type ImportRow struct {
+	Line int               // Line of the row in the file, counting the header as line 1
+	Item CreateItemRequest // Validated with the rules of POST /items
+	// QuantitySet tells whether the quantity cell was filled. An upsert leaves the quantity of
+	// an existing item alone otherwise, as it does its description and low stock threshold
+	// when they are nil.
+	QuantitySet bool
+}
+
+// ImportRowError reports why a row of an import file was rejected.
+type ImportRowError struct {
+	Line   int               `json:"line"`
+	SKU    string            `json:"sku,omitempty"`
+	Errors map[string]string `json:"errors"` // By column
+}
+
+// ItemImport is a parsed import file: the rows to import and the rows already rejected.
+type ItemImport struct {
+	Mode     string
+	Rows     []ImportRow
+	Rejected []ImportRowError
+}
+
+// ItemImportResult reports the outcome of an item import. Imports are all or nothing: when
+// any row is rejected, Errors lists every rejected row and nothing is written.
+type ItemImportResult struct {
+	ImportID string           `json:"import_id,omitempty"` // Reference of the ledger rows; empty when nothing was written
+	Mode     string           `json:"mode"`
+	Rows     int              `json:"rows"`
+	Created  int              `json:"created"`
+	Updated  int              `json:"updated"`
+	Errors   []ImportRowError `json:"errors"`
+}
+
+// ItemImportRepository defines storage operations for item imports.
+type ItemImportRepository interface {
+	// ExistingSKUs returns which of the given SKUs belong to an item, trashed ones included.
+	ExistingSKUs(ctx context.Context, skus []string) (map[string]bool, error)
+	// Import writes the rows in batches within one transaction. With upsert, rows whose SKU is
+	// known update that item, restoring it if trashed; otherwise every row is inserted, and ErrRepositoryDuplicateEntry
+	// is returned, writing nothing, if a SKU is taken. ErrInsufficientStock is returned, writing
+	// nothing, if a row lowers an item's quantity below the units reserved by open sales orders.
+	// Quantity changes are recorded in the movement ledger with importID as reference.
+	Import(ctx context.Context, rows []ImportRow, upsert bool, importID, userID string) (created, updated []*Item, err error)
+}
+
+// ItemImportService defines business logic for importing items from files.
+type ItemImportService interface {
+	// ImportItems imports the rows of imp. It returns ErrImportRejected with the report if
+	// any row is rejected.
+	ImportItems(ctx context.Context, imp *ItemImport, userID string) (*ItemImportResult, error)
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Item revision actions.
+const (
+	ItemRevisionCreated  = "created"
+	ItemRevisionUpdated  = "updated" // Also for quantity changes, whose reasons are in the movement ledger
+	ItemRevisionDeleted  = "deleted" // Moved to the trash
+	ItemRevisionRestored = "restored"
+)
+
+// FieldChange is the change of one item field in a revision. Old is nil for a created item,
+// and either side is nil for a nullable field that was or became unset.
+type FieldChange struct {
+	Field string `json:"field"` // JSON name of the field, e.g. "low_stock_threshold", or "tax_code"
+	Old   any    `json:"old"`
+	New   any    `json:"new"`
+}
+
+// ItemRevision records one change made to an item. Every repository method that writes an
+// item records one in the same transaction, whichever endpoint called it: item edits, stock
+// movements, imports, repricing, category and tax code assignment, reconciliations.
+type ItemRevision struct {
+	ID        string        `json:"id" db:"id"`
+	ItemID    string        `json:"item_id" db:"item_id"`
+	Action    string        `json:"action" db:"action"`                   // One of the ItemRevision* actions
+	Changes   []FieldChange `json:"changes" db:"changes"`                 // Empty for deletions and restores
+	ChangedBy *string       `json:"changed_by,omitempty" db:"changed_by"` // Who made the change, if known
+	ChangedAt time.Time     `json:"changed_at" db:"changed_at"`
+}
+
+// ItemRevisionRepository defines storage for item revisions. They are written by the
+// repositories that change items.
+type ItemRevisionRepository interface {
+	ListByItem(ctx context.Context, itemID string) ([]*ItemRevision, error) // Oldest first
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Statuses of a label print job.
+const (
+	LabelJobStatusQueued   = "queued"
+	LabelJobStatusPrinting = "printing" // Claimed by the station's agent
+	LabelJobStatusPrinted  = "printed"
+	LabelJobStatusFailed   = "failed"
+)
+
+// LabelJobsQueuedMessageType tells station agents connected over WebSocket that jobs are
+// waiting for them, so they need not poll. Payload is a LabelJobsQueuedPayload.
+const LabelJobsQueuedMessageType = "LABEL_JOBS_QUEUED"
+
+// LabelJob prints Copies labels of an item at a station.
+type LabelJob struct {
+	ID          string     `json:"id" db:"id"`
+	Station     string     `json:"station" db:"station"`
+	ItemID      string     `json:"item_id" db:"item_id"`
+	SKU         string     `json:"sku" db:"sku"`   // Of the item, for the label
+	Name        string     `json:"name" db:"name"` // Of the item, for the label
+	Copies      int        `json:"copies" db:"copies"`
+	Reference   *string    `json:"reference,omitempty" db:"reference"`
+	Status      string     `json:"status" db:"status"`
+	Error       *string    `json:"error,omitempty" db:"error"`
+	CreatedBy   string     `json:"created_by" db:"created_by"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+	ClaimedAt   *time.Time `json:"claimed_at,omitempty" db:"claimed_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty" db:"completed_at"`
+}
+
+// EnqueueLabelsRequest defines the payload for queueing labels of items at a station.
+type EnqueueLabelsRequest struct {
+	Station   string             `json:"station" validate:"required,alphanumdash,max=50"`
+	Reference *string            `json:"reference,omitempty" validate:"omitempty,max=100"` // E.g. a purchase order number
+	Items     []LabelRequestLine `json:"items" validate:"required,min=1,max=500,dive"`
+}
+
+// LabelRequestLine is a line of EnqueueLabelsRequest; it becomes one job.
+type LabelRequestLine struct {
+	ItemID string `json:"item_id" validate:"required,uuid"`
+	Copies int    `json:"copies" validate:"required,min=1,max=1000"`
+}
+
+// ClaimLabelJobsQuery defines the query parameters with which a station agent claims jobs.
+type ClaimLabelJobsQuery struct {
+	Station string `query:"station" validate:"required,alphanumdash,max=50"`
+	Limit   int    `query:"limit" validate:"min=1,max=100"`
+}
+
+// CompleteLabelJobRequest defines the payload with which an agent reports a claimed job done.
+type CompleteLabelJobRequest struct {
+	Status string `json:"status" validate:"required,oneof=printed failed"`
+	Error  string `json:"error,omitempty" validate:"max=500"` // Why printing failed
+}
+
+// ListLabelJobsQuery defines the query parameters for listing label jobs.
+type ListLabelJobsQuery struct {
+	Station string `query:"station" validate:"omitempty,alphanumdash,max=50"`
+	Status  string `query:"status" validate:"omitempty,oneof=queued printing printed failed"`
+	Limit   int    `query:"limit" validate:"min=1,max=200"`
+}
+
+// LabelJobsQueuedPayload announces queued jobs to the agent of a station.
+type LabelJobsQueuedPayload struct {
+	Station string `json:"station"`
+	Jobs    int    `json:"jobs"` // Number of jobs just queued
+}
+
+// LabelJobRepository defines storage operations for the label print queue.
+type LabelJobRepository interface {
+	// Enqueue stores the jobs, all or none. It returns ErrRepositoryNotFound if an item does not exist.
+	Enqueue(ctx context.Context, jobs []*LabelJob) ([]*LabelJob, error)
+	List(ctx context.Context, q ListLabelJobsQuery) ([]*LabelJob, error) // Newest first
+	// Claim marks up to limit of the station's oldest waiting jobs as printing and returns
+	// them. Jobs claimed longer than reclaimAfter ago without being completed wait again.
+	// Concurrent claims never return the same job.
+	Claim(ctx context.Context, station string, limit int, reclaimAfter time.Duration) ([]*LabelJob, error)
+	// Complete closes a printing job. It returns ErrLabelJobStatus if the job is not printing.
+	Complete(ctx context.Context, id, status string, errMsg *string) (*LabelJob, error)
+}
+
+// LabelService defines business logic for the label print queue.
+type LabelService interface {
+	EnqueueLabels(ctx context.Context, req *EnqueueLabelsRequest, userID string) ([]*LabelJob, error)
+	ListJobs(ctx context.Context, q ListLabelJobsQuery) ([]*LabelJob, error)
+	ClaimJobs(ctx context.Context, q ClaimLabelJobsQuery) ([]*LabelJob, error)
+	CompleteJob(ctx context.Context, id string, req *CompleteLabelJobRequest) (*LabelJob, error)
+}
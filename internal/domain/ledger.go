@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// How a ledger discrepancy was resolved. The first two are chosen by whoever reconciles it.
+const (
+	LedgerKeepQuantity    = "quantity" // A reconciliation movement brought the ledger in line with the item's quantity
+	LedgerKeepLedger      = "ledger"   // The item's quantity was set to the sum of its ledger
+	LedgerResolvedCleared = "cleared"  // A later check found the two in agreement again
+)
+
+// LedgerDiscrepancyEntityType is the entity type of notifications about a ledger discrepancy.
+const LedgerDiscrepancyEntityType = "ledger_discrepancy"
+
+// LedgerDiscrepancy is an item whose quantity differs from the sum of its stock movements,
+// which the movement ledger promises never happens (see StockMovement). Discrepancies are
+// found by the ledger check job and stay open until reconciled or cleared; an item has at
+// most one open discrepancy, updated by every check that still finds it.
+type LedgerDiscrepancy struct {
+	ID             string     `json:"id" db:"id"`
+	ItemID         string     `json:"item_id" db:"item_id"`
+	SKU            string     `json:"sku" db:"-"`
+	Quantity       int        `json:"quantity" db:"quantity"`               // Quantity of the item at the last check, or when resolved
+	LedgerQuantity int        `json:"ledger_quantity" db:"ledger_quantity"` // Sum of its movement deltas at the same time
+	Difference     int        `json:"difference" db:"-"`                    // Quantity - LedgerQuantity
+	DetectedAt     time.Time  `json:"detected_at" db:"detected_at"`
+	CheckedAt      time.Time  `json:"checked_at" db:"checked_at"` // Last check that found it
+	ResolvedAt     *time.Time `json:"resolved_at,omitempty" db:"resolved_at"`
+	ResolvedBy     *string    `json:"resolved_by,omitempty" db:"resolved_by"` // Nil when cleared
+	Resolution     *string    `json:"resolution,omitempty" db:"resolution"`   // LedgerKeep* or LedgerResolvedCleared
+	MovementID     *string    `json:"movement_id,omitempty" db:"movement_id"` // Reconciliation movement, when the quantity was kept
+	Note           string     `json:"note,omitempty" db:"note"`
+}
+
+// LedgerCheckResult reports one run of the ledger check.
+type LedgerCheckResult struct {
+	Checked       int                  `json:"checked"`       // Items compared with their ledger
+	Discrepancies int                  `json:"discrepancies"` // Items found out of line, including ones already open
+	New           []*LedgerDiscrepancy `json:"new"`           // Discrepancies not open before this run
+	Cleared       int                  `json:"cleared"`       // Open discrepancies no longer found
+}
+
+// ListLedgerDiscrepanciesQuery defines the query parameters for listing ledger discrepancies.
+type ListLedgerDiscrepanciesQuery struct {
+	Open  bool `query:"open"` // Only return discrepancies not yet resolved
+	Limit int  `query:"limit" validate:"min=1,max=200"`
+}
+
+// ReconcileLedgerRequest defines the payload for resolving a ledger discrepancy.
+type ReconcileLedgerRequest struct {
+	Keep string `json:"keep" validate:"required,oneof=quantity ledger"` // Which side is right
+	Note string `json:"note,omitempty" validate:"max=500"`
+}
+
+// LedgerRepository defines storage operations for ledger checks.
+type LedgerRepository interface {
+	// Check compares every item that is not in the trash with the sum of its movements and
+	// records what it finds: each item out of line updates its open discrepancy or opens a
+	// new one, and open discrepancies of items now in line are cleared.
+	Check(ctx context.Context) (*LedgerCheckResult, error)
+	List(ctx context.Context, q ListLedgerDiscrepanciesQuery) ([]*LedgerDiscrepancy, error)
+	// Reconcile resolves an open discrepancy as keep says, using the item's quantity and
+	// ledger as they are under its row lock rather than as they were when checked. It returns
+	// ErrDiscrepancyResolved if the discrepancy is no longer open, and ErrInsufficientStock
+	// if keeping the ledger would leave the item below zero or below its reserved units.
+	Reconcile(ctx context.Context, id, keep, note, userID string) (*LedgerDiscrepancy, error)
+}
+
+// LedgerService checks item quantities against the movement ledger and reconciles the two.
+type LedgerService interface {
+	// Check compares every item with its ledger. New discrepancies are notified.
+	Check(ctx context.Context) (*LedgerCheckResult, error)
+	ListDiscrepancies(ctx context.Context, q ListLedgerDiscrepanciesQuery) ([]*LedgerDiscrepancy, error)
+	Reconcile(ctx context.Context, id string, req *ReconcileLedgerRequest, userID string) (*LedgerDiscrepancy, error)
+}
@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// EditLock is an advisory lock a user takes on an item while editing it.
+// It is not enforced on writes; clients use it to warn users before they overwrite each other.
+type EditLock struct {
+	ItemID     string    `json:"item_id"`
+	UserID     string    `json:"user_id"`
+	AcquiredAt time.Time `json:"acquired_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// EditLockService manages advisory edit locks.
+type EditLockService interface {
+	Acquire(ctx context.Context, itemID, userID string) (*EditLock, error) // Re-acquiring your own lock extends it
+	Release(ctx context.Context, itemID, userID string) error
+	Refresh(itemID, userID string) (*EditLock, error) // Called from WebSocket heartbeats
+	Get(itemID string) *EditLock                      // Nil if the item is not locked
+}
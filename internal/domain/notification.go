@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Notification types.
+const (
+	NotificationTypeMention = "mention"            // The user was @mentioned in a comment
+	NotificationTypeAnomaly = "anomaly"            // A stock adjustment was flagged for loss prevention
+	NotificationTypeLedger  = "ledger_discrepancy" // An item's quantity no longer matches its movement ledger
+)
+
+// Notification is an entry in a user's in-app inbox.
+type Notification struct {
+	ID         string     `json:"id" db:"id"`
+	UserID     string     `json:"user_id" db:"user_id"`
+	Type       string     `json:"type" db:"type"`
+	Message    string     `json:"message" db:"message"`
+	EntityType *string    `json:"entity_type,omitempty" db:"entity_type"` // What the notification is about, if anything
+	EntityID   *string    `json:"entity_id,omitempty" db:"entity_id"`
+	ReadAt     *time.Time `json:"read_at,omitempty" db:"read_at"` // Nil while unread
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+}
+
+// ListNotificationsQuery defines the query parameters for listing a user's notifications.
+type ListNotificationsQuery struct {
+	Unread bool `query:"unread"` // Only return unread notifications
+	Limit  int  `query:"limit" validate:"min=1,max=200"`
+}
+
+// NotificationRepository defines storage operations for notifications.
+type NotificationRepository interface {
+	Create(ctx context.Context, n *Notification) (*Notification, error)
+	ListByUser(ctx context.Context, userID string, unreadOnly bool, limit int) ([]*Notification, error)
+	CountUnread(ctx context.Context, userID string) (int, error)
+	MarkRead(ctx context.Context, userID, id string) (*Notification, error)
+	MarkAllRead(ctx context.Context, userID string) (int, error) // Returns the number of notifications marked
+}
+
+// NotificationService defines business logic for the notification inbox.
+// It also receives @mentions from comments.
+type NotificationService interface {
+	MentionNotifier
+	Notify(ctx context.Context, n *Notification) (*Notification, error)
+	ListNotifications(ctx context.Context, userID string, unreadOnly bool, limit int) ([]*Notification, int, error) // Returns notifications and unread count
+	MarkRead(ctx context.Context, userID, id string) (*Notification, error)
+	MarkAllRead(ctx context.Context, userID string) (int, error)
+}
+
+// UserMessageSender delivers a real-time message to every connection of one user. It needs
+// connections whose user is authenticated, so nothing implements it until authentication exists.
+type UserMessageSender interface {
+	SendToUser(userID string, msg WebSocketMessage)
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Price tiers. Every customer is in one; retail is the default.
+const (
+	PriceTierRetail    = "retail"
+	PriceTierWholesale = "wholesale"
+	PriceTierContract  = "contract"
+)
+
+// Where a resolved price comes from.
+const (
+	PriceSourceBase         = "base"          // The item's own price; the tier has no rule for it
+	PriceSourceTierPrice    = "tier_price"    // A fixed price for the tier
+	PriceSourceTierDiscount = "tier_discount" // A discount off the item's price for the tier
+)
+
+// TierPrice is the price rule of an item for one tier. Exactly one of Price and
+// DiscountPercent is set.
+type TierPrice struct {
+	ItemID          string    `json:"item_id" db:"item_id"`
+	Tier            string    `json:"tier" db:"tier"`
+	Price           *float64  `json:"price,omitempty" db:"price"`
+	DiscountPercent *float64  `json:"discount_percent,omitempty" db:"discount_percent"`
+	UpdatedAt       time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// SetTierPriceRequest defines the payload for setting the price rule of an item for a tier.
+// Give either a price or a discount, not both.
+type SetTierPriceRequest struct {
+	Price           *float64 `json:"price,omitempty" validate:"omitempty,gt=0"`
+	DiscountPercent *float64 `json:"discount_percent,omitempty" validate:"omitempty,gt=0,lte=100"`
+}
+
+// ResolvePriceQuery defines the query parameters for resolving the price of an item.
+type ResolvePriceQuery struct {
+	Customer string `query:"customer" validate:"omitempty,uuid"` // Omit for the retail price
+}
+
+// ResolvedPrice is what a customer pays for one unit of an item.
+type ResolvedPrice struct {
+	ItemID     string  `json:"item_id"`
+	CustomerID string  `json:"customer_id,omitempty"`
+	Tier       string  `json:"tier"`
+	BasePrice  float64 `json:"base_price"` // The item's own price
+	Price      float64 `json:"price"`
+	Source     string  `json:"source"` // One of the PriceSource* values
+}
+
+// PriceTierRepository defines storage operations for tier price rules.
+type PriceTierRepository interface {
+	ListForItem(ctx context.Context, itemID string) ([]*TierPrice, error) // Ordered by tier
+	// Get returns the rule of an item for a tier, or ErrRepositoryNotFound if it has none.
+	Get(ctx context.Context, itemID, tier string) (*TierPrice, error)
+	Set(ctx context.Context, tp *TierPrice) (*TierPrice, error)
+	Delete(ctx context.Context, itemID, tier string) error
+}
+
+// PriceTierService defines business logic for tier pricing.
+type PriceTierService interface {
+	ListTierPrices(ctx context.Context, itemID string) ([]*TierPrice, error)
+	SetTierPrice(ctx context.Context, itemID, tier string, req *SetTierPriceRequest) (*TierPrice, error)
+	DeleteTierPrice(ctx context.Context, itemID, tier string) error
+	// ResolvePrice returns what the customer pays for the item, given the customer's tier.
+	// An empty customerID resolves the retail price.
+	ResolvePrice(ctx context.Context, itemID, customerID string) (*ResolvedPrice, error)
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Bulk price operations.
+const (
+	PriceOpSet             = "set"              // Value is the new price
+	PriceOpIncreasePercent = "increase_percent" // Value is the percentage to add
+	PriceOpDecreasePercent = "decrease_percent" // Value is the percentage to take off
+	PriceOpRound99         = "round_99"         // Value is ignored; prices become the nearest X.99
+)
+
+// PriceFilter selects the items a bulk price update applies to.
+// Criteria are combined with AND; at least one must be given.
+type PriceFilter struct {
+	ItemIDs   []string `json:"item_ids,omitempty" validate:"omitempty,max=1000,dive,uuid"`
+	SKUPrefix string   `json:"sku_prefix,omitempty" validate:"omitempty,max=100"`
+}
+
+// BulkPriceUpdateRequest defines the payload for repricing many items at once.
+type BulkPriceUpdateRequest struct {
+	Filter    PriceFilter `json:"filter"`
+	Operation string      `json:"operation" validate:"required,oneof=set increase_percent decrease_percent round_99"`
+	Value     float64     `json:"value" validate:"gte=0"`
+	DryRun    bool        `json:"dry_run"` // Preview the changes without saving them
+}
+
+// PriceChange is the repricing of one item.
+type PriceChange struct {
+	ItemID   string  `json:"item_id"`
+	SKU      string  `json:"sku"`
+	OldPrice float64 `json:"old_price"`
+	NewPrice float64 `json:"new_price"`
+}
+
+// BulkPriceUpdateResult reports what a bulk price update changed (or would change, for a dry run).
+type BulkPriceUpdateResult struct {
+	DryRun  bool          `json:"dry_run"`
+	Matched int           `json:"matched"` // Items selected by the filter, including unchanged ones
+	Changes []PriceChange `json:"changes"`
+}
+
+// PriceHistoryEntry records one price change of an item.
+type PriceHistoryEntry struct {
+	ID        string    `json:"id" db:"id"`
+	ItemID    string    `json:"item_id" db:"item_id"`
+	OldPrice  float64   `json:"old_price" db:"old_price"`
+	NewPrice  float64   `json:"new_price" db:"new_price"`
+	Reason    string    `json:"reason" db:"reason"`
+	ChangedBy string    `json:"changed_by" db:"changed_by"`
+	ChangedAt time.Time `json:"changed_at" db:"changed_at"`
+}
+
+// PriceRepository defines storage operations for prices and their history.
+type PriceRepository interface {
+	// ApplyPriceChanges reprices every item matching filter in a single transaction and
+	// records a history entry for each changed price. With dryRun nothing is saved.
+	ApplyPriceChanges(ctx context.Context, filter PriceFilter, reprice func(old float64) float64,
+		reason, changedBy string, dryRun bool) (matched int, changes []PriceChange, err error)
+	ListHistory(ctx context.Context, itemID string) ([]*PriceHistoryEntry, error)
+}
+
+// PricingService defines business logic for price management.
+type PricingService interface {
+	BulkUpdatePrices(ctx context.Context, req *BulkPriceUpdateRequest, changedBy string) (*BulkPriceUpdateResult, error)
+	GetPriceHistory(ctx context.Context, itemID string) ([]*PriceHistoryEntry, error)
+}
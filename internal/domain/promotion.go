@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Promotion temporarily overrides the price of a set of items between StartsAt and EndsAt.
+type Promotion struct {
+	ID        string          `json:"id" db:"id"`
+	Name      string          `json:"name" db:"name"`
+	StartsAt  time.Time       `json:"starts_at" db:"starts_at"`
+	EndsAt    time.Time       `json:"ends_at" db:"ends_at"`
+	Items     []PromotionItem `json:"items" db:"-"`
+	CreatedBy string          `json:"created_by" db:"created_by"`
+	CreatedAt time.Time       `json:"created_at" db:"created_at"`
+}
+
+// PromotionItem is the promotional price of one item.
+type PromotionItem struct {
+	ItemID string  `json:"item_id" db:"item_id" validate:"required,uuid"`
+	Price  float64 `json:"price" db:"price" validate:"required,gt=0"`
+}
+
+// ActivePromotion is the promotion currently applied to an item.
+type ActivePromotion struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	EffectivePrice float64   `json:"effective_price"` // Price to charge instead of Item.Price
+	EndsAt         time.Time `json:"ends_at"`
+}
+
+// CreatePromotionRequest defines the payload for scheduling a promotion.
+type CreatePromotionRequest struct {
+	Name     string          `json:"name" validate:"required,max=255"`
+	StartsAt time.Time       `json:"starts_at" validate:"required"`
+	EndsAt   time.Time       `json:"ends_at" validate:"required,gtfield=StartsAt"`
+	Items    []PromotionItem `json:"items" validate:"required,min=1,max=1000,dive"`
+}
+
+// PromotionRepository defines storage operations for promotions.
+type PromotionRepository interface {
+	Create(ctx context.Context, p *Promotion) (*Promotion, error)
+	GetByID(ctx context.Context, id string) (*Promotion, error)
+	List(ctx context.Context) ([]*Promotion, error)
+	Delete(ctx context.Context, id string) error
+	// ActiveForItems returns the promotion running at time at for each of itemIDs that has
+	// one. When promotions overlap, the lowest price wins.
+	ActiveForItems(ctx context.Context, itemIDs []string, at time.Time) (map[string]*ActivePromotion, error)
+}
+
+// PromotionPricer fills in the running promotion of items.
+type PromotionPricer interface {
+	ApplyPromotions(ctx context.Context, items ...*Item) error
+}
+
+// PromotionService defines business logic for promotions.
+type PromotionService interface {
+	PromotionPricer
+	CreatePromotion(ctx context.Context, req *CreatePromotionRequest, createdBy string) (*Promotion, error)
+	GetPromotion(ctx context.Context, id string) (*Promotion, error)
+	ListPromotions(ctx context.Context) ([]*Promotion, error)
+	DeletePromotion(ctx context.Context, id string) error
+}
@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Statuses of a purchase order. Orders are drafted, submitted to the supplier and then
+// received, possibly over several deliveries; they can be cancelled until fully received.
+const (
+	PurchaseOrderStatusDraft             = "draft"
+	PurchaseOrderStatusSubmitted         = "submitted"
+	PurchaseOrderStatusPartiallyReceived = "partially_received"
+	PurchaseOrderStatusReceived          = "received"
+	PurchaseOrderStatusCancelled         = "cancelled" // Stock received before cancelling stays
+)
+
+// Workflow document type of purchase orders and the actions moving them between statuses
+// (see workflow.PurchaseOrder). Deployments can require roles for them in WORKFLOW_FILE.
+const (
+	WorkflowDocumentPurchaseOrder = "purchase_order"
+
+	PurchaseOrderActionSubmit   = "submit"
+	PurchaseOrderActionReceive  = "receive"  // A delivery that leaves something outstanding
+	PurchaseOrderActionComplete = "complete" // The delivery after which nothing is outstanding
+	PurchaseOrderActionCancel   = "cancel"
+)
+
+// Supplier is someone stock is bought from.
+type Supplier struct {
+	ID        string    `json:"id" db:"id"`
+	Name      string    `json:"name" db:"name"` // Unique, ignoring case
+	Email     *string   `json:"email,omitempty" db:"email"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// CreateSupplierRequest defines the payload for creating a supplier.
+type CreateSupplierRequest struct {
+	Name  string  `json:"name" validate:"required,max=255"`
+	Email *string `json:"email,omitempty" validate:"omitempty,email,max=255"`
+}
+
+// PurchaseOrder orders items from a supplier.
+type PurchaseOrder struct {
+	ID         string              `json:"id" db:"id"`
+	Number     string              `json:"number" db:"number"` // E.g. PO-2024-0001; see DocumentSequence
+	SupplierID string              `json:"supplier_id" db:"supplier_id"`
+	Status     string              `json:"status" db:"status"`
+	Lines      []PurchaseOrderLine `json:"lines" db:"-"`
+	CreatedBy  string              `json:"created_by" db:"created_by"`
+	CreatedAt  time.Time           `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time           `json:"updated_at" db:"updated_at"`
+}
+
+// PurchaseOrderLine is an item on a purchase order and how much of it has arrived.
+type PurchaseOrderLine struct {
+	ItemID           string  `json:"item_id" db:"item_id"`
+	QuantityOrdered  int     `json:"quantity_ordered" db:"quantity_ordered"`
+	QuantityReceived int     `json:"quantity_received" db:"quantity_received"`
+	UnitCost         float64 `json:"unit_cost" db:"unit_cost"`
+}
+
+// CreatePurchaseOrderRequest defines the payload for drafting a purchase order.
+type CreatePurchaseOrderRequest struct {
+	SupplierID string                     `json:"supplier_id" validate:"required,uuid"`
+	Lines      []PurchaseOrderLineRequest `json:"lines" validate:"required,min=1,max=500,dive"`
+}
+
+// PurchaseOrderLineRequest is a line of CreatePurchaseOrderRequest.
+type PurchaseOrderLineRequest struct {
+	ItemID   string  `json:"item_id" validate:"required,uuid"`
+	Quantity int     `json:"quantity" validate:"required,min=1,max=1000000"`
+	UnitCost float64 `json:"unit_cost" validate:"gte=0"`
+}
+
+// ReceivePurchaseOrderRequest defines the payload for recording a delivery against a
+// purchase order.
+type ReceivePurchaseOrderRequest struct {
+	Lines []ReceiptLine `json:"lines" validate:"required,min=1,max=500,dive"`
+}
+
+// ReceiptLine is how many units of an item arrived in a delivery.
+type ReceiptLine struct {
+	ItemID   string `json:"item_id" validate:"required,uuid"`
+	Quantity int    `json:"quantity" validate:"required,min=1"`
+}
+
+// ReceivePurchaseOrderResult is a purchase order after a delivery, with the items it changed
+// and the ledger rows recording the changes.
+type ReceivePurchaseOrderResult struct {
+	Order     *PurchaseOrder   `json:"order"`
+	Items     []*Item          `json:"items"`
+	Movements []*StockMovement `json:"movements"`
+}
+
+// ListPurchaseOrdersQuery defines the query parameters for listing purchase orders.
+type ListPurchaseOrdersQuery struct {
+	Status   string `query:"status" validate:"omitempty,oneof=draft submitted partially_received received cancelled"`
+	Supplier string `query:"supplier" validate:"omitempty,uuid"`
+	Limit    int    `query:"limit" validate:"min=1,max=200"`
+}
+
+// PurchasingRepository defines storage operations for suppliers and purchase orders.
+type PurchasingRepository interface {
+	CreateSupplier(ctx context.Context, s *Supplier) (*Supplier, error)
+	GetSupplier(ctx context.Context, id string) (*Supplier, error)
+	ListSuppliers(ctx context.Context) ([]*Supplier, error) // Ordered by name
+	CreateOrder(ctx context.Context, o *PurchaseOrder) (*PurchaseOrder, error)
+	GetOrder(ctx context.Context, id string) (*PurchaseOrder, error)
+	ListOrders(ctx context.Context, q ListPurchaseOrdersQuery) ([]*PurchaseOrder, error) // Newest first
+	// Apply performs a workflow action on an order: step resolves it against the order's
+	// status, and the new status and the transition are saved in one transaction. Errors of
+	// step are returned unchanged.
+	Apply(ctx context.Context, id, action string, step WorkflowStep) (*PurchaseOrder, error)
+	// Receive adds the delivered quantities to stock and to the order's lines, and applies the
+	// receive or complete action through step, in one transaction. It returns ErrOverReceipt,
+	// and changes nothing, if a line would receive more than is outstanding.
+	Receive(ctx context.Context, id string, lines []ReceiptLine, userID string, step WorkflowStep) (*ReceivePurchaseOrderResult, error)
+}
+
+// PurchasingService defines business logic for suppliers and purchase orders.
+type PurchasingService interface {
+	CreateSupplier(ctx context.Context, req *CreateSupplierRequest) (*Supplier, error)
+	GetSupplier(ctx context.Context, id string) (*Supplier, error)
+	ListSuppliers(ctx context.Context) ([]*Supplier, error)
+	CreateOrder(ctx context.Context, req *CreatePurchaseOrderRequest, userID string) (*PurchaseOrder, error)
+	GetOrder(ctx context.Context, id string) (*PurchaseOrder, error)
+	ListOrders(ctx context.Context, q ListPurchaseOrdersQuery) ([]*PurchaseOrder, error)
+	// The status changes below are checked against the purchase order workflow; roles are
+	// those held by the calling user.
+	SubmitOrder(ctx context.Context, id, userID string, roles []string) (*PurchaseOrder, error)
+	ReceiveOrder(ctx context.Context, id string, req *ReceivePurchaseOrderRequest, userID string, roles []string) (*ReceivePurchaseOrderResult, error)
+	CancelOrder(ctx context.Context, id, userID string, roles []string) (*PurchaseOrder, error)
+	OrderHistory(ctx context.Context, id string) ([]*WorkflowTransition, error) // Oldest first
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// DerivedStore is data kept outside the primary database and computed from it, such as a
+// cache. After a bug or a schema change it can be rebuilt from the primary database.
+type DerivedStore interface {
+	Name() string // e.g. "item-cache"; unique among stores
+	// Rebuild discards the store's contents and recomputes them from the primary database,
+	// reporting progress as items done out of total. Total is an estimate until it returns.
+	Rebuild(ctx context.Context, progress func(done, total int)) error
+}
+
+// Rebuild job statuses.
+const (
+	RebuildStatusRunning   = "running"
+	RebuildStatusSucceeded = "succeeded"
+	RebuildStatusFailed    = "failed"
+)
+
+// RebuildJob is one rebuild of a derived store.
+type RebuildJob struct {
+	ID         string     `json:"id"`
+	Store      string     `json:"store"`
+	Status     string     `json:"status"`
+	Done       int        `json:"done"`
+	Total      int        `json:"total"`
+	StartedBy  string     `json:"started_by,omitempty"`
+	StartedAt  time.Time  `json:"started_at"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	Error      string     `json:"error,omitempty"` // Why a failed job failed
+}
+
+// StartRebuildRequest defines the payload for starting a rebuild.
+type StartRebuildRequest struct {
+	Store string `json:"store" validate:"required"`
+}
+
+// RebuildService runs rebuilds of derived stores in the background and tracks their progress.
+// Derived stores live in each server process, so jobs only cover the instance that runs them.
+type RebuildService interface {
+	Stores() []string // Names of the stores that can be rebuilt
+	// StartRebuild starts rebuilding the named store and returns at once. It fails with
+	// ErrDerivedStoreNotFound for unknown stores and ErrRebuildRunning if one is in progress.
+	StartRebuild(ctx context.Context, store, startedBy string) (*RebuildJob, error)
+	GetRebuild(ctx context.Context, id string) (*RebuildJob, error)
+	ListRebuilds(ctx context.Context) ([]*RebuildJob, error) // Most recent first
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Statuses of a sales order. Open orders reserve their stock; fulfilling an order ships it.
+const (
+	SalesOrderStatusOpen      = "open"
+	SalesOrderStatusFulfilled = "fulfilled" // Stock deducted
+	SalesOrderStatusCancelled = "cancelled" // Reservation released, stock untouched
+)
+
+// SalesOrder sells items, optionally to a known customer.
+type SalesOrder struct {
+	ID         string           `json:"id" db:"id"`
+	Number     string           `json:"number" db:"number"` // E.g. SO-2024-0001; see DocumentSequence
+	CustomerID *string          `json:"customer_id,omitempty" db:"customer_id"`
+	Status     string           `json:"status" db:"status"`
+	Lines      []SalesOrderLine `json:"lines" db:"-"`
+	CreatedBy  string           `json:"created_by" db:"created_by"`
+	CreatedAt  time.Time        `json:"created_at" db:"created_at"`
+	ClosedBy   *string          `json:"closed_by,omitempty" db:"closed_by"`
+	ClosedAt   *time.Time       `json:"closed_at,omitempty" db:"closed_at"`
+}
+
+// SalesOrderLine is an item on a sales order at the price resolved when it was ordered.
+type SalesOrderLine struct {
+	ItemID    string  `json:"item_id" db:"item_id"`
+	Quantity  int     `json:"quantity" db:"quantity"`
+	UnitPrice float64 `json:"unit_price" db:"unit_price"`
+}
+
+// CreateSalesOrderRequest defines the payload for placing a sales order.
+type CreateSalesOrderRequest struct {
+	CustomerID *string                 `json:"customer_id,omitempty" validate:"omitempty,uuid"` // Prices follow the customer's tier; omit for retail
+	Lines      []SalesOrderLineRequest `json:"lines" validate:"required,min=1,max=500,dive"`
+}
+
+// SalesOrderLineRequest is a line of CreateSalesOrderRequest.
+type SalesOrderLineRequest struct {
+	ItemID   string `json:"item_id" validate:"required,uuid"`
+	Quantity int    `json:"quantity" validate:"required,min=1,max=1000000"`
+}
+
+// FulfillSalesOrderResult is a fulfilled order with the items it changed and the ledger
+// rows recording the changes.
+type FulfillSalesOrderResult struct {
+	Order     *SalesOrder      `json:"order"`
+	Items     []*Item          `json:"items"`
+	Movements []*StockMovement `json:"movements"`
+}
+
+// ListSalesOrdersQuery defines the query parameters for listing sales orders.
+type ListSalesOrdersQuery struct {
+	Status   string `query:"status" validate:"omitempty,oneof=open fulfilled cancelled"`
+	Customer string `query:"customer" validate:"omitempty,uuid"`
+	Limit    int    `query:"limit" validate:"min=1,max=200"`
+}
+
+// SalesOrderRepository defines storage operations for sales orders.
+type SalesOrderRepository interface {
+	// Create stores an open order, reserving its lines. It returns ErrInsufficientStock, and
+	// stores nothing, if an item's quantity minus its open reservations cannot cover a line.
+	Create(ctx context.Context, o *SalesOrder) (*SalesOrder, error)
+	GetByID(ctx context.Context, id string) (*SalesOrder, error)
+	List(ctx context.Context, q ListSalesOrdersQuery) ([]*SalesOrder, error) // Newest first
+	// Fulfill deducts the lines of an open order from stock and closes it, in one transaction.
+	// Other removals cannot take reserved units, but it returns ErrInsufficientStock, and
+	// changes nothing, if an item no longer has enough, e.g. after a reconciliation.
+	Fulfill(ctx context.Context, id, userID string) (*FulfillSalesOrderResult, error)
+	Cancel(ctx context.Context, id, userID string) (*SalesOrder, error)
+}
+
+// SalesOrderService defines business logic for sales orders.
+type SalesOrderService interface {
+	CreateOrder(ctx context.Context, req *CreateSalesOrderRequest, userID string) (*SalesOrder, error)
+	GetOrder(ctx context.Context, id string) (*SalesOrder, error)
+	ListOrders(ctx context.Context, q ListSalesOrdersQuery) ([]*SalesOrder, error)
+	FulfillOrder(ctx context.Context, id, userID string) (*FulfillSalesOrderResult, error)
+	CancelOrder(ctx context.Context, id, userID string) (*SalesOrder, error)
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// MaxBatchAdjustments is the largest number of lines accepted in one adjustment batch.
+const MaxBatchAdjustments = 500
+
+// Reason codes for stock adjustments.
+const (
+	AdjustmentReasonCycleCount = "cycle_count" // Physical count differs from the system
+	AdjustmentReasonDamage     = "damage"
+	AdjustmentReasonShrinkage  = "shrinkage" // Theft or unexplained loss
+	AdjustmentReasonReturn     = "return"
+	AdjustmentReasonReceiving  = "receiving"
+	AdjustmentReasonCorrection = "correction" // Fixes an earlier data entry mistake
+)
+
+// Per-line outcomes of an adjustment batch.
+const (
+	AdjustmentStatusApplied           = "applied"
+	AdjustmentStatusNotFound          = "not_found"
+	AdjustmentStatusInsufficientStock = "insufficient_stock" // Would take units reserved by open sales orders, or go below zero
+)
+
+// StockAdjustmentLine is one quantity change within a batch.
+type StockAdjustmentLine struct {
+	ItemID     string `json:"item_id" validate:"required,uuid"`
+	Delta      int    `json:"delta" validate:"required"` // Positive to add stock, negative to remove it
+	ReasonCode string `json:"reason_code" validate:"required,oneof=cycle_count damage shrinkage return receiving correction"`
+	Note       string `json:"note,omitempty" validate:"max=500"`
+}
+
+// BatchAdjustmentRequest defines the payload for applying many adjustments at once,
+// e.g. at end-of-day reconciliation.
+type BatchAdjustmentRequest struct {
+	Adjustments []StockAdjustmentLine `json:"adjustments" validate:"required,min=1,max=500,dive"`
+}
+
+// StockAdjustmentResult reports the outcome of one line of a batch.
+// Lines are applied in order, so several lines for the same item see each other's effect.
+type StockAdjustmentResult struct {
+	Line           int    `json:"line"` // Zero-based index into the request
+	ItemID         string `json:"item_id"`
+	SKU            string `json:"sku,omitempty"`
+	Delta          int    `json:"delta"`
+	ReasonCode     string `json:"reason_code"`
+	QuantityBefore int    `json:"quantity_before"`
+	QuantityAfter  int    `json:"quantity_after"`
+	Status         string `json:"status"` // One of the AdjustmentStatus* constants
+}
+
+// BatchAdjustmentResult reports what an adjustment batch did. A batch is applied completely
+// or not at all: when Applied is false, Results shows which lines prevented it.
+type BatchAdjustmentResult struct {
+	BatchID string                  `json:"batch_id"`
+	Applied bool                    `json:"applied"`
+	Results []StockAdjustmentResult `json:"results"`
+}
+
+// StockAdjustment is a recorded quantity change.
+type StockAdjustment struct {
+	ID            string    `json:"id" db:"id"`
+	BatchID       string    `json:"batch_id" db:"batch_id"`
+	ItemID        string    `json:"item_id" db:"item_id"`
+	Delta         int       `json:"delta" db:"delta"`
+	QuantityAfter int       `json:"quantity_after" db:"quantity_after"`
+	ReasonCode    string    `json:"reason_code" db:"reason_code"`
+	Note          string    `json:"note" db:"note"`
+	AdjustedBy    string    `json:"adjusted_by" db:"adjusted_by"`
+	AdjustedAt    time.Time `json:"adjusted_at" db:"adjusted_at"`
+}
+
+// StockAdjustmentRepository defines storage operations for stock adjustments.
+type StockAdjustmentRepository interface {
+	// ApplyBatch applies every line in a single transaction and records each one under batchID.
+	// If any line fails, nothing is saved and the per-line results are returned with ErrBatchRejected.
+	ApplyBatch(ctx context.Context, batchID string, lines []StockAdjustmentLine, adjustedBy string) ([]StockAdjustmentResult, error)
+}
+
+// StockAdjustmentService defines business logic for stock adjustments.
+type StockAdjustmentService interface {
+	ApplyBatch(ctx context.Context, req *BatchAdjustmentRequest, adjustedBy string) (*BatchAdjustmentResult, error)
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// Reasons accepted by POST /items/:id/adjust-stock.
+const (
+	MovementReasonSale       = "sale"
+	MovementReasonReceive    = "receive"
+	MovementReasonDamage     = "damage"
+	MovementReasonCorrection = "correction"
+)
+
+// Reasons recorded by the system for quantity changes made without one. Movements of
+// adjustment batches carry the reason code of their line (see AdjustmentReason*).
+const (
+	MovementReasonOpening    = "opening"        // Quantity of an item when the ledger was introduced
+	MovementReasonInitial    = "initial"        // Quantity an item was created with
+	MovementReasonEdit       = "edit"           // Quantity set directly through an item update
+	MovementReasonAdjustment = "adjustment"     // Delta applied through POST /items/:id/quantity
+	MovementReasonReconcile  = "reconciliation" // Ledger-only entry making up a discrepancy; the quantity is unchanged
+)
+
+// StockMovement is one row of the movement ledger. Every change to an item's quantity writes
+// one in the same transaction, so an item's quantity is always the sum of its movements'
+// deltas and its history can be reconstructed.
+type StockMovement struct {
+	ID            string    `json:"id" db:"id"`
+	ItemID        string    `json:"item_id" db:"item_id"`
+	Delta         int       `json:"delta" db:"delta"`
+	QuantityAfter int       `json:"quantity_after" db:"quantity_after"`
+	Reason        string    `json:"reason" db:"reason"`
+	Reference     string    `json:"reference,omitempty" db:"reference"` // E.g. the adjustment batch ID
+	Note          string    `json:"note,omitempty" db:"note"`
+	MovedBy       string    `json:"moved_by,omitempty" db:"moved_by"` // Empty when the change did not carry a user
+	MovedAt       time.Time `json:"moved_at" db:"moved_at"`
+}
+
+// AdjustStockRequest defines the payload for changing an item's quantity with a reason.
+type AdjustStockRequest struct {
+	Delta  int    `json:"delta" validate:"required"` // Positive to add stock, negative to remove it; never zero
+	Reason string `json:"reason" validate:"required,oneof=sale receive damage correction"`
+	Note   string `json:"note,omitempty" validate:"max=500"`
+}
+
+// AdjustStockResult is the item after an adjustment and the ledger row recording it.
+type AdjustStockResult struct {
+	Item     *Item          `json:"item"`
+	Movement *StockMovement `json:"movement"`
+}
+
+// ListMovementsQuery defines the query parameters for an item's movement history.
+type ListMovementsQuery struct {
+	Limit int `query:"limit" validate:"min=1,max=500"`
+}
+
+// StockMovementRepository defines storage operations for the movement ledger.
+type StockMovementRepository interface {
+	// Adjust adds m.Delta to the item's quantity and records m, in one transaction.
+	// It returns ErrInsufficientStock, and changes nothing, if the result would be negative or,
+	// for a removal, below what open sales orders reserve.
+	Adjust(ctx context.Context, m *StockMovement) (*Item, *StockMovement, error)
+	// ListByItem returns the item's latest movements, newest first.
+	ListByItem(ctx context.Context, itemID string, limit int) ([]*StockMovement, error)
+
+	// ListSince returns the movements made at or after since, oldest first, without
+	// reconciliation entries.
+	ListSince(ctx context.Context, since time.Time) ([]*StockMovement, error)
+	// RemovalStats summarises, per item ID, the quantities removed by movements with one of
+	// the given reasons made in [from, to).
+	RemovalStats(ctx context.Context, from, to time.Time, reasons []string) (map[string]AdjustmentStats, error)
+	// DailyVolumeStats summarises, per user, the number of movements made on each day in [from, to)
+	// on which the user moved anything.
+	DailyVolumeStats(ctx context.Context, from, to time.Time) (map[string]AdjustmentStats, error)
+}
+
+// StockMovementService defines business logic for ledger-backed stock changes.
+type StockMovementService interface {
+	AdjustStock(ctx context.Context, itemID string, req *AdjustStockRequest, userID string) (*AdjustStockResult, error)
+	ListMovements(ctx context.Context, itemID string, limit int) ([]*StockMovement, error)
+}
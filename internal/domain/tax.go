@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// TaxCodeStandard is the tax code of items that have none.
+const TaxCodeStandard = "standard"
+
+// TaxRate is the rate of a tax code in a jurisdiction.
+type TaxRate struct {
+	TaxCode      string    `json:"tax_code" db:"tax_code"`
+	Jurisdiction string    `json:"jurisdiction" db:"jurisdiction"`
+	RatePercent  float64   `json:"rate_percent" db:"rate_percent"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// SetTaxRateRequest defines the payload for setting the rate of a tax code in a jurisdiction.
+type SetTaxRateRequest struct {
+	RatePercent *float64 `json:"rate_percent" validate:"required,gte=0,lte=100"`
+}
+
+// AssignTaxCodeRequest defines the payload for setting the tax code of an item.
+type AssignTaxCodeRequest struct {
+	TaxCode *string `json:"tax_code" validate:"omitempty,min=1,max=32"` // Null puts the item back under the standard code
+}
+
+// TaxQuoteQuery defines the query parameters for quoting the tax of an order.
+type TaxQuoteQuery struct {
+	Jurisdiction string `query:"jurisdiction" validate:"required,max=32"`
+}
+
+// TaxableLine is an order line handed to a TaxProvider.
+type TaxableLine struct {
+	ItemID  string  `json:"item_id"`
+	TaxCode string  `json:"tax_code"`
+	Net     float64 `json:"net"` // Quantity times unit price
+}
+
+// TaxedLine is a TaxableLine with its tax.
+type TaxedLine struct {
+	TaxableLine
+	RatePercent float64 `json:"rate_percent"`
+	Tax         float64 `json:"tax"`
+}
+
+// TaxQuote is the tax of an order in a jurisdiction.
+type TaxQuote struct {
+	OrderID      string      `json:"order_id"`
+	Jurisdiction string      `json:"jurisdiction"`
+	Provider     string      `json:"provider"`
+	Lines        []TaxedLine `json:"lines"`
+	Net          float64     `json:"net"`
+	Tax          float64     `json:"tax"`
+	Gross        float64     `json:"gross"`
+}
+
+// TaxProvider calculates the tax of order lines. The default applies the rates stored per tax
+// code and jurisdiction; an external tax service can be plugged in instead.
+type TaxProvider interface {
+	Name() string
+	// Calculate returns the lines with their tax, in the same order. It returns
+	// ErrTaxRateNotFound if it cannot tax a line.
+	Calculate(ctx context.Context, jurisdiction string, lines []TaxableLine) ([]TaxedLine, error)
+}
+
+// TaxRepository defines storage operations for tax rates and the tax codes of items.
+type TaxRepository interface {
+	ListRates(ctx context.Context) ([]*TaxRate, error) // Ordered by jurisdiction and code
+	// GetRates returns the rates of the given codes in a jurisdiction, by code; codes without
+	// a rate there are left out.
+	GetRates(ctx context.Context, jurisdiction string, codes []string) (map[string]float64, error)
+	SetRate(ctx context.Context, r *TaxRate) (*TaxRate, error)
+	DeleteRate(ctx context.Context, code, jurisdiction string) error
+	// AssignItemTaxCode sets or clears the tax code of an item, recording a revision of the item
+	// as changed by userID.
+	AssignItemTaxCode(ctx context.Context, itemID string, code *string, userID string) error
+	// ItemTaxCodes returns the tax codes of the given items, by ID; items without one are left out.
+	ItemTaxCodes(ctx context.Context, itemIDs []string) (map[string]string, error)
+}
+
+// TaxService defines business logic for taxes.
+type TaxService interface {
+	ListRates(ctx context.Context) ([]*TaxRate, error)
+	SetRate(ctx context.Context, code, jurisdiction string, req *SetTaxRateRequest) (*TaxRate, error)
+	DeleteRate(ctx context.Context, code, jurisdiction string) error
+	AssignItemTaxCode(ctx context.Context, itemID string, req *AssignTaxCodeRequest, userID string) error
+	QuoteOrder(ctx context.Context, orderID, jurisdiction string) (*TaxQuote, error)
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// WorkflowTransition records a single state change of a document that is
+// governed by a workflow (e.g. a purchase order moving from "draft" to "submitted").
+type WorkflowTransition struct {
+	ID           string    `json:"id" db:"id"`
+	DocumentType string    `json:"document_type" db:"document_type"` // e.g. "purchase_order"
+	DocumentID   string    `json:"document_id" db:"document_id"`
+	Action       string    `json:"action" db:"action"` // Name of the transition that was applied
+	FromState    string    `json:"from_state" db:"from_state"`
+	ToState      string    `json:"to_state" db:"to_state"`
+	Actor        *string   `json:"actor,omitempty" db:"actor"` // Who performed the transition, if known
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+}
+
+// WorkflowHistoryRepository defines storage for workflow transition history. Transitions
+// are written by the repository of each document type, in the transaction that changes the
+// document's state.
+type WorkflowHistoryRepository interface {
+	ListByDocument(ctx context.Context, documentType, documentID string) ([]*WorkflowTransition, error)
+}
+
+// WorkflowStep resolves an action against a document's current state and returns the
+// transition to record, or ErrInvalidTransition or ErrTransitionForbidden. Repositories call
+// it while holding the document's row lock, so the check and the state change cannot race.
+type WorkflowStep func(currentState, action string) (*WorkflowTransition, error)
@@ -0,0 +1,44 @@
+// Package events is the in-process event bus. It lets the parts of the application react to
+// changes made elsewhere (e.g. caches dropping entries) without depending on each other.
+//
+// Delivery is synchronous, in the publisher's goroutine, so by the time Publish returns every
+// subscriber has seen the event. Events do not leave the process.
+package events
+
+import (
+	"context"
+	"sync"
+)
+
+// Bus delivers events to the subscribers registered for them. The zero value is not usable;
+// create one with NewBus. A Bus is safe for concurrent use.
+type Bus struct {
+	mu          sync.RWMutex
+	itemChanged []func(ctx context.Context, itemIDs []string)
+}
+
+// NewBus creates an empty Bus.
+func NewBus() *Bus {
+	return &Bus{}
+}
+
+// SubscribeItemChanged registers fn to be called with the IDs of changed items.
+// Subscribers must be quick and must not publish to the bus themselves.
+func (b *Bus) SubscribeItemChanged(fn func(ctx context.Context, itemIDs []string)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.itemChanged = append(b.itemChanged, fn)
+}
+
+// PublishItemChanged announces that the given items changed. It implements domain.ItemChangePublisher.
+func (b *Bus) PublishItemChanged(ctx context.Context, itemIDs ...string) {
+	if len(itemIDs) == 0 {
+		return
+	}
+	b.mu.RLock()
+	subscribers := b.itemChanged
+	b.mu.RUnlock()
+	for _, fn := range subscribers {
+		fn(ctx, itemIDs)
+	}
+}
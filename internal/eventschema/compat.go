@@ -0,0 +1,149 @@
+package eventschema
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// BreakingChanges lists the changes from the old to the new schema of an event that would
+// break its consumers: clients for server events, the server for client events. Both
+// schemas are documents produced by Schema and decoded from JSON.
+//
+// Removing a field, changing its type or format, and changing a constant always break.
+// Server events may gain fields but must keep sending every field they sent; client events
+// may gain optional fields but must not start requiring one.
+func BreakingChanges(old, new map[string]any, direction string) []string {
+	c := &comparison{
+		direction: direction,
+		oldDefs:   asMap(old["$defs"]),
+		newDefs:   asMap(new["$defs"]),
+		seen:      make(map[string]bool),
+	}
+	c.compare("$", old, new)
+	return c.breaks
+}
+
+type comparison struct {
+	direction        string
+	oldDefs, newDefs map[string]any
+	seen             map[string]bool // Pairs of $refs already compared, so recursive types terminate
+	breaks           []string
+}
+
+func (c *comparison) report(path, format string, args ...any) {
+	c.breaks = append(c.breaks, path+": "+fmt.Sprintf(format, args...))
+}
+
+func (c *comparison) compare(path string, old, new map[string]any) {
+	oldRef, _ := old["$ref"].(string)
+	newRef, _ := new["$ref"].(string)
+	if oldRef != "" || newRef != "" {
+		pair := oldRef + "|" + newRef
+		if c.seen[pair] {
+			return
+		}
+		c.seen[pair] = true
+		old, new = resolve(old, c.oldDefs), resolve(new, c.newDefs)
+	}
+
+	if want, ok := old["const"]; ok && !reflect.DeepEqual(want, new["const"]) {
+		c.report(path, "was always %v, now %v", want, new["const"])
+	}
+	if o, n := typeSet(old["type"]), typeSet(new["type"]); !reflect.DeepEqual(o, n) {
+		c.report(path, "type changed from %v to %v", o, n)
+		return
+	}
+	if old["format"] != new["format"] {
+		c.report(path, "format changed from %v to %v", old["format"], new["format"])
+	}
+
+	oldAlts, newAlts := asSlice(old["anyOf"]), asSlice(new["anyOf"])
+	if len(oldAlts) != len(newAlts) {
+		c.report(path, "allowed types changed")
+	} else {
+		for i := range oldAlts {
+			c.compare(path, asMap(oldAlts[i]), asMap(newAlts[i]))
+		}
+	}
+
+	if items, ok := old["items"]; ok {
+		c.compare(path+"[]", asMap(items), asMap(new["items"]))
+	}
+
+	oldProps, newProps := asMap(old["properties"]), asMap(new["properties"])
+	oldReq, newReq := stringSet(old["required"]), stringSet(new["required"])
+	for _, name := range sortedKeys(oldProps) {
+		field := path + "." + name
+		prop, ok := newProps[name]
+		if !ok {
+			c.report(field, "removed")
+			continue
+		}
+		c.compare(field, asMap(oldProps[name]), asMap(prop))
+		switch {
+		case c.direction == DirectionServer && oldReq[name] && !newReq[name]:
+			c.report(field, "no longer always sent")
+		case c.direction == DirectionClient && !oldReq[name] && newReq[name]:
+			c.report(field, "now required")
+		}
+	}
+	for _, name := range sortedKeys(newProps) {
+		if _, ok := oldProps[name]; !ok && c.direction == DirectionClient && newReq[name] {
+			c.report(path+"."+name, "added as a required field")
+		}
+	}
+}
+
+func resolve(schema map[string]any, defs map[string]any) map[string]any {
+	ref, ok := schema["$ref"].(string)
+	if !ok {
+		return schema
+	}
+	return asMap(defs[strings.TrimPrefix(ref, "#/$defs/")])
+}
+
+// typeSet normalises the type keyword, which may hold one type or a list of them.
+func typeSet(v any) []string {
+	var types []string
+	for _, t := range asSlice(v) {
+		types = append(types, fmt.Sprint(t))
+	}
+	sort.Strings(types)
+	return types
+}
+
+func stringSet(v any) map[string]bool {
+	set := make(map[string]bool)
+	for _, s := range asSlice(v) {
+		set[fmt.Sprint(s)] = true
+	}
+	return set
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func asMap(v any) map[string]any {
+	m, _ := v.(map[string]any)
+	return m
+}
+
+// asSlice normalises schema keywords that may hold a single value or a list.
+func asSlice(v any) []any {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []any:
+		return v
+	default:
+		return []any{v}
+	}
+}
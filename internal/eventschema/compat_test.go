@@ -0,0 +1,106 @@
+package eventschema_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"inventory-system/internal/eventschema"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type oldPayload struct {
+	ID       string     `json:"id"`
+	Quantity int        `json:"quantity"`
+	Note     string     `json:"note,omitempty"`
+	Tags     []string   `json:"tags"`
+	Due      *time.Time `json:"due"`
+}
+
+// schemaOf builds the schema of payload the way the committed files are read back.
+func schemaOf(t *testing.T, direction string, payload any) map[string]any {
+	t.Helper()
+	data, err := json.Marshal(eventschema.Schema(eventschema.Event{Type: "T", Version: 1, Direction: direction, Payload: payload}))
+	if err != nil {
+		t.Fatalf("marshal schema: %v", err)
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("decode schema: %v", err)
+	}
+	return doc
+}
+
+func TestBreakingChanges(t *testing.T) {
+	type addedOptional struct {
+		ID       string     `json:"id"`
+		Quantity int        `json:"quantity"`
+		Note     string     `json:"note,omitempty"`
+		Tags     []string   `json:"tags"`
+		Due      *time.Time `json:"due"`
+		Extra    string     `json:"extra,omitempty"`
+	}
+	type addedRequired struct {
+		ID       string     `json:"id"`
+		Quantity int        `json:"quantity"`
+		Note     string     `json:"note,omitempty"`
+		Tags     []string   `json:"tags"`
+		Due      *time.Time `json:"due"`
+		Extra    string     `json:"extra"`
+	}
+	type removed struct {
+		ID   string     `json:"id"`
+		Note string     `json:"note,omitempty"`
+		Tags []string   `json:"tags"`
+		Due  *time.Time `json:"due"`
+	}
+	type retyped struct {
+		ID       string     `json:"id"`
+		Quantity float64    `json:"quantity"`
+		Note     string     `json:"note,omitempty"`
+		Tags     []int      `json:"tags"`
+		Due      *time.Time `json:"due"`
+	}
+	type madeOptional struct {
+		ID       string     `json:"id"`
+		Quantity int        `json:"quantity,omitempty"`
+		Note     string     `json:"note,omitempty"`
+		Tags     []string   `json:"tags"`
+		Due      *time.Time `json:"due"`
+	}
+	type madeRequired struct {
+		ID       string     `json:"id"`
+		Quantity int        `json:"quantity"`
+		Note     string     `json:"note"`
+		Tags     []string   `json:"tags"`
+		Due      *time.Time `json:"due"`
+	}
+
+	tests := []struct {
+		name      string
+		direction string
+		payload   any
+		want      []string
+	}{
+		{"unchanged", eventschema.DirectionServer, oldPayload{}, nil},
+		{"server adds optional field", eventschema.DirectionServer, addedOptional{}, nil},
+		{"server adds required field", eventschema.DirectionServer, addedRequired{}, nil},
+		{"client adds optional field", eventschema.DirectionClient, addedOptional{}, nil},
+		{"client adds required field", eventschema.DirectionClient, addedRequired{}, []string{"$.payload.extra: added as a required field"}},
+		{"field removed", eventschema.DirectionClient, removed{}, []string{"$.payload.quantity: removed"}},
+		{"types changed", eventschema.DirectionServer, retyped{}, []string{
+			"$.payload.quantity: type changed from [integer] to [number]",
+			"$.payload.tags[]: type changed from [string] to [integer]",
+		}},
+		{"server field made optional", eventschema.DirectionServer, madeOptional{}, []string{"$.payload.quantity: no longer always sent"}},
+		{"client field made optional", eventschema.DirectionClient, madeOptional{}, nil},
+		{"client field made required", eventschema.DirectionClient, madeRequired{}, []string{"$.payload.note: now required"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			old := schemaOf(t, tc.direction, oldPayload{})
+			assert.Equal(t, tc.want, eventschema.BreakingChanges(old, schemaOf(t, tc.direction, tc.payload), tc.direction))
+		})
+	}
+}
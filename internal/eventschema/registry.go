@@ -0,0 +1,67 @@
+// Package eventschema is the registry of every event the application emits or accepts, with
+// the version of each payload's schema. Messages carry that version as schema_version, and
+// the committed schemas in testdata record each version so a change that would break
+// consumers fails the tests instead of reaching them.
+//
+// WebSocket messages are the only events that leave the process today. The in-process bus
+// (internal/events) passes Go values between packages of the same binary, so it has no wire
+// format to version.
+package eventschema
+
+import "inventory-system/internal/domain"
+
+// Directions of an event.
+const (
+	DirectionServer = "server" // Server -> client
+	DirectionClient = "client" // Client -> server
+)
+
+// Event binds an event type to the Go type of its payload and the version of its schema.
+//
+// Version starts at 1. Compatible changes (a new optional field on a client message, any
+// new field on a server message) keep it; anything else, such as removing or renaming a
+// field or changing its type, needs a new version.
+type Event struct {
+	Type      string
+	Version   int
+	Direction string
+	Payload   any
+}
+
+// registry lists every event. TestEveryMessageTypeIsRegistered fails when a new
+// *MessageType constant is not added here.
+var registry = []Event{
+	{Type: domain.StockUpdateMessageType, Version: 1, Direction: DirectionServer, Payload: domain.StockUpdatePayload{}},
+	{Type: domain.StockBatchUpdateMessageType, Version: 1, Direction: DirectionServer, Payload: domain.StockBatchUpdatePayload{}},
+	{Type: domain.NotificationMessageType, Version: 1, Direction: DirectionServer, Payload: domain.Notification{}},
+	{Type: domain.PresenceUpdateMessageType, Version: 1, Direction: DirectionServer, Payload: domain.PresenceUpdatePayload{}},
+	{Type: domain.PresenceMessageType, Version: 1, Direction: DirectionClient, Payload: domain.PresenceReport{}},
+	{Type: domain.LockHeartbeatMessageType, Version: 1, Direction: DirectionClient, Payload: domain.LockHeartbeat{}},
+	{Type: domain.HeartbeatMessageType, Version: 1, Direction: DirectionServer, Payload: domain.Heartbeat{}},
+	{Type: domain.HeartbeatAckMessageType, Version: 1, Direction: DirectionClient, Payload: domain.HeartbeatAck{}},
+	{Type: domain.LabelJobsQueuedMessageType, Version: 1, Direction: DirectionServer, Payload: domain.LabelJobsQueuedPayload{}},
+}
+
+var byType = func() map[string]Event {
+	m := make(map[string]Event, len(registry))
+	for _, e := range registry {
+		m[e.Type] = e
+	}
+	return m
+}()
+
+// Events returns every registered event, in registration order.
+func Events() []Event {
+	return append([]Event(nil), registry...)
+}
+
+// Lookup returns the registered event of the given type.
+func Lookup(eventType string) (Event, bool) {
+	e, ok := byType[eventType]
+	return e, ok
+}
+
+// Version returns the current schema version of the given event type, or 0 if it is not registered.
+func Version(eventType string) int {
+	return byType[eventType].Version
+}
@@ -0,0 +1,113 @@
+package eventschema_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"inventory-system/internal/eventschema"
+)
+
+var update = flag.Bool("update", false, "record compatible schema changes in the committed version files")
+
+// versionPath is the committed schema of one version of an event. Files of older versions
+// stay in the tree as the record of what consumers of those versions rely on.
+func versionPath(e eventschema.Event, version int) string {
+	return filepath.Join("testdata", fmt.Sprintf("%s.v%d.schema.json", strings.ToLower(e.Type), version))
+}
+
+// TestEventSchemasAreCompatible compares each event with the committed schema of its current
+// version. Compatible changes are recorded with -update; breaking ones need a new version.
+func TestEventSchemasAreCompatible(t *testing.T) {
+	for _, e := range eventschema.Events() {
+		t.Run(e.Type, func(t *testing.T) {
+			got, err := json.MarshalIndent(eventschema.Schema(e), "", "  ")
+			if err != nil {
+				t.Fatalf("marshal schema: %v", err)
+			}
+			got = append(got, '\n')
+
+			path := versionPath(e, e.Version)
+			want, err := os.ReadFile(path)
+			switch {
+			case errors.Is(err, fs.ErrNotExist):
+				if e.Version > 1 {
+					if _, err := os.Stat(versionPath(e, e.Version-1)); err != nil {
+						t.Errorf("v%d of %s is not committed; keep the schemas of earlier versions: %v", e.Version-1, e.Type, err)
+					}
+				}
+				if !*update {
+					t.Fatalf("%s does not exist; run `go test ./internal/eventschema -update` to create it", path)
+				}
+			case err != nil:
+				t.Fatalf("read schema: %v", err)
+			case bytes.Equal(got, want):
+				return
+			default:
+				if breaks := eventschema.BreakingChanges(decode(t, want), decode(t, got), e.Direction); len(breaks) > 0 {
+					t.Fatalf("%s changed incompatibly with schema version %d:\n  %s\n"+
+						"Bump its Version in the registry and run with -update; %s must stay as it is.",
+						e.Type, e.Version, strings.Join(breaks, "\n  "), path)
+				}
+				if !*update {
+					t.Fatalf("%s changed compatibly; run `go test ./internal/eventschema -update` and review the diff.\n--- got ---\n%s", e.Type, got)
+				}
+			}
+			if err := os.WriteFile(path, got, 0o644); err != nil {
+				t.Fatalf("write schema: %v", err)
+			}
+		})
+	}
+}
+
+func decode(t *testing.T, data []byte) map[string]any {
+	t.Helper()
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("decode schema: %v", err)
+	}
+	return doc
+}
+
+// TestEveryMessageTypeIsRegistered guards against adding a message type without describing it.
+func TestEveryMessageTypeIsRegistered(t *testing.T) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, filepath.Join("..", "domain"), nil, 0)
+	if err != nil {
+		t.Fatalf("parse domain package: %v", err)
+	}
+
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			ast.Inspect(file, func(n ast.Node) bool {
+				spec, ok := n.(*ast.ValueSpec)
+				if !ok {
+					return true
+				}
+				for i, name := range spec.Names {
+					if !strings.HasSuffix(name.Name, "MessageType") || i >= len(spec.Values) {
+						continue
+					}
+					lit, ok := spec.Values[i].(*ast.BasicLit)
+					if !ok || lit.Kind != token.STRING {
+						continue
+					}
+					if value := strings.Trim(lit.Value, "\"`"); eventschema.Version(value) == 0 {
+						t.Errorf("domain.%s (%q) is not in the registry", name.Name, value)
+					}
+				}
+				return true
+			})
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package eventschema
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+const draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema describes the message envelope of e with its payload, as a standalone JSON Schema
+// (draft 2020-12) document.
+func Schema(e Event) map[string]any {
+	defs := make(map[string]any)
+	doc := envelope(e, defs)
+	doc["$schema"] = draft
+	doc["$defs"] = defs
+	return doc
+}
+
+// Document describes the given events in one JSON Schema document: a message must match
+// exactly one of them.
+func Document(title string, events []Event) map[string]any {
+	defs := make(map[string]any)
+	variants := make([]any, 0, len(events))
+	for _, e := range events {
+		variants = append(variants, envelope(e, defs))
+	}
+	return map[string]any{
+		"$schema": draft,
+		"title":   title,
+		"oneOf":   variants,
+		"$defs":   defs,
+	}
+}
+
+// envelope describes the domain.WebSocketMessage carrying e. The server always stamps
+// schema_version; clients may leave it out.
+func envelope(e Event, defs map[string]any) map[string]any {
+	payload := reflect.TypeOf(e.Payload)
+	required := []string{"type", "payload"}
+	if e.Direction == DirectionServer {
+		required = append(required, "schema_version")
+	}
+	return map[string]any{
+		"title":       e.Type,
+		"description": fmt.Sprintf("Sent by the %s. Payload: %s, schema version %d.", e.Direction, payload.Name(), e.Version),
+		"type":        "object",
+		"properties": map[string]any{
+			"type":           map[string]any{"const": e.Type},
+			"payload":        typeSchema(payload, defs),
+			"request_id":     map[string]any{"type": "string"},
+			"schema_version": map[string]any{"const": e.Version},
+		},
+		"required":             required,
+		"additionalProperties": false,
+	}
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// typeSchema describes t, registering named structs under defs and referencing them.
+func typeSchema(t reflect.Type, defs map[string]any) map[string]any {
+	switch {
+	case t == timeType:
+		return map[string]any{"type": "string", "format": "date-time"}
+	case t.Kind() == reflect.Pointer:
+		inner := typeSchema(t.Elem(), defs)
+		return map[string]any{"anyOf": []any{inner, map[string]any{"type": "null"}}}
+	case t.Kind() == reflect.Slice:
+		return map[string]any{"type": []string{"array", "null"}, "items": typeSchema(t.Elem(), defs)}
+	case t.Kind() == reflect.Struct:
+		if _, ok := defs[t.Name()]; !ok {
+			defs[t.Name()] = nil // Reserve the name first so recursive types terminate
+			defs[t.Name()] = structSchema(t, defs)
+		}
+		return map[string]any{"$ref": "#/$defs/" + t.Name()}
+	case t.Kind() == reflect.String:
+		return map[string]any{"type": "string"}
+	case t.Kind() == reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case t.Kind() >= reflect.Int && t.Kind() <= reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		return map[string]any{"type": "number"}
+	default:
+		return map[string]any{} // interface{} and friends: anything goes
+	}
+}
+
+func structSchema(t reflect.Type, defs map[string]any) map[string]any {
+	properties := make(map[string]any)
+	required := []string{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if !f.IsExported() || tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = f.Name
+		}
+		properties[name] = typeSchema(f.Type, defs)
+		if !strings.Contains(opts, "omitempty") {
+			required = append(required, name)
+		}
+	}
+	sort.Strings(required)
+	return map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"required":             required,
+		"additionalProperties": false,
+	}
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"net/http"
+
+	"inventory-system/internal/config"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AdminHandler serves operational endpoints for the people running the service.
+type AdminHandler struct {
+	config *config.Live
+}
+
+// NewAdminHandler creates a new AdminHandler.
+func NewAdminHandler(live *config.Live) *AdminHandler {
+	return &AdminHandler{config: live}
+}
+
+// GetEffectiveConfig godoc
+// @Summary Show effective configuration
+// @Description Returns the configuration in force, including tunables changed at runtime. Credentials are never included.
+// @Tags admin
+// @Produce json
+// @Success 200 {object} config.Effective
+// @Router /admin/config [get]
+func (h *AdminHandler) GetEffectiveConfig(c echo.Context) error {
+	return c.JSON(http.StatusOK, h.config.Effective())
+}
+
+// ReloadConfig godoc
+// @Summary Reload tunable configuration
+// @Description Re-reads .env and the environment and applies the tunable settings, like sending SIGHUP.
+// @Tags admin
+// @Produce json
+// @Success 200 {object} config.Effective
+// @Router /admin/config/reload [post]
+func (h *AdminHandler) ReloadConfig(c echo.Context) error {
+	h.config.Reload()
+	return c.JSON(http.StatusOK, h.config.Effective())
+}
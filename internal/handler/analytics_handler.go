@@ -3,22 +3,26 @@ package handler
 import (
 	"log"
 	"net/http"
-	"strconv"
 
 	"inventory-system/internal/domain"
 	"inventory-system/pkg/httputil"
 
+	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 )
 
 // AnalyticsHandler handles HTTP requests for analytics.
 type AnalyticsHandler struct {
 	analyticsService domain.AnalyticsService
+	validate         *validator.Validate
 }
 
 // NewAnalyticsHandler creates a new AnalyticsHandler.
 func NewAnalyticsHandler(as domain.AnalyticsService) *AnalyticsHandler {
-	return &AnalyticsHandler{analyticsService: as}
+	return &AnalyticsHandler{
+		analyticsService: as,
+		validate:         newValidator(),
+	}
 }
 
 // GetTotalStockValue godoc
@@ -45,19 +49,17 @@ func (h *AnalyticsHandler) GetTotalStockValue(c echo.Context) error {
 // @Produce json
 // @Param global_threshold query int false "Global low stock threshold if item-specific one isn't set (default: 5)"
 // @Success 200 {array} domain.Item "List of low stock items"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid query parameters, listed in details)"
 // @Failure 500 {object} httputil.HTTPError "Internal Server Error"
 // @Router /analytics/low-stock [get]
 func (h *AnalyticsHandler) GetLowStockItems(c echo.Context) error {
-	thresholdStr := c.QueryParam("global_threshold")
-	globalThreshold := 5 // Default global threshold
-	if thresholdStr != "" {
-		parsedThreshold, err := strconv.Atoi(thresholdStr)
-		if err == nil && parsedThreshold >= 0 {
-			globalThreshold = parsedThreshold
-		}
+	query := domain.LowStockQuery{GlobalThreshold: 5} // Default global threshold
+	if httpErr := bindQuery(c, h.validate, &query); httpErr != nil {
+		log.Printf("GetLowStockItems: Invalid query parameters: %v", httpErr.Details)
+		return httputil.SendErrorResponse(c, httpErr)
 	}
 
-	items, err := h.analyticsService.ListLowStockItems(c.Request().Context(), globalThreshold)
+	items, err := h.analyticsService.ListLowStockItems(c.Request().Context(), query.GlobalThreshold)
 	if err != nil {
 		log.Printf("GetLowStockItems: Service error: %v", err)
 		return httputil.SendErrorResponse(c, httputil.InternalServerError("Failed to retrieve low stock items."))
@@ -72,23 +74,17 @@ func (h *AnalyticsHandler) GetLowStockItems(c echo.Context) error {
 // @Produce json
 // @Param limit query int false "Number of items to return (default: 5, max: 50)"
 // @Success 200 {array} domain.Item "List of most valuable items"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid query parameters, listed in details)"
 // @Failure 500 {object} httputil.HTTPError "Internal Server Error"
 // @Router /analytics/most-valuable [get]
 func (h *AnalyticsHandler) GetMostValuableItems(c echo.Context) error {
-	limitStr := c.QueryParam("limit")
-	limit := 5 // Default limit
-	if limitStr != "" {
-		parsedLimit, err := strconv.Atoi(limitStr)
-		if err == nil && parsedLimit > 0 {
-			limit = parsedLimit
-		}
+	query := domain.MostValuableQuery{Limit: 5} // Default limit
+	if httpErr := bindQuery(c, h.validate, &query); httpErr != nil {
+		log.Printf("GetMostValuableItems: Invalid query parameters: %v", httpErr.Details)
+		return httputil.SendErrorResponse(c, httpErr)
 	}
-    if limit > 50 { // Max limit defined in service, can also be enforced here
-        limit = 50
-    }
-
 
-	items, err := h.analyticsService.ListMostValuableItems(c.Request().Context(), limit)
+	items, err := h.analyticsService.ListMostValuableItems(c.Request().Context(), query.Limit)
 	if err != nil {
 		log.Printf("GetMostValuableItems: Service error: %v", err)
 		return httputil.SendErrorResponse(c, httputil.InternalServerError("Failed to retrieve most valuable items."))
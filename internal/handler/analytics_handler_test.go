@@ -0,0 +1,120 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/handler"
+	"inventory-system/internal/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// analyticsCase mirrors handlerCase for the analytics service.
+type analyticsCase struct {
+	name       string
+	target     string
+	setup      func(s *mocks.AnalyticsService)
+	wantStatus int
+	wantBody   string
+}
+
+func runAnalyticsCases(t *testing.T, cases []analyticsCase, route func(*handler.AnalyticsHandler) echo.HandlerFunc) {
+	t.Helper()
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewAnalyticsService(t)
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+			rec := serve(t, handlerCase{method: http.MethodGet, target: tc.target}, route(handler.NewAnalyticsHandler(svc)))
+
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tc.wantBody)
+		})
+	}
+}
+
+func TestAnalyticsHandler_GetTotalStockValue(t *testing.T) {
+	runAnalyticsCases(t, []analyticsCase{
+		{
+			name: "value", target: "/analytics/stock-value",
+			setup: func(s *mocks.AnalyticsService) {
+				s.On("CalculateTotalStockValue", mock.Anything).Return(1234.5, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"total_value":1234.5`,
+		},
+		{
+			name: "service failure", target: "/analytics/stock-value",
+			setup: func(s *mocks.AnalyticsService) {
+				s.On("CalculateTotalStockValue", mock.Anything).Return(0.0, errBoom)
+			},
+			wantStatus: http.StatusInternalServerError, wantBody: "Failed to calculate total stock value.",
+		},
+	}, func(h *handler.AnalyticsHandler) echo.HandlerFunc { return h.GetTotalStockValue })
+}
+
+func TestAnalyticsHandler_GetLowStockItems(t *testing.T) {
+	withThreshold := func(threshold int) func(s *mocks.AnalyticsService) {
+		return func(s *mocks.AnalyticsService) {
+			s.On("ListLowStockItems", mock.Anything, threshold).Return([]*domain.Item{{ID: itemID}}, nil)
+		}
+	}
+
+	runAnalyticsCases(t, []analyticsCase{
+		{name: "default threshold", target: "/analytics/low-stock", setup: withThreshold(5), wantStatus: http.StatusOK, wantBody: itemID},
+		{name: "custom threshold", target: "/analytics/low-stock?global_threshold=12", setup: withThreshold(12), wantStatus: http.StatusOK},
+		{name: "zero threshold", target: "/analytics/low-stock?global_threshold=0", setup: withThreshold(0), wantStatus: http.StatusOK},
+		{
+			name: "negative threshold rejected", target: "/analytics/low-stock?global_threshold=-1",
+			wantStatus: http.StatusBadRequest, wantBody: `"global_threshold":"Failed validation on rule 'gte=0'"`,
+		},
+		{
+			name: "non-numeric threshold rejected", target: "/analytics/low-stock?global_threshold=lots",
+			wantStatus: http.StatusBadRequest, wantBody: `"global_threshold":"Must be an integer"`,
+		},
+		{
+			name: "service failure", target: "/analytics/low-stock",
+			setup: func(s *mocks.AnalyticsService) {
+				s.On("ListLowStockItems", mock.Anything, 5).Return(nil, errBoom)
+			},
+			wantStatus: http.StatusInternalServerError, wantBody: "Failed to retrieve low stock items.",
+		},
+	}, func(h *handler.AnalyticsHandler) echo.HandlerFunc { return h.GetLowStockItems })
+}
+
+func TestAnalyticsHandler_GetMostValuableItems(t *testing.T) {
+	withLimit := func(limit int) func(s *mocks.AnalyticsService) {
+		return func(s *mocks.AnalyticsService) {
+			s.On("ListMostValuableItems", mock.Anything, limit).Return([]*domain.Item{{ID: itemID}}, nil)
+		}
+	}
+
+	runAnalyticsCases(t, []analyticsCase{
+		{name: "default limit", target: "/analytics/most-valuable", setup: withLimit(5), wantStatus: http.StatusOK, wantBody: itemID},
+		{name: "custom limit", target: "/analytics/most-valuable?limit=20", setup: withLimit(20), wantStatus: http.StatusOK},
+		{name: "maximum limit", target: "/analytics/most-valuable?limit=50", setup: withLimit(50), wantStatus: http.StatusOK},
+		{
+			name: "limit above maximum rejected", target: "/analytics/most-valuable?limit=500",
+			wantStatus: http.StatusBadRequest, wantBody: `"limit":"Failed validation on rule 'max=50'"`,
+		},
+		{
+			name: "zero limit rejected", target: "/analytics/most-valuable?limit=0",
+			wantStatus: http.StatusBadRequest, wantBody: `"limit":"Failed validation on rule 'min=1'"`,
+		},
+		{
+			name: "non-numeric limit rejected", target: "/analytics/most-valuable?limit=ten",
+			wantStatus: http.StatusBadRequest, wantBody: `"limit":"Must be an integer"`,
+		},
+		{
+			name: "service failure", target: "/analytics/most-valuable",
+			setup: func(s *mocks.AnalyticsService) {
+				s.On("ListMostValuableItems", mock.Anything, 5).Return(nil, errBoom)
+			},
+			wantStatus: http.StatusInternalServerError, wantBody: "Failed to retrieve most valuable items.",
+		},
+	}, func(h *handler.AnalyticsHandler) echo.HandlerFunc { return h.GetMostValuableItems })
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// AnomalyHandler handles HTTP requests for stock movement anomalies.
+type AnomalyHandler struct {
+	anomalyService domain.AnomalyService
+	validate       *validator.Validate
+}
+
+// NewAnomalyHandler creates a new AnomalyHandler.
+func NewAnomalyHandler(as domain.AnomalyService) *AnomalyHandler {
+	return &AnomalyHandler{
+		anomalyService: as,
+		validate:       newValidator(),
+	}
+}
+
+// ListAnomalies godoc
+// @Summary List anomalies
+// @Description Lists stock movements flagged for loss prevention, newest first: write-offs and downward corrections far above
+// @Description an item's usual ones (large_write_off; sales and assembly consumption are not write-offs), stock moved by a user outside business hours (after_hours) and users moving stock far
+// @Description more often than usual in a day (user_volume). Every quantity change in the movement ledger is checked.
+// @Tags anomalies
+// @Produce json
+// @Param kind query string false "Only this kind (large_write_off, after_hours or user_volume)"
+// @Param unacknowledged query bool false "Only anomalies nobody has acknowledged"
+// @Param limit query int false "Maximum number of anomalies (default: 50, max: 200)"
+// @Success 200 {array} domain.Anomaly
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid query parameters, listed in details)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /anomalies [get]
+func (h *AnomalyHandler) ListAnomalies(c echo.Context) error {
+	query := domain.ListAnomaliesQuery{Limit: 50} // Defaults
+	if httpErr := bindQuery(c, h.validate, &query); httpErr != nil {
+		log.Printf("ListAnomalies: Invalid query parameters: %v", httpErr.Details)
+		return httputil.SendErrorResponse(c, httpErr)
+	}
+
+	anomalies, err := h.anomalyService.ListAnomalies(c.Request().Context(), query)
+	if err != nil {
+		log.Printf("ListAnomalies: Service error: %v", err)
+		return sendAnomalyError(c, err, "Failed to retrieve anomalies.")
+	}
+	return c.JSON(http.StatusOK, anomalies)
+}
+
+// AcknowledgeAnomaly godoc
+// @Summary Acknowledge an anomaly
+// @Description Records that the caller reviewed the anomaly. Acknowledging it again keeps the first reviewer.
+// @Tags anomalies
+// @Produce json
+// @Param X-User-ID header string true "Calling user"
+// @Param id path string true "Anomaly ID (UUID)"
+// @Success 200 {object} domain.Anomaly "Acknowledged anomaly"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /anomalies/{id}/acknowledge [post]
+func (h *AnomalyHandler) AcknowledgeAnomaly(c echo.Context) error {
+	id := c.Param("id")
+
+	a, err := h.anomalyService.Acknowledge(c.Request().Context(), id, currentUserID(c))
+	if err != nil {
+		log.Printf("AcknowledgeAnomaly: Service error for ID %s: %v", id, err)
+		return sendAnomalyError(c, err, "Failed to acknowledge anomaly.")
+	}
+	return c.JSON(http.StatusOK, a)
+}
+
+// DetectAnomalies godoc
+// @Summary Run anomaly detection now
+// @Description Runs the detection job immediately instead of waiting for its next scheduled run. Anomalies flagged
+// @Description by an earlier run are not flagged or notified again.
+// @Tags admin
+// @Produce json
+// @Success 200 {object} domain.AnomalyDetectionResult "Newly flagged anomalies"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /admin/anomalies/detect [post]
+func (h *AnomalyHandler) DetectAnomalies(c echo.Context) error {
+	result, err := h.anomalyService.Detect(c.Request().Context(), time.Now())
+	if err != nil {
+		log.Printf("DetectAnomalies: Service error: %v", err)
+		return sendAnomalyError(c, err, "Failed to run anomaly detection.")
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// sendAnomalyError maps anomaly service errors to HTTP responses.
+func sendAnomalyError(c echo.Context, err error, fallback string) error {
+	switch {
+	case errors.Is(err, domain.ErrMissingUser):
+		return httputil.SendErrorResponse(c, httputil.UnauthorizedError("Missing "+HeaderUserID+" header."))
+	case errors.Is(err, domain.ErrInvalidInput):
+		return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+	case errors.Is(err, domain.ErrAnomalyNotFound):
+		return httputil.SendErrorResponse(c, httputil.NotFoundError(err.Error()))
+	}
+	return httputil.SendErrorResponse(c, httputil.InternalServerError(fallback))
+}
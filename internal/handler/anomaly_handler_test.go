@@ -0,0 +1,111 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/handler"
+	"inventory-system/internal/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const anomalyID = "7d3c1a0e-5b6f-4c2d-9e8a-1f2b3c4d5e6f"
+
+func TestAnomalyHandler_ListAnomalies(t *testing.T) {
+	cases := []struct {
+		name       string
+		target     string
+		setup      func(s *mocks.AnomalyService)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:   "filtered",
+			target: "/api/v1/anomalies?kind=after_hours&unacknowledged=true",
+			setup: func(s *mocks.AnomalyService) {
+				s.On("ListAnomalies", mock.Anything, domain.ListAnomaliesQuery{Kind: domain.AnomalyKindAfterHours, Unacknowledged: true, Limit: 50}).
+					Return([]*domain.Anomaly{{ID: anomalyID, Kind: domain.AnomalyKindAfterHours, UserID: "alice"}}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"kind":"after_hours"`,
+		},
+		{
+			name:       "unknown kind",
+			target:     "/api/v1/anomalies?kind=theft",
+			wantStatus: http.StatusBadRequest, wantBody: "kind",
+		},
+		{
+			name:   "service error",
+			target: "/api/v1/anomalies",
+			setup: func(s *mocks.AnomalyService) {
+				s.On("ListAnomalies", mock.Anything, mock.Anything).Return(nil, errBoom)
+			},
+			wantStatus: http.StatusInternalServerError, wantBody: "Failed to retrieve anomalies.",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewAnomalyService(t)
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+			rec := serve(t, handlerCase{method: http.MethodGet, target: tc.target}, handler.NewAnomalyHandler(svc).ListAnomalies)
+
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tc.wantBody)
+		})
+	}
+}
+
+func TestAnomalyHandler_AcknowledgeAnomaly(t *testing.T) {
+	cases := []struct {
+		name       string
+		user       string
+		setup      func(s *mocks.AnomalyService)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "acknowledged",
+			user: "bob",
+			setup: func(s *mocks.AnomalyService) {
+				by := "bob"
+				s.On("Acknowledge", mock.Anything, anomalyID, "bob").
+					Return(&domain.Anomaly{ID: anomalyID, AcknowledgedBy: &by}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"acknowledged_by":"bob"`,
+		},
+		{
+			name: "missing user",
+			setup: func(s *mocks.AnomalyService) {
+				s.On("Acknowledge", mock.Anything, anomalyID, "").Return(nil, domain.ErrMissingUser)
+			},
+			wantStatus: http.StatusUnauthorized, wantBody: handler.HeaderUserID,
+		},
+		{
+			name: "not found",
+			user: "bob",
+			setup: func(s *mocks.AnomalyService) {
+				s.On("Acknowledge", mock.Anything, anomalyID, "bob").
+					Return(nil, fmt.Errorf("%w: ID %s", domain.ErrAnomalyNotFound, anomalyID))
+			},
+			wantStatus: http.StatusNotFound, wantBody: "anomaly not found",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewAnomalyService(t)
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+			rec := serve(t, handlerCase{method: http.MethodPost, target: "/api/v1/anomalies/" + anomalyID + "/acknowledge", id: anomalyID, user: tc.user},
+				handler.NewAnomalyHandler(svc).AcknowledgeAnomaly)
+
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tc.wantBody)
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// AssemblyHandler handles HTTP requests for bills of materials and assembly orders.
+type AssemblyHandler struct {
+	assemblyService domain.AssemblyService
+	validate        *validator.Validate
+}
+
+// NewAssemblyHandler creates a new AssemblyHandler.
+func NewAssemblyHandler(as domain.AssemblyService) *AssemblyHandler {
+	return &AssemblyHandler{
+		assemblyService: as,
+		validate:        newValidator(),
+	}
+}
+
+// GetItemComponents godoc
+// @Summary Get the components of an item
+// @Description Lists the bill of materials of an item: the components consumed to assemble one unit of it
+// @Tags items
+// @Produce json
+// @Param id path string true "Item ID (UUID)"
+// @Success 200 {array} domain.Component
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/{id}/components [get]
+func (h *AssemblyHandler) GetItemComponents(c echo.Context) error {
+	id := c.Param("id")
+
+	components, err := h.assemblyService.GetComponents(c.Request().Context(), id)
+	if err != nil {
+		log.Printf("GetItemComponents: Service error for ID %s: %v", id, err)
+		return sendAssemblyError(c, err, "Failed to retrieve item components.")
+	}
+	return c.JSON(http.StatusOK, components)
+}
+
+// SetItemComponents godoc
+// @Summary Set the components of an item
+// @Description Replaces the bill of materials of an item; an empty list clears it. Open assembly orders keep
+// @Description the components they were created with.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID (UUID)"
+// @Param components body domain.SetComponentsRequest true "Components per assembled unit"
+// @Success 200 {array} domain.Component
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID or payload, e.g. the item itself as component)"
+// @Failure 404 {object} httputil.HTTPError "Item or component not found"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/{id}/components [put]
+func (h *AssemblyHandler) SetItemComponents(c echo.Context) error {
+	id := c.Param("id")
+
+	var req domain.SetComponentsRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("SetItemComponents: Bind error for ID %s: %v", id, err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("SetItemComponents: Validation error for ID %s: %v", id, err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	components, err := h.assemblyService.SetComponents(c.Request().Context(), id, &req)
+	if err != nil {
+		log.Printf("SetItemComponents: Service error for ID %s: %v", id, err)
+		return sendAssemblyError(c, err, "Failed to set item components.")
+	}
+	return c.JSON(http.StatusOK, components)
+}
+
+// CreateAssemblyOrder godoc
+// @Summary Create an assembly order
+// @Description Opens an order to assemble quantity units of an item out of its components. The components
+// @Description to consume are fixed now; stock changes only when the order is completed.
+// @Tags assembly
+// @Accept json
+// @Produce json
+// @Param X-User-ID header string true "Calling user"
+// @Param order body domain.CreateAssemblyOrderRequest true "Item and quantity to assemble"
+// @Success 201 {object} domain.AssemblyOrder "Successfully created order"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (e.g. the item has no components)"
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 404 {object} httputil.HTTPError "Item not found"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /assembly-orders [post]
+func (h *AssemblyHandler) CreateAssemblyOrder(c echo.Context) error {
+	var req domain.CreateAssemblyOrderRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("CreateAssemblyOrder: Bind error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("CreateAssemblyOrder: Validation error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	order, err := h.assemblyService.CreateOrder(c.Request().Context(), &req, currentUserID(c))
+	if err != nil {
+		log.Printf("CreateAssemblyOrder: Service error: %v", err)
+		return sendAssemblyError(c, err, "Failed to create assembly order.")
+	}
+	return c.JSON(http.StatusCreated, order)
+}
+
+// ListAssemblyOrders godoc
+// @Summary List assembly orders
+// @Description Retrieves the latest assembly orders, newest first
+// @Tags assembly
+// @Produce json
+// @Param status query string false "Only orders with this status (open, completed or cancelled)"
+// @Param limit query int false "Maximum number of orders (default: 50, max: 200)"
+// @Success 200 {array} domain.AssemblyOrder
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid query parameters)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /assembly-orders [get]
+func (h *AssemblyHandler) ListAssemblyOrders(c echo.Context) error {
+	query := domain.ListAssemblyOrdersQuery{Limit: 50} // Defaults
+	if httpErr := bindQuery(c, h.validate, &query); httpErr != nil {
+		log.Printf("ListAssemblyOrders: Invalid query parameters: %v", httpErr.Details)
+		return httputil.SendErrorResponse(c, httpErr)
+	}
+
+	orders, err := h.assemblyService.ListOrders(c.Request().Context(), query)
+	if err != nil {
+		log.Printf("ListAssemblyOrders: Service error: %v", err)
+		return sendAssemblyError(c, err, "Failed to retrieve assembly orders.")
+	}
+	return c.JSON(http.StatusOK, orders)
+}
+
+// GetAssemblyOrder godoc
+// @Summary Get an assembly order by ID
+// @Description Retrieves an assembly order with the components it consumes
+// @Tags assembly
+// @Produce json
+// @Param id path string true "Assembly order ID (UUID)"
+// @Success 200 {object} domain.AssemblyOrder
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /assembly-orders/{id} [get]
+func (h *AssemblyHandler) GetAssemblyOrder(c echo.Context) error {
+	id := c.Param("id")
+
+	order, err := h.assemblyService.GetOrder(c.Request().Context(), id)
+	if err != nil {
+		log.Printf("GetAssemblyOrder: Service error for ID %s: %v", id, err)
+		return sendAssemblyError(c, err, "Failed to retrieve assembly order.")
+	}
+	return c.JSON(http.StatusOK, order)
+}
+
+// CompleteAssemblyOrder godoc
+// @Summary Complete an assembly order
+// @Description Consumes the order's components and receives the assembled items into stock, in one transaction.
+// @Description Every change is written to the movement ledger with the order ID as reference, and the new
+// @Description quantities are broadcast over WebSocket. Fails with 409, changing nothing, if a component is short;
+// @Description units reserved by open sales orders are not available to it.
+// @Tags assembly
+// @Produce json
+// @Param X-User-ID header string true "Calling user"
+// @Param id path string true "Assembly order ID (UUID)"
+// @Success 200 {object} domain.CompleteAssemblyResult "Completed order, changed items and their ledger rows"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 409 {object} httputil.HTTPError "Conflict (order not open, or insufficient component stock)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /assembly-orders/{id}/complete [post]
+func (h *AssemblyHandler) CompleteAssemblyOrder(c echo.Context) error {
+	id := c.Param("id")
+
+	result, err := h.assemblyService.CompleteOrder(c.Request().Context(), id, currentUserID(c))
+	if err != nil {
+		log.Printf("CompleteAssemblyOrder: Service error for ID %s: %v", id, err)
+		return sendAssemblyError(c, err, "Failed to complete assembly order.")
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// CancelAssemblyOrder godoc
+// @Summary Cancel an assembly order
+// @Description Closes an open assembly order without touching stock
+// @Tags assembly
+// @Produce json
+// @Param X-User-ID header string true "Calling user"
+// @Param id path string true "Assembly order ID (UUID)"
+// @Success 200 {object} domain.AssemblyOrder "Cancelled order"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 409 {object} httputil.HTTPError "Conflict (order not open)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /assembly-orders/{id}/cancel [post]
+func (h *AssemblyHandler) CancelAssemblyOrder(c echo.Context) error {
+	id := c.Param("id")
+
+	order, err := h.assemblyService.CancelOrder(c.Request().Context(), id, currentUserID(c))
+	if err != nil {
+		log.Printf("CancelAssemblyOrder: Service error for ID %s: %v", id, err)
+		return sendAssemblyError(c, err, "Failed to cancel assembly order.")
+	}
+	return c.JSON(http.StatusOK, order)
+}
+
+// sendAssemblyError maps assembly service errors to HTTP responses.
+func sendAssemblyError(c echo.Context, err error, fallback string) error {
+	switch {
+	case errors.Is(err, domain.ErrMissingUser):
+		return httputil.SendErrorResponse(c, httputil.UnauthorizedError("Missing "+HeaderUserID+" header."))
+	case errors.Is(err, domain.ErrInvalidInput), errors.Is(err, domain.ErrInvalidItemID), errors.Is(err, domain.ErrNoComponents):
+		return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+	case errors.Is(err, domain.ErrItemNotFound), errors.Is(err, domain.ErrAssemblyOrderNotFound):
+		return httputil.SendErrorResponse(c, httputil.NotFoundError(err.Error()))
+	case errors.Is(err, domain.ErrAssemblyOrderClosed), errors.Is(err, domain.ErrInsufficientStock):
+		return httputil.SendErrorResponse(c, httputil.ConflictError(err.Error()))
+	}
+	return httputil.SendErrorResponse(c, httputil.InternalServerError(fallback))
+}
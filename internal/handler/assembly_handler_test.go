@@ -0,0 +1,158 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/handler"
+	"inventory-system/internal/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	componentID     = "5c4b3a29-1807-4f6e-9d5c-4b3a29180706"
+	assemblyOrderID = "7e6d5c4b-3a29-4180-8f6e-5d4c3b2a1908"
+)
+
+func TestAssemblyHandler(t *testing.T) {
+	cases := []struct {
+		name       string
+		tc         handlerCase
+		route      func(h *handler.AssemblyHandler) echo.HandlerFunc
+		setup      func(s *mocks.AssemblyService)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:  "set components",
+			tc:    handlerCase{method: http.MethodPut, target: "/api/v1/items/" + itemID + "/components", id: itemID, body: `{"components":[{"component_id":"` + componentID + `","quantity":4}]}`},
+			route: func(h *handler.AssemblyHandler) echo.HandlerFunc { return h.SetItemComponents },
+			setup: func(s *mocks.AssemblyService) {
+				s.On("SetComponents", mock.Anything, itemID, &domain.SetComponentsRequest{Components: []domain.Component{{ComponentID: componentID, Quantity: 4}}}).
+					Return([]domain.Component{{ComponentID: componentID, Quantity: 4}}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"quantity":4`,
+		},
+		{
+			name:       "set component with zero quantity",
+			tc:         handlerCase{method: http.MethodPut, target: "/api/v1/items/" + itemID + "/components", id: itemID, body: `{"components":[{"component_id":"` + componentID + `","quantity":0}]}`},
+			route:      func(h *handler.AssemblyHandler) echo.HandlerFunc { return h.SetItemComponents },
+			wantStatus: http.StatusUnprocessableEntity, wantBody: "Input validation failed",
+		},
+		{
+			name:  "set item as its own component",
+			tc:    handlerCase{method: http.MethodPut, target: "/api/v1/items/" + itemID + "/components", id: itemID, body: `{"components":[{"component_id":"` + itemID + `","quantity":1}]}`},
+			route: func(h *handler.AssemblyHandler) echo.HandlerFunc { return h.SetItemComponents },
+			setup: func(s *mocks.AssemblyService) {
+				s.On("SetComponents", mock.Anything, itemID, mock.Anything).
+					Return(nil, fmt.Errorf("%w: an item cannot be a component of itself", domain.ErrInvalidInput))
+			},
+			wantStatus: http.StatusBadRequest, wantBody: "component of itself",
+		},
+		{
+			name:  "create order",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/assembly-orders", body: `{"item_id":"` + itemID + `","quantity":2}`, user: "alice"},
+			route: func(h *handler.AssemblyHandler) echo.HandlerFunc { return h.CreateAssemblyOrder },
+			setup: func(s *mocks.AssemblyService) {
+				s.On("CreateOrder", mock.Anything, &domain.CreateAssemblyOrderRequest{ItemID: itemID, Quantity: 2}, "alice").
+					Return(&domain.AssemblyOrder{ID: assemblyOrderID, ItemID: itemID, Quantity: 2, Status: domain.AssemblyStatusOpen,
+						Lines: []domain.Component{{ComponentID: componentID, Quantity: 8}}, CreatedBy: "alice"}, nil)
+			},
+			wantStatus: http.StatusCreated, wantBody: `"lines":[{"component_id":"` + componentID + `","quantity":8}]`,
+		},
+		{
+			name:  "create order without components",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/assembly-orders", body: `{"item_id":"` + itemID + `","quantity":2}`, user: "alice"},
+			route: func(h *handler.AssemblyHandler) echo.HandlerFunc { return h.CreateAssemblyOrder },
+			setup: func(s *mocks.AssemblyService) {
+				s.On("CreateOrder", mock.Anything, mock.Anything, "alice").Return(nil, domain.ErrNoComponents)
+			},
+			wantStatus: http.StatusBadRequest, wantBody: "no components",
+		},
+		{
+			name:  "create order without user",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/assembly-orders", body: `{"item_id":"` + itemID + `","quantity":2}`},
+			route: func(h *handler.AssemblyHandler) echo.HandlerFunc { return h.CreateAssemblyOrder },
+			setup: func(s *mocks.AssemblyService) {
+				s.On("CreateOrder", mock.Anything, mock.Anything, "").Return(nil, domain.ErrMissingUser)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "list with unknown status",
+			tc:         handlerCase{method: http.MethodGet, target: "/api/v1/assembly-orders?status=shipped"},
+			route:      func(h *handler.AssemblyHandler) echo.HandlerFunc { return h.ListAssemblyOrders },
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "list open orders",
+			tc:    handlerCase{method: http.MethodGet, target: "/api/v1/assembly-orders?status=open"},
+			route: func(h *handler.AssemblyHandler) echo.HandlerFunc { return h.ListAssemblyOrders },
+			setup: func(s *mocks.AssemblyService) {
+				s.On("ListOrders", mock.Anything, domain.ListAssemblyOrdersQuery{Status: "open", Limit: 50}).
+					Return([]*domain.AssemblyOrder{{ID: assemblyOrderID, Status: domain.AssemblyStatusOpen}}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: assemblyOrderID,
+		},
+		{
+			name:  "complete order",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/assembly-orders/" + assemblyOrderID + "/complete", id: assemblyOrderID, user: "alice"},
+			route: func(h *handler.AssemblyHandler) echo.HandlerFunc { return h.CompleteAssemblyOrder },
+			setup: func(s *mocks.AssemblyService) {
+				s.On("CompleteOrder", mock.Anything, assemblyOrderID, "alice").Return(&domain.CompleteAssemblyResult{
+					Order: &domain.AssemblyOrder{ID: assemblyOrderID, Status: domain.AssemblyStatusCompleted},
+					Movements: []*domain.StockMovement{
+						{ItemID: componentID, Delta: -8, Reason: domain.MovementReasonAssemblyConsume, Reference: assemblyOrderID},
+						{ItemID: itemID, Delta: 2, Reason: domain.MovementReasonAssemblyProduce, Reference: assemblyOrderID},
+					},
+				}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"reason":"assembly_produce"`,
+		},
+		{
+			name:  "complete with short component",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/assembly-orders/" + assemblyOrderID + "/complete", id: assemblyOrderID, user: "alice"},
+			route: func(h *handler.AssemblyHandler) echo.HandlerFunc { return h.CompleteAssemblyOrder },
+			setup: func(s *mocks.AssemblyService) {
+				s.On("CompleteOrder", mock.Anything, assemblyOrderID, "alice").
+					Return(nil, fmt.Errorf("%w: component '%s' has 3, needs 8", domain.ErrInsufficientStock, componentID))
+			},
+			wantStatus: http.StatusConflict, wantBody: "needs 8",
+		},
+		{
+			name:  "cancel closed order",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/assembly-orders/" + assemblyOrderID + "/cancel", id: assemblyOrderID, user: "alice"},
+			route: func(h *handler.AssemblyHandler) echo.HandlerFunc { return h.CancelAssemblyOrder },
+			setup: func(s *mocks.AssemblyService) {
+				s.On("CancelOrder", mock.Anything, assemblyOrderID, "alice").Return(nil, domain.ErrAssemblyOrderClosed)
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:  "get unknown order",
+			tc:    handlerCase{method: http.MethodGet, target: "/api/v1/assembly-orders/" + assemblyOrderID, id: assemblyOrderID},
+			route: func(h *handler.AssemblyHandler) echo.HandlerFunc { return h.GetAssemblyOrder },
+			setup: func(s *mocks.AssemblyService) {
+				s.On("GetOrder", mock.Anything, assemblyOrderID).Return(nil, fmt.Errorf("%w: ID %s", domain.ErrAssemblyOrderNotFound, assemblyOrderID))
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewAssemblyService(t)
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+			rec := serve(t, tc.tc, tc.route(handler.NewAssemblyHandler(svc)))
+
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tc.wantBody)
+		})
+	}
+}
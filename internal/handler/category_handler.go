@@ -0,0 +1,205 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// CategoryHandler handles HTTP requests for the category tree and the categories of items.
+type CategoryHandler struct {
+	categoryService domain.CategoryService
+	validate        *validator.Validate
+}
+
+// NewCategoryHandler creates a new CategoryHandler.
+func NewCategoryHandler(cs domain.CategoryService) *CategoryHandler {
+	return &CategoryHandler{
+		categoryService: cs,
+		validate:        newValidator(),
+	}
+}
+
+// CreateCategory godoc
+// @Summary Create a category
+// @Description Creates a root category, or a subcategory when parent_id is given. Sibling names must be unique.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param category body domain.CreateCategoryRequest true "Category to create"
+// @Success 201 {object} domain.Category "Successfully created category"
+// @Failure 400 {object} httputil.HTTPError "Bad Request"
+// @Failure 404 {object} httputil.HTTPError "Parent category not found"
+// @Failure 409 {object} httputil.HTTPError "Conflict (a sibling has the same name)"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /categories [post]
+func (h *CategoryHandler) CreateCategory(c echo.Context) error {
+	var req domain.CreateCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("CreateCategory: Bind error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("CreateCategory: Validation error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	category, err := h.categoryService.CreateCategory(c.Request().Context(), &req)
+	if err != nil {
+		log.Printf("CreateCategory: Service error: %v", err)
+		return sendCategoryError(c, err, "Failed to create category.")
+	}
+	return c.JSON(http.StatusCreated, category)
+}
+
+// ListCategories godoc
+// @Summary List categories
+// @Description Retrieves every category, ordered by name. The tree is given by parent_id (null for roots).
+// @Tags categories
+// @Produce json
+// @Success 200 {array} domain.Category "List of categories"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /categories [get]
+func (h *CategoryHandler) ListCategories(c echo.Context) error {
+	categories, err := h.categoryService.ListCategories(c.Request().Context())
+	if err != nil {
+		log.Printf("ListCategories: Service error: %v", err)
+		return sendCategoryError(c, err, "Failed to retrieve categories.")
+	}
+	return c.JSON(http.StatusOK, categories)
+}
+
+// GetCategory godoc
+// @Summary Get a category by ID
+// @Description Retrieves a category with its direct subcategories. Use GET /items?category= for its items.
+// @Tags categories
+// @Produce json
+// @Param id path string true "Category ID (UUID)"
+// @Success 200 {object} domain.Category "Category"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /categories/{id} [get]
+func (h *CategoryHandler) GetCategory(c echo.Context) error {
+	id := c.Param("id")
+
+	category, err := h.categoryService.GetCategory(c.Request().Context(), id)
+	if err != nil {
+		log.Printf("GetCategory: Service error for ID %s: %v", id, err)
+		return sendCategoryError(c, err, "Failed to retrieve category.")
+	}
+	return c.JSON(http.StatusOK, category)
+}
+
+// UpdateCategory godoc
+// @Summary Rename or move a category
+// @Description Replaces the name and parent of a category; omitting parent_id makes it a root. Subcategories and
+// @Description items move along. A category cannot be moved below itself or one of its subcategories.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param id path string true "Category ID (UUID)"
+// @Param category body domain.UpdateCategoryRequest true "New name and parent"
+// @Success 200 {object} domain.Category "Successfully updated category"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID or payload, or a move below itself)"
+// @Failure 404 {object} httputil.HTTPError "Category or parent not found"
+// @Failure 409 {object} httputil.HTTPError "Conflict (a sibling has the same name)"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /categories/{id} [put]
+func (h *CategoryHandler) UpdateCategory(c echo.Context) error {
+	id := c.Param("id")
+
+	var req domain.UpdateCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("UpdateCategory: Bind error for ID %s: %v", id, err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("UpdateCategory: Validation error for ID %s: %v", id, err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	category, err := h.categoryService.UpdateCategory(c.Request().Context(), id, &req)
+	if err != nil {
+		log.Printf("UpdateCategory: Service error for ID %s: %v", id, err)
+		return sendCategoryError(c, err, "Failed to update category.")
+	}
+	return c.JSON(http.StatusOK, category)
+}
+
+// DeleteCategory godoc
+// @Summary Delete a category
+// @Description Deletes a category without subcategories; its items become uncategorized
+// @Tags categories
+// @Param X-User-ID header string false "Calling user, recorded in the history of the uncategorized items"
+// @Param id path string true "Category ID (UUID)"
+// @Success 204 "Successfully deleted category (No Content)"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 409 {object} httputil.HTTPError "Conflict (the category has subcategories)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /categories/{id} [delete]
+func (h *CategoryHandler) DeleteCategory(c echo.Context) error {
+	id := c.Param("id")
+
+	if err := h.categoryService.DeleteCategory(c.Request().Context(), id, currentUserID(c)); err != nil {
+		log.Printf("DeleteCategory: Service error for ID %s: %v", id, err)
+		return sendCategoryError(c, err, "Failed to delete category.")
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// AssignItemCategory godoc
+// @Summary Set the category of an item
+// @Description Puts the item into a category, or takes it out of its category when category_id is null
+// @Tags items
+// @Accept json
+// @Param X-User-ID header string false "Calling user, recorded in the item history"
+// @Param id path string true "Item ID (UUID)"
+// @Param assignment body domain.AssignCategoryRequest true "Category of the item"
+// @Success 204 "Successfully assigned (No Content)"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID or payload)"
+// @Failure 404 {object} httputil.HTTPError "Item or category not found"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/{id}/category [put]
+func (h *CategoryHandler) AssignItemCategory(c echo.Context) error {
+	id := c.Param("id")
+
+	var req domain.AssignCategoryRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("AssignItemCategory: Bind error for ID %s: %v", id, err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("AssignItemCategory: Validation error for ID %s: %v", id, err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	if err := h.categoryService.AssignItem(c.Request().Context(), id, &req, currentUserID(c)); err != nil {
+		log.Printf("AssignItemCategory: Service error for ID %s: %v", id, err)
+		return sendCategoryError(c, err, "Failed to assign item to category.")
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// sendCategoryError maps category service errors to HTTP responses.
+func sendCategoryError(c echo.Context, err error, fallback string) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidInput), errors.Is(err, domain.ErrInvalidItemID), errors.Is(err, domain.ErrCategoryCycle):
+		return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+	case errors.Is(err, domain.ErrCategoryNotFound), errors.Is(err, domain.ErrItemNotFound):
+		return httputil.SendErrorResponse(c, httputil.NotFoundError(err.Error()))
+	case errors.Is(err, domain.ErrCategoryNameTaken), errors.Is(err, domain.ErrCategoryHasChildren):
+		return httputil.SendErrorResponse(c, httputil.ConflictError(err.Error()))
+	}
+	return httputil.SendErrorResponse(c, httputil.InternalServerError(fallback))
+}
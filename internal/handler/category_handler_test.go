@@ -0,0 +1,134 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/handler"
+	"inventory-system/internal/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	categoryID = "3f1e2d4c-6b5a-4978-8a1b-2c3d4e5f6a7b"
+	parentID   = "9a8b7c6d-5e4f-4a3b-9c2d-1e0f2a3b4c5d"
+)
+
+func TestCategoryHandler(t *testing.T) {
+	parent := parentID
+	cases := []struct {
+		name       string
+		tc         handlerCase
+		route      func(h *handler.CategoryHandler) echo.HandlerFunc
+		setup      func(s *mocks.CategoryService)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:  "create subcategory",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/categories", body: `{"name":"Screws","parent_id":"` + parentID + `"}`},
+			route: func(h *handler.CategoryHandler) echo.HandlerFunc { return h.CreateCategory },
+			setup: func(s *mocks.CategoryService) {
+				s.On("CreateCategory", mock.Anything, &domain.CreateCategoryRequest{Name: "Screws", ParentID: &parent}).
+					Return(&domain.Category{ID: categoryID, Name: "Screws", ParentID: &parent}, nil)
+			},
+			wantStatus: http.StatusCreated, wantBody: `"parent_id":"` + parentID + `"`,
+		},
+		{
+			name:       "create without name",
+			tc:         handlerCase{method: http.MethodPost, target: "/api/v1/categories", body: `{"parent_id":"` + parentID + `"}`},
+			route:      func(h *handler.CategoryHandler) echo.HandlerFunc { return h.CreateCategory },
+			wantStatus: http.StatusUnprocessableEntity, wantBody: "Input validation failed",
+		},
+		{
+			name:  "create duplicate name",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/categories", body: `{"name":"Tools"}`},
+			route: func(h *handler.CategoryHandler) echo.HandlerFunc { return h.CreateCategory },
+			setup: func(s *mocks.CategoryService) {
+				s.On("CreateCategory", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("%w: 'Tools'", domain.ErrCategoryNameTaken))
+			},
+			wantStatus: http.StatusConflict, wantBody: "already has this name",
+		},
+		{
+			name:  "get with children",
+			tc:    handlerCase{method: http.MethodGet, target: "/api/v1/categories/" + parentID, id: parentID},
+			route: func(h *handler.CategoryHandler) echo.HandlerFunc { return h.GetCategory },
+			setup: func(s *mocks.CategoryService) {
+				s.On("GetCategory", mock.Anything, parentID).Return(&domain.Category{ID: parentID, Name: "Hardware",
+					Children: []*domain.Category{{ID: categoryID, Name: "Screws", ParentID: &parent}}}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"children":[{"id":"` + categoryID + `"`,
+		},
+		{
+			name:  "get not found",
+			tc:    handlerCase{method: http.MethodGet, target: "/api/v1/categories/" + categoryID, id: categoryID},
+			route: func(h *handler.CategoryHandler) echo.HandlerFunc { return h.GetCategory },
+			setup: func(s *mocks.CategoryService) {
+				s.On("GetCategory", mock.Anything, categoryID).Return(nil, fmt.Errorf("%w: ID %s", domain.ErrCategoryNotFound, categoryID))
+			},
+			wantStatus: http.StatusNotFound, wantBody: "category not found",
+		},
+		{
+			name:  "move below itself",
+			tc:    handlerCase{method: http.MethodPut, target: "/api/v1/categories/" + parentID, id: parentID, body: `{"name":"Hardware","parent_id":"` + categoryID + `"}`},
+			route: func(h *handler.CategoryHandler) echo.HandlerFunc { return h.UpdateCategory },
+			setup: func(s *mocks.CategoryService) {
+				s.On("UpdateCategory", mock.Anything, parentID, mock.Anything).Return(nil, domain.ErrCategoryCycle)
+			},
+			wantStatus: http.StatusBadRequest, wantBody: "below itself",
+		},
+		{
+			name:  "delete with children",
+			tc:    handlerCase{method: http.MethodDelete, target: "/api/v1/categories/" + parentID, id: parentID},
+			route: func(h *handler.CategoryHandler) echo.HandlerFunc { return h.DeleteCategory },
+			setup: func(s *mocks.CategoryService) {
+				s.On("DeleteCategory", mock.Anything, parentID, "").Return(domain.ErrCategoryHasChildren)
+			},
+			wantStatus: http.StatusConflict, wantBody: "subcategories",
+		},
+		{
+			name: "assign item",
+			tc: handlerCase{method: http.MethodPut, target: "/api/v1/items/" + itemID + "/category", id: itemID,
+				body: `{"category_id":"` + categoryID + `"}`, user: "alice"},
+			route: func(h *handler.CategoryHandler) echo.HandlerFunc { return h.AssignItemCategory },
+			setup: func(s *mocks.CategoryService) {
+				s.On("AssignItem", mock.Anything, itemID, mock.MatchedBy(func(r *domain.AssignCategoryRequest) bool {
+					return r.CategoryID != nil && *r.CategoryID == categoryID
+				}), "alice").Return(nil)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:  "unassign item",
+			tc:    handlerCase{method: http.MethodPut, target: "/api/v1/items/" + itemID + "/category", id: itemID, body: `{"category_id":null}`},
+			route: func(h *handler.CategoryHandler) echo.HandlerFunc { return h.AssignItemCategory },
+			setup: func(s *mocks.CategoryService) {
+				s.On("AssignItem", mock.Anything, itemID, &domain.AssignCategoryRequest{}, "").Return(nil)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:       "assign to malformed category",
+			tc:         handlerCase{method: http.MethodPut, target: "/api/v1/items/" + itemID + "/category", id: itemID, body: `{"category_id":"tools"}`},
+			route:      func(h *handler.CategoryHandler) echo.HandlerFunc { return h.AssignItemCategory },
+			wantStatus: http.StatusUnprocessableEntity, wantBody: "Input validation failed",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewCategoryService(t)
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+			rec := serve(t, tc.tc, tc.route(handler.NewCategoryHandler(svc)))
+
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tc.wantBody)
+		})
+	}
+}
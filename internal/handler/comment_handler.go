@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// CommentHandler handles HTTP requests for comments on entities.
+type CommentHandler struct {
+	commentService domain.CommentService
+	validate       *validator.Validate
+}
+
+// NewCommentHandler creates a new CommentHandler.
+func NewCommentHandler(cs domain.CommentService) *CommentHandler {
+	return &CommentHandler{
+		commentService: cs,
+		validate:       newValidator(),
+	}
+}
+
+// CreateItemComment godoc
+// @Summary Comment on an item
+// @Description Posts a comment on an item. @mentions in the body notify the mentioned users.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID (UUID)"
+// @Param X-User-ID header string true "Calling user"
+// @Param comment body domain.CreateCommentRequest true "Comment to post"
+// @Success 201 {object} domain.Comment "Successfully created comment"
+// @Failure 400 {object} httputil.HTTPError "Bad Request"
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 404 {object} httputil.HTTPError "Item not found"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/{id}/comments [post]
+func (h *CommentHandler) CreateItemComment(c echo.Context) error {
+	return h.createComment(c, domain.CommentEntityItem)
+}
+
+// ListItemComments godoc
+// @Summary List comments on an item
+// @Description Retrieves all comments on an item, oldest first
+// @Tags comments
+// @Produce json
+// @Param id path string true "Item ID (UUID)"
+// @Success 200 {array} domain.Comment "List of comments"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 404 {object} httputil.HTTPError "Item not found"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/{id}/comments [get]
+func (h *CommentHandler) ListItemComments(c echo.Context) error {
+	return h.listComments(c, domain.CommentEntityItem)
+}
+
+// DeleteComment godoc
+// @Summary Delete a comment
+// @Description Deletes a comment. Only the author may delete it.
+// @Tags comments
+// @Param commentId path string true "Comment ID (UUID)"
+// @Param X-User-ID header string true "Calling user"
+// @Success 204 "Successfully deleted comment (No Content)"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 403 {object} httputil.HTTPError "Forbidden (not the author)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /comments/{commentId} [delete]
+func (h *CommentHandler) DeleteComment(c echo.Context) error {
+	id := c.Param("commentId")
+
+	err := h.commentService.DeleteComment(c.Request().Context(), id, currentUserID(c))
+	if err != nil {
+		log.Printf("DeleteComment: Service error for ID %s: %v", id, err)
+		return sendCommentError(c, err, "Failed to delete comment.")
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *CommentHandler) createComment(c echo.Context, entityType string) error {
+	entityID := c.Param("id")
+
+	var req domain.CreateCommentRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("CreateComment: Bind error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("CreateComment: Validation error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	comment, err := h.commentService.AddComment(c.Request().Context(), entityType, entityID, currentUserID(c), &req)
+	if err != nil {
+		log.Printf("CreateComment: Service error for %s %s: %v", entityType, entityID, err)
+		return sendCommentError(c, err, "Failed to create comment.")
+	}
+	return c.JSON(http.StatusCreated, comment)
+}
+
+func (h *CommentHandler) listComments(c echo.Context, entityType string) error {
+	entityID := c.Param("id")
+
+	comments, err := h.commentService.ListComments(c.Request().Context(), entityType, entityID)
+	if err != nil {
+		log.Printf("ListComments: Service error for %s %s: %v", entityType, entityID, err)
+		return sendCommentError(c, err, "Failed to retrieve comments.")
+	}
+	return c.JSON(http.StatusOK, comments)
+}
+
+// sendCommentError maps comment service errors to HTTP responses.
+func sendCommentError(c echo.Context, err error, fallback string) error {
+	switch {
+	case errors.Is(err, domain.ErrMissingUser):
+		return httputil.SendErrorResponse(c, httputil.UnauthorizedError("Missing "+HeaderUserID+" header."))
+	case errors.Is(err, domain.ErrNotCommentAuthor):
+		return httputil.SendErrorResponse(c, httputil.ForbiddenError(err.Error()))
+	case errors.Is(err, domain.ErrInvalidItemID), errors.Is(err, domain.ErrInvalidInput):
+		return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+	case errors.Is(err, domain.ErrItemNotFound), errors.Is(err, domain.ErrCommentNotFound):
+		return httputil.SendErrorResponse(c, httputil.NotFoundError(err.Error()))
+	}
+	return httputil.SendErrorResponse(c, httputil.InternalServerError(fallback))
+}
@@ -0,0 +1,156 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// CustomerHandler handles HTTP requests for customers.
+type CustomerHandler struct {
+	customerService domain.CustomerService
+	validate        *validator.Validate
+}
+
+// NewCustomerHandler creates a new CustomerHandler.
+func NewCustomerHandler(cs domain.CustomerService) *CustomerHandler {
+	return &CustomerHandler{
+		customerService: cs,
+		validate:        newValidator(),
+	}
+}
+
+// CreateCustomer godoc
+// @Summary Create a customer
+// @Description Creates a customer, in the retail price tier unless price_tier is given. Email addresses, when given, must be unique.
+// @Tags customers
+// @Accept json
+// @Produce json
+// @Param customer body domain.CreateCustomerRequest true "Customer to create"
+// @Success 201 {object} domain.Customer "Successfully created customer"
+// @Failure 400 {object} httputil.HTTPError "Bad Request"
+// @Failure 409 {object} httputil.HTTPError "Conflict (email address in use)"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /customers [post]
+func (h *CustomerHandler) CreateCustomer(c echo.Context) error {
+	var req domain.CreateCustomerRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("CreateCustomer: Bind error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("CreateCustomer: Validation error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	customer, err := h.customerService.CreateCustomer(c.Request().Context(), &req)
+	if err != nil {
+		log.Printf("CreateCustomer: Service error: %v", err)
+		return sendCustomerError(c, err, "Failed to create customer.")
+	}
+	return c.JSON(http.StatusCreated, customer)
+}
+
+// ListCustomers godoc
+// @Summary List customers
+// @Description Retrieves a page of customers, ordered by name
+// @Tags customers
+// @Produce json
+// @Param page query int false "Page number (default: 1)"
+// @Param limit query int false "Customers per page (default: 10, max: 100)"
+// @Success 200 {array} domain.Customer "List of customers"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid query parameters)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /customers [get]
+func (h *CustomerHandler) ListCustomers(c echo.Context) error {
+	query := domain.ListCustomersQuery{Page: 1, Limit: 10} // Defaults
+	if httpErr := bindQuery(c, h.validate, &query); httpErr != nil {
+		log.Printf("ListCustomers: Invalid query parameters: %v", httpErr.Details)
+		return httputil.SendErrorResponse(c, httpErr)
+	}
+
+	customers, err := h.customerService.ListCustomers(c.Request().Context(), query)
+	if err != nil {
+		log.Printf("ListCustomers: Service error: %v", err)
+		return sendCustomerError(c, err, "Failed to retrieve customers.")
+	}
+	return c.JSON(http.StatusOK, customers)
+}
+
+// GetCustomer godoc
+// @Summary Get a customer by ID
+// @Description Retrieves a single customer
+// @Tags customers
+// @Produce json
+// @Param id path string true "Customer ID (UUID)"
+// @Success 200 {object} domain.Customer "Customer"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /customers/{id} [get]
+func (h *CustomerHandler) GetCustomer(c echo.Context) error {
+	id := c.Param("id")
+
+	customer, err := h.customerService.GetCustomer(c.Request().Context(), id)
+	if err != nil {
+		log.Printf("GetCustomer: Service error for ID %s: %v", id, err)
+		return sendCustomerError(c, err, "Failed to retrieve customer.")
+	}
+	return c.JSON(http.StatusOK, customer)
+}
+
+// UpdateCustomer godoc
+// @Summary Update a customer
+// @Description Replaces the name, email and price tier of a customer; omitted fields are cleared (the tier goes back to retail)
+// @Tags customers
+// @Accept json
+// @Produce json
+// @Param id path string true "Customer ID (UUID)"
+// @Param customer body domain.UpdateCustomerRequest true "New name, email and price tier"
+// @Success 200 {object} domain.Customer "Successfully updated customer"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID or payload)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 409 {object} httputil.HTTPError "Conflict (email address in use)"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /customers/{id} [put]
+func (h *CustomerHandler) UpdateCustomer(c echo.Context) error {
+	id := c.Param("id")
+
+	var req domain.UpdateCustomerRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("UpdateCustomer: Bind error for ID %s: %v", id, err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("UpdateCustomer: Validation error for ID %s: %v", id, err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	customer, err := h.customerService.UpdateCustomer(c.Request().Context(), id, &req)
+	if err != nil {
+		log.Printf("UpdateCustomer: Service error for ID %s: %v", id, err)
+		return sendCustomerError(c, err, "Failed to update customer.")
+	}
+	return c.JSON(http.StatusOK, customer)
+}
+
+// sendCustomerError maps customer service errors to HTTP responses.
+func sendCustomerError(c echo.Context, err error, fallback string) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidInput):
+		return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+	case errors.Is(err, domain.ErrCustomerNotFound):
+		return httputil.SendErrorResponse(c, httputil.NotFoundError(err.Error()))
+	case errors.Is(err, domain.ErrCustomerEmailTaken):
+		return httputil.SendErrorResponse(c, httputil.ConflictError(err.Error()))
+	}
+	return httputil.SendErrorResponse(c, httputil.InternalServerError(fallback))
+}
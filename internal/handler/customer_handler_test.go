@@ -0,0 +1,102 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/handler"
+	"inventory-system/internal/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const customerID = "2b3c4d5e-6f70-4812-9a3b-4c5d6e7f8091"
+
+func TestCustomerHandler(t *testing.T) {
+	email := "buyer@example.com"
+	cases := []struct {
+		name       string
+		tc         handlerCase
+		route      func(h *handler.CustomerHandler) echo.HandlerFunc
+		setup      func(s *mocks.CustomerService)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:  "create",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/customers", body: `{"name":"Acme","email":"` + email + `"}`},
+			route: func(h *handler.CustomerHandler) echo.HandlerFunc { return h.CreateCustomer },
+			setup: func(s *mocks.CustomerService) {
+				s.On("CreateCustomer", mock.Anything, &domain.CreateCustomerRequest{Name: "Acme", Email: &email}).
+					Return(&domain.Customer{ID: customerID, Name: "Acme", Email: &email}, nil)
+			},
+			wantStatus: http.StatusCreated, wantBody: `"email":"` + email + `"`,
+		},
+		{
+			name:       "create with malformed email",
+			tc:         handlerCase{method: http.MethodPost, target: "/api/v1/customers", body: `{"name":"Acme","email":"acme"}`},
+			route:      func(h *handler.CustomerHandler) echo.HandlerFunc { return h.CreateCustomer },
+			wantStatus: http.StatusUnprocessableEntity, wantBody: "Input validation failed",
+		},
+		{
+			name:       "create in unknown price tier",
+			tc:         handlerCase{method: http.MethodPost, target: "/api/v1/customers", body: `{"name":"Acme","price_tier":"vip"}`},
+			route:      func(h *handler.CustomerHandler) echo.HandlerFunc { return h.CreateCustomer },
+			wantStatus: http.StatusUnprocessableEntity, wantBody: "Input validation failed",
+		},
+		{
+			name:  "create with email in use",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/customers", body: `{"name":"Acme","email":"` + email + `"}`},
+			route: func(h *handler.CustomerHandler) echo.HandlerFunc { return h.CreateCustomer },
+			setup: func(s *mocks.CustomerService) {
+				s.On("CreateCustomer", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("%w: '%s'", domain.ErrCustomerEmailTaken, email))
+			},
+			wantStatus: http.StatusConflict, wantBody: "already has this email",
+		},
+		{
+			name:  "list second page",
+			tc:    handlerCase{method: http.MethodGet, target: "/api/v1/customers?page=2"},
+			route: func(h *handler.CustomerHandler) echo.HandlerFunc { return h.ListCustomers },
+			setup: func(s *mocks.CustomerService) {
+				s.On("ListCustomers", mock.Anything, domain.ListCustomersQuery{Page: 2, Limit: 10}).
+					Return([]*domain.Customer{{ID: customerID, Name: "Acme"}}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: customerID,
+		},
+		{
+			name:  "get not found",
+			tc:    handlerCase{method: http.MethodGet, target: "/api/v1/customers/" + customerID, id: customerID},
+			route: func(h *handler.CustomerHandler) echo.HandlerFunc { return h.GetCustomer },
+			setup: func(s *mocks.CustomerService) {
+				s.On("GetCustomer", mock.Anything, customerID).Return(nil, fmt.Errorf("%w: ID %s", domain.ErrCustomerNotFound, customerID))
+			},
+			wantStatus: http.StatusNotFound, wantBody: "customer not found",
+		},
+		{
+			name:  "update clears email",
+			tc:    handlerCase{method: http.MethodPut, target: "/api/v1/customers/" + customerID, id: customerID, body: `{"name":"Acme Ltd"}`},
+			route: func(h *handler.CustomerHandler) echo.HandlerFunc { return h.UpdateCustomer },
+			setup: func(s *mocks.CustomerService) {
+				s.On("UpdateCustomer", mock.Anything, customerID, &domain.UpdateCustomerRequest{Name: "Acme Ltd"}).
+					Return(&domain.Customer{ID: customerID, Name: "Acme Ltd"}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"name":"Acme Ltd"`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewCustomerService(t)
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+			rec := serve(t, tc.tc, tc.route(handler.NewCustomerHandler(svc)))
+
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tc.wantBody)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// DocumentSequenceHandler serves the admin API for document numbering sequences.
+type DocumentSequenceHandler struct {
+	sequenceService domain.DocumentSequenceService
+	validate        *validator.Validate
+}
+
+// NewDocumentSequenceHandler creates a new DocumentSequenceHandler.
+func NewDocumentSequenceHandler(ss domain.DocumentSequenceService) *DocumentSequenceHandler {
+	return &DocumentSequenceHandler{
+		sequenceService: ss,
+		validate:        newValidator(),
+	}
+}
+
+// ListDocumentSequences godoc
+// @Summary List document numbering sequences
+// @Description Returns how purchase, sales and assembly orders are numbered
+// @Tags admin
+// @Produce json
+// @Success 200 {array} domain.DocumentSequence "Numbering sequences"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /admin/document-sequences [get]
+func (h *DocumentSequenceHandler) ListDocumentSequences(c echo.Context) error {
+	sequences, err := h.sequenceService.ListSequences(c.Request().Context())
+	if err != nil {
+		log.Printf("ListDocumentSequences: Service error: %v", err)
+		return sendDocumentSequenceError(c, err, "Failed to retrieve document sequences.")
+	}
+	return c.JSON(http.StatusOK, sequences)
+}
+
+// SetDocumentSequence godoc
+// @Summary Configure a document numbering sequence
+// @Description Sets the prefix, whether numbers carry the year (restarting every year), and the minimum digits of the
+// @Description counter. Applies to documents created from now on; a new prefix starts counting from 1.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param type path string true "Document type: purchase_order, sales_order or assembly_order"
+// @Param sequence body domain.SetDocumentSequenceRequest true "Sequence settings"
+// @Success 200 {object} domain.DocumentSequence "Saved sequence"
+// @Failure 404 {object} httputil.HTTPError "Unknown document type"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /admin/document-sequences/{type} [put]
+func (h *DocumentSequenceHandler) SetDocumentSequence(c echo.Context) error {
+	docType := c.Param("type")
+
+	var req domain.SetDocumentSequenceRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("SetDocumentSequence: Bind error for %s: %v", docType, err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("SetDocumentSequence: Validation error for %s: %v", docType, err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	seq, err := h.sequenceService.SetSequence(c.Request().Context(), docType, &req)
+	if err != nil {
+		log.Printf("SetDocumentSequence: Service error for %s: %v", docType, err)
+		return sendDocumentSequenceError(c, err, "Failed to save document sequence.")
+	}
+	return c.JSON(http.StatusOK, seq)
+}
+
+// sendDocumentSequenceError maps document sequence service errors to HTTP responses.
+func sendDocumentSequenceError(c echo.Context, err error, fallback string) error {
+	if errors.Is(err, domain.ErrDocumentSequenceNotFound) {
+		return httputil.SendErrorResponse(c, httputil.NotFoundError(err.Error()))
+	}
+	return httputil.SendErrorResponse(c, httputil.InternalServerError(fallback))
+}
@@ -0,0 +1,73 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/handler"
+	"inventory-system/internal/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDocumentSequenceHandler(t *testing.T) {
+	cases := []struct {
+		name       string
+		tc         handlerCase
+		route      func(h *handler.DocumentSequenceHandler) echo.HandlerFunc
+		setup      func(s *mocks.DocumentSequenceService)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:  "list",
+			tc:    handlerCase{method: http.MethodGet, target: "/admin/document-sequences"},
+			route: func(h *handler.DocumentSequenceHandler) echo.HandlerFunc { return h.ListDocumentSequences },
+			setup: func(s *mocks.DocumentSequenceService) {
+				s.On("ListSequences", mock.Anything).Return([]*domain.DocumentSequence{
+					{DocType: domain.DocumentTypePurchaseOrder, Prefix: "PO", Yearly: true, Padding: 4}}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"prefix":"PO"`,
+		},
+		{
+			name:  "set",
+			tc:    handlerCase{method: http.MethodPut, target: "/admin/document-sequences/sales_order", params: map[string]string{"type": "sales_order"}, body: `{"prefix":"INV","yearly":false,"padding":6}`},
+			route: func(h *handler.DocumentSequenceHandler) echo.HandlerFunc { return h.SetDocumentSequence },
+			setup: func(s *mocks.DocumentSequenceService) {
+				s.On("SetSequence", mock.Anything, "sales_order", &domain.SetDocumentSequenceRequest{Prefix: "INV", Padding: 6}).
+					Return(&domain.DocumentSequence{DocType: "sales_order", Prefix: "INV", Padding: 6}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"prefix":"INV"`,
+		},
+		{
+			name:       "set prefix with spaces",
+			tc:         handlerCase{method: http.MethodPut, target: "/admin/document-sequences/sales_order", params: map[string]string{"type": "sales_order"}, body: `{"prefix":"S O","padding":4}`},
+			route:      func(h *handler.DocumentSequenceHandler) echo.HandlerFunc { return h.SetDocumentSequence },
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+		{
+			name:  "set unknown type",
+			tc:    handlerCase{method: http.MethodPut, target: "/admin/document-sequences/invoice", params: map[string]string{"type": "invoice"}, body: `{"prefix":"IN","padding":4}`},
+			route: func(h *handler.DocumentSequenceHandler) echo.HandlerFunc { return h.SetDocumentSequence },
+			setup: func(s *mocks.DocumentSequenceService) {
+				s.On("SetSequence", mock.Anything, "invoice", mock.Anything).Return(nil, domain.ErrDocumentSequenceNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewDocumentSequenceService(t)
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+			rec := serve(t, tc.tc, tc.route(handler.NewDocumentSequenceHandler(svc)))
+
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tc.wantBody)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// FeatureFlagHandler serves the admin API for feature flags.
+type FeatureFlagHandler struct {
+	flagService domain.FeatureFlagService
+	validate    *validator.Validate
+}
+
+// NewFeatureFlagHandler creates a new FeatureFlagHandler.
+func NewFeatureFlagHandler(fs domain.FeatureFlagService) *FeatureFlagHandler {
+	return &FeatureFlagHandler{
+		flagService: fs,
+		validate:    newValidator(),
+	}
+}
+
+// ListFeatureFlags godoc
+// @Summary List feature flags
+// @Description Returns every feature flag with its rollout and overrides
+// @Tags admin
+// @Produce json
+// @Success 200 {array} domain.FeatureFlag "Feature flags"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /admin/feature-flags [get]
+func (h *FeatureFlagHandler) ListFeatureFlags(c echo.Context) error {
+	flags, err := h.flagService.ListFlags(c.Request().Context())
+	if err != nil {
+		log.Printf("ListFeatureFlags: Service error: %v", err)
+		return sendFeatureFlagError(c, err, "Failed to retrieve feature flags.")
+	}
+	return c.JSON(http.StatusOK, flags)
+}
+
+// SetFeatureFlag godoc
+// @Summary Create or replace a feature flag
+// @Description Sets whether the flag is enabled, the percentage of users it is rolled out to, and per-user overrides.
+// @Description Other instances pick up the change within 30 seconds.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param X-User-ID header string true "Calling user"
+// @Param key path string true "Flag key, e.g. keyset-pagination"
+// @Param flag body domain.SetFeatureFlagRequest true "Flag settings"
+// @Success 200 {object} domain.FeatureFlag "Saved flag"
+// @Failure 400 {object} httputil.HTTPError "Bad Request"
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /admin/feature-flags/{key} [put]
+func (h *FeatureFlagHandler) SetFeatureFlag(c echo.Context) error {
+	key := c.Param("key")
+
+	var req domain.SetFeatureFlagRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("SetFeatureFlag: Bind error for %s: %v", key, err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("SetFeatureFlag: Validation error for %s: %v", key, err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	flag, err := h.flagService.SetFlag(c.Request().Context(), key, &req, currentUserID(c))
+	if err != nil {
+		log.Printf("SetFeatureFlag: Service error for %s: %v", key, err)
+		return sendFeatureFlagError(c, err, "Failed to save feature flag.")
+	}
+	return c.JSON(http.StatusOK, flag)
+}
+
+// DeleteFeatureFlag godoc
+// @Summary Delete a feature flag
+// @Description Removes a flag, which turns its feature off for everyone
+// @Tags admin
+// @Param key path string true "Flag key"
+// @Success 204 "Successfully deleted flag (No Content)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /admin/feature-flags/{key} [delete]
+func (h *FeatureFlagHandler) DeleteFeatureFlag(c echo.Context) error {
+	key := c.Param("key")
+
+	if err := h.flagService.DeleteFlag(c.Request().Context(), key); err != nil {
+		log.Printf("DeleteFeatureFlag: Service error for %s: %v", key, err)
+		return sendFeatureFlagError(c, err, "Failed to delete feature flag.")
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// sendFeatureFlagError maps feature flag service errors to HTTP responses.
+func sendFeatureFlagError(c echo.Context, err error, fallback string) error {
+	switch {
+	case errors.Is(err, domain.ErrMissingUser):
+		return httputil.SendErrorResponse(c, httputil.UnauthorizedError("Missing "+HeaderUserID+" header."))
+	case errors.Is(err, domain.ErrInvalidInput):
+		return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+	case errors.Is(err, domain.ErrFeatureFlagNotFound):
+		return httputil.SendErrorResponse(c, httputil.NotFoundError(err.Error()))
+	}
+	return httputil.SendErrorResponse(c, httputil.InternalServerError(fallback))
+}
@@ -0,0 +1,138 @@
+package handler_test
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/handler"
+	"inventory-system/internal/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/mock"
+)
+
+// Run a target for longer with e.g.:
+//
+//	go test ./internal/handler -run '^$' -fuzz FuzzCreateItemBody -fuzztime 1m
+
+var skuCharset = regexp.MustCompile(`^[a-zA-Z0-9-]+$`)
+
+// fuzzItemHandler serves arbitrary request bodies through h. Whatever the input,
+// the handler must not panic and must answer with one of the allowed statuses.
+// The service accepts everything and records what reached it, so check can
+// assert that validation let nothing dangerous through.
+func fuzzItemHandler(
+	t *testing.T,
+	method string,
+	body string,
+	route func(*handler.ItemHandler) echo.HandlerFunc,
+	check func(t *testing.T, svc *mocks.ItemService),
+	allowed ...int,
+) {
+	svc := &mocks.ItemService{}
+	svc.On("CreateItem", mock.Anything, mock.Anything, mock.Anything).Return(&domain.Item{ID: itemID}, nil).Maybe()
+	svc.On("UpdateItem", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&domain.Item{ID: itemID}, nil).Maybe()
+
+	rec := serve(t, handlerCase{method: method, target: "/items/" + itemID, id: itemID, body: body},
+		route(handler.NewItemHandler(svc, nil)))
+
+	for _, status := range allowed {
+		if rec.Code == status {
+			check(t, svc)
+			return
+		}
+	}
+	t.Fatalf("unexpected status %d for body %q: %s", rec.Code, body, rec.Body.String())
+}
+
+// receivedSKUs returns the SKUs of every request that reached the service.
+func receivedSKUs(svc *mocks.ItemService) []string {
+	var skus []string
+	for _, call := range svc.Calls {
+		switch req := call.Arguments.Get(len(call.Arguments) - 1).(type) {
+		case *domain.CreateItemRequest:
+			skus = append(skus, req.SKU)
+		case *domain.UpdateItemRequest:
+			if req.SKU != nil {
+				skus = append(skus, *req.SKU)
+			}
+		}
+	}
+	return skus
+}
+
+func FuzzCreateItemBody(f *testing.F) {
+	for _, seed := range []string{
+		`{"sku":"WIDGET-1","name":"Widget","quantity":3,"price":9.5}`,
+		`{"sku":"W'; DROP TABLE items;--","name":"x","price":1}`,
+		`{"sku":"WIDGET\u00001","name":"x","price":1}`,
+		`{"sku":"ＷＩＤＧＥＴ","name":"x","price":1}`,
+		`{"sku":"A","name":"x","price":1e308,"quantity":9223372036854775807}`,
+		`{"sku":null,"name":[],"price":"1"}`,
+		`{"sku":"A","sku":"B","name":"x","price":1}`,
+		`[]`, `null`, `{`, ``,
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, body string) {
+		fuzzItemHandler(t, http.MethodPost, body,
+			func(h *handler.ItemHandler) echo.HandlerFunc { return h.CreateItem },
+			func(t *testing.T, svc *mocks.ItemService) {
+				for _, sku := range receivedSKUs(svc) {
+					if !skuCharset.MatchString(sku) || len(sku) > 100 {
+						t.Fatalf("invalid SKU %q reached the service", sku)
+					}
+				}
+			},
+			http.StatusCreated, http.StatusBadRequest, http.StatusUnprocessableEntity)
+	})
+}
+
+// FuzzUpdateItemBody covers the partial-update (merge) semantics of PUT /items/{id}:
+// only fields present in the body may be set, and whatever is set must be valid.
+func FuzzUpdateItemBody(f *testing.F) {
+	for _, seed := range []string{
+		`{"quantity":0,"version":1}`,
+		`{"name":null,"sku":"NEW-SKU","version":2}`,
+		`{"name":"x","version":0}`,
+		`{"description":""}`,
+		`{"price":-0.0}`,
+		`{"low_stock_threshold":-1}`,
+		`{"sku":""}`,
+		`{"unknown":true}`,
+		`{}`, `{"quantity":1.5}`, `"quantity"`,
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, body string) {
+		fuzzItemHandler(t, http.MethodPut, body,
+			func(h *handler.ItemHandler) echo.HandlerFunc { return h.UpdateItem },
+			func(t *testing.T, svc *mocks.ItemService) {
+				for _, call := range svc.Calls {
+					req := call.Arguments.Get(2).(*domain.UpdateItemRequest)
+					if req.Version == nil || *req.Version < 1 {
+						t.Fatalf("update without a valid version reached the service")
+					}
+					if req.Quantity != nil && *req.Quantity < 0 {
+						t.Fatalf("negative quantity reached the service")
+					}
+					if req.Price != nil && *req.Price <= 0 {
+						t.Fatalf("non-positive price reached the service")
+					}
+					if req.LowStockThreshold != nil && *req.LowStockThreshold < 0 {
+						t.Fatalf("negative low stock threshold reached the service")
+					}
+				}
+				for _, sku := range receivedSKUs(svc) {
+					if !skuCharset.MatchString(sku) || len(sku) > 100 {
+						t.Fatalf("invalid SKU %q reached the service", sku)
+					}
+				}
+			},
+			http.StatusOK, http.StatusBadRequest, http.StatusUnprocessableEntity, http.StatusPreconditionRequired)
+	})
+}
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"inventory-system/internal/buildinfo"
+
+	"github.com/labstack/echo/v4"
+)
+
+// HealthCheck godoc
+// @Summary Health check
+// @Description Reports that the API is up, with the running build's version
+// @Tags health
+// @Produce json
+// @Success 200 {object} map[string]interface{} "status, message, version, commit, build_date, time"
+// @Router /healthz [get]
+func HealthCheck(c echo.Context) error {
+	build := buildinfo.Get()
+	return c.JSON(http.StatusOK, echo.Map{
+		"status":     "ok",
+		"message":    "Inventory System API is running!",
+		"version":    build.Version, // Injected via -ldflags at build time
+		"commit":     build.Commit,
+		"build_date": build.BuildDate,
+		"time":       time.Now().Format(time.RFC3339),
+	})
+}
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// HeaderUserID carries the identity of the calling user.
+// There is no authentication layer in this service yet; the header is expected to be
+// set by the API gateway / auth proxy in front of it and must not be trusted from the internet.
+const HeaderUserID = "X-User-ID"
+
+// HeaderUserRoles carries the comma-separated roles of the calling user, e.g. "buyer,manager".
+// Like HeaderUserID it is set by the gateway; workflows use it to decide who may change a
+// document's status.
+const HeaderUserRoles = "X-User-Roles"
+
+// currentUserID returns the calling user's ID, or "" when the request is anonymous.
+func currentUserID(c echo.Context) string {
+	return strings.TrimSpace(c.Request().Header.Get(HeaderUserID))
+}
+
+// currentUserRoles returns the calling user's roles; blank entries are dropped.
+func currentUserRoles(c echo.Context) []string {
+	var roles []string
+	for _, role := range strings.Split(c.Request().Header.Get(HeaderUserRoles), ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// IntegrityHandler handles HTTP requests for the data integrity report.
+type IntegrityHandler struct {
+	integrityService domain.IntegrityService
+	validate         *validator.Validate
+}
+
+// NewIntegrityHandler creates a new IntegrityHandler.
+func NewIntegrityHandler(is domain.IntegrityService) *IntegrityHandler {
+	return &IntegrityHandler{
+		integrityService: is,
+		validate:         newValidator(),
+	}
+}
+
+// GetIntegrityReport godoc
+// @Summary Check data integrity
+// @Description Counts the records that need attention: comments and notifications left behind by purged items,
+// @Description open ledger discrepancies, uncategorized items, open sales orders reserving stock for too long or on
+// @Description trashed items. Each check links to the endpoint listing its records, where there is one. Ledger
+// @Description mismatches are those the last ledger check found; run POST /admin/ledger/check to refresh them.
+// @Tags admin
+// @Produce json
+// @Param stale_days query int false "Age in days from which an open sales order is stale (default: 30, max: 365)"
+// @Success 200 {object} domain.IntegrityReport
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid query parameters, listed in details)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /admin/integrity [get]
+func (h *IntegrityHandler) GetIntegrityReport(c echo.Context) error {
+	query := domain.IntegrityQuery{StaleDays: 30} // Defaults
+	if httpErr := bindQuery(c, h.validate, &query); httpErr != nil {
+		log.Printf("GetIntegrityReport: Invalid query parameters: %v", httpErr.Details)
+		return httputil.SendErrorResponse(c, httpErr)
+	}
+
+	report, err := h.integrityService.Report(c.Request().Context(), query)
+	if err != nil {
+		log.Printf("GetIntegrityReport: Service error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.InternalServerError("Failed to check data integrity."))
+	}
+	return c.JSON(http.StatusOK, report)
+}
@@ -0,0 +1,69 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/handler"
+	"inventory-system/internal/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestIntegrityHandler_GetIntegrityReport(t *testing.T) {
+	cases := []struct {
+		name       string
+		target     string
+		setup      func(s *mocks.IntegrityService)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:   "report",
+			target: "/admin/integrity?stale_days=14",
+			setup: func(s *mocks.IntegrityService) {
+				s.On("Report", mock.Anything, domain.IntegrityQuery{StaleDays: 14}).Return(&domain.IntegrityReport{
+					Checks: []domain.IntegrityCheck{{Name: domain.IntegrityLedgerMismatches, Count: 2,
+						Link: "/api/v1/ledger/discrepancies?open=true"}},
+				}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"link":"/api/v1/ledger/discrepancies?open=true"`,
+		},
+		{
+			name:   "default stale age",
+			target: "/admin/integrity",
+			setup: func(s *mocks.IntegrityService) {
+				s.On("Report", mock.Anything, domain.IntegrityQuery{StaleDays: 30}).
+					Return(&domain.IntegrityReport{Healthy: true, Checks: []domain.IntegrityCheck{}}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"healthy":true`,
+		},
+		{
+			name:       "stale age out of range",
+			target:     "/admin/integrity?stale_days=0",
+			wantStatus: http.StatusBadRequest, wantBody: "stale_days",
+		},
+		{
+			name:   "service error",
+			target: "/admin/integrity",
+			setup: func(s *mocks.IntegrityService) {
+				s.On("Report", mock.Anything, mock.Anything).Return(nil, errBoom)
+			},
+			wantStatus: http.StatusInternalServerError, wantBody: "Failed to check data integrity.",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewIntegrityService(t)
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+			rec := serve(t, handlerCase{method: http.MethodGet, target: tc.target}, handler.NewIntegrityHandler(svc).GetIntegrityReport)
+
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tc.wantBody)
+		})
+	}
+}
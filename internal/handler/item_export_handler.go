@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+	"inventory-system/pkg/xlsx"
+
+	"github.com/labstack/echo/v4"
+)
+
+// exportColumns are the columns of an item export, in order. The ones an import reads
+// (importColumns) have the same names.
+var exportColumns = []any{"id", "sku", "name", "description", "quantity", "price", "low_stock_threshold",
+	"category_id", "created_at", "updated_at"}
+
+// exportFlushEvery is how many rows are buffered before they are pushed to the client.
+const exportFlushEvery = 500
+
+// tableWriter writes an export file row by row.
+type tableWriter interface {
+	WriteRow(cells ...any) error
+	Flush() error
+	Close() error
+}
+
+// ExportItems godoc
+// @Summary Export items as CSV or Excel
+// @Description Downloads the items GET /items would list, on every page, as a CSV file or an Excel workbook. The
+// @Description filters, sort and search are those of GET /items; page, limit and highlight do not apply. The file is
+// @Description streamed as rows are read, so exports of any size use little memory. If the export fails after the
+// @Description download has started, the connection is closed before the end of the file.
+// @Tags items
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param format query string false "csv (default) or xlsx"
+// @Param category query string false "Only items in this category (UUID) or any of its subcategories"
+// @Param supplier query string false "Only items on a purchase order of this supplier (UUID)"
+// @Param min_price query number false "Only items at or above this price"
+// @Param max_price query number false "Only items at or below this price"
+// @Param min_quantity query int false "Only items with at least this quantity"
+// @Param max_quantity query int false "Only items with at most this quantity"
+// @Param created_since query string false "Only items created at or after this time (RFC 3339)"
+// @Param sort query string false "Sort keys, e.g. -price,name; fields are sku, name, price, quantity, created_at and updated_at; - sorts descending"
+// @Param search query string false "Full-text search: words, \"quoted phrases\", or, -excluded words"
+// @Success 200 {file} file "Export file, named items-<date>.<format>"
+// @Header 200 {string} Content-Disposition "attachment; filename=\"items-20240131.csv\""
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid query parameters, listed in details, or contradictory filters)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/export [get]
+func (h *ItemHandler) ExportItems(c echo.Context) error {
+	// Defaults; Page and Limit only need to pass validation
+	query := domain.ExportItemsQuery{ListItemsQuery: domain.ListItemsQuery{Page: 1, Limit: 10}, Format: domain.ExportFormatCSV}
+	if httpErr := bindQuery(c, h.validate, &query); httpErr != nil {
+		log.Printf("ExportItems: Invalid query parameters: %v", httpErr.Details)
+		return httputil.SendErrorResponse(c, httpErr)
+	}
+
+	res := c.Response()
+	var table tableWriter
+	if query.Format == domain.ExportFormatXLSX {
+		w, err := xlsx.NewWriter(res, "Items")
+		if err != nil {
+			log.Printf("ExportItems: Workbook error: %v", err)
+			return httputil.SendErrorResponse(c, httputil.InternalServerError("Failed to export items."))
+		}
+		res.Header().Set(echo.HeaderContentType, xlsx.MIMEType)
+		table = w
+	} else {
+		res.Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+		table = &csvTable{w: csv.NewWriter(res)}
+	}
+	filename := fmt.Sprintf("items-%s.%s", time.Now().UTC().Format("20060102"), query.Format)
+	res.Header().Set(echo.HeaderContentDisposition, `attachment; filename="`+filename+`"`)
+
+	rows := 0
+	write := func(item *domain.Item) error {
+		err := table.WriteRow(item.ID, item.SKU, item.Name, item.Description, item.Quantity, item.Price, item.LowStockThreshold,
+			item.CategoryID, item.CreatedAt.UTC().Format(time.RFC3339), item.UpdatedAt.UTC().Format(time.RFC3339))
+		if err != nil {
+			return err
+		}
+		rows++
+		if rows%exportFlushEvery == 0 {
+			return table.Flush()
+		}
+		return nil
+	}
+
+	ctx := c.Request().Context()
+	err := table.WriteRow(exportColumns...)
+	if err == nil {
+		err = h.itemService.StreamMatchingItems(ctx, query.ListItemsQuery, write)
+	}
+	if err == nil {
+		err = table.Close()
+	}
+	if err != nil {
+		log.Printf("ExportItems: Export stopped after %d items: %v", rows, err)
+		if !res.Committed { // Nothing sent yet: the rows written so far are still buffered
+			res.Header().Del(echo.HeaderContentDisposition)
+			if errors.Is(err, domain.ErrInvalidInput) {
+				return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+			}
+			return httputil.SendErrorResponse(c, httputil.InternalServerError("Failed to export items."))
+		}
+		// The 200 is out. Breaking the connection keeps the client from saving a truncated
+		// file as if it were complete.
+		panic(http.ErrAbortHandler)
+	}
+	return nil
+}
+
+// csvTable writes export rows as CSV.
+type csvTable struct {
+	w *csv.Writer
+}
+
+func (t *csvTable) WriteRow(cells ...any) error {
+	record := make([]string, len(cells))
+	for i, v := range cells {
+		record[i] = csvCell(v)
+	}
+	return t.w.Write(record)
+}
+
+func (t *csvTable) Flush() error {
+	t.w.Flush()
+	return t.w.Error()
+}
+
+func (t *csvTable) Close() error {
+	return t.Flush()
+}
+
+// csvCell formats an export cell as CSV text.
+func csvCell(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return ""
+	case *string:
+		if x == nil {
+			return ""
+		}
+		return csvCell(*x)
+	case *int:
+		if x == nil {
+			return ""
+		}
+		return strconv.Itoa(*x)
+	case string:
+		// Spreadsheets run text starting with these as a formula; a leading quote makes
+		// them show it as typed.
+		if x != "" && strings.ContainsRune("=+-@\t\r", rune(x[0])) {
+			return "'" + x
+		}
+		return x
+	case int:
+		return strconv.Itoa(x)
+	case float64:
+		return strconv.FormatFloat(x, 'f', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
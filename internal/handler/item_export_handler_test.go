@@ -0,0 +1,128 @@
+package handler_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/handler"
+	"inventory-system/internal/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// streamOf returns a mock StreamItems implementation yielding items, then err.
+func streamOf(err error, items ...*domain.Item) func(context.Context, func(*domain.Item) error) error {
+	return func(_ context.Context, fn func(*domain.Item) error) error {
+		for _, item := range items {
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+		return err
+	}
+}
+
+func TestItemHandler_ExportItems(t *testing.T) {
+	created := time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC)
+	desc := "=HYPERLINK(\"x\")"
+	threshold := 5
+	widget := &domain.Item{ID: itemID, SKU: "WIDGET-1", Name: "Widget, large", Description: &desc, Quantity: 3, Price: 9.5,
+		LowStockThreshold: &threshold, CreatedAt: created, UpdatedAt: created}
+
+	// matching returns a mock StreamMatchingItems implementation yielding items, then err.
+	matching := func(err error, items ...*domain.Item) func(context.Context, domain.ListItemsQuery, func(*domain.Item) error) error {
+		return func(ctx context.Context, _ domain.ListItemsQuery, fn func(*domain.Item) error) error {
+			return streamOf(err, items...)(ctx, fn)
+		}
+	}
+	unfiltered := domain.ListItemsQuery{Page: 1, Limit: 10}
+
+	t.Run("csv", func(t *testing.T) {
+		svc := mocks.NewItemService(t)
+		svc.On("StreamMatchingItems", mock.Anything, unfiltered, mock.Anything).Return(matching(nil, widget))
+		rec := serve(t, handlerCase{method: http.MethodGet, target: "/items/export"}, handler.NewItemHandler(svc, nil).ExportItems)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+		assert.Regexp(t, `^attachment; filename="items-\d{8}\.csv"$`, rec.Header().Get(echo.HeaderContentDisposition))
+		assert.Equal(t, "id,sku,name,description,quantity,price,low_stock_threshold,category_id,created_at,updated_at\n"+
+			itemID+`,WIDGET-1,"Widget, large","'=HYPERLINK(""x"")",3,9.5,5,,2024-01-31T09:00:00Z,2024-01-31T09:00:00Z`+"\n",
+			rec.Body.String())
+	})
+
+	t.Run("xlsx filtered like GET /items", func(t *testing.T) {
+		minPrice := 5.0
+		svc := mocks.NewItemService(t)
+		svc.On("StreamMatchingItems", mock.Anything, domain.ListItemsQuery{Page: 1, Limit: 10, Category: categoryID,
+			MinPrice: &minPrice, Sort: "-price", Search: "widget"}, mock.Anything).Return(matching(nil, widget))
+		rec := serve(t, handlerCase{method: http.MethodGet,
+			target: "/items/export?format=xlsx&category=" + categoryID + "&min_price=5&sort=-price&search=widget"},
+			handler.NewItemHandler(svc, nil).ExportItems)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Regexp(t, `filename="items-\d{8}\.xlsx"`, rec.Header().Get(echo.HeaderContentDisposition))
+		body := rec.Body.Bytes()
+		zr, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+		if err != nil {
+			t.Fatalf("not a workbook: %v", err)
+		}
+		var sheet string
+		for _, f := range zr.File {
+			if f.Name == "xl/worksheets/sheet1.xml" {
+				rc, _ := f.Open()
+				b, _ := io.ReadAll(rc)
+				sheet = string(b)
+			}
+		}
+		assert.Contains(t, sheet, `<t xml:space="preserve">WIDGET-1</t>`)
+		assert.Contains(t, sheet, `<c r="E2"><v>3</v></c>`)
+	})
+
+	t.Run("fails before the first flush", func(t *testing.T) {
+		svc := mocks.NewItemService(t)
+		svc.On("StreamMatchingItems", mock.Anything, unfiltered, mock.Anything).Return(matching(errBoom, widget))
+		rec := serve(t, handlerCase{method: http.MethodGet, target: "/items/export"}, handler.NewItemHandler(svc, nil).ExportItems)
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Empty(t, rec.Header().Get(echo.HeaderContentDisposition))
+		assert.Contains(t, rec.Body.String(), "Failed to export items.")
+		assert.NotContains(t, rec.Body.String(), "WIDGET-1")
+	})
+
+	t.Run("contradictory filters", func(t *testing.T) {
+		svc := mocks.NewItemService(t)
+		svc.On("StreamMatchingItems", mock.Anything, mock.Anything, mock.Anything).
+			Return(fmt.Errorf("%w: min_price is above max_price", domain.ErrInvalidInput))
+		rec := serve(t, handlerCase{method: http.MethodGet, target: "/items/export?min_price=9&max_price=1"},
+			handler.NewItemHandler(svc, nil).ExportItems)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Empty(t, rec.Header().Get(echo.HeaderContentDisposition))
+		assert.Contains(t, rec.Body.String(), "min_price is above max_price")
+	})
+
+	t.Run("invalid filter parameter", func(t *testing.T) {
+		svc := mocks.NewItemService(t)
+		rec := serve(t, handlerCase{method: http.MethodGet, target: "/items/export?min_quantity=many"},
+			handler.NewItemHandler(svc, nil).ExportItems)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "min_quantity")
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		svc := mocks.NewItemService(t)
+		rec := serve(t, handlerCase{method: http.MethodGet, target: "/items/export?format=pdf"}, handler.NewItemHandler(svc, nil).ExportItems)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -1,12 +1,16 @@
 package handler
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"inventory-system/internal/domain"
 	"inventory-system/pkg/httputil" // Our error utility
@@ -28,24 +32,16 @@ func validateAlphaNumDash(fl validator.FieldLevel) bool {
 // ItemHandler handles HTTP requests for items.
 type ItemHandler struct {
 	itemService domain.ItemService
-	validate    *validator.Validate // Validator instance
+	locks       domain.EditLockService // Used to show current edit locks; may be nil
+	validate    *validator.Validate    // Validator instance
 }
 
 // NewItemHandler creates a new ItemHandler.
-func NewItemHandler(is domain.ItemService) *ItemHandler {
-	validate := validator.New() // Initialize a new validator
-
-	// <<<<<<< REGISTER THE CUSTOM VALIDATION HERE >>>>>>>>>
-	err := validate.RegisterValidation("alphanumdash", validateAlphaNumDash)
-	if err != nil {
-		// This is a critical setup error, so we panic.
-		// The server will fail to start, which is what we want if validation can't be set up.
-		log.Fatalf("Failed to register custom validation: %v", err)
-	}
-
+func NewItemHandler(is domain.ItemService, locks domain.EditLockService) *ItemHandler {
 	return &ItemHandler{
 		itemService: is,
-		validate:    validate, // Use the configured validator
+		locks:       locks,
+		validate:    newValidator(), // Validator with our custom rules registered
 	}
 }
 
@@ -55,6 +51,7 @@ func NewItemHandler(is domain.ItemService) *ItemHandler {
 // @Tags items
 // @Accept json
 // @Produce json
+// @Param X-User-ID header string false "Calling user, recorded in the item history"
 // @Param item body domain.CreateItemRequest true "Item to create"
 // @Success 201 {object} domain.Item "Successfully created item"
 // @Failure 400 {object} httputil.HTTPError "Bad Request (e.g., invalid input format)"
@@ -77,7 +74,7 @@ func (h *ItemHandler) CreateItem(c echo.Context) error {
 		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", validationErrors))
 	}
 
-	item, err := h.itemService.CreateItem(c.Request().Context(), &req)
+	item, err := h.itemService.CreateItem(c.Request().Context(), &req, currentUserID(c))
 	if err != nil {
 		log.Printf("CreateItem: Service error: %v", err)
 		if errors.Is(err, domain.ErrSKUAlreadyExists) { // Assuming service.ErrSKUAlreadyExists
@@ -100,6 +97,7 @@ func (h *ItemHandler) CreateItem(c echo.Context) error {
 // @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
 // @Failure 404 {object} httputil.HTTPError "Not Found"
 // @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Header 200 {string} ETag "Version of the item, for If-Match on PUT /items/{id}"
 // @Router /items/{id} [get]
 func (h *ItemHandler) GetItemByID(c echo.Context) error {
 	id := c.Param("id")
@@ -117,42 +115,96 @@ func (h *ItemHandler) GetItemByID(c echo.Context) error {
 		}
 		return httputil.SendErrorResponse(c, httputil.InternalServerError("Failed to retrieve item."))
 	}
+	h.attachLocks(item)
 
+	c.Response().Header().Set("ETag", versionETag(item.Version))
 	return c.JSON(http.StatusOK, item)
 }
 
+// GetItemBySKU godoc
+// @Summary Get an item by SKU
+// @Description Retrieves a specific item by its SKU, e.g. for barcode scanners
+// @Tags items
+// @Produce json
+// @Param sku path string true "Item SKU"
+// @Success 200 {object} domain.Item "Successfully retrieved item"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/sku/{sku} [get]
+func (h *ItemHandler) GetItemBySKU(c echo.Context) error {
+	sku := c.Param("sku")
+
+	item, err := h.itemService.GetItemBySKU(c.Request().Context(), sku)
+	if err != nil {
+		log.Printf("GetItemBySKU: Service error for SKU %s: %v", sku, err)
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+		}
+		if errors.Is(err, domain.ErrItemNotFound) {
+			return httputil.SendErrorResponse(c, httputil.NotFoundError(fmt.Sprintf("Item with SKU '%s' not found.", sku)))
+		}
+		return httputil.SendErrorResponse(c, httputil.InternalServerError("Failed to retrieve item."))
+	}
+	h.attachLocks(item)
+
+	return c.JSON(http.StatusOK, item)
+}
+
+// unstreamableItemParams are the parameters of GetItems that the NDJSON stream does not support.
+var unstreamableItemParams = []string{"category", "supplier", "min_price", "max_price", "min_quantity", "max_quantity",
+	"created_since", "sort", "search"}
+
 // GetItems godoc
 // @Summary Get all items (paginated)
-// @Description Retrieves a list of items with pagination
+// @Description Retrieves a list of items with pagination, newest first unless sort is given. Filters combine with AND.
+// @Description With search, only items whose SKU, name or description match are listed, best match first, each with
+// @Description its rank in "match"; with highlight=true as well, "match" also holds the name and the best fragments
+// @Description of the description, HTML-escaped, with the matched words wrapped in <mark></mark> as the only markup.
+// @Description With "Accept: application/x-ndjson" every item is streamed instead, one JSON object per line,
+// @Description newest first, without pagination, filters or sorting. If the stream breaks, its last line is {"error": {...}}.
 // @Tags items
 // @Produce json
+// @Produce x-ndjson
 // @Param page query int false "Page number (default: 1)"
 // @Param limit query int false "Items per page (default: 10, max: 100)"
+// @Param category query string false "Only items in this category (UUID) or any of its subcategories"
+// @Param supplier query string false "Only items on a purchase order of this supplier (UUID)"
+// @Param min_price query number false "Only items at or above this price"
+// @Param max_price query number false "Only items at or below this price"
+// @Param min_quantity query int false "Only items with at least this quantity"
+// @Param max_quantity query int false "Only items with at most this quantity"
+// @Param created_since query string false "Only items created at or after this time (RFC 3339)"
+// @Param sort query string false "Sort keys, e.g. -price,name; fields are sku, name, price, quantity, created_at and updated_at; - sorts descending"
+// @Param search query string false "Full-text search: words, \"quoted phrases\", or, -excluded words"
+// @Param highlight query bool false "With search: mark the matched words"
 // @Success 200 {object} map[string]interface{} "items":[]domain.Item, "total":int, "page":int, "limit":int "List of items and pagination info"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid query parameters, listed in details)"
 // @Failure 500 {object} httputil.HTTPError "Internal Server Error"
 // @Router /items [get]
 func (h *ItemHandler) GetItems(c echo.Context) error {
-	pageStr := c.QueryParam("page")
-	limitStr := c.QueryParam("limit")
-
-	page, err := strconv.Atoi(pageStr)
-	if err != nil || page < 1 {
-		page = 1 // Default page
-	}
-
-	limit, err := strconv.Atoi(limitStr)
-	if err != nil || limit < 1 {
-		limit = 10 // Default limit
+	if AcceptsNDJSON(c) {
+		for _, name := range unstreamableItemParams {
+			if c.QueryParam(name) != "" {
+				return httputil.SendErrorResponse(c, httputil.BadRequestError("The "+name+" parameter is not supported when streaming."))
+			}
+		}
+		return h.streamItems(c)
 	}
-	if limit > 100 { // Max limit
-		limit = 100
+	query := domain.ListItemsQuery{Page: 1, Limit: 10} // Defaults
+	if httpErr := bindQuery(c, h.validate, &query); httpErr != nil {
+		log.Printf("GetItems: Invalid query parameters: %v", httpErr.Details)
+		return httputil.SendErrorResponse(c, httpErr)
 	}
 
-	items, total, err := h.itemService.GetItems(c.Request().Context(), page, limit)
+	items, total, err := h.itemService.GetItems(c.Request().Context(), query)
 	if err != nil {
 		log.Printf("GetItems: Service error: %v", err)
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+		}
 		return httputil.SendErrorResponse(c, httputil.InternalServerError("Failed to retrieve items."))
 	}
+	h.attachLocks(items...)
 
 	response := struct {
 		Items []*domain.Item `json:"items"`
@@ -162,26 +214,117 @@ func (h *ItemHandler) GetItems(c echo.Context) error {
 	}{
 		Items: items,
 		Total: total,
-		Page:  page,
-		Limit: limit,
+		Page:  query.Page,
+		Limit: query.Limit,
 	}
 
 	return c.JSON(http.StatusOK, response)
 }
 
+// GetItemChanges godoc
+// @Summary Get items changed since a cursor
+// @Description Change feed for sync workers (e.g. Shopify or ERP connectors). Returns items changed after the cursor,
+// @Description oldest change first, with their running promotions. Start with no parameters (or since=<RFC 3339 time>),
+// @Description then always pass back next_cursor; store it to resume after a restart. When has_more is false the feed
+// @Description is caught up: poll again later with the same cursor. Changes show up after a few seconds of delay,
+// @Description and deleted items are not reported.
+// @Tags items
+// @Produce json
+// @Param since query string false "Start of a new sync (RFC 3339); cannot be combined with cursor"
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "Items per page (default: 100, max: 1000)"
+// @Success 200 {object} domain.ItemChangesPage "Changed items and the cursor to continue from"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid since, cursor or limit)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/changes [get]
+func (h *ItemHandler) GetItemChanges(c echo.Context) error {
+	query := domain.ItemChangesQuery{Limit: 100} // Defaults
+	if httpErr := bindQuery(c, h.validate, &query); httpErr != nil {
+		log.Printf("GetItemChanges: Invalid query parameters: %v", httpErr.Details)
+		return httputil.SendErrorResponse(c, httpErr)
+	}
+
+	page, err := h.itemService.GetItemChanges(c.Request().Context(), query)
+	if err != nil {
+		log.Printf("GetItemChanges: Service error: %v", err)
+		if errors.Is(err, domain.ErrInvalidInput) || errors.Is(err, domain.ErrInvalidCursor) {
+			return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+		}
+		return httputil.SendErrorResponse(c, httputil.InternalServerError("Failed to retrieve item changes."))
+	}
+	return c.JSON(http.StatusOK, page)
+}
+
+// streamItems writes every item as NDJSON, as the service reads them.
+func (h *ItemHandler) streamItems(c echo.Context) error {
+	w := newNDJSONWriter(c)
+	err := h.itemService.StreamItems(c.Request().Context(), func(item *domain.Item) error {
+		h.attachLocks(item)
+		return w.write(item)
+	})
+	if err != nil {
+		log.Printf("GetItems: Streaming stopped after %d items: %v", w.lines, err)
+		w.fail(httputil.InternalServerError("Failed to retrieve items."))
+		return nil
+	}
+	w.close()
+	return nil
+}
+
+// GetItemOptions godoc
+// @Summary List item options
+// @Description Returns id, SKU and name of every item, ordered by SKU, for populating select inputs.
+// @Description The response carries an ETag; send it back in If-None-Match to get 304 when nothing changed.
+// @Tags items
+// @Produce json
+// @Param If-None-Match header string false "ETag of a previously fetched response"
+// @Success 200 {array} domain.ItemOption "Item options"
+// @Success 304 "Not Modified"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/options [get]
+func (h *ItemHandler) GetItemOptions(c echo.Context) error {
+	options, err := h.itemService.ListItemOptions(c.Request().Context())
+	if err != nil {
+		log.Printf("GetItemOptions: Service error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.InternalServerError("Failed to retrieve item options."))
+	}
+
+	body, err := json.Marshal(options)
+	if err != nil {
+		log.Printf("GetItemOptions: Marshal error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.InternalServerError("Failed to retrieve item options."))
+	}
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	res := c.Response().Header()
+	res.Set("ETag", etag)
+	res.Set("Cache-Control", "private, max-age=60") // Dropdowns tolerate a minute of staleness
+	if c.Request().Header.Get("If-None-Match") == etag {
+		return c.NoContent(http.StatusNotModified)
+	}
+	return c.JSONBlob(http.StatusOK, body)
+}
+
 // UpdateItem godoc
 // @Summary Update an existing item
-// @Description Updates specified fields of an existing item by its UUID
+// @Description Updates specified fields of an existing item by its UUID. The version the edit is based on must be sent,
+// @Description as the If-Match header (the ETag of GET /items/{id}) or as "version" in the body; if the item was
+// @Description changed since, nothing is written and 409 is returned.
 // @Tags items
 // @Accept json
 // @Produce json
+// @Param X-User-ID header string false "Calling user, recorded in the item history and movement ledger"
 // @Param id path string true "Item ID (UUID)"
+// @Param If-Match header string false "ETag of the item being edited, e.g. \"3\""
 // @Param item body domain.UpdateItemRequest true "Fields to update"
 // @Success 200 {object} domain.Item "Successfully updated item"
+// @Header 200 {string} ETag "New version of the item"
 // @Failure 400 {object} httputil.HTTPError "Bad Request (e.g., invalid ID or input format)"
 // @Failure 404 {object} httputil.HTTPError "Not Found"
-// @Failure 409 {object} httputil.HTTPError "Conflict (e.g., SKU already exists after update)"
+// @Failure 409 {object} httputil.HTTPError "Conflict (SKU already exists, the item was changed since it was read, or the quantity would fall below what open sales orders reserve)"
 // @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 428 {object} httputil.HTTPError "Precondition Required (no version sent)"
 // @Failure 500 {object} httputil.HTTPError "Internal Server Error"
 // @Router /items/{id} [put]
 func (h *ItemHandler) UpdateItem(c echo.Context) error {
@@ -200,8 +343,12 @@ func (h *ItemHandler) UpdateItem(c echo.Context) error {
 		validationErrors := ParseValidationErrors(err)
 		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", validationErrors))
 	}
+	if httpErr := applyIfMatch(c, &req); httpErr != nil {
+		log.Printf("UpdateItem: Version error for ID %s: %s", id, httpErr.Message)
+		return httputil.SendErrorResponse(c, httpErr)
+	}
 
-	item, err := h.itemService.UpdateItem(c.Request().Context(), id, &req)
+	item, err := h.itemService.UpdateItem(c.Request().Context(), id, &req, currentUserID(c))
 	if err != nil {
 		log.Printf("UpdateItem: Service error for ID %s: %v", id, err)
 		if errors.Is(err, domain.ErrInvalidItemID) {
@@ -210,7 +357,8 @@ func (h *ItemHandler) UpdateItem(c echo.Context) error {
 		if errors.Is(err, domain.ErrItemNotFound) {
 			return httputil.SendErrorResponse(c, httputil.NotFoundError(fmt.Sprintf("Item with ID '%s' not found for update.", id)))
 		}
-		if errors.Is(err, domain.ErrSKUAlreadyExists) {
+		if errors.Is(err, domain.ErrSKUAlreadyExists) || errors.Is(err, domain.ErrVersionConflict) ||
+			errors.Is(err, domain.ErrInsufficientStock) {
 			return httputil.SendErrorResponse(c, httputil.ConflictError(err.Error()))
 		}
 		// if errors.Is(err, domain.ErrUpdateNoChanges) { // If service returns this
@@ -219,14 +367,93 @@ func (h *ItemHandler) UpdateItem(c echo.Context) error {
 		return httputil.SendErrorResponse(c, httputil.InternalServerError("Failed to update item."))
 	}
 
+	c.Response().Header().Set("ETag", versionETag(item.Version))
+	return c.JSON(http.StatusOK, item)
+}
+
+// versionETag renders an item version as an entity tag.
+func versionETag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// applyIfMatch copies the version in the If-Match header, if any, into req and checks that
+// the request carries a version one way or the other.
+func applyIfMatch(c echo.Context, req *domain.UpdateItemRequest) *httputil.HTTPError {
+	header := c.Request().Header.Get("If-Match")
+	if header == "" {
+		if req.Version == nil {
+			return httputil.PreconditionRequiredError("The item version is required: send the ETag of the item as If-Match, or \"version\" in the body.")
+		}
+		return nil
+	}
+	tag := strings.TrimPrefix(strings.TrimSpace(header), "W/")
+	version, err := strconv.Atoi(strings.Trim(tag, `"`))
+	if err != nil || version < 1 {
+		return httputil.BadRequestError("Invalid If-Match header: expected an item ETag such as \"3\".")
+	}
+	if req.Version != nil && *req.Version != version {
+		return httputil.BadRequestError("If-Match and \"version\" disagree.")
+	}
+	req.Version = &version
+	return nil
+}
+
+// AdjustItemQuantity godoc
+// @Summary Adjust an item's quantity
+// @Description Adds delta (negative to remove stock) to the quantity atomically, so concurrent adjustments are never lost.
+// @Description Fails with 409 if a removal would take units reserved by open sales orders, or drop the quantity below zero.
+// @Description The new quantity is broadcast over WebSocket.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID (UUID)"
+// @Param X-User-ID header string false "Calling user, recorded in the item history and movement ledger"
+// @Param adjustment body domain.AdjustQuantityRequest true "Quantity delta"
+// @Success 200 {object} domain.Item "Item with its new quantity"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID or payload)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 409 {object} httputil.HTTPError "Conflict (insufficient stock)"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/{id}/quantity [post]
+func (h *ItemHandler) AdjustItemQuantity(c echo.Context) error {
+	id := c.Param("id")
+
+	var req domain.AdjustQuantityRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("AdjustItemQuantity: Bind error for ID %s: %v", id, err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("AdjustItemQuantity: Validation error for ID %s: %v", id, err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	item, err := h.itemService.AdjustQuantity(c.Request().Context(), id, req.Delta, currentUserID(c))
+	if err != nil {
+		log.Printf("AdjustItemQuantity: Service error for ID %s: %v", id, err)
+		switch {
+		case errors.Is(err, domain.ErrInvalidItemID), errors.Is(err, domain.ErrInvalidInput):
+			return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+		case errors.Is(err, domain.ErrItemNotFound):
+			return httputil.SendErrorResponse(c, httputil.NotFoundError(fmt.Sprintf("Item with ID '%s' not found.", id)))
+		case errors.Is(err, domain.ErrInsufficientStock):
+			return httputil.SendErrorResponse(c, httputil.ConflictError(err.Error()))
+		}
+		return httputil.SendErrorResponse(c, httputil.InternalServerError("Failed to adjust item quantity."))
+	}
+	h.attachLocks(item)
+
 	return c.JSON(http.StatusOK, item)
 }
 
 // DeleteItem godoc
 // @Summary Delete an item by ID
-// @Description Deletes a specific item by its UUID
+// @Description Moves an item to the trash. It disappears from every other endpoint but keeps its SKU and history, and
+// @Description can be restored with POST /items/{id}/restore until it is purged.
 // @Tags items
 // @Produce json
+// @Param X-User-ID header string false "Calling user, recorded in the item history"
 // @Param id path string true "Item ID (UUID)"
 // @Success 204 "Successfully deleted item (No Content)"
 // @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
@@ -237,7 +464,7 @@ func (h *ItemHandler) DeleteItem(c echo.Context) error {
 	id := c.Param("id")
 	// ID format validation done by service
 
-	err := h.itemService.DeleteItem(c.Request().Context(), id)
+	err := h.itemService.DeleteItem(c.Request().Context(), id, currentUserID(c))
 	if err != nil {
 		log.Printf("DeleteItem: Service error for ID %s: %v", id, err)
 		if errors.Is(err, domain.ErrInvalidItemID) {
@@ -252,6 +479,141 @@ func (h *ItemHandler) DeleteItem(c echo.Context) error {
 	return c.NoContent(http.StatusNoContent)
 }
 
+// ListTrash godoc
+// @Summary List trashed items
+// @Description Lists the deleted items that can still be restored, most recently deleted first, with their deleted_at.
+// @Tags items
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} object{items=[]domain.Item,total=int,page=int,limit=int} "Paginated list of trashed items"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid query parameters, listed in details)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/trash [get]
+func (h *ItemHandler) ListTrash(c echo.Context) error {
+	query := domain.ListTrashQuery{Page: 1, Limit: 10} // Defaults
+	if httpErr := bindQuery(c, h.validate, &query); httpErr != nil {
+		log.Printf("ListTrash: Invalid query parameters: %v", httpErr.Details)
+		return httputil.SendErrorResponse(c, httpErr)
+	}
+
+	items, total, err := h.itemService.ListTrash(c.Request().Context(), query)
+	if err != nil {
+		log.Printf("ListTrash: Service error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.InternalServerError("Failed to retrieve trashed items."))
+	}
+
+	response := struct {
+		Items []*domain.Item `json:"items"`
+		Total int            `json:"total"`
+		Page  int            `json:"page"`
+		Limit int            `json:"limit"`
+	}{
+		Items: items,
+		Total: total,
+		Page:  query.Page,
+		Limit: query.Limit,
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// RestoreItem godoc
+// @Summary Restore a trashed item
+// @Description Takes a deleted item out of the trash, as it was when it was deleted.
+// @Tags items
+// @Produce json
+// @Param X-User-ID header string false "Calling user, recorded in the item history"
+// @Param id path string true "Item ID (UUID)"
+// @Success 200 {object} domain.Item "Restored item"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 404 {object} httputil.HTTPError "Not Found (no such item in the trash)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/{id}/restore [post]
+func (h *ItemHandler) RestoreItem(c echo.Context) error {
+	id := c.Param("id")
+	item, err := h.itemService.RestoreItem(c.Request().Context(), id, currentUserID(c))
+	if err != nil {
+		log.Printf("RestoreItem: Service error for ID %s: %v", id, err)
+		return sendTrashError(c, err, id, "Failed to restore item.")
+	}
+	h.attachLocks(item)
+	return c.JSON(http.StatusOK, item)
+}
+
+// GetItemHistory godoc
+// @Summary Get the change history of an item
+// @Description Lists the changes made to an item, oldest first, whichever endpoint made them: its creation, every change
+// @Description with the fields it changed (old and new values), and moves to and from the trash. Quantity changes are
+// @Description listed too; their reasons are in GET /items/{id}/movements. Trashed items keep their history.
+// @Tags items
+// @Produce json
+// @Param id path string true "Item ID (UUID)"
+// @Success 200 {array} domain.ItemRevision "Revisions, oldest first"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/{id}/history [get]
+func (h *ItemHandler) GetItemHistory(c echo.Context) error {
+	id := c.Param("id")
+	revisions, err := h.itemService.GetItemHistory(c.Request().Context(), id)
+	if err != nil {
+		log.Printf("GetItemHistory: Service error for ID %s: %v", id, err)
+		if errors.Is(err, domain.ErrInvalidItemID) {
+			return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+		}
+		if errors.Is(err, domain.ErrItemNotFound) {
+			return httputil.SendErrorResponse(c, httputil.NotFoundError(fmt.Sprintf("Item with ID '%s' not found.", id)))
+		}
+		return httputil.SendErrorResponse(c, httputil.InternalServerError("Failed to retrieve item history."))
+	}
+	return c.JSON(http.StatusOK, revisions)
+}
+
+// PurgeItem godoc
+// @Summary Permanently delete a trashed item
+// @Description Deletes a trashed item for good, together with its revisions, price history and labels. Only items in
+// @Description the trash can be purged; delete an item first. Items that ever appeared on a purchase, sales or
+// @Description assembly order, in a bill of materials or in the movement ledger are kept, and 409 names what holds them.
+// @Tags items
+// @Param id path string true "Item ID (UUID)"
+// @Success 204 "Item purged (No Content)"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 404 {object} httputil.HTTPError "Not Found (no such item in the trash)"
+// @Failure 409 {object} httputil.HTTPError "Conflict (still referenced by orders, assemblies or the ledger)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/trash/{id} [delete]
+func (h *ItemHandler) PurgeItem(c echo.Context) error {
+	id := c.Param("id")
+	if err := h.itemService.PurgeItem(c.Request().Context(), id); err != nil {
+		log.Printf("PurgeItem: Service error for ID %s: %v", id, err)
+		return sendTrashError(c, err, id, "Failed to purge item.")
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// sendTrashError maps the errors of restoring and purging trashed items to responses.
+func sendTrashError(c echo.Context, err error, id, fallback string) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidItemID):
+		return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+	case errors.Is(err, domain.ErrItemNotFound):
+		return httputil.SendErrorResponse(c, httputil.NotFoundError(fmt.Sprintf("Item with ID '%s' is not in the trash.", id)))
+	case errors.Is(err, domain.ErrItemInUse):
+		return httputil.SendErrorResponse(c, httputil.ConflictError(err.Error()))
+	}
+	return httputil.SendErrorResponse(c, httputil.InternalServerError(fallback))
+}
+
+// attachLocks fills in the current edit lock of each item, if any.
+func (h *ItemHandler) attachLocks(items ...*domain.Item) {
+	if h.locks == nil {
+		return
+	}
+	for _, item := range items {
+		item.Lock = h.locks.Get(item.ID)
+	}
+}
+
 // ParseValidationErrors is a helper to convert validator.ValidationErrors into a map.
 func ParseValidationErrors(err error) map[string]string {
 	var ve validator.ValidationErrors
@@ -0,0 +1,626 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/handler"
+	"inventory-system/internal/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const itemID = "3f7c1e9a-2b4d-4c8e-9f1a-6d5b7e8c9a0b"
+
+var errBoom = errors.New("connection refused")
+
+// handlerCase is one request against a handler whose service is mocked.
+type handlerCase struct {
+	name       string
+	method     string
+	target     string
+	body       string
+	id         string                     // Value of the :id path parameter, if any
+	params     map[string]string          // Other path parameters, by name
+	user       string                     // Value of the X-User-ID header, if any
+	roles      string                     // Value of the X-User-Roles header, if any
+	accept     string                     // Value of the Accept header, if any
+	ifMatch    string                     // Value of the If-Match header, if any
+	mediaType  string                     // Content-Type of the body; JSON if empty
+	setup      func(s *mocks.ItemService) // Expectations on the service; nil means it must not be called
+	wantStatus int
+	wantBody   string // Substring expected in the response body
+}
+
+// serve builds an Echo context for tc and runs it through h.
+func serve(t *testing.T, tc handlerCase, h echo.HandlerFunc) *httptest.ResponseRecorder {
+	t.Helper()
+	e := echo.New()
+	req := httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body))
+	if tc.mediaType != "" {
+		req.Header.Set(echo.HeaderContentType, tc.mediaType)
+	} else if tc.body != "" {
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	}
+	if tc.user != "" {
+		req.Header.Set(handler.HeaderUserID, tc.user)
+	}
+	if tc.roles != "" {
+		req.Header.Set(handler.HeaderUserRoles, tc.roles)
+	}
+	if tc.accept != "" {
+		req.Header.Set(echo.HeaderAccept, tc.accept)
+	}
+	if tc.ifMatch != "" {
+		req.Header.Set("If-Match", tc.ifMatch)
+	}
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	var names, values []string
+	if tc.id != "" {
+		names, values = append(names, "id"), append(values, tc.id)
+	}
+	for name, value := range tc.params {
+		names, values = append(names, name), append(values, value)
+	}
+	c.SetParamNames(names...)
+	c.SetParamValues(values...)
+	if err := h(c); err != nil {
+		t.Fatalf("handler returned error: %v", err)
+	}
+	return rec
+}
+
+func runItemCases(t *testing.T, cases []handlerCase, route func(*handler.ItemHandler) echo.HandlerFunc) {
+	t.Helper()
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewItemService(t) // Fails the test on unexpected calls and unmet expectations
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+			rec := serve(t, tc, route(handler.NewItemHandler(svc, nil)))
+
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tc.wantBody)
+		})
+	}
+}
+
+func TestItemHandler_CreateItem(t *testing.T) {
+	valid := `{"sku":"WIDGET-1","name":"Widget","quantity":3,"price":9.5}`
+
+	runItemCases(t, []handlerCase{
+		{
+			name: "created", method: http.MethodPost, target: "/items", body: valid, user: "alice",
+			setup: func(s *mocks.ItemService) {
+				s.On("CreateItem", mock.Anything, mock.MatchedBy(func(r *domain.CreateItemRequest) bool {
+					return r.SKU == "WIDGET-1" && r.Quantity == 3 && r.Price == 9.5
+				}), "alice").Return(&domain.Item{ID: itemID, SKU: "WIDGET-1"}, nil)
+			},
+			wantStatus: http.StatusCreated, wantBody: itemID,
+		},
+		{
+			name: "malformed json", method: http.MethodPost, target: "/items", body: `{"sku":`,
+			wantStatus: http.StatusBadRequest, wantBody: "BAD_REQUEST",
+		},
+		{
+			name: "wrong field type", method: http.MethodPost, target: "/items", body: `{"sku":"A","quantity":"three"}`,
+			wantStatus: http.StatusBadRequest, wantBody: "Invalid request payload",
+		},
+		{
+			name: "missing required fields", method: http.MethodPost, target: "/items", body: `{"quantity":1}`,
+			wantStatus: http.StatusUnprocessableEntity, wantBody: `"SKU":"Failed validation on rule 'required'"`,
+		},
+		{
+			name: "sku with invalid characters", method: http.MethodPost, target: "/items",
+			body:       `{"sku":"WIDGET 1!","name":"Widget","quantity":3,"price":9.5}`,
+			wantStatus: http.StatusUnprocessableEntity, wantBody: "alphanumdash",
+		},
+		{
+			name: "negative quantity", method: http.MethodPost, target: "/items",
+			body:       `{"sku":"WIDGET-1","name":"Widget","quantity":-1,"price":9.5}`,
+			wantStatus: http.StatusUnprocessableEntity, wantBody: `"Quantity":"Failed validation on rule 'gte'"`,
+		},
+		{
+			name: "duplicate sku", method: http.MethodPost, target: "/items", body: valid,
+			setup: func(s *mocks.ItemService) {
+				s.On("CreateItem", mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, fmt.Errorf("%w: SKU WIDGET-1", domain.ErrSKUAlreadyExists))
+			},
+			wantStatus: http.StatusConflict, wantBody: "CONFLICT",
+		},
+		{
+			name: "service failure", method: http.MethodPost, target: "/items", body: valid,
+			setup: func(s *mocks.ItemService) {
+				s.On("CreateItem", mock.Anything, mock.Anything, mock.Anything).Return(nil, errBoom)
+			},
+			wantStatus: http.StatusInternalServerError, wantBody: "Failed to create item.",
+		},
+	}, func(h *handler.ItemHandler) echo.HandlerFunc { return h.CreateItem })
+}
+
+func TestItemHandler_GetItemByID(t *testing.T) {
+	runItemCases(t, []handlerCase{
+		{
+			name: "found", method: http.MethodGet, target: "/items/" + itemID, id: itemID,
+			setup: func(s *mocks.ItemService) {
+				s.On("GetItemByID", mock.Anything, itemID).Return(&domain.Item{ID: itemID, Name: "Widget"}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"name":"Widget"`,
+		},
+		{
+			name: "invalid id", method: http.MethodGet, target: "/items/nope", id: "nope",
+			setup: func(s *mocks.ItemService) {
+				s.On("GetItemByID", mock.Anything, "nope").Return(nil, fmt.Errorf("%w: nope", domain.ErrInvalidItemID))
+			},
+			wantStatus: http.StatusBadRequest, wantBody: "invalid item ID format",
+		},
+		{
+			name: "not found", method: http.MethodGet, target: "/items/" + itemID, id: itemID,
+			setup: func(s *mocks.ItemService) {
+				s.On("GetItemByID", mock.Anything, itemID).Return(nil, fmt.Errorf("%w: ID %s", domain.ErrItemNotFound, itemID))
+			},
+			wantStatus: http.StatusNotFound, wantBody: "NOT_FOUND",
+		},
+		{
+			name: "service failure", method: http.MethodGet, target: "/items/" + itemID, id: itemID,
+			setup: func(s *mocks.ItemService) {
+				s.On("GetItemByID", mock.Anything, itemID).Return(nil, errBoom)
+			},
+			wantStatus: http.StatusInternalServerError, wantBody: "Failed to retrieve item.",
+		},
+	}, func(h *handler.ItemHandler) echo.HandlerFunc { return h.GetItemByID })
+}
+
+func TestItemHandler_GetItemBySKU(t *testing.T) {
+	get := func(svc *mocks.ItemService, sku string) *httptest.ResponseRecorder {
+		e := echo.New()
+		e.GET("/items/sku/:sku", handler.NewItemHandler(svc, nil).GetItemBySKU)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/items/sku/"+sku, nil))
+		return rec
+	}
+
+	svc := mocks.NewItemService(t)
+	svc.On("GetItemBySKU", mock.Anything, "WIDGET-1").Return(&domain.Item{ID: itemID, SKU: "WIDGET-1"}, nil)
+	svc.On("GetItemBySKU", mock.Anything, "NOPE").Return(nil, fmt.Errorf("%w: SKU NOPE", domain.ErrItemNotFound))
+	svc.On("GetItemBySKU", mock.Anything, "BOOM").Return(nil, errBoom)
+
+	rec := get(svc, "WIDGET-1")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"id":"`+itemID+`"`)
+	rec = get(svc, "NOPE")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Item with SKU 'NOPE' not found.")
+	assert.Equal(t, http.StatusInternalServerError, get(svc, "BOOM").Code)
+}
+
+func TestItemHandler_GetItems(t *testing.T) {
+	listed := func(query domain.ListItemsQuery) func(s *mocks.ItemService) {
+		return func(s *mocks.ItemService) {
+			s.On("GetItems", mock.Anything, query).Return([]*domain.Item{{ID: itemID}}, 1, nil)
+		}
+	}
+	minPrice, maxQuantity := 2.5, 10
+
+	runItemCases(t, []handlerCase{
+		{
+			name: "defaults", method: http.MethodGet, target: "/items",
+			setup: listed(domain.ListItemsQuery{Page: 1, Limit: 10}), wantStatus: http.StatusOK, wantBody: `"total":1,"page":1,"limit":10`,
+		},
+		{
+			name: "explicit paging", method: http.MethodGet, target: "/items?page=3&limit=25",
+			setup: listed(domain.ListItemsQuery{Page: 3, Limit: 25}), wantStatus: http.StatusOK, wantBody: `"page":3,"limit":25`,
+		},
+		{
+			name: "limit above maximum", method: http.MethodGet, target: "/items?limit=1000",
+			wantStatus: http.StatusBadRequest, wantBody: `"limit":"Failed validation on rule 'max=100'"`,
+		},
+		{
+			name: "category filter", method: http.MethodGet, target: "/items?category=" + categoryID,
+			setup: listed(domain.ListItemsQuery{Page: 1, Limit: 10, Category: categoryID}), wantStatus: http.StatusOK, wantBody: `"total":1`,
+		},
+		{
+			name: "malformed category", method: http.MethodGet, target: "/items?category=tools",
+			wantStatus: http.StatusBadRequest, wantBody: `"category":"Failed validation on rule 'uuid'"`,
+		},
+		{
+			name: "ranges and sort", method: http.MethodGet,
+			target: "/items?min_price=2.5&max_quantity=10&created_since=2024-01-01T00:00:00Z&sort=-price,name",
+			setup: listed(domain.ListItemsQuery{Page: 1, Limit: 10, MinPrice: &minPrice, MaxQuantity: &maxQuantity,
+				CreatedSince: "2024-01-01T00:00:00Z", Sort: "-price,name"}),
+			wantStatus: http.StatusOK, wantBody: `"total":1`,
+		},
+		{
+			name: "negative price", method: http.MethodGet, target: "/items?min_price=-1",
+			wantStatus: http.StatusBadRequest, wantBody: `"min_price":"Failed validation on rule 'gte=0'"`,
+		},
+		{
+			name: "unknown sort field", method: http.MethodGet, target: "/items?sort=cost",
+			setup: func(s *mocks.ItemService) {
+				s.On("GetItems", mock.Anything, mock.Anything).
+					Return(nil, 0, fmt.Errorf("%w: cannot sort by 'cost'", domain.ErrInvalidInput))
+			},
+			wantStatus: http.StatusBadRequest, wantBody: "cannot sort by 'cost'",
+		},
+		{
+			name: "search with highlights", method: http.MethodGet, target: "/items?search=red+widget&highlight=true",
+			setup: func(s *mocks.ItemService) {
+				name := "<mark>Red</mark> <mark>widget</mark>"
+				s.On("GetItems", mock.Anything, domain.ListItemsQuery{Page: 1, Limit: 10, Search: "red widget", Highlight: true}).
+					Return([]*domain.Item{{ID: itemID, Match: &domain.SearchMatch{Rank: 0.5, Name: &name}}}, 1, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"match":{"rank":0.5,"name":"\u003cmark\u003eRed`,
+		},
+		{
+			name: "filters not streamed", method: http.MethodGet, target: "/items?sort=name", accept: handler.MIMEApplicationNDJSON,
+			wantStatus: http.StatusBadRequest, wantBody: "The sort parameter is not supported when streaming.",
+		},
+		{
+			name: "every bad parameter reported", method: http.MethodGet, target: "/items?page=-2&limit=abc",
+			wantStatus: http.StatusBadRequest,
+			wantBody:   `"details":{"limit":"Must be an integer","page":"Failed validation on rule 'min=1'"}`,
+		},
+		{
+			name: "service failure", method: http.MethodGet, target: "/items",
+			setup: func(s *mocks.ItemService) {
+				s.On("GetItems", mock.Anything, domain.ListItemsQuery{Page: 1, Limit: 10}).Return(nil, 0, errBoom)
+			},
+			wantStatus: http.StatusInternalServerError, wantBody: "Failed to retrieve items.",
+		},
+	}, func(h *handler.ItemHandler) echo.HandlerFunc { return h.GetItems })
+}
+
+func TestItemHandler_GetItemChanges(t *testing.T) {
+	page := &domain.ItemChangesPage{Items: []*domain.Item{{ID: itemID}}, NextCursor: "next", HasMore: true}
+
+	runItemCases(t, []handlerCase{
+		{
+			name: "first page", method: http.MethodGet, target: "/items/changes",
+			setup: func(s *mocks.ItemService) {
+				s.On("GetItemChanges", mock.Anything, domain.ItemChangesQuery{Limit: 100}).Return(page, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"next_cursor":"next","has_more":true`,
+		},
+		{
+			name: "resumed", method: http.MethodGet, target: "/items/changes?cursor=abc&limit=500",
+			setup: func(s *mocks.ItemService) {
+				s.On("GetItemChanges", mock.Anything, domain.ItemChangesQuery{Cursor: "abc", Limit: 500}).Return(page, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: itemID,
+		},
+		{
+			name: "limit above maximum", method: http.MethodGet, target: "/items/changes?limit=5000",
+			wantStatus: http.StatusBadRequest, wantBody: `"limit":"Failed validation on rule 'max=1000'"`,
+		},
+		{
+			name: "bad cursor", method: http.MethodGet, target: "/items/changes?cursor=garbage",
+			setup: func(s *mocks.ItemService) {
+				s.On("GetItemChanges", mock.Anything, mock.Anything).Return(nil, domain.ErrInvalidCursor)
+			},
+			wantStatus: http.StatusBadRequest, wantBody: "invalid change feed cursor",
+		},
+		{
+			name: "service failure", method: http.MethodGet, target: "/items/changes",
+			setup: func(s *mocks.ItemService) {
+				s.On("GetItemChanges", mock.Anything, mock.Anything).Return(nil, errBoom)
+			},
+			wantStatus: http.StatusInternalServerError, wantBody: "Failed to retrieve item changes.",
+		},
+	}, func(h *handler.ItemHandler) echo.HandlerFunc { return h.GetItemChanges })
+}
+
+func TestItemHandler_GetItemsNDJSON(t *testing.T) {
+	for name, tc := range map[string]struct {
+		skus      []string // Yielded by the service before it returns err
+		err       error
+		wantLines []string
+	}{
+		"complete": {[]string{"A", "B"}, nil, []string{`"sku":"A"`, `"sku":"B"`}},
+		"broken":   {[]string{"A"}, errBoom, []string{`"sku":"A"`, `{"error":{"code":"INTERNAL_SERVER_ERROR","message":"Failed to retrieve items."}}`}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			svc := mocks.NewItemService(t)
+			svc.On("StreamItems", mock.Anything, mock.Anything).Return(func(_ context.Context, fn func(*domain.Item) error) error {
+				for _, sku := range tc.skus {
+					if err := fn(&domain.Item{ID: itemID, SKU: sku}); err != nil {
+						return err
+					}
+				}
+				return tc.err
+			})
+			rec := serve(t, handlerCase{method: http.MethodGet, target: "/items", accept: handler.MIMEApplicationNDJSON},
+				handler.NewItemHandler(svc, nil).GetItems)
+
+			assert.Equal(t, handler.MIMEApplicationNDJSON, rec.Header().Get(echo.HeaderContentType))
+			lines := strings.Split(strings.TrimSuffix(rec.Body.String(), "\n"), "\n")
+			if assert.Len(t, lines, len(tc.wantLines), rec.Body.String()) {
+				for i, want := range tc.wantLines {
+					assert.Contains(t, lines[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestItemHandler_UpdateItem(t *testing.T) {
+	target := "/items/" + itemID
+	failing := func(err error) func(s *mocks.ItemService) {
+		return func(s *mocks.ItemService) {
+			s.On("UpdateItem", mock.Anything, itemID, mock.Anything, mock.Anything).Return(nil, err)
+		}
+	}
+
+	runItemCases(t, []handlerCase{
+		{
+			name: "updated", method: http.MethodPut, target: target, id: itemID, body: `{"quantity":0,"version":3}`, user: "alice",
+			setup: func(s *mocks.ItemService) {
+				s.On("UpdateItem", mock.Anything, itemID, mock.MatchedBy(func(r *domain.UpdateItemRequest) bool {
+					return r.Quantity != nil && *r.Quantity == 0 && r.Name == nil && *r.Version == 3
+				}), "alice").Return(&domain.Item{ID: itemID, Quantity: 0, Version: 4}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"quantity":0`,
+		},
+		{
+			name: "version from if-match", method: http.MethodPut, target: target, id: itemID, body: `{"name":"x"}`, ifMatch: `W/"7"`,
+			setup: func(s *mocks.ItemService) {
+				s.On("UpdateItem", mock.Anything, itemID, mock.MatchedBy(func(r *domain.UpdateItemRequest) bool {
+					return r.Version != nil && *r.Version == 7
+				}), "").Return(&domain.Item{ID: itemID, Version: 8}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"version":8`,
+		},
+		{
+			name: "missing version", method: http.MethodPut, target: target, id: itemID, body: `{"name":"x"}`,
+			wantStatus: http.StatusPreconditionRequired, wantBody: "If-Match",
+		},
+		{
+			name: "malformed if-match", method: http.MethodPut, target: target, id: itemID, body: `{"name":"x"}`, ifMatch: `"abc"`,
+			wantStatus: http.StatusBadRequest, wantBody: "Invalid If-Match header",
+		},
+		{
+			name: "if-match disagrees with body", method: http.MethodPut, target: target, id: itemID, body: `{"name":"x","version":2}`, ifMatch: `"3"`,
+			wantStatus: http.StatusBadRequest, wantBody: "disagree",
+		},
+		{
+			name: "malformed json", method: http.MethodPut, target: target, id: itemID, body: `[1,2`,
+			wantStatus: http.StatusBadRequest, wantBody: "Invalid request payload",
+		},
+		{
+			name: "validation failure", method: http.MethodPut, target: target, id: itemID, body: `{"price":-4,"version":3}`,
+			wantStatus: http.StatusUnprocessableEntity, wantBody: `"Price":"Failed validation on rule 'gt'"`,
+		},
+		{
+			name: "invalid id", method: http.MethodPut, target: "/items/nope", id: itemID, body: `{"name":"x","version":3}`,
+			setup:      failing(fmt.Errorf("%w: nope", domain.ErrInvalidItemID)),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "not found", method: http.MethodPut, target: target, id: itemID, body: `{"name":"x","version":3}`,
+			setup:      failing(fmt.Errorf("%w: ID %s", domain.ErrItemNotFound, itemID)),
+			wantStatus: http.StatusNotFound, wantBody: "not found for update",
+		},
+		{
+			name: "duplicate sku", method: http.MethodPut, target: target, id: itemID, body: `{"sku":"TAKEN","version":3}`,
+			setup:      failing(fmt.Errorf("%w: SKU TAKEN", domain.ErrSKUAlreadyExists)),
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name: "version conflict", method: http.MethodPut, target: target, id: itemID, body: `{"name":"x","version":3}`,
+			setup:      failing(fmt.Errorf("%w: item '%s' is at version 5, not 3", domain.ErrVersionConflict, itemID)),
+			wantStatus: http.StatusConflict, wantBody: "is at version 5, not 3",
+		},
+		{
+			name: "quantity below reservations", method: http.MethodPut, target: target, id: itemID, body: `{"quantity":2,"version":3}`,
+			setup: failing(fmt.Errorf("%w: item '%s' has 5 reserved by open sales orders, cannot set quantity to 2",
+				domain.ErrInsufficientStock, itemID)),
+			wantStatus: http.StatusConflict, wantBody: "reserved by open sales orders",
+		},
+		{
+			name: "service failure", method: http.MethodPut, target: target, id: itemID, body: `{"name":"x","version":3}`,
+			setup:      failing(errBoom),
+			wantStatus: http.StatusInternalServerError, wantBody: "Failed to update item.",
+		},
+	}, func(h *handler.ItemHandler) echo.HandlerFunc { return h.UpdateItem })
+}
+
+func TestItemHandler_DeleteItem(t *testing.T) {
+	target := "/items/" + itemID
+	deleting := func(err error) func(s *mocks.ItemService) {
+		return func(s *mocks.ItemService) {
+			s.On("DeleteItem", mock.Anything, itemID, "").Return(err)
+		}
+	}
+
+	runItemCases(t, []handlerCase{
+		{name: "deleted", method: http.MethodDelete, target: target, id: itemID, setup: deleting(nil), wantStatus: http.StatusNoContent},
+		{
+			name: "invalid id", method: http.MethodDelete, target: target, id: itemID,
+			setup: deleting(fmt.Errorf("%w: x", domain.ErrInvalidItemID)), wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "not found", method: http.MethodDelete, target: target, id: itemID,
+			setup: deleting(fmt.Errorf("%w: ID %s", domain.ErrItemNotFound, itemID)), wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "service failure", method: http.MethodDelete, target: target, id: itemID,
+			setup: deleting(errBoom), wantStatus: http.StatusInternalServerError, wantBody: "Failed to delete item.",
+		},
+	}, func(h *handler.ItemHandler) echo.HandlerFunc { return h.DeleteItem })
+}
+
+func TestItemHandler_ListTrash(t *testing.T) {
+	deletedAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	listing := func(query domain.ListTrashQuery, items []*domain.Item, err error) func(s *mocks.ItemService) {
+		return func(s *mocks.ItemService) {
+			s.On("ListTrash", mock.Anything, query).Return(items, len(items), err)
+		}
+	}
+
+	runItemCases(t, []handlerCase{
+		{
+			name: "listed", method: http.MethodGet, target: "/items/trash?page=2&limit=5",
+			setup:      listing(domain.ListTrashQuery{Page: 2, Limit: 5}, []*domain.Item{{ID: itemID, DeletedAt: &deletedAt}}, nil),
+			wantStatus: http.StatusOK, wantBody: `"deleted_at":"2024-03-01T12:00:00Z"`,
+		},
+		{name: "limit too high", method: http.MethodGet, target: "/items/trash?limit=1000", wantStatus: http.StatusBadRequest},
+		{
+			name: "service failure", method: http.MethodGet, target: "/items/trash",
+			setup:      listing(domain.ListTrashQuery{Page: 1, Limit: 10}, nil, errBoom),
+			wantStatus: http.StatusInternalServerError, wantBody: "Failed to retrieve trashed items.",
+		},
+	}, func(h *handler.ItemHandler) echo.HandlerFunc { return h.ListTrash })
+}
+
+func TestItemHandler_RestoreItem(t *testing.T) {
+	target := "/items/" + itemID + "/restore"
+	restoring := func(item *domain.Item, err error) func(s *mocks.ItemService) {
+		return func(s *mocks.ItemService) {
+			s.On("RestoreItem", mock.Anything, itemID, "").Return(item, err)
+		}
+	}
+
+	runItemCases(t, []handlerCase{
+		{
+			name: "restored", method: http.MethodPost, target: target, id: itemID,
+			setup: restoring(&domain.Item{ID: itemID, SKU: "WIDGET-1"}, nil), wantStatus: http.StatusOK, wantBody: `"sku":"WIDGET-1"`,
+		},
+		{
+			name: "not in the trash", method: http.MethodPost, target: target, id: itemID,
+			setup:      restoring(nil, fmt.Errorf("%w: ID %s in the trash", domain.ErrItemNotFound, itemID)),
+			wantStatus: http.StatusNotFound, wantBody: "is not in the trash",
+		},
+		{
+			name: "service failure", method: http.MethodPost, target: target, id: itemID,
+			setup: restoring(nil, errBoom), wantStatus: http.StatusInternalServerError, wantBody: "Failed to restore item.",
+		},
+	}, func(h *handler.ItemHandler) echo.HandlerFunc { return h.RestoreItem })
+}
+
+func TestItemHandler_GetItemHistory(t *testing.T) {
+	target := "/items/" + itemID + "/history"
+	history := func(revisions []*domain.ItemRevision, err error) func(s *mocks.ItemService) {
+		return func(s *mocks.ItemService) {
+			s.On("GetItemHistory", mock.Anything, itemID).Return(revisions, err)
+		}
+	}
+
+	runItemCases(t, []handlerCase{
+		{
+			name: "listed", method: http.MethodGet, target: target, id: itemID,
+			setup: history([]*domain.ItemRevision{{ID: "r-1", ItemID: itemID, Action: domain.ItemRevisionUpdated,
+				Changes: []domain.FieldChange{{Field: "price", Old: 9.5, New: 11.0}}}}, nil),
+			wantStatus: http.StatusOK, wantBody: `"changes":[{"field":"price","old":9.5,"new":11}]`,
+		},
+		{
+			name: "invalid id", method: http.MethodGet, target: target, id: itemID,
+			setup: history(nil, fmt.Errorf("%w: x", domain.ErrInvalidItemID)), wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "not found", method: http.MethodGet, target: target, id: itemID,
+			setup: history(nil, fmt.Errorf("%w: ID %s", domain.ErrItemNotFound, itemID)), wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "service failure", method: http.MethodGet, target: target, id: itemID,
+			setup: history(nil, errBoom), wantStatus: http.StatusInternalServerError, wantBody: "Failed to retrieve item history.",
+		},
+	}, func(h *handler.ItemHandler) echo.HandlerFunc { return h.GetItemHistory })
+}
+
+func TestItemHandler_PurgeItem(t *testing.T) {
+	target := "/items/trash/" + itemID
+	purging := func(err error) func(s *mocks.ItemService) {
+		return func(s *mocks.ItemService) {
+			s.On("PurgeItem", mock.Anything, itemID).Return(err)
+		}
+	}
+
+	runItemCases(t, []handlerCase{
+		{name: "purged", method: http.MethodDelete, target: target, id: itemID, setup: purging(nil), wantStatus: http.StatusNoContent},
+		{
+			name: "invalid id", method: http.MethodDelete, target: target, id: itemID,
+			setup: purging(fmt.Errorf("%w: x", domain.ErrInvalidItemID)), wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "live item", method: http.MethodDelete, target: target, id: itemID,
+			setup:      purging(fmt.Errorf("%w: ID %s in the trash", domain.ErrItemNotFound, itemID)),
+			wantStatus: http.StatusNotFound, wantBody: "is not in the trash",
+		},
+		{
+			name: "still referenced", method: http.MethodDelete, target: target, id: itemID,
+			setup:      purging(fmt.Errorf("%w: item '%s' is referenced by sales orders, stock movements", domain.ErrItemInUse, itemID)),
+			wantStatus: http.StatusConflict, wantBody: "referenced by sales orders",
+		},
+		{
+			name: "service failure", method: http.MethodDelete, target: target, id: itemID,
+			setup: purging(errBoom), wantStatus: http.StatusInternalServerError, wantBody: "Failed to purge item.",
+		},
+	}, func(h *handler.ItemHandler) echo.HandlerFunc { return h.PurgeItem })
+}
+
+func TestItemHandler_AdjustItemQuantity(t *testing.T) {
+	target := "/items/" + itemID + "/quantity"
+	adjusting := func(item *domain.Item, err error) func(s *mocks.ItemService) {
+		return func(s *mocks.ItemService) {
+			s.On("AdjustQuantity", mock.Anything, itemID, -3, "").Return(item, err)
+		}
+	}
+
+	runItemCases(t, []handlerCase{
+		{
+			name: "adjusted", method: http.MethodPost, target: target, id: itemID, body: `{"delta":-3}`,
+			setup: adjusting(&domain.Item{ID: itemID, Quantity: 7}, nil), wantStatus: http.StatusOK, wantBody: `"quantity":7`,
+		},
+		{
+			name: "adjusted by user", method: http.MethodPost, target: target, id: itemID, body: `{"delta":-3}`, user: "carol",
+			setup: func(s *mocks.ItemService) {
+				s.On("AdjustQuantity", mock.Anything, itemID, -3, "carol").Return(&domain.Item{ID: itemID, Quantity: 7}, nil)
+			},
+			wantStatus: http.StatusOK,
+		},
+		{name: "malformed json", method: http.MethodPost, target: target, id: itemID, body: `{"delta":`, wantStatus: http.StatusBadRequest},
+		{name: "zero delta", method: http.MethodPost, target: target, id: itemID, body: `{"delta":0}`, wantStatus: http.StatusUnprocessableEntity},
+		{
+			name: "not found", method: http.MethodPost, target: target, id: itemID, body: `{"delta":-3}`,
+			setup: adjusting(nil, fmt.Errorf("%w: ID %s", domain.ErrItemNotFound, itemID)), wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "insufficient stock", method: http.MethodPost, target: target, id: itemID, body: `{"delta":-3}`,
+			setup: adjusting(nil, domain.ErrInsufficientStock), wantStatus: http.StatusConflict,
+		},
+		{
+			name: "service failure", method: http.MethodPost, target: target, id: itemID, body: `{"delta":-3}`,
+			setup: adjusting(nil, errBoom), wantStatus: http.StatusInternalServerError, wantBody: "Failed to adjust item quantity.",
+		},
+	}, func(h *handler.ItemHandler) echo.HandlerFunc { return h.AdjustItemQuantity })
+}
+
+func TestItemHandler_GetItemOptions(t *testing.T) {
+	svc := mocks.NewItemService(t)
+	svc.On("ListItemOptions", mock.Anything).Return([]domain.ItemOption{{ID: itemID, SKU: "WIDGET-1", Name: "Widget"}}, nil)
+	h := handler.NewItemHandler(svc, nil)
+
+	rec := serve(t, handlerCase{method: http.MethodGet, target: "/items/options"}, h.GetItemOptions)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `[{"id":"`+itemID+`","sku":"WIDGET-1","name":"Widget"}]`, rec.Body.String())
+	etag := rec.Header().Get("ETag")
+	assert.NotEmpty(t, etag)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/items/options", nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	assert.NoError(t, h.GetItemOptions(e.NewContext(req, rec)))
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+	assert.Empty(t, rec.Body.String())
+}
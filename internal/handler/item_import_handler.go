@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// importColumns are the columns an import file may have, named like the fields of
+// POST /items. Columns can come in any order.
+var importColumns = []string{"sku", "name", "description", "quantity", "price", "low_stock_threshold"}
+
+// requiredImportColumns must be in every import file.
+var requiredImportColumns = []string{"sku", "name", "price"}
+
+// ItemImportHandler handles HTTP requests for importing items from CSV files.
+type ItemImportHandler struct {
+	itemImportService domain.ItemImportService
+	validate          *validator.Validate
+}
+
+// NewItemImportHandler creates a new ItemImportHandler.
+func NewItemImportHandler(is domain.ItemImportService) *ItemImportHandler {
+	validate := newValidator()
+	// Report rejected fields under their column names.
+	validate.RegisterTagNameFunc(func(f reflect.StructField) string {
+		return strings.Split(f.Tag.Get("json"), ",")[0]
+	})
+	return &ItemImportHandler{
+		itemImportService: is,
+		validate:          validate,
+	}
+}
+
+// ImportItems godoc
+// @Summary Import items from a CSV file
+// @Description Creates items from the rows of a CSV file, uploaded as the "file" field of a multipart form. The header
+// @Description names the columns: sku, name and price are required; description, quantity and low_stock_threshold
+// @Description are optional. Each row is validated like the body of POST /items. In upsert mode, a row whose SKU is
+// @Description known updates that item instead; its empty optional cells leave the item's values alone.
+// @Description The import is all or nothing: if any row is rejected, nothing is written and 422 lists every rejected
+// @Description row by line. Otherwise the rows are written in batches within one transaction, quantities are recorded
+// @Description in the movement ledger with the import ID as reference, and the new quantities are broadcast over WebSocket.
+// @Description A row may not lower an item's quantity below the units reserved by open sales orders.
+// @Tags items
+// @Accept multipart/form-data
+// @Produce json
+// @Param mode query string false "insert (default) or upsert"
+// @Param file formData file true "CSV file, at most 10000 rows"
+// @Success 200 {object} domain.ItemImportResult "Counts of created and updated items"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (missing file, unreadable CSV, or bad header)"
+// @Failure 409 {object} httputil.HTTPError "Conflict (a SKU was taken during the import, or a quantity is below the reserved stock)"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (rejected rows in the details)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/import [post]
+func (h *ItemImportHandler) ImportItems(c echo.Context) error {
+	query := domain.ImportItemsQuery{Mode: domain.ImportModeInsert} // Defaults
+	if httpErr := bindQuery(c, h.validate, &query); httpErr != nil {
+		log.Printf("ImportItems: Invalid query parameters: %v", httpErr.Details)
+		return httputil.SendErrorResponse(c, httpErr)
+	}
+
+	fh, err := c.FormFile("file")
+	if err != nil {
+		log.Printf("ImportItems: No file: %v", err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Missing CSV file in form field 'file'."))
+	}
+	f, err := fh.Open()
+	if err != nil {
+		log.Printf("ImportItems: Open error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.InternalServerError("Failed to read the uploaded file."))
+	}
+	defer f.Close()
+
+	imp, err := h.parseImportFile(c.Request().Context(), f)
+	if err != nil {
+		log.Printf("ImportItems: Parse error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+	}
+	imp.Mode = query.Mode
+
+	result, err := h.itemImportService.ImportItems(c.Request().Context(), imp, currentUserID(c))
+	if err != nil {
+		log.Printf("ImportItems: Service error: %v", err)
+		switch {
+		case errors.Is(err, domain.ErrImportRejected):
+			return httputil.SendErrorResponse(c, httputil.ValidationError("Import rejected; nothing was imported.", result))
+		case errors.Is(err, domain.ErrInvalidInput):
+			return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+		case errors.Is(err, domain.ErrSKUAlreadyExists), errors.Is(err, domain.ErrInsufficientStock):
+			return httputil.SendErrorResponse(c, httputil.ConflictError(err.Error()))
+		}
+		return httputil.SendErrorResponse(c, httputil.InternalServerError("Failed to import items."))
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// parseImportFile reads an import file, validating every row. Rows with bad cells are
+// returned as rejected; an error means the file itself is unusable.
+func (h *ItemImportHandler) parseImportFile(ctx context.Context, r io.Reader) (*domain.ItemImport, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("the file is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV header: %w", err)
+	}
+	cols := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\uFEFF"))) // Spreadsheet exports may start with a BOM
+		if !slices.Contains(importColumns, name) {
+			return nil, fmt.Errorf("unknown column '%s'; columns are %s", name, strings.Join(importColumns, ", "))
+		}
+		if _, ok := cols[name]; ok {
+			return nil, fmt.Errorf("column '%s' appears twice", name)
+		}
+		cols[name] = i
+	}
+	for _, name := range requiredImportColumns {
+		if _, ok := cols[name]; !ok {
+			return nil, fmt.Errorf("missing column '%s'", name)
+		}
+	}
+
+	imp := &domain.ItemImport{}
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if len(imp.Rows)+len(imp.Rejected) == domain.MaxImportRows {
+			return nil, fmt.Errorf("the file has more than %d rows", domain.MaxImportRows)
+		}
+		if err != nil && !errors.Is(err, csv.ErrFieldCount) {
+			return nil, fmt.Errorf("invalid CSV: %w", err)
+		}
+		line, _ := cr.FieldPos(0)
+		if err != nil {
+			imp.Rejected = append(imp.Rejected, domain.ImportRowError{Line: line,
+				Errors: map[string]string{"row": fmt.Sprintf("Has %d fields instead of %d", len(record), len(header))}})
+			continue
+		}
+
+		row, problems := parseImportRecord(record, cols)
+		row.Line = line
+		if len(problems) == 0 {
+			if err := h.validate.StructCtx(ctx, row.Item); err != nil {
+				problems = ParseValidationErrors(err)
+			}
+		}
+		if len(problems) > 0 {
+			imp.Rejected = append(imp.Rejected, domain.ImportRowError{Line: line, SKU: row.Item.SKU, Errors: problems})
+			continue
+		}
+		imp.Rows = append(imp.Rows, row)
+	}
+	return imp, nil
+}
+
+// parseImportRecord turns a CSV record into an import row, reporting cells that are not
+// numbers where numbers are expected. Empty optional cells stay unset.
+func parseImportRecord(record []string, cols map[string]int) (domain.ImportRow, map[string]string) {
+	cell := func(name string) string {
+		if i, ok := cols[name]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	problems := make(map[string]string)
+	row := domain.ImportRow{Item: domain.CreateItemRequest{SKU: cell("sku"), Name: cell("name")}}
+
+	if v := cell("description"); v != "" {
+		row.Item.Description = &v
+	}
+	if v := cell("quantity"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			problems["quantity"] = "Not a whole number"
+		}
+		row.Item.Quantity, row.QuantitySet = n, true
+	}
+	if v := cell("price"); v != "" {
+		p, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			problems["price"] = "Not a number"
+		}
+		row.Item.Price = p
+	}
+	if v := cell("low_stock_threshold"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			problems["low_stock_threshold"] = "Not a whole number"
+		}
+		row.Item.LowStockThreshold = &n
+	}
+	return row, problems
+}
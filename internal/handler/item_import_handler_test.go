@@ -0,0 +1,122 @@
+package handler_test
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"testing"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/handler"
+	"inventory-system/internal/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// csvUpload returns a multipart body with content as its "file" field, and its media type.
+func csvUpload(t *testing.T, content string) (string, string) {
+	t.Helper()
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile("file", "items.csv")
+	if err == nil {
+		_, err = part.Write([]byte(content))
+	}
+	if err == nil {
+		err = w.Close()
+	}
+	if err != nil {
+		t.Fatalf("failed to build upload: %v", err)
+	}
+	return body.String(), w.FormDataContentType()
+}
+
+func TestItemImportHandler(t *testing.T) {
+	cases := []struct {
+		name       string
+		target     string
+		file       string
+		setup      func(s *mocks.ItemImportService)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:   "insert valid rows",
+			target: "/api/v1/items/import",
+			file:   "sku,name,price,quantity\nWID-1,Widget,9.99,5\nWID-2,\"Widget, large\",12.5,\n",
+			setup: func(s *mocks.ItemImportService) {
+				s.On("ImportItems", mock.Anything, mock.MatchedBy(func(imp *domain.ItemImport) bool {
+					return imp.Mode == domain.ImportModeInsert && len(imp.Rows) == 2 && len(imp.Rejected) == 0 &&
+						imp.Rows[0].Line == 2 && imp.Rows[0].QuantitySet && imp.Rows[0].Item.Quantity == 5 &&
+						imp.Rows[1].Item.Name == "Widget, large" && !imp.Rows[1].QuantitySet
+				}), "").Return(&domain.ItemImportResult{ImportID: "imp-1", Mode: domain.ImportModeInsert, Rows: 2, Created: 2}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"created":2`,
+		},
+		{
+			name:   "bad rows are handed on as rejected",
+			target: "/api/v1/items/import?mode=upsert",
+			file:   "sku,name,price\nWID-1,Widget,abc\nWID 2,Widget,3\nWID-3,Widget\n",
+			setup: func(s *mocks.ItemImportService) {
+				s.On("ImportItems", mock.Anything, mock.MatchedBy(func(imp *domain.ItemImport) bool {
+					return imp.Mode == domain.ImportModeUpsert && len(imp.Rows) == 0 && len(imp.Rejected) == 3 &&
+						imp.Rejected[0].Errors["price"] == "Not a number" &&
+						imp.Rejected[1].Errors["sku"] != "" &&
+						imp.Rejected[2].Line == 4 && imp.Rejected[2].Errors["row"] != ""
+				}), "").Return(&domain.ItemImportResult{Mode: domain.ImportModeUpsert, Rows: 3,
+					Errors: []domain.ImportRowError{{Line: 2, SKU: "WID-1", Errors: map[string]string{"price": "Not a number"}}}}, domain.ErrImportRejected)
+			},
+			wantStatus: http.StatusUnprocessableEntity, wantBody: `"line":2`,
+		},
+		{
+			name:   "quantity below reserved stock",
+			target: "/api/v1/items/import?mode=upsert",
+			file:   "sku,name,price,quantity\nWID-1,Widget,9.99,1\n",
+			setup: func(s *mocks.ItemImportService) {
+				s.On("ImportItems", mock.Anything, mock.Anything, "").Return(nil,
+					fmt.Errorf("%w: line 2: item 'WID-1' has 4 reserved by open sales orders, cannot set quantity to 1", domain.ErrInsufficientStock))
+			},
+			wantStatus: http.StatusConflict, wantBody: "reserved by open sales orders",
+		},
+		{
+			name:       "missing required column",
+			target:     "/api/v1/items/import",
+			file:       "sku,name\nWID-1,Widget\n",
+			wantStatus: http.StatusBadRequest, wantBody: "missing column 'price'",
+		},
+		{
+			name:       "unknown column",
+			target:     "/api/v1/items/import",
+			file:       "sku,name,price,colour\nWID-1,Widget,1,red\n",
+			wantStatus: http.StatusBadRequest, wantBody: "unknown column 'colour'",
+		},
+		{
+			name:       "unknown mode",
+			target:     "/api/v1/items/import?mode=merge",
+			file:       "sku,name,price\nWID-1,Widget,1\n",
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewItemImportService(t)
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+			body, mediaType := csvUpload(t, tc.file)
+			rec := serve(t, handlerCase{method: http.MethodPost, target: tc.target, body: body, mediaType: mediaType},
+				handler.NewItemImportHandler(svc).ImportItems)
+
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tc.wantBody)
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		rec := serve(t, handlerCase{method: http.MethodPost, target: "/api/v1/items/import"},
+			handler.NewItemImportHandler(mocks.NewItemImportService(t)).ImportItems)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+	})
+}
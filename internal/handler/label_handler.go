@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// LabelHandler handles HTTP requests for the label print queue.
+type LabelHandler struct {
+	labelService domain.LabelService
+	validate     *validator.Validate
+}
+
+// NewLabelHandler creates a new LabelHandler.
+func NewLabelHandler(ls domain.LabelService) *LabelHandler {
+	return &LabelHandler{
+		labelService: ls,
+		validate:     newValidator(),
+	}
+}
+
+// EnqueueLabels godoc
+// @Summary Queue labels for printing
+// @Description Queues one print job per item at a station, e.g. for the items of a receipt. The station's agent
+// @Description is told over WebSocket (LABEL_JOBS_QUEUED) and can also poll the claim endpoint.
+// @Tags labels
+// @Accept json
+// @Produce json
+// @Param X-User-ID header string true "Calling user"
+// @Param labels body domain.EnqueueLabelsRequest true "Station and items"
+// @Success 201 {array} domain.LabelJob "Queued jobs"
+// @Failure 400 {object} httputil.HTTPError "Bad Request"
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 404 {object} httputil.HTTPError "Item not found"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /label-jobs [post]
+func (h *LabelHandler) EnqueueLabels(c echo.Context) error {
+	var req domain.EnqueueLabelsRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("EnqueueLabels: Bind error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("EnqueueLabels: Validation error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	jobs, err := h.labelService.EnqueueLabels(c.Request().Context(), &req, currentUserID(c))
+	if err != nil {
+		log.Printf("EnqueueLabels: Service error: %v", err)
+		return sendLabelError(c, err, "Failed to queue labels.")
+	}
+	return c.JSON(http.StatusCreated, jobs)
+}
+
+// ListLabelJobs godoc
+// @Summary List label jobs
+// @Description Retrieves the latest label jobs, newest first, to track their status
+// @Tags labels
+// @Produce json
+// @Param station query string false "Only jobs of this station"
+// @Param status query string false "Only jobs with this status (queued, printing, printed or failed)"
+// @Param limit query int false "Maximum number of jobs (default: 50, max: 200)"
+// @Success 200 {array} domain.LabelJob
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid query parameters)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /label-jobs [get]
+func (h *LabelHandler) ListLabelJobs(c echo.Context) error {
+	query := domain.ListLabelJobsQuery{Limit: 50} // Defaults
+	if httpErr := bindQuery(c, h.validate, &query); httpErr != nil {
+		log.Printf("ListLabelJobs: Invalid query parameters: %v", httpErr.Details)
+		return httputil.SendErrorResponse(c, httpErr)
+	}
+
+	jobs, err := h.labelService.ListJobs(c.Request().Context(), query)
+	if err != nil {
+		log.Printf("ListLabelJobs: Service error: %v", err)
+		return sendLabelError(c, err, "Failed to retrieve label jobs.")
+	}
+	return c.JSON(http.StatusOK, jobs)
+}
+
+// ClaimLabelJobs godoc
+// @Summary Claim label jobs of a station
+// @Description Used by a station's agent: marks the station's oldest queued jobs as printing and returns them,
+// @Description oldest first. Concurrent claims never return the same job. Jobs left printing for five minutes
+// @Description are handed out again. An empty array means there is nothing to print.
+// @Tags labels
+// @Produce json
+// @Param station query string true "Station"
+// @Param limit query int false "Maximum number of jobs (default: 10, max: 100)"
+// @Success 200 {array} domain.LabelJob "Claimed jobs"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid query parameters)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /label-jobs/claim [post]
+func (h *LabelHandler) ClaimLabelJobs(c echo.Context) error {
+	query := domain.ClaimLabelJobsQuery{Limit: 10} // Defaults
+	if httpErr := bindQuery(c, h.validate, &query); httpErr != nil {
+		log.Printf("ClaimLabelJobs: Invalid query parameters: %v", httpErr.Details)
+		return httputil.SendErrorResponse(c, httpErr)
+	}
+
+	jobs, err := h.labelService.ClaimJobs(c.Request().Context(), query)
+	if err != nil {
+		log.Printf("ClaimLabelJobs: Service error: %v", err)
+		return sendLabelError(c, err, "Failed to claim label jobs.")
+	}
+	return c.JSON(http.StatusOK, jobs)
+}
+
+// CompleteLabelJob godoc
+// @Summary Report a label job done
+// @Description Used by a station's agent to report a claimed job as printed or failed
+// @Tags labels
+// @Accept json
+// @Produce json
+// @Param id path string true "Label job ID (UUID)"
+// @Param result body domain.CompleteLabelJobRequest true "Outcome"
+// @Success 200 {object} domain.LabelJob "Completed job"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 409 {object} httputil.HTTPError "Conflict (job is not being printed)"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /label-jobs/{id}/complete [post]
+func (h *LabelHandler) CompleteLabelJob(c echo.Context) error {
+	id := c.Param("id")
+	var req domain.CompleteLabelJobRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("CompleteLabelJob: Bind error for ID %s: %v", id, err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("CompleteLabelJob: Validation error for ID %s: %v", id, err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	job, err := h.labelService.CompleteJob(c.Request().Context(), id, &req)
+	if err != nil {
+		log.Printf("CompleteLabelJob: Service error for ID %s: %v", id, err)
+		return sendLabelError(c, err, "Failed to complete label job.")
+	}
+	return c.JSON(http.StatusOK, job)
+}
+
+// sendLabelError maps label service errors to HTTP responses.
+func sendLabelError(c echo.Context, err error, fallback string) error {
+	switch {
+	case errors.Is(err, domain.ErrMissingUser):
+		return httputil.SendErrorResponse(c, httputil.UnauthorizedError("Missing "+HeaderUserID+" header."))
+	case errors.Is(err, domain.ErrInvalidInput):
+		return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+	case errors.Is(err, domain.ErrLabelJobNotFound), errors.Is(err, domain.ErrItemNotFound):
+		return httputil.SendErrorResponse(c, httputil.NotFoundError(err.Error()))
+	case errors.Is(err, domain.ErrLabelJobStatus):
+		return httputil.SendErrorResponse(c, httputil.ConflictError(err.Error()))
+	}
+	return httputil.SendErrorResponse(c, httputil.InternalServerError(fallback))
+}
@@ -0,0 +1,98 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/handler"
+	"inventory-system/internal/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const labelJobID = "5e6f7081-92a3-44b5-8c6d-7e8f90a1b2c3"
+
+func TestLabelHandler(t *testing.T) {
+	cases := []struct {
+		name       string
+		tc         handlerCase
+		route      func(h *handler.LabelHandler) echo.HandlerFunc
+		setup      func(s *mocks.LabelService)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:  "enqueue",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/label-jobs", user: "alice", body: `{"station":"dock-1","items":[{"item_id":"` + itemID + `","copies":3}]}`},
+			route: func(h *handler.LabelHandler) echo.HandlerFunc { return h.EnqueueLabels },
+			setup: func(s *mocks.LabelService) {
+				s.On("EnqueueLabels", mock.Anything, &domain.EnqueueLabelsRequest{Station: "dock-1",
+					Items: []domain.LabelRequestLine{{ItemID: itemID, Copies: 3}}}, "alice").
+					Return([]*domain.LabelJob{{ID: labelJobID, Station: "dock-1", ItemID: itemID, Copies: 3, Status: domain.LabelJobStatusQueued}}, nil)
+			},
+			wantStatus: http.StatusCreated, wantBody: `"status":"queued"`,
+		},
+		{
+			name:       "enqueue without copies",
+			tc:         handlerCase{method: http.MethodPost, target: "/api/v1/label-jobs", user: "alice", body: `{"station":"dock-1","items":[{"item_id":"` + itemID + `"}]}`},
+			route:      func(h *handler.LabelHandler) echo.HandlerFunc { return h.EnqueueLabels },
+			wantStatus: http.StatusUnprocessableEntity, wantBody: "Input validation failed",
+		},
+		{
+			name:  "enqueue unknown item",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/label-jobs", user: "alice", body: `{"station":"dock-1","items":[{"item_id":"` + itemID + `","copies":1}]}`},
+			route: func(h *handler.LabelHandler) echo.HandlerFunc { return h.EnqueueLabels },
+			setup: func(s *mocks.LabelService) {
+				s.On("EnqueueLabels", mock.Anything, mock.Anything, "alice").Return(nil, domain.ErrItemNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:  "claim with default limit",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/label-jobs/claim?station=dock-1"},
+			route: func(h *handler.LabelHandler) echo.HandlerFunc { return h.ClaimLabelJobs },
+			setup: func(s *mocks.LabelService) {
+				s.On("ClaimJobs", mock.Anything, domain.ClaimLabelJobsQuery{Station: "dock-1", Limit: 10}).Return([]*domain.LabelJob{}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `[]`,
+		},
+		{
+			name:       "claim without station",
+			tc:         handlerCase{method: http.MethodPost, target: "/api/v1/label-jobs/claim"},
+			route:      func(h *handler.LabelHandler) echo.HandlerFunc { return h.ClaimLabelJobs },
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "complete job not being printed",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/label-jobs/" + labelJobID + "/complete", id: labelJobID, body: `{"status":"printed"}`},
+			route: func(h *handler.LabelHandler) echo.HandlerFunc { return h.CompleteLabelJob },
+			setup: func(s *mocks.LabelService) {
+				s.On("CompleteJob", mock.Anything, labelJobID, &domain.CompleteLabelJobRequest{Status: "printed"}).
+					Return(nil, fmt.Errorf("%w: job '%s' is queued", domain.ErrLabelJobStatus, labelJobID))
+			},
+			wantStatus: http.StatusConflict, wantBody: "is queued",
+		},
+		{
+			name:       "complete with unknown status",
+			tc:         handlerCase{method: http.MethodPost, target: "/api/v1/label-jobs/" + labelJobID + "/complete", id: labelJobID, body: `{"status":"lost"}`},
+			route:      func(h *handler.LabelHandler) echo.HandlerFunc { return h.CompleteLabelJob },
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewLabelService(t)
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+			rec := serve(t, tc.tc, tc.route(handler.NewLabelHandler(svc)))
+
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tc.wantBody)
+		})
+	}
+}
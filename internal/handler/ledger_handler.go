@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// LedgerHandler handles HTTP requests for checking the movement ledger.
+type LedgerHandler struct {
+	ledgerService domain.LedgerService
+	validate      *validator.Validate
+}
+
+// NewLedgerHandler creates a new LedgerHandler.
+func NewLedgerHandler(ls domain.LedgerService) *LedgerHandler {
+	return &LedgerHandler{
+		ledgerService: ls,
+		validate:      newValidator(),
+	}
+}
+
+// ListLedgerDiscrepancies godoc
+// @Summary List ledger discrepancies
+// @Description Lists the items whose quantity was found to differ from the sum of their stock movements by the
+// @Description ledger check, most recently detected first. An open discrepancy carries the numbers of the last check
+// @Description that found it; a resolved one those it was resolved from.
+// @Tags ledger
+// @Produce json
+// @Param open query bool false "Only discrepancies not yet resolved"
+// @Param limit query int false "Maximum number of discrepancies (default: 50, max: 200)"
+// @Success 200 {array} domain.LedgerDiscrepancy
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid query parameters, listed in details)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /ledger/discrepancies [get]
+func (h *LedgerHandler) ListLedgerDiscrepancies(c echo.Context) error {
+	query := domain.ListLedgerDiscrepanciesQuery{Limit: 50} // Defaults
+	if httpErr := bindQuery(c, h.validate, &query); httpErr != nil {
+		log.Printf("ListLedgerDiscrepancies: Invalid query parameters: %v", httpErr.Details)
+		return httputil.SendErrorResponse(c, httpErr)
+	}
+
+	discrepancies, err := h.ledgerService.ListDiscrepancies(c.Request().Context(), query)
+	if err != nil {
+		log.Printf("ListLedgerDiscrepancies: Service error: %v", err)
+		return sendLedgerError(c, err, "Failed to retrieve ledger discrepancies.")
+	}
+	return c.JSON(http.StatusOK, discrepancies)
+}
+
+// ReconcileLedgerDiscrepancy godoc
+// @Summary Reconcile a ledger discrepancy
+// @Description Resolves an open discrepancy. keep=quantity trusts the item's quantity and records a "reconciliation"
+// @Description movement of the difference in its ledger; keep=ledger sets the quantity to the sum of the ledger. The
+// @Description difference is taken as it is now, not as it was when checked. Keeping a ledger below zero or below the
+// @Description units reserved by open sales orders is refused.
+// @Tags ledger
+// @Accept json
+// @Produce json
+// @Param X-User-ID header string true "Calling user"
+// @Param id path string true "Discrepancy ID (UUID)"
+// @Param reconciliation body domain.ReconcileLedgerRequest true "Side to keep and an optional note"
+// @Success 200 {object} domain.LedgerDiscrepancy "Resolved discrepancy"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format or payload)"
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 404 {object} httputil.HTTPError "Not Found (or the item is in the trash)"
+// @Failure 409 {object} httputil.HTTPError "Conflict (already resolved, or the ledger is below the reserved stock)"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /ledger/discrepancies/{id}/reconcile [post]
+func (h *LedgerHandler) ReconcileLedgerDiscrepancy(c echo.Context) error {
+	id := c.Param("id")
+	var req domain.ReconcileLedgerRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("ReconcileLedgerDiscrepancy: Bind error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("ReconcileLedgerDiscrepancy: Validation error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	d, err := h.ledgerService.Reconcile(c.Request().Context(), id, &req, currentUserID(c))
+	if err != nil {
+		log.Printf("ReconcileLedgerDiscrepancy: Service error for ID %s: %v", id, err)
+		return sendLedgerError(c, err, "Failed to reconcile ledger discrepancy.")
+	}
+	return c.JSON(http.StatusOK, d)
+}
+
+// CheckLedger godoc
+// @Summary Run the ledger check now
+// @Description Compares every item's quantity with the sum of its stock movements immediately instead of waiting for
+// @Description the next scheduled run. Discrepancies already open are updated but not notified again.
+// @Tags admin
+// @Produce json
+// @Success 200 {object} domain.LedgerCheckResult "Check result, with the newly found discrepancies"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /admin/ledger/check [post]
+func (h *LedgerHandler) CheckLedger(c echo.Context) error {
+	result, err := h.ledgerService.Check(c.Request().Context())
+	if err != nil {
+		log.Printf("CheckLedger: Service error: %v", err)
+		return sendLedgerError(c, err, "Failed to run the ledger check.")
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// sendLedgerError maps ledger service errors to HTTP responses.
+func sendLedgerError(c echo.Context, err error, fallback string) error {
+	switch {
+	case errors.Is(err, domain.ErrMissingUser):
+		return httputil.SendErrorResponse(c, httputil.UnauthorizedError("Missing "+HeaderUserID+" header."))
+	case errors.Is(err, domain.ErrInvalidInput):
+		return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+	case errors.Is(err, domain.ErrDiscrepancyNotFound), errors.Is(err, domain.ErrItemNotFound):
+		return httputil.SendErrorResponse(c, httputil.NotFoundError(err.Error()))
+	case errors.Is(err, domain.ErrDiscrepancyResolved), errors.Is(err, domain.ErrInsufficientStock):
+		return httputil.SendErrorResponse(c, httputil.ConflictError(err.Error()))
+	}
+	return httputil.SendErrorResponse(c, httputil.InternalServerError(fallback))
+}
@@ -0,0 +1,153 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/handler"
+	"inventory-system/internal/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const discrepancyID = "6a7b8c9d-0e1f-4a2b-8c3d-4e5f6a7b8c9d"
+
+func TestLedgerHandler_ListLedgerDiscrepancies(t *testing.T) {
+	cases := []struct {
+		name       string
+		target     string
+		setup      func(s *mocks.LedgerService)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:   "open only",
+			target: "/api/v1/ledger/discrepancies?open=true&limit=20",
+			setup: func(s *mocks.LedgerService) {
+				s.On("ListDiscrepancies", mock.Anything, domain.ListLedgerDiscrepanciesQuery{Open: true, Limit: 20}).
+					Return([]*domain.LedgerDiscrepancy{{ID: discrepancyID, ItemID: itemID, Quantity: 7, LedgerQuantity: 5, Difference: 2}}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"difference":2`,
+		},
+		{
+			name:       "limit too high",
+			target:     "/api/v1/ledger/discrepancies?limit=500",
+			wantStatus: http.StatusBadRequest, wantBody: "limit",
+		},
+		{
+			name:   "service error",
+			target: "/api/v1/ledger/discrepancies",
+			setup: func(s *mocks.LedgerService) {
+				s.On("ListDiscrepancies", mock.Anything, mock.Anything).Return(nil, errBoom)
+			},
+			wantStatus: http.StatusInternalServerError, wantBody: "Failed to retrieve ledger discrepancies.",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewLedgerService(t)
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+			rec := serve(t, handlerCase{method: http.MethodGet, target: tc.target}, handler.NewLedgerHandler(svc).ListLedgerDiscrepancies)
+
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tc.wantBody)
+		})
+	}
+}
+
+func TestLedgerHandler_ReconcileLedgerDiscrepancy(t *testing.T) {
+	keepQuantity := &domain.ReconcileLedgerRequest{Keep: domain.LedgerKeepQuantity, Note: "recount"}
+	cases := []struct {
+		name       string
+		body       string
+		user       string
+		setup      func(s *mocks.LedgerService)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "reconciled",
+			body: `{"keep":"quantity","note":"recount"}`,
+			user: "carol",
+			setup: func(s *mocks.LedgerService) {
+				resolution, movementID := domain.LedgerKeepQuantity, "m-1"
+				s.On("Reconcile", mock.Anything, discrepancyID, keepQuantity, "carol").
+					Return(&domain.LedgerDiscrepancy{ID: discrepancyID, Resolution: &resolution, MovementID: &movementID}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"movement_id":"m-1"`,
+		},
+		{
+			name:       "unknown side",
+			body:       `{"keep":"both"}`,
+			user:       "carol",
+			wantStatus: http.StatusUnprocessableEntity, wantBody: "Keep",
+		},
+		{
+			name: "missing user",
+			body: `{"keep":"quantity","note":"recount"}`,
+			setup: func(s *mocks.LedgerService) {
+				s.On("Reconcile", mock.Anything, discrepancyID, keepQuantity, "").Return(nil, domain.ErrMissingUser)
+			},
+			wantStatus: http.StatusUnauthorized, wantBody: handler.HeaderUserID,
+		},
+		{
+			name: "not found",
+			body: `{"keep":"quantity","note":"recount"}`,
+			user: "carol",
+			setup: func(s *mocks.LedgerService) {
+				s.On("Reconcile", mock.Anything, discrepancyID, keepQuantity, "carol").
+					Return(nil, fmt.Errorf("%w: ID %s", domain.ErrDiscrepancyNotFound, discrepancyID))
+			},
+			wantStatus: http.StatusNotFound, wantBody: "ledger discrepancy not found",
+		},
+		{
+			name: "already resolved",
+			body: `{"keep":"quantity","note":"recount"}`,
+			user: "carol",
+			setup: func(s *mocks.LedgerService) {
+				s.On("Reconcile", mock.Anything, discrepancyID, keepQuantity, "carol").Return(nil, domain.ErrDiscrepancyResolved)
+			},
+			wantStatus: http.StatusConflict, wantBody: "already resolved",
+		},
+		{
+			name: "ledger below reserved stock",
+			body: `{"keep":"ledger"}`,
+			user: "carol",
+			setup: func(s *mocks.LedgerService) {
+				s.On("Reconcile", mock.Anything, discrepancyID, &domain.ReconcileLedgerRequest{Keep: domain.LedgerKeepLedger}, "carol").
+					Return(nil, fmt.Errorf("%w: item 'i-1' has 5 reserved by open sales orders, cannot set quantity to its ledger of 2",
+						domain.ErrInsufficientStock))
+			},
+			wantStatus: http.StatusConflict, wantBody: "reserved by open sales orders",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewLedgerService(t)
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+			rec := serve(t, handlerCase{method: http.MethodPost, target: "/api/v1/ledger/discrepancies/" + discrepancyID + "/reconcile",
+				id: discrepancyID, body: tc.body, user: tc.user}, handler.NewLedgerHandler(svc).ReconcileLedgerDiscrepancy)
+
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tc.wantBody)
+		})
+	}
+}
+
+func TestLedgerHandler_CheckLedger(t *testing.T) {
+	svc := mocks.NewLedgerService(t)
+	svc.On("Check", mock.Anything).Return(&domain.LedgerCheckResult{Checked: 40, Discrepancies: 1,
+		New: []*domain.LedgerDiscrepancy{{ID: discrepancyID, ItemID: itemID}}}, nil)
+	rec := serve(t, handlerCase{method: http.MethodPost, target: "/admin/ledger/check"}, handler.NewLedgerHandler(svc).CheckLedger)
+
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Contains(t, rec.Body.String(), `"checked":40`)
+	assert.Contains(t, rec.Body.String(), discrepancyID)
+}
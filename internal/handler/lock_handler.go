@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+
+	"github.com/labstack/echo/v4"
+)
+
+// LockHandler handles HTTP requests for advisory edit locks.
+type LockHandler struct {
+	lockService domain.EditLockService
+}
+
+// NewLockHandler creates a new LockHandler.
+func NewLockHandler(ls domain.EditLockService) *LockHandler {
+	return &LockHandler{lockService: ls}
+}
+
+// AcquireItemLock godoc
+// @Summary Lock an item for editing
+// @Description Acquires (or extends) a short-lived advisory edit lock on an item for the calling user.
+// @Description Keep it alive by sending LOCK_HEARTBEAT messages over the WebSocket connection.
+// @Tags items
+// @Produce json
+// @Param id path string true "Item ID (UUID)"
+// @Param X-User-ID header string true "Calling user"
+// @Success 200 {object} domain.EditLock "Lock held by the caller"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 409 {object} httputil.HTTPError "Conflict (locked by another user; details hold the lock)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/{id}/lock [post]
+func (h *LockHandler) AcquireItemLock(c echo.Context) error {
+	id := c.Param("id")
+
+	lock, err := h.lockService.Acquire(c.Request().Context(), id, currentUserID(c))
+	if err != nil {
+		log.Printf("AcquireItemLock: Service error for ID %s: %v", id, err)
+		if errors.Is(err, domain.ErrItemLocked) {
+			return httputil.SendErrorResponse(c, httputil.ConflictError(err.Error()).WithDetails(lock))
+		}
+		return sendLockError(c, err, "Failed to lock item.")
+	}
+	return c.JSON(http.StatusOK, lock)
+}
+
+// ReleaseItemLock godoc
+// @Summary Release an item edit lock
+// @Tags items
+// @Param id path string true "Item ID (UUID)"
+// @Param X-User-ID header string true "Calling user"
+// @Success 204 "Lock released (No Content)"
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 409 {object} httputil.HTTPError "Conflict (caller does not hold the lock)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/{id}/lock [delete]
+func (h *LockHandler) ReleaseItemLock(c echo.Context) error {
+	id := c.Param("id")
+
+	if err := h.lockService.Release(c.Request().Context(), id, currentUserID(c)); err != nil {
+		log.Printf("ReleaseItemLock: Service error for ID %s: %v", id, err)
+		return sendLockError(c, err, "Failed to release lock.")
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// sendLockError maps edit lock service errors to HTTP responses.
+func sendLockError(c echo.Context, err error, fallback string) error {
+	switch {
+	case errors.Is(err, domain.ErrMissingUser):
+		return httputil.SendErrorResponse(c, httputil.UnauthorizedError("Missing "+HeaderUserID+" header."))
+	case errors.Is(err, domain.ErrInvalidItemID):
+		return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+	case errors.Is(err, domain.ErrItemNotFound):
+		return httputil.SendErrorResponse(c, httputil.NotFoundError(err.Error()))
+	case errors.Is(err, domain.ErrLockNotHeld):
+		return httputil.SendErrorResponse(c, httputil.ConflictError(err.Error()))
+	}
+	return httputil.SendErrorResponse(c, httputil.InternalServerError(fallback))
+}
@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"inventory-system/pkg/httputil"
+
+	"github.com/labstack/echo/v4"
+)
+
+// MIMEApplicationNDJSON is newline-delimited JSON: one document per line. Listings that
+// support it stream every row, unpaginated, when it is listed in the Accept header.
+const MIMEApplicationNDJSON = "application/x-ndjson"
+
+// ndjsonFlushEvery is how many lines are buffered before they are pushed to the client.
+const ndjsonFlushEvery = 100
+
+// AcceptsNDJSON reports whether the request asks for a streamed NDJSON response.
+func AcceptsNDJSON(c echo.Context) bool {
+	return strings.Contains(c.Request().Header.Get(echo.HeaderAccept), MIMEApplicationNDJSON)
+}
+
+// ndjsonWriter streams JSON documents to the response, one per line.
+type ndjsonWriter struct {
+	c     echo.Context
+	enc   *json.Encoder
+	lines int
+}
+
+// newNDJSONWriter sends the response headers; the status is 200 from here on.
+func newNDJSONWriter(c echo.Context) *ndjsonWriter {
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, MIMEApplicationNDJSON)
+	res.WriteHeader(http.StatusOK)
+	return &ndjsonWriter{c: c, enc: json.NewEncoder(res)}
+}
+
+// write sends v as the next line. An error means the client went away.
+func (w *ndjsonWriter) write(v any) error {
+	if err := w.enc.Encode(v); err != nil { // Encode terminates the line
+		return err
+	}
+	w.lines++
+	if w.lines%ndjsonFlushEvery == 0 {
+		w.c.Response().Flush()
+	}
+	return nil
+}
+
+// fail ends a stream that broke after the headers were sent. The last line is then
+// {"error": HTTPError} rather than a row, which is how clients tell a failed stream from
+// a complete one.
+func (w *ndjsonWriter) fail(httpErr *httputil.HTTPError) {
+	w.enc.Encode(struct {
+		Error *httputil.HTTPError `json:"error"`
+	}{httpErr})
+	w.c.Response().Flush()
+}
+
+// close pushes out any buffered lines.
+func (w *ndjsonWriter) close() {
+	w.c.Response().Flush()
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// NotificationHandler handles HTTP requests for the calling user's notification inbox.
+type NotificationHandler struct {
+	notificationService domain.NotificationService
+	validate            *validator.Validate
+}
+
+// NewNotificationHandler creates a new NotificationHandler.
+func NewNotificationHandler(ns domain.NotificationService) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: ns,
+		validate:            newValidator(),
+	}
+}
+
+// ListMyNotifications godoc
+// @Summary List my notifications
+// @Description Retrieves the calling user's notifications, newest first, with the unread count
+// @Tags notifications
+// @Produce json
+// @Param X-User-ID header string true "Calling user"
+// @Param unread query bool false "Only return unread notifications"
+// @Param limit query int false "Maximum number of notifications (default: 50, max: 200)"
+// @Success 200 {object} map[string]interface{} "notifications":[]domain.Notification, "unread_count":int
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid query parameters, listed in details)"
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /me/notifications [get]
+func (h *NotificationHandler) ListMyNotifications(c echo.Context) error {
+	query := domain.ListNotificationsQuery{Limit: 50} // Defaults
+	if httpErr := bindQuery(c, h.validate, &query); httpErr != nil {
+		log.Printf("ListMyNotifications: Invalid query parameters: %v", httpErr.Details)
+		return httputil.SendErrorResponse(c, httpErr)
+	}
+
+	notifications, unread, err := h.notificationService.ListNotifications(c.Request().Context(), currentUserID(c), query.Unread, query.Limit)
+	if err != nil {
+		log.Printf("ListMyNotifications: Service error: %v", err)
+		return sendNotificationError(c, err, "Failed to retrieve notifications.")
+	}
+
+	response := struct {
+		Notifications []*domain.Notification `json:"notifications"`
+		UnreadCount   int                    `json:"unread_count"`
+	}{
+		Notifications: notifications,
+		UnreadCount:   unread,
+	}
+	return c.JSON(http.StatusOK, response)
+}
+
+// MarkNotificationRead godoc
+// @Summary Mark a notification as read
+// @Tags notifications
+// @Produce json
+// @Param X-User-ID header string true "Calling user"
+// @Param id path string true "Notification ID (UUID)"
+// @Success 200 {object} domain.Notification "Updated notification"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /me/notifications/{id}/read [post]
+func (h *NotificationHandler) MarkNotificationRead(c echo.Context) error {
+	id := c.Param("id")
+
+	n, err := h.notificationService.MarkRead(c.Request().Context(), currentUserID(c), id)
+	if err != nil {
+		log.Printf("MarkNotificationRead: Service error for ID %s: %v", id, err)
+		return sendNotificationError(c, err, "Failed to update notification.")
+	}
+	return c.JSON(http.StatusOK, n)
+}
+
+// MarkAllNotificationsRead godoc
+// @Summary Mark all my notifications as read
+// @Tags notifications
+// @Produce json
+// @Param X-User-ID header string true "Calling user"
+// @Success 200 {object} map[string]int "marked": 3
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /me/notifications/read-all [post]
+func (h *NotificationHandler) MarkAllNotificationsRead(c echo.Context) error {
+	count, err := h.notificationService.MarkAllRead(c.Request().Context(), currentUserID(c))
+	if err != nil {
+		log.Printf("MarkAllNotificationsRead: Service error: %v", err)
+		return sendNotificationError(c, err, "Failed to update notifications.")
+	}
+	return c.JSON(http.StatusOK, echo.Map{"marked": count})
+}
+
+// sendNotificationError maps notification service errors to HTTP responses.
+func sendNotificationError(c echo.Context, err error, fallback string) error {
+	switch {
+	case errors.Is(err, domain.ErrMissingUser):
+		return httputil.SendErrorResponse(c, httputil.UnauthorizedError("Missing "+HeaderUserID+" header."))
+	case errors.Is(err, domain.ErrInvalidInput):
+		return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+	case errors.Is(err, domain.ErrNotificationNotFound):
+		return httputil.SendErrorResponse(c, httputil.NotFoundError(err.Error()))
+	}
+	return httputil.SendErrorResponse(c, httputil.InternalServerError(fallback))
+}
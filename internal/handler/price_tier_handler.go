@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// PriceTierHandler handles HTTP requests for tier price rules and customer price resolution.
+type PriceTierHandler struct {
+	priceTierService domain.PriceTierService
+	validate         *validator.Validate
+}
+
+// NewPriceTierHandler creates a new PriceTierHandler.
+func NewPriceTierHandler(ps domain.PriceTierService) *PriceTierHandler {
+	return &PriceTierHandler{
+		priceTierService: ps,
+		validate:         newValidator(),
+	}
+}
+
+// ListItemTierPrices godoc
+// @Summary List the tier prices of an item
+// @Description Lists the price rules of an item per tier. Tiers without a rule pay the item's price.
+// @Tags items
+// @Produce json
+// @Param id path string true "Item ID (UUID)"
+// @Success 200 {array} domain.TierPrice
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/{id}/tier-prices [get]
+func (h *PriceTierHandler) ListItemTierPrices(c echo.Context) error {
+	id := c.Param("id")
+
+	prices, err := h.priceTierService.ListTierPrices(c.Request().Context(), id)
+	if err != nil {
+		log.Printf("ListItemTierPrices: Service error for ID %s: %v", id, err)
+		return sendPriceTierError(c, err, "Failed to retrieve tier prices.")
+	}
+	return c.JSON(http.StatusOK, prices)
+}
+
+// SetItemTierPrice godoc
+// @Summary Set the tier price of an item
+// @Description Sets the price rule of an item for a tier (retail, wholesale or contract): either a fixed price
+// @Description or a discount off the item's price, replacing any previous rule
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param id path string true "Item ID (UUID)"
+// @Param tier path string true "Price tier"
+// @Param rule body domain.SetTierPriceRequest true "Price or discount"
+// @Success 200 {object} domain.TierPrice
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID or tier, or not exactly one of price and discount)"
+// @Failure 404 {object} httputil.HTTPError "Item not found"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/{id}/tier-prices/{tier} [put]
+func (h *PriceTierHandler) SetItemTierPrice(c echo.Context) error {
+	id, tier := c.Param("id"), c.Param("tier")
+
+	var req domain.SetTierPriceRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("SetItemTierPrice: Bind error for ID %s: %v", id, err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("SetItemTierPrice: Validation error for ID %s: %v", id, err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	price, err := h.priceTierService.SetTierPrice(c.Request().Context(), id, tier, &req)
+	if err != nil {
+		log.Printf("SetItemTierPrice: Service error for ID %s: %v", id, err)
+		return sendPriceTierError(c, err, "Failed to set tier price.")
+	}
+	return c.JSON(http.StatusOK, price)
+}
+
+// DeleteItemTierPrice godoc
+// @Summary Delete the tier price of an item
+// @Description Removes the price rule of an item for a tier; the tier then pays the item's price
+// @Tags items
+// @Param id path string true "Item ID (UUID)"
+// @Param tier path string true "Price tier"
+// @Success 204 "Successfully deleted (No Content)"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID or tier)"
+// @Failure 404 {object} httputil.HTTPError "The item has no rule for this tier"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/{id}/tier-prices/{tier} [delete]
+func (h *PriceTierHandler) DeleteItemTierPrice(c echo.Context) error {
+	id, tier := c.Param("id"), c.Param("tier")
+
+	if err := h.priceTierService.DeleteTierPrice(c.Request().Context(), id, tier); err != nil {
+		log.Printf("DeleteItemTierPrice: Service error for ID %s: %v", id, err)
+		return sendPriceTierError(c, err, "Failed to delete tier price.")
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ResolveItemPrice godoc
+// @Summary Get the price of an item for a customer
+// @Description Resolves what a customer pays for one unit of an item, from the rule of the customer's price tier.
+// @Description Without a customer, the retail price is resolved.
+// @Tags items
+// @Produce json
+// @Param id path string true "Item ID (UUID)"
+// @Param customer query string false "Customer ID (UUID)"
+// @Success 200 {object} domain.ResolvedPrice
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 404 {object} httputil.HTTPError "Item or customer not found"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/{id}/price [get]
+func (h *PriceTierHandler) ResolveItemPrice(c echo.Context) error {
+	id := c.Param("id")
+
+	var query domain.ResolvePriceQuery
+	if httpErr := bindQuery(c, h.validate, &query); httpErr != nil {
+		log.Printf("ResolveItemPrice: Invalid query parameters: %v", httpErr.Details)
+		return httputil.SendErrorResponse(c, httpErr)
+	}
+
+	price, err := h.priceTierService.ResolvePrice(c.Request().Context(), id, query.Customer)
+	if err != nil {
+		log.Printf("ResolveItemPrice: Service error for ID %s: %v", id, err)
+		return sendPriceTierError(c, err, "Failed to resolve price.")
+	}
+	return c.JSON(http.StatusOK, price)
+}
+
+// sendPriceTierError maps price tier service errors to HTTP responses.
+func sendPriceTierError(c echo.Context, err error, fallback string) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidInput), errors.Is(err, domain.ErrInvalidItemID):
+		return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+	case errors.Is(err, domain.ErrItemNotFound), errors.Is(err, domain.ErrCustomerNotFound), errors.Is(err, domain.ErrTierPriceNotFound):
+		return httputil.SendErrorResponse(c, httputil.NotFoundError(err.Error()))
+	}
+	return httputil.SendErrorResponse(c, httputil.InternalServerError(fallback))
+}
@@ -0,0 +1,91 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/handler"
+	"inventory-system/internal/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPriceTierHandler(t *testing.T) {
+	discount := 15.0
+	cases := []struct {
+		name       string
+		tc         handlerCase
+		route      func(h *handler.PriceTierHandler) echo.HandlerFunc
+		setup      func(s *mocks.PriceTierService)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:  "set wholesale discount",
+			tc:    handlerCase{method: http.MethodPut, target: "/api/v1/items/" + itemID + "/tier-prices/wholesale", id: itemID, params: map[string]string{"tier": "wholesale"}, body: `{"discount_percent":15}`},
+			route: func(h *handler.PriceTierHandler) echo.HandlerFunc { return h.SetItemTierPrice },
+			setup: func(s *mocks.PriceTierService) {
+				s.On("SetTierPrice", mock.Anything, itemID, "wholesale", &domain.SetTierPriceRequest{DiscountPercent: &discount}).
+					Return(&domain.TierPrice{ItemID: itemID, Tier: "wholesale", DiscountPercent: &discount}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"discount_percent":15`,
+		},
+		{
+			name:       "set discount over 100 percent",
+			tc:         handlerCase{method: http.MethodPut, target: "/api/v1/items/" + itemID + "/tier-prices/wholesale", id: itemID, params: map[string]string{"tier": "wholesale"}, body: `{"discount_percent":120}`},
+			route:      func(h *handler.PriceTierHandler) echo.HandlerFunc { return h.SetItemTierPrice },
+			wantStatus: http.StatusUnprocessableEntity, wantBody: "Input validation failed",
+		},
+		{
+			name:  "set unknown tier",
+			tc:    handlerCase{method: http.MethodPut, target: "/api/v1/items/" + itemID + "/tier-prices/vip", id: itemID, params: map[string]string{"tier": "vip"}, body: `{"price":9.5}`},
+			route: func(h *handler.PriceTierHandler) echo.HandlerFunc { return h.SetItemTierPrice },
+			setup: func(s *mocks.PriceTierService) {
+				s.On("SetTierPrice", mock.Anything, itemID, "vip", mock.Anything).
+					Return(nil, fmt.Errorf("%w: unknown price tier 'vip'", domain.ErrInvalidInput))
+			},
+			wantStatus: http.StatusBadRequest, wantBody: "unknown price tier",
+		},
+		{
+			name:  "delete missing rule",
+			tc:    handlerCase{method: http.MethodDelete, target: "/api/v1/items/" + itemID + "/tier-prices/contract", id: itemID, params: map[string]string{"tier": "contract"}},
+			route: func(h *handler.PriceTierHandler) echo.HandlerFunc { return h.DeleteItemTierPrice },
+			setup: func(s *mocks.PriceTierService) {
+				s.On("DeleteTierPrice", mock.Anything, itemID, "contract").Return(domain.ErrTierPriceNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:  "resolve for customer",
+			tc:    handlerCase{method: http.MethodGet, target: "/api/v1/items/" + itemID + "/price?customer=" + customerID, id: itemID},
+			route: func(h *handler.PriceTierHandler) echo.HandlerFunc { return h.ResolveItemPrice },
+			setup: func(s *mocks.PriceTierService) {
+				s.On("ResolvePrice", mock.Anything, itemID, customerID).Return(&domain.ResolvedPrice{ItemID: itemID, CustomerID: customerID,
+					Tier: "wholesale", BasePrice: 10, Price: 8.5, Source: domain.PriceSourceTierDiscount}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"source":"tier_discount"`,
+		},
+		{
+			name:       "resolve for malformed customer",
+			tc:         handlerCase{method: http.MethodGet, target: "/api/v1/items/" + itemID + "/price?customer=acme", id: itemID},
+			route:      func(h *handler.PriceTierHandler) echo.HandlerFunc { return h.ResolveItemPrice },
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewPriceTierService(t)
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+			rec := serve(t, tc.tc, tc.route(handler.NewPriceTierHandler(svc)))
+
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tc.wantBody)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// PricingHandler handles HTTP requests for price management.
+type PricingHandler struct {
+	pricingService domain.PricingService
+	validate       *validator.Validate
+}
+
+// NewPricingHandler creates a new PricingHandler.
+func NewPricingHandler(ps domain.PricingService) *PricingHandler {
+	return &PricingHandler{
+		pricingService: ps,
+		validate:       newValidator(),
+	}
+}
+
+// BulkPriceUpdate godoc
+// @Summary Bulk update item prices
+// @Description Reprices every item matching the filter in one transaction: set a price, raise or lower by a percentage, or round to .99.
+// @Description Every change is recorded in the price history. With dry_run the changes are returned but not saved.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param X-User-ID header string true "Calling user"
+// @Param update body domain.BulkPriceUpdateRequest true "Filter and operation"
+// @Success 200 {object} domain.BulkPriceUpdateResult "Changes applied (or previewed)"
+// @Failure 400 {object} httputil.HTTPError "Bad Request"
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/bulk-price-update [post]
+func (h *PricingHandler) BulkPriceUpdate(c echo.Context) error {
+	var req domain.BulkPriceUpdateRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("BulkPriceUpdate: Bind error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("BulkPriceUpdate: Validation error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	result, err := h.pricingService.BulkUpdatePrices(c.Request().Context(), &req, currentUserID(c))
+	if err != nil {
+		log.Printf("BulkPriceUpdate: Service error: %v", err)
+		return sendPricingError(c, err, "Failed to update prices.")
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// GetPriceHistory godoc
+// @Summary Get the price history of an item
+// @Description Lists every recorded price change of an item, newest first
+// @Tags items
+// @Produce json
+// @Param id path string true "Item ID (UUID)"
+// @Success 200 {array} domain.PriceHistoryEntry "Price changes"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 404 {object} httputil.HTTPError "Item not found"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/{id}/price-history [get]
+func (h *PricingHandler) GetPriceHistory(c echo.Context) error {
+	id := c.Param("id")
+
+	history, err := h.pricingService.GetPriceHistory(c.Request().Context(), id)
+	if err != nil {
+		log.Printf("GetPriceHistory: Service error for ID %s: %v", id, err)
+		return sendPricingError(c, err, "Failed to retrieve price history.")
+	}
+	return c.JSON(http.StatusOK, history)
+}
+
+// sendPricingError maps pricing service errors to HTTP responses.
+func sendPricingError(c echo.Context, err error, fallback string) error {
+	switch {
+	case errors.Is(err, domain.ErrMissingUser):
+		return httputil.SendErrorResponse(c, httputil.UnauthorizedError("Missing "+HeaderUserID+" header."))
+	case errors.Is(err, domain.ErrInvalidItemID), errors.Is(err, domain.ErrInvalidInput):
+		return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+	case errors.Is(err, domain.ErrItemNotFound):
+		return httputil.SendErrorResponse(c, httputil.NotFoundError(err.Error()))
+	}
+	return httputil.SendErrorResponse(c, httputil.InternalServerError(fallback))
+}
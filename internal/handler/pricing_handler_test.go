@@ -0,0 +1,76 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/handler"
+	"inventory-system/internal/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPricingHandler_BulkPriceUpdate(t *testing.T) {
+	cases := []struct {
+		name       string
+		body       string
+		setup      func(s *mocks.PricingService)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "dry run",
+			body: `{"filter":{"sku_prefix":"WID"},"operation":"increase_percent","value":10,"dry_run":true}`,
+			setup: func(s *mocks.PricingService) {
+				s.On("BulkUpdatePrices", mock.Anything, mock.MatchedBy(func(r *domain.BulkPriceUpdateRequest) bool {
+					return r.DryRun && r.Operation == domain.PriceOpIncreasePercent && r.Value == 10 && r.Filter.SKUPrefix == "WID"
+				}), "alice").Return(&domain.BulkPriceUpdateResult{DryRun: true, Matched: 1,
+					Changes: []domain.PriceChange{{ItemID: itemID, OldPrice: 10, NewPrice: 11}}}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"new_price":11`,
+		},
+		{
+			name:       "unknown operation",
+			body:       `{"filter":{"sku_prefix":"WID"},"operation":"double"}`,
+			wantStatus: http.StatusUnprocessableEntity, wantBody: "Input validation failed",
+		},
+		{
+			name:       "invalid item ID in filter",
+			body:       `{"filter":{"item_ids":["nope"]},"operation":"round_99"}`,
+			wantStatus: http.StatusUnprocessableEntity, wantBody: "Input validation failed",
+		},
+		{
+			name: "empty filter",
+			body: `{"operation":"round_99"}`,
+			setup: func(s *mocks.PricingService) {
+				s.On("BulkUpdatePrices", mock.Anything, mock.Anything, "alice").
+					Return(nil, fmt.Errorf("%w: filter must select items", domain.ErrInvalidInput))
+			},
+			wantStatus: http.StatusBadRequest, wantBody: "filter must select items",
+		},
+		{
+			name: "service failure",
+			body: `{"filter":{"sku_prefix":"WID"},"operation":"set","value":5}`,
+			setup: func(s *mocks.PricingService) {
+				s.On("BulkUpdatePrices", mock.Anything, mock.Anything, "alice").Return(nil, errBoom)
+			},
+			wantStatus: http.StatusInternalServerError, wantBody: "Failed to update prices.",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewPricingService(t)
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+			h := handler.NewPricingHandler(svc)
+			rec := serve(t, handlerCase{method: http.MethodPost, target: "/items/bulk-price-update", body: tc.body, user: "alice"}, h.BulkPriceUpdate)
+
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tc.wantBody)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// PromotionHandler handles HTTP requests for scheduled promotions.
+type PromotionHandler struct {
+	promotionService domain.PromotionService
+	validate         *validator.Validate
+}
+
+// NewPromotionHandler creates a new PromotionHandler.
+func NewPromotionHandler(ps domain.PromotionService) *PromotionHandler {
+	return &PromotionHandler{
+		promotionService: ps,
+		validate:         newValidator(),
+	}
+}
+
+// CreatePromotion godoc
+// @Summary Schedule a promotion
+// @Description Sets temporary prices for a set of items between starts_at and ends_at. Item reads report the
+// @Description promotional price while the promotion runs; regular prices apply again automatically afterwards.
+// @Tags promotions
+// @Accept json
+// @Produce json
+// @Param X-User-ID header string true "Calling user"
+// @Param promotion body domain.CreatePromotionRequest true "Promotion to schedule"
+// @Success 201 {object} domain.Promotion "Successfully scheduled promotion"
+// @Failure 400 {object} httputil.HTTPError "Bad Request"
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 404 {object} httputil.HTTPError "Item not found"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /promotions [post]
+func (h *PromotionHandler) CreatePromotion(c echo.Context) error {
+	var req domain.CreatePromotionRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("CreatePromotion: Bind error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("CreatePromotion: Validation error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	promotion, err := h.promotionService.CreatePromotion(c.Request().Context(), &req, currentUserID(c))
+	if err != nil {
+		log.Printf("CreatePromotion: Service error: %v", err)
+		return sendPromotionError(c, err, "Failed to create promotion.")
+	}
+	return c.JSON(http.StatusCreated, promotion)
+}
+
+// ListPromotions godoc
+// @Summary List promotions
+// @Description Retrieves all promotions, past, running and scheduled, latest start first
+// @Tags promotions
+// @Produce json
+// @Success 200 {array} domain.Promotion "List of promotions"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /promotions [get]
+func (h *PromotionHandler) ListPromotions(c echo.Context) error {
+	promotions, err := h.promotionService.ListPromotions(c.Request().Context())
+	if err != nil {
+		log.Printf("ListPromotions: Service error: %v", err)
+		return sendPromotionError(c, err, "Failed to retrieve promotions.")
+	}
+	return c.JSON(http.StatusOK, promotions)
+}
+
+// GetPromotion godoc
+// @Summary Get a promotion by ID
+// @Description Retrieves a promotion with its item prices
+// @Tags promotions
+// @Produce json
+// @Param id path string true "Promotion ID (UUID)"
+// @Success 200 {object} domain.Promotion "Promotion"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /promotions/{id} [get]
+func (h *PromotionHandler) GetPromotion(c echo.Context) error {
+	id := c.Param("id")
+
+	promotion, err := h.promotionService.GetPromotion(c.Request().Context(), id)
+	if err != nil {
+		log.Printf("GetPromotion: Service error for ID %s: %v", id, err)
+		return sendPromotionError(c, err, "Failed to retrieve promotion.")
+	}
+	return c.JSON(http.StatusOK, promotion)
+}
+
+// DeletePromotion godoc
+// @Summary Cancel a promotion
+// @Description Deletes a promotion; its items return to their regular price immediately
+// @Tags promotions
+// @Param id path string true "Promotion ID (UUID)"
+// @Success 204 "Successfully cancelled promotion (No Content)"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /promotions/{id} [delete]
+func (h *PromotionHandler) DeletePromotion(c echo.Context) error {
+	id := c.Param("id")
+
+	if err := h.promotionService.DeletePromotion(c.Request().Context(), id); err != nil {
+		log.Printf("DeletePromotion: Service error for ID %s: %v", id, err)
+		return sendPromotionError(c, err, "Failed to delete promotion.")
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// sendPromotionError maps promotion service errors to HTTP responses.
+func sendPromotionError(c echo.Context, err error, fallback string) error {
+	switch {
+	case errors.Is(err, domain.ErrMissingUser):
+		return httputil.SendErrorResponse(c, httputil.UnauthorizedError("Missing "+HeaderUserID+" header."))
+	case errors.Is(err, domain.ErrInvalidInput):
+		return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+	case errors.Is(err, domain.ErrItemNotFound), errors.Is(err, domain.ErrPromotionNotFound):
+		return httputil.SendErrorResponse(c, httputil.NotFoundError(err.Error()))
+	}
+	return httputil.SendErrorResponse(c, httputil.InternalServerError(fallback))
+}
@@ -0,0 +1,314 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// PurchasingHandler handles HTTP requests for suppliers and purchase orders.
+type PurchasingHandler struct {
+	purchasingService domain.PurchasingService
+	validate          *validator.Validate
+}
+
+// NewPurchasingHandler creates a new PurchasingHandler.
+func NewPurchasingHandler(ps domain.PurchasingService) *PurchasingHandler {
+	return &PurchasingHandler{
+		purchasingService: ps,
+		validate:          newValidator(),
+	}
+}
+
+// CreateSupplier godoc
+// @Summary Create a supplier
+// @Description Creates a supplier. Supplier names must be unique.
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Param supplier body domain.CreateSupplierRequest true "Supplier to create"
+// @Success 201 {object} domain.Supplier "Successfully created supplier"
+// @Failure 400 {object} httputil.HTTPError "Bad Request"
+// @Failure 409 {object} httputil.HTTPError "Conflict (name in use)"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /suppliers [post]
+func (h *PurchasingHandler) CreateSupplier(c echo.Context) error {
+	var req domain.CreateSupplierRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("CreateSupplier: Bind error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("CreateSupplier: Validation error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	supplier, err := h.purchasingService.CreateSupplier(c.Request().Context(), &req)
+	if err != nil {
+		log.Printf("CreateSupplier: Service error: %v", err)
+		return sendPurchasingError(c, err, "Failed to create supplier.")
+	}
+	return c.JSON(http.StatusCreated, supplier)
+}
+
+// ListSuppliers godoc
+// @Summary List suppliers
+// @Description Retrieves every supplier, ordered by name
+// @Tags purchasing
+// @Produce json
+// @Success 200 {array} domain.Supplier "List of suppliers"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /suppliers [get]
+func (h *PurchasingHandler) ListSuppliers(c echo.Context) error {
+	suppliers, err := h.purchasingService.ListSuppliers(c.Request().Context())
+	if err != nil {
+		log.Printf("ListSuppliers: Service error: %v", err)
+		return sendPurchasingError(c, err, "Failed to retrieve suppliers.")
+	}
+	return c.JSON(http.StatusOK, suppliers)
+}
+
+// GetSupplier godoc
+// @Summary Get a supplier by ID
+// @Description Retrieves a single supplier
+// @Tags purchasing
+// @Produce json
+// @Param id path string true "Supplier ID (UUID)"
+// @Success 200 {object} domain.Supplier "Supplier"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /suppliers/{id} [get]
+func (h *PurchasingHandler) GetSupplier(c echo.Context) error {
+	id := c.Param("id")
+
+	supplier, err := h.purchasingService.GetSupplier(c.Request().Context(), id)
+	if err != nil {
+		log.Printf("GetSupplier: Service error for ID %s: %v", id, err)
+		return sendPurchasingError(c, err, "Failed to retrieve supplier.")
+	}
+	return c.JSON(http.StatusOK, supplier)
+}
+
+// CreatePurchaseOrder godoc
+// @Summary Draft a purchase order
+// @Description Creates a draft purchase order for a supplier. Each item may appear on one line only.
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Param X-User-ID header string true "Calling user"
+// @Param order body domain.CreatePurchaseOrderRequest true "Supplier and lines"
+// @Success 201 {object} domain.PurchaseOrder "Successfully created order"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (e.g. an item listed twice)"
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 404 {object} httputil.HTTPError "Supplier or item not found"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /purchase-orders [post]
+func (h *PurchasingHandler) CreatePurchaseOrder(c echo.Context) error {
+	var req domain.CreatePurchaseOrderRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("CreatePurchaseOrder: Bind error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("CreatePurchaseOrder: Validation error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	order, err := h.purchasingService.CreateOrder(c.Request().Context(), &req, currentUserID(c))
+	if err != nil {
+		log.Printf("CreatePurchaseOrder: Service error: %v", err)
+		return sendPurchasingError(c, err, "Failed to create purchase order.")
+	}
+	return c.JSON(http.StatusCreated, order)
+}
+
+// ListPurchaseOrders godoc
+// @Summary List purchase orders
+// @Description Retrieves the latest purchase orders, newest first
+// @Tags purchasing
+// @Produce json
+// @Param status query string false "Only orders with this status"
+// @Param supplier query string false "Only orders from this supplier (UUID)"
+// @Param limit query int false "Maximum number of orders (default: 50, max: 200)"
+// @Success 200 {array} domain.PurchaseOrder
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid query parameters)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /purchase-orders [get]
+func (h *PurchasingHandler) ListPurchaseOrders(c echo.Context) error {
+	query := domain.ListPurchaseOrdersQuery{Limit: 50} // Defaults
+	if httpErr := bindQuery(c, h.validate, &query); httpErr != nil {
+		log.Printf("ListPurchaseOrders: Invalid query parameters: %v", httpErr.Details)
+		return httputil.SendErrorResponse(c, httpErr)
+	}
+
+	orders, err := h.purchasingService.ListOrders(c.Request().Context(), query)
+	if err != nil {
+		log.Printf("ListPurchaseOrders: Service error: %v", err)
+		return sendPurchasingError(c, err, "Failed to retrieve purchase orders.")
+	}
+	return c.JSON(http.StatusOK, orders)
+}
+
+// GetPurchaseOrder godoc
+// @Summary Get a purchase order by ID
+// @Description Retrieves a purchase order with its lines and the quantities received so far
+// @Tags purchasing
+// @Produce json
+// @Param id path string true "Purchase order ID (UUID)"
+// @Success 200 {object} domain.PurchaseOrder
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /purchase-orders/{id} [get]
+func (h *PurchasingHandler) GetPurchaseOrder(c echo.Context) error {
+	id := c.Param("id")
+
+	order, err := h.purchasingService.GetOrder(c.Request().Context(), id)
+	if err != nil {
+		log.Printf("GetPurchaseOrder: Service error for ID %s: %v", id, err)
+		return sendPurchasingError(c, err, "Failed to retrieve purchase order.")
+	}
+	return c.JSON(http.StatusOK, order)
+}
+
+// SubmitPurchaseOrder godoc
+// @Summary Submit a purchase order
+// @Description Marks a draft as sent to the supplier, after which deliveries can be received against it
+// @Tags purchasing
+// @Produce json
+// @Param X-User-ID header string false "Calling user, recorded in the order history"
+// @Param X-User-Roles header string false "Comma-separated roles of the calling user"
+// @Param id path string true "Purchase order ID (UUID)"
+// @Success 200 {object} domain.PurchaseOrder "Submitted order"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 403 {object} httputil.HTTPError "Forbidden (the workflow requires a role the user lacks)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 409 {object} httputil.HTTPError "Conflict (the order is not a draft)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /purchase-orders/{id}/submit [post]
+func (h *PurchasingHandler) SubmitPurchaseOrder(c echo.Context) error {
+	id := c.Param("id")
+
+	order, err := h.purchasingService.SubmitOrder(c.Request().Context(), id, currentUserID(c), currentUserRoles(c))
+	if err != nil {
+		log.Printf("SubmitPurchaseOrder: Service error for ID %s: %v", id, err)
+		return sendPurchasingError(c, err, "Failed to submit purchase order.")
+	}
+	return c.JSON(http.StatusOK, order)
+}
+
+// ReceivePurchaseOrder godoc
+// @Summary Receive a delivery against a purchase order
+// @Description Adds the delivered quantities to stock and to the order, in one transaction. Each change is written
+// @Description to the movement ledger with reason receive and the order ID as reference, and the new quantities are
+// @Description broadcast over WebSocket. The order becomes received once every line has fully arrived.
+// @Tags purchasing
+// @Accept json
+// @Produce json
+// @Param X-User-ID header string true "Calling user"
+// @Param X-User-Roles header string false "Comma-separated roles of the calling user"
+// @Param id path string true "Purchase order ID (UUID)"
+// @Param receipt body domain.ReceivePurchaseOrderRequest true "Delivered quantities"
+// @Success 200 {object} domain.ReceivePurchaseOrderResult "Order after the delivery, changed items and their ledger rows"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID or payload)"
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 403 {object} httputil.HTTPError "Forbidden (the workflow requires a role the user lacks)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 409 {object} httputil.HTTPError "Conflict (order not submitted, or more than outstanding received)"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /purchase-orders/{id}/receive [post]
+func (h *PurchasingHandler) ReceivePurchaseOrder(c echo.Context) error {
+	id := c.Param("id")
+
+	var req domain.ReceivePurchaseOrderRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("ReceivePurchaseOrder: Bind error for ID %s: %v", id, err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("ReceivePurchaseOrder: Validation error for ID %s: %v", id, err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	result, err := h.purchasingService.ReceiveOrder(c.Request().Context(), id, &req, currentUserID(c), currentUserRoles(c))
+	if err != nil {
+		log.Printf("ReceivePurchaseOrder: Service error for ID %s: %v", id, err)
+		return sendPurchasingError(c, err, "Failed to receive purchase order.")
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// CancelPurchaseOrder godoc
+// @Summary Cancel a purchase order
+// @Description Cancels an order that has not been fully received. Stock already received stays.
+// @Tags purchasing
+// @Produce json
+// @Param X-User-ID header string false "Calling user, recorded in the order history"
+// @Param X-User-Roles header string false "Comma-separated roles of the calling user"
+// @Param id path string true "Purchase order ID (UUID)"
+// @Success 200 {object} domain.PurchaseOrder "Cancelled order"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 403 {object} httputil.HTTPError "Forbidden (the workflow requires a role the user lacks)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 409 {object} httputil.HTTPError "Conflict (already received or cancelled)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /purchase-orders/{id}/cancel [post]
+func (h *PurchasingHandler) CancelPurchaseOrder(c echo.Context) error {
+	id := c.Param("id")
+
+	order, err := h.purchasingService.CancelOrder(c.Request().Context(), id, currentUserID(c), currentUserRoles(c))
+	if err != nil {
+		log.Printf("CancelPurchaseOrder: Service error for ID %s: %v", id, err)
+		return sendPurchasingError(c, err, "Failed to cancel purchase order.")
+	}
+	return c.JSON(http.StatusOK, order)
+}
+
+// GetPurchaseOrderHistory godoc
+// @Summary Get the status history of a purchase order
+// @Description Retrieves the workflow transitions of a purchase order, oldest first, with who performed each
+// @Tags purchasing
+// @Produce json
+// @Param id path string true "Purchase order ID (UUID)"
+// @Success 200 {array} domain.WorkflowTransition
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /purchase-orders/{id}/history [get]
+func (h *PurchasingHandler) GetPurchaseOrderHistory(c echo.Context) error {
+	id := c.Param("id")
+
+	history, err := h.purchasingService.OrderHistory(c.Request().Context(), id)
+	if err != nil {
+		log.Printf("GetPurchaseOrderHistory: Service error for ID %s: %v", id, err)
+		return sendPurchasingError(c, err, "Failed to retrieve purchase order history.")
+	}
+	return c.JSON(http.StatusOK, history)
+}
+
+// sendPurchasingError maps purchasing service errors to HTTP responses.
+func sendPurchasingError(c echo.Context, err error, fallback string) error {
+	switch {
+	case errors.Is(err, domain.ErrMissingUser):
+		return httputil.SendErrorResponse(c, httputil.UnauthorizedError("Missing "+HeaderUserID+" header."))
+	case errors.Is(err, domain.ErrInvalidInput):
+		return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+	case errors.Is(err, domain.ErrTransitionForbidden):
+		return httputil.SendErrorResponse(c, httputil.ForbiddenError(err.Error()))
+	case errors.Is(err, domain.ErrSupplierNotFound), errors.Is(err, domain.ErrPurchaseOrderNotFound), errors.Is(err, domain.ErrItemNotFound):
+		return httputil.SendErrorResponse(c, httputil.NotFoundError(err.Error()))
+	case errors.Is(err, domain.ErrSupplierNameTaken), errors.Is(err, domain.ErrPurchaseOrderStatus), errors.Is(err, domain.ErrOverReceipt):
+		return httputil.SendErrorResponse(c, httputil.ConflictError(err.Error()))
+	}
+	return httputil.SendErrorResponse(c, httputil.InternalServerError(fallback))
+}
@@ -0,0 +1,182 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/handler"
+	"inventory-system/internal/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	supplierID      = "4d5e6f70-8192-4a3b-8c4d-5e6f70819203"
+	purchaseOrderID = "6f708192-a3b4-4c5d-9e6f-708192a3b4c5"
+)
+
+func TestPurchasingHandler(t *testing.T) {
+	cases := []struct {
+		name       string
+		tc         handlerCase
+		route      func(h *handler.PurchasingHandler) echo.HandlerFunc
+		setup      func(s *mocks.PurchasingService)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:  "create supplier with name in use",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/suppliers", body: `{"name":"Bolts Inc"}`},
+			route: func(h *handler.PurchasingHandler) echo.HandlerFunc { return h.CreateSupplier },
+			setup: func(s *mocks.PurchasingService) {
+				s.On("CreateSupplier", mock.Anything, &domain.CreateSupplierRequest{Name: "Bolts Inc"}).
+					Return(nil, fmt.Errorf("%w: 'Bolts Inc'", domain.ErrSupplierNameTaken))
+			},
+			wantStatus: http.StatusConflict, wantBody: "already has this name",
+		},
+		{
+			name:  "draft order",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/purchase-orders", user: "alice", body: `{"supplier_id":"` + supplierID + `","lines":[{"item_id":"` + itemID + `","quantity":10,"unit_cost":2.5}]}`},
+			route: func(h *handler.PurchasingHandler) echo.HandlerFunc { return h.CreatePurchaseOrder },
+			setup: func(s *mocks.PurchasingService) {
+				s.On("CreateOrder", mock.Anything, &domain.CreatePurchaseOrderRequest{SupplierID: supplierID,
+					Lines: []domain.PurchaseOrderLineRequest{{ItemID: itemID, Quantity: 10, UnitCost: 2.5}}}, "alice").
+					Return(&domain.PurchaseOrder{ID: purchaseOrderID, SupplierID: supplierID, Status: domain.PurchaseOrderStatusDraft,
+						Lines: []domain.PurchaseOrderLine{{ItemID: itemID, QuantityOrdered: 10, UnitCost: 2.5}}}, nil)
+			},
+			wantStatus: http.StatusCreated, wantBody: `"status":"draft"`,
+		},
+		{
+			name:       "draft order without lines",
+			tc:         handlerCase{method: http.MethodPost, target: "/api/v1/purchase-orders", user: "alice", body: `{"supplier_id":"` + supplierID + `","lines":[]}`},
+			route:      func(h *handler.PurchasingHandler) echo.HandlerFunc { return h.CreatePurchaseOrder },
+			wantStatus: http.StatusUnprocessableEntity, wantBody: "Input validation failed",
+		},
+		{
+			name:  "draft order for unknown supplier",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/purchase-orders", user: "alice", body: `{"supplier_id":"` + supplierID + `","lines":[{"item_id":"` + itemID + `","quantity":10}]}`},
+			route: func(h *handler.PurchasingHandler) echo.HandlerFunc { return h.CreatePurchaseOrder },
+			setup: func(s *mocks.PurchasingService) {
+				s.On("CreateOrder", mock.Anything, mock.Anything, "alice").Return(nil, fmt.Errorf("%w: ID %s", domain.ErrSupplierNotFound, supplierID))
+			},
+			wantStatus: http.StatusNotFound, wantBody: "supplier not found",
+		},
+		{
+			name:  "submit order twice",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/purchase-orders/" + purchaseOrderID + "/submit", id: purchaseOrderID},
+			route: func(h *handler.PurchasingHandler) echo.HandlerFunc { return h.SubmitPurchaseOrder },
+			setup: func(s *mocks.PurchasingService) {
+				s.On("SubmitOrder", mock.Anything, purchaseOrderID, "", []string(nil)).
+					Return(nil, fmt.Errorf("%w: order '%s' is submitted", domain.ErrPurchaseOrderStatus, purchaseOrderID))
+			},
+			wantStatus: http.StatusConflict, wantBody: "is submitted",
+		},
+		{
+			name:  "submit order without the required role",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/purchase-orders/" + purchaseOrderID + "/submit", id: purchaseOrderID, user: "alice", roles: " clerk, ,buyer "},
+			route: func(h *handler.PurchasingHandler) echo.HandlerFunc { return h.SubmitPurchaseOrder },
+			setup: func(s *mocks.PurchasingService) {
+				s.On("SubmitOrder", mock.Anything, purchaseOrderID, "alice", []string{"clerk", "buyer"}).
+					Return(nil, fmt.Errorf("%w: submit requires role \"manager\"", domain.ErrTransitionForbidden))
+			},
+			wantStatus: http.StatusForbidden, wantBody: "requires role",
+		},
+		{
+			name:  "cancel order",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/purchase-orders/" + purchaseOrderID + "/cancel", id: purchaseOrderID, user: "alice", roles: "manager"},
+			route: func(h *handler.PurchasingHandler) echo.HandlerFunc { return h.CancelPurchaseOrder },
+			setup: func(s *mocks.PurchasingService) {
+				s.On("CancelOrder", mock.Anything, purchaseOrderID, "alice", []string{"manager"}).
+					Return(&domain.PurchaseOrder{ID: purchaseOrderID, Status: domain.PurchaseOrderStatusCancelled}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"status":"cancelled"`,
+		},
+		{
+			name:  "order history",
+			tc:    handlerCase{method: http.MethodGet, target: "/api/v1/purchase-orders/" + purchaseOrderID + "/history", id: purchaseOrderID},
+			route: func(h *handler.PurchasingHandler) echo.HandlerFunc { return h.GetPurchaseOrderHistory },
+			setup: func(s *mocks.PurchasingService) {
+				actor := "alice"
+				s.On("OrderHistory", mock.Anything, purchaseOrderID).Return([]*domain.WorkflowTransition{{
+					DocumentType: domain.WorkflowDocumentPurchaseOrder, DocumentID: purchaseOrderID, Action: domain.PurchaseOrderActionSubmit,
+					FromState: domain.PurchaseOrderStatusDraft, ToState: domain.PurchaseOrderStatusSubmitted, Actor: &actor,
+				}}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"action":"submit"`,
+		},
+		{
+			name:  "history of unknown order",
+			tc:    handlerCase{method: http.MethodGet, target: "/api/v1/purchase-orders/" + purchaseOrderID + "/history", id: purchaseOrderID},
+			route: func(h *handler.PurchasingHandler) echo.HandlerFunc { return h.GetPurchaseOrderHistory },
+			setup: func(s *mocks.PurchasingService) {
+				s.On("OrderHistory", mock.Anything, purchaseOrderID).Return(nil, fmt.Errorf("%w: ID %s", domain.ErrPurchaseOrderNotFound, purchaseOrderID))
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:  "receive part of the order",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/purchase-orders/" + purchaseOrderID + "/receive", id: purchaseOrderID, user: "alice", body: `{"lines":[{"item_id":"` + itemID + `","quantity":4}]}`},
+			route: func(h *handler.PurchasingHandler) echo.HandlerFunc { return h.ReceivePurchaseOrder },
+			setup: func(s *mocks.PurchasingService) {
+				s.On("ReceiveOrder", mock.Anything, purchaseOrderID, &domain.ReceivePurchaseOrderRequest{Lines: []domain.ReceiptLine{{ItemID: itemID, Quantity: 4}}}, "alice", []string(nil)).
+					Return(&domain.ReceivePurchaseOrderResult{
+						Order:     &domain.PurchaseOrder{ID: purchaseOrderID, Status: domain.PurchaseOrderStatusPartiallyReceived},
+						Items:     []*domain.Item{{ID: itemID, Quantity: 14}},
+						Movements: []*domain.StockMovement{{ItemID: itemID, Delta: 4, QuantityAfter: 14, Reason: domain.MovementReasonReceive, Reference: purchaseOrderID}},
+					}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"status":"partially_received"`,
+		},
+		{
+			name:  "receive more than outstanding",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/purchase-orders/" + purchaseOrderID + "/receive", id: purchaseOrderID, user: "alice", body: `{"lines":[{"item_id":"` + itemID + `","quantity":40}]}`},
+			route: func(h *handler.PurchasingHandler) echo.HandlerFunc { return h.ReceivePurchaseOrder },
+			setup: func(s *mocks.PurchasingService) {
+				s.On("ReceiveOrder", mock.Anything, purchaseOrderID, mock.Anything, "alice", mock.Anything).
+					Return(nil, fmt.Errorf("%w: item '%s' has 6 outstanding", domain.ErrOverReceipt, itemID))
+			},
+			wantStatus: http.StatusConflict, wantBody: "6 outstanding",
+		},
+		{
+			name:  "receive without user",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/purchase-orders/" + purchaseOrderID + "/receive", id: purchaseOrderID, body: `{"lines":[{"item_id":"` + itemID + `","quantity":4}]}`},
+			route: func(h *handler.PurchasingHandler) echo.HandlerFunc { return h.ReceivePurchaseOrder },
+			setup: func(s *mocks.PurchasingService) {
+				s.On("ReceiveOrder", mock.Anything, purchaseOrderID, mock.Anything, "", mock.Anything).Return(nil, domain.ErrMissingUser)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "list with unknown status",
+			tc:         handlerCase{method: http.MethodGet, target: "/api/v1/purchase-orders?status=shipped"},
+			route:      func(h *handler.PurchasingHandler) echo.HandlerFunc { return h.ListPurchaseOrders },
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "list orders of a supplier",
+			tc:    handlerCase{method: http.MethodGet, target: "/api/v1/purchase-orders?supplier=" + supplierID},
+			route: func(h *handler.PurchasingHandler) echo.HandlerFunc { return h.ListPurchaseOrders },
+			setup: func(s *mocks.PurchasingService) {
+				s.On("ListOrders", mock.Anything, domain.ListPurchaseOrdersQuery{Supplier: supplierID, Limit: 50}).
+					Return([]*domain.PurchaseOrder{{ID: purchaseOrderID, SupplierID: supplierID}}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: purchaseOrderID,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewPurchasingService(t)
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+			rec := serve(t, tc.tc, tc.route(handler.NewPurchasingHandler(svc)))
+
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tc.wantBody)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"inventory-system/pkg/httputil"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// bindQuery fills dst, a pointer to a struct whose fields carry `query` tags, from the
+// request's query string and validates it with v. Parameters absent from the request keep
+// the value already in dst, so callers pre-fill dst with their defaults. The fields of
+// embedded structs are bound as if they were dst's own.
+//
+// Every malformed or invalid parameter is reported, keyed by its query name, in the details
+// of a single 400 response; nil means dst is ready to use.
+func bindQuery(c echo.Context, v *validator.Validate, dst any) *httputil.HTTPError {
+	rv := reflect.ValueOf(dst).Elem()
+	rt := rv.Type()
+	problems := make(map[string]string)
+	bindQueryFields(rv, c.QueryParams(), problems)
+
+	if err := v.StructCtx(c.Request().Context(), dst); err != nil {
+		var ve validator.ValidationErrors
+		if !errors.As(err, &ve) {
+			return httputil.BadRequestError("Invalid query parameters.")
+		}
+		for _, fe := range ve {
+			field, _ := rt.FieldByName(fe.StructField())
+			name := field.Tag.Get("query")
+			if _, unparsable := problems[name]; unparsable {
+				continue // Report why the value could not be read, not the default it left behind
+			}
+			rule := fe.Tag()
+			if fe.Param() != "" {
+				rule += "=" + fe.Param()
+			}
+			problems[name] = fmt.Sprintf("Failed validation on rule '%s'", rule)
+		}
+	}
+
+	if len(problems) > 0 {
+		return httputil.BadRequestError("Invalid query parameters.").WithDetails(problems)
+	}
+	return nil
+}
+
+// bindQueryFields sets the tagged fields of the struct rv, and those of its embedded
+// structs, from params, recording in problems the parameters that cannot be parsed.
+func bindQueryFields(rv reflect.Value, params url.Values, problems map[string]string) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			bindQueryFields(rv.Field(i), params, problems)
+			continue
+		}
+		name := field.Tag.Get("query")
+		if name == "" || !params.Has(name) {
+			continue
+		}
+		if err := setQueryField(rv.Field(i), params.Get(name)); err != nil {
+			problems[name] = err.Error()
+		}
+	}
+}
+
+// setQueryField parses raw into the kinds of fields used by query structs.
+func setQueryField(field reflect.Value, raw string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(strings.TrimSpace(raw), 10, field.Type().Bits())
+		if err != nil {
+			return errors.New("Must be an integer")
+		}
+		field.SetInt(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(strings.TrimSpace(raw))
+		if err != nil {
+			return errors.New("Must be a boolean (true or false)")
+		}
+		field.SetBool(b)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return errors.New("Must be a number")
+		}
+		field.SetFloat(f)
+	case reflect.Pointer: // Optional parameter: nil unless given
+		v := reflect.New(field.Type().Elem())
+		if err := setQueryField(v.Elem(), raw); err != nil {
+			return err
+		}
+		field.Set(v)
+	default:
+		return fmt.Errorf("Unsupported parameter type %s", field.Type())
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// RebuildHandler serves the admin API for rebuilding derived stores (caches).
+type RebuildHandler struct {
+	rebuildService domain.RebuildService
+	validate       *validator.Validate
+}
+
+// NewRebuildHandler creates a new RebuildHandler.
+func NewRebuildHandler(rs domain.RebuildService) *RebuildHandler {
+	return &RebuildHandler{
+		rebuildService: rs,
+		validate:       newValidator(),
+	}
+}
+
+// StartRebuild godoc
+// @Summary Rebuild a derived store
+// @Description Discards a derived store of this instance (e.g. "item-cache") and rebuilds it from the database in the
+// @Description background, for recovery after a bug or a schema change. Poll the returned job for progress.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param rebuild body domain.StartRebuildRequest true "Store to rebuild"
+// @Success 202 {object} domain.RebuildJob "Rebuild started"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid payload)"
+// @Failure 404 {object} httputil.HTTPError "Not Found (unknown store; the valid ones are listed in details)"
+// @Failure 409 {object} httputil.HTTPError "Conflict (a rebuild of the store is already running)"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation failed)"
+// @Router /admin/rebuilds [post]
+func (h *RebuildHandler) StartRebuild(c echo.Context) error {
+	var req domain.StartRebuildRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("StartRebuild: Bind error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("StartRebuild: Validation error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	job, err := h.rebuildService.StartRebuild(c.Request().Context(), req.Store, currentUserID(c))
+	if err != nil {
+		log.Printf("StartRebuild: Service error for %s: %v", req.Store, err)
+		return h.sendRebuildError(c, err)
+	}
+	return c.JSON(http.StatusAccepted, job)
+}
+
+// ListRebuilds godoc
+// @Summary List rebuild jobs
+// @Description Lists recent rebuilds of derived stores on this instance, most recent first
+// @Tags admin
+// @Produce json
+// @Success 200 {array} domain.RebuildJob "Rebuild jobs"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /admin/rebuilds [get]
+func (h *RebuildHandler) ListRebuilds(c echo.Context) error {
+	jobs, err := h.rebuildService.ListRebuilds(c.Request().Context())
+	if err != nil {
+		log.Printf("ListRebuilds: Service error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.InternalServerError("Failed to list rebuilds."))
+	}
+	return c.JSON(http.StatusOK, jobs)
+}
+
+// GetRebuild godoc
+// @Summary Get a rebuild job
+// @Description Returns the status and progress of a rebuild
+// @Tags admin
+// @Produce json
+// @Param id path string true "Job ID"
+// @Success 200 {object} domain.RebuildJob "Rebuild job"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Router /admin/rebuilds/{id} [get]
+func (h *RebuildHandler) GetRebuild(c echo.Context) error {
+	id := c.Param("id")
+
+	job, err := h.rebuildService.GetRebuild(c.Request().Context(), id)
+	if err != nil {
+		log.Printf("GetRebuild: Service error for %s: %v", id, err)
+		return h.sendRebuildError(c, err)
+	}
+	return c.JSON(http.StatusOK, job)
+}
+
+// sendRebuildError maps rebuild service errors to HTTP responses.
+func (h *RebuildHandler) sendRebuildError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrDerivedStoreNotFound):
+		return httputil.SendErrorResponse(c, httputil.NotFoundError(err.Error()).
+			WithDetails(map[string][]string{"stores": h.rebuildService.Stores()}))
+	case errors.Is(err, domain.ErrRebuildJobNotFound):
+		return httputil.SendErrorResponse(c, httputil.NotFoundError(err.Error()))
+	case errors.Is(err, domain.ErrRebuildRunning):
+		return httputil.SendErrorResponse(c, httputil.ConflictError(err.Error()))
+	}
+	return httputil.SendErrorResponse(c, httputil.InternalServerError("Failed to start rebuild."))
+}
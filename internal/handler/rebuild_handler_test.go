@@ -0,0 +1,70 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/handler"
+	"inventory-system/internal/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRebuildHandler_StartRebuild(t *testing.T) {
+	cases := []struct {
+		name       string
+		body       string
+		setup      func(s *mocks.RebuildService)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "started",
+			body: `{"store":"item-cache"}`,
+			setup: func(s *mocks.RebuildService) {
+				s.On("StartRebuild", mock.Anything, "item-cache", "alice").
+					Return(&domain.RebuildJob{ID: "j1", Store: "item-cache", Status: domain.RebuildStatusRunning}, nil)
+			},
+			wantStatus: http.StatusAccepted, wantBody: `"status":"running"`,
+		},
+		{
+			name:       "missing store",
+			body:       `{}`,
+			wantStatus: http.StatusUnprocessableEntity, wantBody: "Input validation failed",
+		},
+		{
+			name: "unknown store",
+			body: `{"store":"search-index"}`,
+			setup: func(s *mocks.RebuildService) {
+				s.On("StartRebuild", mock.Anything, "search-index", "alice").
+					Return(nil, fmt.Errorf("%w: search-index", domain.ErrDerivedStoreNotFound))
+				s.On("Stores").Return([]string{"item-cache"})
+			},
+			wantStatus: http.StatusNotFound, wantBody: `"stores":["item-cache"]`,
+		},
+		{
+			name: "already running",
+			body: `{"store":"item-cache"}`,
+			setup: func(s *mocks.RebuildService) {
+				s.On("StartRebuild", mock.Anything, "item-cache", "alice").Return(nil, domain.ErrRebuildRunning)
+			},
+			wantStatus: http.StatusConflict, wantBody: "already running",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewRebuildService(t)
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+			rec := serve(t, handlerCase{method: http.MethodPost, target: "/admin/rebuilds", body: tc.body, user: "alice"},
+				handler.NewRebuildHandler(svc).StartRebuild)
+
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tc.wantBody)
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// SalesOrderHandler handles HTTP requests for sales orders.
+type SalesOrderHandler struct {
+	salesOrderService domain.SalesOrderService
+	validate          *validator.Validate
+}
+
+// NewSalesOrderHandler creates a new SalesOrderHandler.
+func NewSalesOrderHandler(ss domain.SalesOrderService) *SalesOrderHandler {
+	return &SalesOrderHandler{
+		salesOrderService: ss,
+		validate:          newValidator(),
+	}
+}
+
+// CreateSalesOrder godoc
+// @Summary Place a sales order
+// @Description Places an order and reserves its stock. Lines are priced for the customer's price tier, or at
+// @Description retail without a customer. Each item may appear on one line only.
+// @Tags sales
+// @Accept json
+// @Produce json
+// @Param X-User-ID header string true "Calling user"
+// @Param order body domain.CreateSalesOrderRequest true "Customer and lines"
+// @Success 201 {object} domain.SalesOrder "Successfully placed order"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (e.g. an item listed twice)"
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 404 {object} httputil.HTTPError "Customer or item not found"
+// @Failure 409 {object} httputil.HTTPError "Conflict (not enough unreserved stock)"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /sales-orders [post]
+func (h *SalesOrderHandler) CreateSalesOrder(c echo.Context) error {
+	var req domain.CreateSalesOrderRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("CreateSalesOrder: Bind error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("CreateSalesOrder: Validation error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	order, err := h.salesOrderService.CreateOrder(c.Request().Context(), &req, currentUserID(c))
+	if err != nil {
+		log.Printf("CreateSalesOrder: Service error: %v", err)
+		return sendSalesOrderError(c, err, "Failed to create sales order.")
+	}
+	return c.JSON(http.StatusCreated, order)
+}
+
+// ListSalesOrders godoc
+// @Summary List sales orders
+// @Description Retrieves the latest sales orders, newest first
+// @Tags sales
+// @Produce json
+// @Param status query string false "Only orders with this status (open, fulfilled or cancelled)"
+// @Param customer query string false "Only orders of this customer (UUID)"
+// @Param limit query int false "Maximum number of orders (default: 50, max: 200)"
+// @Success 200 {array} domain.SalesOrder
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid query parameters)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /sales-orders [get]
+func (h *SalesOrderHandler) ListSalesOrders(c echo.Context) error {
+	query := domain.ListSalesOrdersQuery{Limit: 50} // Defaults
+	if httpErr := bindQuery(c, h.validate, &query); httpErr != nil {
+		log.Printf("ListSalesOrders: Invalid query parameters: %v", httpErr.Details)
+		return httputil.SendErrorResponse(c, httpErr)
+	}
+
+	orders, err := h.salesOrderService.ListOrders(c.Request().Context(), query)
+	if err != nil {
+		log.Printf("ListSalesOrders: Service error: %v", err)
+		return sendSalesOrderError(c, err, "Failed to retrieve sales orders.")
+	}
+	return c.JSON(http.StatusOK, orders)
+}
+
+// GetSalesOrder godoc
+// @Summary Get a sales order by ID
+// @Description Retrieves a sales order with its lines
+// @Tags sales
+// @Produce json
+// @Param id path string true "Sales order ID (UUID)"
+// @Success 200 {object} domain.SalesOrder
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /sales-orders/{id} [get]
+func (h *SalesOrderHandler) GetSalesOrder(c echo.Context) error {
+	id := c.Param("id")
+
+	order, err := h.salesOrderService.GetOrder(c.Request().Context(), id)
+	if err != nil {
+		log.Printf("GetSalesOrder: Service error for ID %s: %v", id, err)
+		return sendSalesOrderError(c, err, "Failed to retrieve sales order.")
+	}
+	return c.JSON(http.StatusOK, order)
+}
+
+// FulfillSalesOrder godoc
+// @Summary Fulfill a sales order
+// @Description Deducts the order's lines from stock in one transaction and closes the order. Each change is written
+// @Description to the movement ledger with reason sale and the order ID as reference, and the new quantities are
+// @Description broadcast over WebSocket. Fails with 409, changing nothing, if an item no longer has enough stock.
+// @Tags sales
+// @Produce json
+// @Param X-User-ID header string true "Calling user"
+// @Param id path string true "Sales order ID (UUID)"
+// @Success 200 {object} domain.FulfillSalesOrderResult "Fulfilled order, changed items and their ledger rows"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 409 {object} httputil.HTTPError "Conflict (order not open, or insufficient stock)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /sales-orders/{id}/fulfill [post]
+func (h *SalesOrderHandler) FulfillSalesOrder(c echo.Context) error {
+	id := c.Param("id")
+
+	result, err := h.salesOrderService.FulfillOrder(c.Request().Context(), id, currentUserID(c))
+	if err != nil {
+		log.Printf("FulfillSalesOrder: Service error for ID %s: %v", id, err)
+		return sendSalesOrderError(c, err, "Failed to fulfill sales order.")
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// CancelSalesOrder godoc
+// @Summary Cancel a sales order
+// @Description Closes an open order and releases its reservation without touching stock
+// @Tags sales
+// @Produce json
+// @Param X-User-ID header string true "Calling user"
+// @Param id path string true "Sales order ID (UUID)"
+// @Success 200 {object} domain.SalesOrder "Cancelled order"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 409 {object} httputil.HTTPError "Conflict (order not open)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /sales-orders/{id}/cancel [post]
+func (h *SalesOrderHandler) CancelSalesOrder(c echo.Context) error {
+	id := c.Param("id")
+
+	order, err := h.salesOrderService.CancelOrder(c.Request().Context(), id, currentUserID(c))
+	if err != nil {
+		log.Printf("CancelSalesOrder: Service error for ID %s: %v", id, err)
+		return sendSalesOrderError(c, err, "Failed to cancel sales order.")
+	}
+	return c.JSON(http.StatusOK, order)
+}
+
+// sendSalesOrderError maps sales order service errors to HTTP responses.
+func sendSalesOrderError(c echo.Context, err error, fallback string) error {
+	switch {
+	case errors.Is(err, domain.ErrMissingUser):
+		return httputil.SendErrorResponse(c, httputil.UnauthorizedError("Missing "+HeaderUserID+" header."))
+	case errors.Is(err, domain.ErrInvalidInput), errors.Is(err, domain.ErrInvalidItemID):
+		return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+	case errors.Is(err, domain.ErrSalesOrderNotFound), errors.Is(err, domain.ErrCustomerNotFound), errors.Is(err, domain.ErrItemNotFound):
+		return httputil.SendErrorResponse(c, httputil.NotFoundError(err.Error()))
+	case errors.Is(err, domain.ErrSalesOrderClosed), errors.Is(err, domain.ErrInsufficientStock):
+		return httputil.SendErrorResponse(c, httputil.ConflictError(err.Error()))
+	}
+	return httputil.SendErrorResponse(c, httputil.InternalServerError(fallback))
+}
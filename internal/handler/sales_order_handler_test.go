@@ -0,0 +1,130 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/handler"
+	"inventory-system/internal/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const salesOrderID = "8192a3b4-c5d6-4e7f-8091-a2b3c4d5e6f7"
+
+func TestSalesOrderHandler(t *testing.T) {
+	customer := customerID
+	cases := []struct {
+		name       string
+		tc         handlerCase
+		route      func(h *handler.SalesOrderHandler) echo.HandlerFunc
+		setup      func(s *mocks.SalesOrderService)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:  "place order",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/sales-orders", user: "alice", body: `{"customer_id":"` + customerID + `","lines":[{"item_id":"` + itemID + `","quantity":3}]}`},
+			route: func(h *handler.SalesOrderHandler) echo.HandlerFunc { return h.CreateSalesOrder },
+			setup: func(s *mocks.SalesOrderService) {
+				s.On("CreateOrder", mock.Anything, &domain.CreateSalesOrderRequest{CustomerID: &customer,
+					Lines: []domain.SalesOrderLineRequest{{ItemID: itemID, Quantity: 3}}}, "alice").
+					Return(&domain.SalesOrder{ID: salesOrderID, CustomerID: &customer, Status: domain.SalesOrderStatusOpen,
+						Lines: []domain.SalesOrderLine{{ItemID: itemID, Quantity: 3, UnitPrice: 8.5}}}, nil)
+			},
+			wantStatus: http.StatusCreated, wantBody: `"unit_price":8.5`,
+		},
+		{
+			name:  "place order beyond available stock",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/sales-orders", user: "alice", body: `{"lines":[{"item_id":"` + itemID + `","quantity":300}]}`},
+			route: func(h *handler.SalesOrderHandler) echo.HandlerFunc { return h.CreateSalesOrder },
+			setup: func(s *mocks.SalesOrderService) {
+				s.On("CreateOrder", mock.Anything, mock.Anything, "alice").
+					Return(nil, fmt.Errorf("%w: item 'SKU-1' has 12 available, order needs 300", domain.ErrInsufficientStock))
+			},
+			wantStatus: http.StatusConflict, wantBody: "12 available",
+		},
+		{
+			name:       "place order with zero quantity",
+			tc:         handlerCase{method: http.MethodPost, target: "/api/v1/sales-orders", user: "alice", body: `{"lines":[{"item_id":"` + itemID + `","quantity":0}]}`},
+			route:      func(h *handler.SalesOrderHandler) echo.HandlerFunc { return h.CreateSalesOrder },
+			wantStatus: http.StatusUnprocessableEntity, wantBody: "Input validation failed",
+		},
+		{
+			name:  "place order for unknown customer",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/sales-orders", user: "alice", body: `{"customer_id":"` + customerID + `","lines":[{"item_id":"` + itemID + `","quantity":3}]}`},
+			route: func(h *handler.SalesOrderHandler) echo.HandlerFunc { return h.CreateSalesOrder },
+			setup: func(s *mocks.SalesOrderService) {
+				s.On("CreateOrder", mock.Anything, mock.Anything, "alice").Return(nil, fmt.Errorf("%w: ID %s", domain.ErrCustomerNotFound, customerID))
+			},
+			wantStatus: http.StatusNotFound, wantBody: "customer not found",
+		},
+		{
+			name:  "fulfill order",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/sales-orders/" + salesOrderID + "/fulfill", id: salesOrderID, user: "alice"},
+			route: func(h *handler.SalesOrderHandler) echo.HandlerFunc { return h.FulfillSalesOrder },
+			setup: func(s *mocks.SalesOrderService) {
+				s.On("FulfillOrder", mock.Anything, salesOrderID, "alice").Return(&domain.FulfillSalesOrderResult{
+					Order:     &domain.SalesOrder{ID: salesOrderID, Status: domain.SalesOrderStatusFulfilled},
+					Items:     []*domain.Item{{ID: itemID, Quantity: 9}},
+					Movements: []*domain.StockMovement{{ItemID: itemID, Delta: -3, QuantityAfter: 9, Reason: domain.MovementReasonSale, Reference: salesOrderID}},
+				}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"reason":"sale"`,
+		},
+		{
+			name:  "fulfill after stock was written off",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/sales-orders/" + salesOrderID + "/fulfill", id: salesOrderID, user: "alice"},
+			route: func(h *handler.SalesOrderHandler) echo.HandlerFunc { return h.FulfillSalesOrder },
+			setup: func(s *mocks.SalesOrderService) {
+				s.On("FulfillOrder", mock.Anything, salesOrderID, "alice").Return(nil, domain.ErrInsufficientStock)
+			},
+			wantStatus: http.StatusConflict,
+		},
+		{
+			name:  "cancel fulfilled order",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/sales-orders/" + salesOrderID + "/cancel", id: salesOrderID, user: "alice"},
+			route: func(h *handler.SalesOrderHandler) echo.HandlerFunc { return h.CancelSalesOrder },
+			setup: func(s *mocks.SalesOrderService) {
+				s.On("CancelOrder", mock.Anything, salesOrderID, "alice").
+					Return(nil, fmt.Errorf("%w: order '%s' is fulfilled", domain.ErrSalesOrderClosed, salesOrderID))
+			},
+			wantStatus: http.StatusConflict, wantBody: "no longer open",
+		},
+		{
+			name:  "cancel without user",
+			tc:    handlerCase{method: http.MethodPost, target: "/api/v1/sales-orders/" + salesOrderID + "/cancel", id: salesOrderID},
+			route: func(h *handler.SalesOrderHandler) echo.HandlerFunc { return h.CancelSalesOrder },
+			setup: func(s *mocks.SalesOrderService) {
+				s.On("CancelOrder", mock.Anything, salesOrderID, "").Return(nil, domain.ErrMissingUser)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:  "list open orders of a customer",
+			tc:    handlerCase{method: http.MethodGet, target: "/api/v1/sales-orders?status=open&customer=" + customerID},
+			route: func(h *handler.SalesOrderHandler) echo.HandlerFunc { return h.ListSalesOrders },
+			setup: func(s *mocks.SalesOrderService) {
+				s.On("ListOrders", mock.Anything, domain.ListSalesOrdersQuery{Status: "open", Customer: customerID, Limit: 50}).
+					Return([]*domain.SalesOrder{{ID: salesOrderID, CustomerID: &customer}}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: salesOrderID,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewSalesOrderService(t)
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+			rec := serve(t, tc.tc, tc.route(handler.NewSalesOrderHandler(svc)))
+
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tc.wantBody)
+		})
+	}
+}
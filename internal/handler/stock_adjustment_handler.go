@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// StockAdjustmentHandler handles HTTP requests for stock adjustments.
+type StockAdjustmentHandler struct {
+	adjustmentService domain.StockAdjustmentService
+	validate          *validator.Validate
+}
+
+// NewStockAdjustmentHandler creates a new StockAdjustmentHandler.
+func NewStockAdjustmentHandler(as domain.StockAdjustmentService) *StockAdjustmentHandler {
+	return &StockAdjustmentHandler{
+		adjustmentService: as,
+		validate:          newValidator(),
+	}
+}
+
+// ApplyBatch godoc
+// @Summary Apply a batch of stock adjustments
+// @Description Applies up to 500 quantity adjustments, each with a reason code, in one transaction, e.g. for end-of-day reconciliation.
+// @Description Either every line is applied or none is: if any item is missing, or a removal would take units reserved by open
+// @Description sales orders or drop below zero, the batch is rejected with 409 and the per-line results in details. An applied batch is broadcast as a single STOCK_BATCH_UPDATE message.
+// @Tags stock
+// @Accept json
+// @Produce json
+// @Param X-User-ID header string true "Calling user"
+// @Param batch body domain.BatchAdjustmentRequest true "Adjustments"
+// @Success 200 {object} domain.BatchAdjustmentResult "Batch applied"
+// @Failure 400 {object} httputil.HTTPError "Bad Request"
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 409 {object} httputil.HTTPError "Conflict (batch rejected; details holds the per-line results)"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /stock-adjustments/batch [post]
+func (h *StockAdjustmentHandler) ApplyBatch(c echo.Context) error {
+	var req domain.BatchAdjustmentRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("ApplyBatch: Bind error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("ApplyBatch: Validation error: %v", err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	result, err := h.adjustmentService.ApplyBatch(c.Request().Context(), &req, currentUserID(c))
+	if err != nil {
+		log.Printf("ApplyBatch: Service error: %v", err)
+		switch {
+		case errors.Is(err, domain.ErrMissingUser):
+			return httputil.SendErrorResponse(c, httputil.UnauthorizedError("Missing "+HeaderUserID+" header."))
+		case errors.Is(err, domain.ErrInvalidItemID), errors.Is(err, domain.ErrInvalidInput):
+			return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+		case errors.Is(err, domain.ErrBatchRejected):
+			return httputil.SendErrorResponse(c, httputil.ConflictError("Adjustment batch rejected; nothing was applied.").WithDetails(result))
+		}
+		return httputil.SendErrorResponse(c, httputil.InternalServerError("Failed to apply stock adjustments."))
+	}
+	return c.JSON(http.StatusOK, result)
+}
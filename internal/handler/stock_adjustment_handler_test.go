@@ -0,0 +1,78 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/handler"
+	"inventory-system/internal/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStockAdjustmentHandler_ApplyBatch(t *testing.T) {
+	body := `{"adjustments":[{"item_id":"` + itemID + `","delta":-2,"reason_code":"damage"}]}`
+	cases := []struct {
+		name       string
+		body       string
+		setup      func(s *mocks.StockAdjustmentService)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "applied",
+			body: body,
+			setup: func(s *mocks.StockAdjustmentService) {
+				s.On("ApplyBatch", mock.Anything, mock.MatchedBy(func(r *domain.BatchAdjustmentRequest) bool {
+					return len(r.Adjustments) == 1 && r.Adjustments[0].Delta == -2 && r.Adjustments[0].ReasonCode == domain.AdjustmentReasonDamage
+				}), "alice").Return(&domain.BatchAdjustmentResult{BatchID: "b1", Applied: true, Results: []domain.StockAdjustmentResult{
+					{ItemID: itemID, Delta: -2, QuantityBefore: 5, QuantityAfter: 3, Status: domain.AdjustmentStatusApplied}}}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"quantity_after":3`,
+		},
+		{
+			name:       "unknown reason code",
+			body:       `{"adjustments":[{"item_id":"` + itemID + `","delta":1,"reason_code":"gift"}]}`,
+			wantStatus: http.StatusUnprocessableEntity, wantBody: "Input validation failed",
+		},
+		{
+			name:       "empty batch",
+			body:       `{"adjustments":[]}`,
+			wantStatus: http.StatusUnprocessableEntity, wantBody: "Input validation failed",
+		},
+		{
+			name: "rejected",
+			body: body,
+			setup: func(s *mocks.StockAdjustmentService) {
+				s.On("ApplyBatch", mock.Anything, mock.Anything, "alice").Return(&domain.BatchAdjustmentResult{BatchID: "b1",
+					Results: []domain.StockAdjustmentResult{{ItemID: itemID, Delta: -2, Status: domain.AdjustmentStatusInsufficientStock}}},
+					fmt.Errorf("%w: batch b1", domain.ErrBatchRejected))
+			},
+			wantStatus: http.StatusConflict, wantBody: `"status":"insufficient_stock"`,
+		},
+		{
+			name: "service failure",
+			body: body,
+			setup: func(s *mocks.StockAdjustmentService) {
+				s.On("ApplyBatch", mock.Anything, mock.Anything, "alice").Return(nil, errBoom)
+			},
+			wantStatus: http.StatusInternalServerError, wantBody: "Failed to apply stock adjustments.",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewStockAdjustmentService(t)
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+			h := handler.NewStockAdjustmentHandler(svc)
+			rec := serve(t, handlerCase{method: http.MethodPost, target: "/stock-adjustments/batch", body: tc.body, user: "alice"}, h.ApplyBatch)
+
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tc.wantBody)
+		})
+	}
+}
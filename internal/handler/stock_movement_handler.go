@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// StockMovementHandler handles HTTP requests for reasoned stock changes and their ledger.
+type StockMovementHandler struct {
+	movementService domain.StockMovementService
+	validate        *validator.Validate
+}
+
+// NewStockMovementHandler creates a new StockMovementHandler.
+func NewStockMovementHandler(ms domain.StockMovementService) *StockMovementHandler {
+	return &StockMovementHandler{
+		movementService: ms,
+		validate:        newValidator(),
+	}
+}
+
+// AdjustStock godoc
+// @Summary Adjust an item's stock with a reason
+// @Description Adds delta (negative to remove stock) to the quantity atomically and records the change, with its reason
+// @Description and the calling user, in the item's movement ledger. Fails with 409 if a removal would take units reserved
+// @Description by open sales orders, or drop the quantity below zero. The new quantity is broadcast over WebSocket.
+// @Tags items
+// @Accept json
+// @Produce json
+// @Param X-User-ID header string true "Calling user"
+// @Param id path string true "Item ID (UUID)"
+// @Param adjustment body domain.AdjustStockRequest true "Delta and reason (sale, receive, damage or correction)"
+// @Success 200 {object} domain.AdjustStockResult "Item with its new quantity, and the ledger row"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID or payload)"
+// @Failure 401 {object} httputil.HTTPError "Unauthorized (missing user identity)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 409 {object} httputil.HTTPError "Conflict (insufficient stock)"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/{id}/adjust-stock [post]
+func (h *StockMovementHandler) AdjustStock(c echo.Context) error {
+	id := c.Param("id")
+
+	var req domain.AdjustStockRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("AdjustStock: Bind error for ID %s: %v", id, err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("AdjustStock: Validation error for ID %s: %v", id, err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	result, err := h.movementService.AdjustStock(c.Request().Context(), id, &req, currentUserID(c))
+	if err != nil {
+		log.Printf("AdjustStock: Service error for ID %s: %v", id, err)
+		return sendMovementError(c, err, id, "Failed to adjust item stock.")
+	}
+	return c.JSON(http.StatusOK, result)
+}
+
+// ListItemMovements godoc
+// @Summary Get the movement history of an item
+// @Description Lists the item's movement ledger, newest first: every change to its quantity with the delta, the resulting
+// @Description quantity, the reason and who made it.
+// @Tags items
+// @Produce json
+// @Param id path string true "Item ID (UUID)"
+// @Param limit query int false "Maximum number of movements (default: 100, max: 500)"
+// @Success 200 {array} domain.StockMovement
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID or query parameters)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/{id}/movements [get]
+func (h *StockMovementHandler) ListItemMovements(c echo.Context) error {
+	id := c.Param("id")
+
+	query := domain.ListMovementsQuery{Limit: 100} // Defaults
+	if httpErr := bindQuery(c, h.validate, &query); httpErr != nil {
+		log.Printf("ListItemMovements: Invalid query parameters: %v", httpErr.Details)
+		return httputil.SendErrorResponse(c, httpErr)
+	}
+
+	movements, err := h.movementService.ListMovements(c.Request().Context(), id, query.Limit)
+	if err != nil {
+		log.Printf("ListItemMovements: Service error for ID %s: %v", id, err)
+		return sendMovementError(c, err, id, "Failed to retrieve stock movements.")
+	}
+	return c.JSON(http.StatusOK, movements)
+}
+
+// sendMovementError maps stock movement service errors to HTTP responses.
+func sendMovementError(c echo.Context, err error, id, fallback string) error {
+	switch {
+	case errors.Is(err, domain.ErrMissingUser):
+		return httputil.SendErrorResponse(c, httputil.UnauthorizedError("Missing "+HeaderUserID+" header."))
+	case errors.Is(err, domain.ErrInvalidItemID), errors.Is(err, domain.ErrInvalidInput):
+		return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+	case errors.Is(err, domain.ErrItemNotFound):
+		return httputil.SendErrorResponse(c, httputil.NotFoundError(fmt.Sprintf("Item with ID '%s' not found.", id)))
+	case errors.Is(err, domain.ErrInsufficientStock):
+		return httputil.SendErrorResponse(c, httputil.ConflictError(err.Error()))
+	}
+	return httputil.SendErrorResponse(c, httputil.InternalServerError(fallback))
+}
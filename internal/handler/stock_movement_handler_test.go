@@ -0,0 +1,104 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/handler"
+	"inventory-system/internal/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStockMovementHandler_AdjustStock(t *testing.T) {
+	cases := []struct {
+		name       string
+		body       string
+		user       string
+		setup      func(s *mocks.StockMovementService)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name: "adjusted",
+			body: `{"delta":-2,"reason":"sale","note":"till 4"}`,
+			user: "alice",
+			setup: func(s *mocks.StockMovementService) {
+				s.On("AdjustStock", mock.Anything, itemID, &domain.AdjustStockRequest{Delta: -2, Reason: domain.MovementReasonSale, Note: "till 4"}, "alice").
+					Return(&domain.AdjustStockResult{
+						Item:     &domain.Item{ID: itemID, Quantity: 3},
+						Movement: &domain.StockMovement{ItemID: itemID, Delta: -2, QuantityAfter: 3, Reason: domain.MovementReasonSale, MovedBy: "alice"},
+					}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"movement":{"id":"","item_id":"` + itemID + `","delta":-2,"quantity_after":3,"reason":"sale"`,
+		},
+		{
+			name:       "unknown reason",
+			body:       `{"delta":1,"reason":"gift"}`,
+			user:       "alice",
+			wantStatus: http.StatusUnprocessableEntity, wantBody: "Input validation failed",
+		},
+		{
+			name:       "zero delta",
+			body:       `{"delta":0,"reason":"sale"}`,
+			user:       "alice",
+			wantStatus: http.StatusUnprocessableEntity, wantBody: "Input validation failed",
+		},
+		{
+			name: "missing user",
+			body: `{"delta":1,"reason":"receive"}`,
+			setup: func(s *mocks.StockMovementService) {
+				s.On("AdjustStock", mock.Anything, itemID, mock.Anything, "").Return(nil, domain.ErrMissingUser)
+			},
+			wantStatus: http.StatusUnauthorized, wantBody: handler.HeaderUserID,
+		},
+		{
+			name: "insufficient stock",
+			body: `{"delta":-9,"reason":"damage"}`,
+			user: "alice",
+			setup: func(s *mocks.StockMovementService) {
+				s.On("AdjustStock", mock.Anything, itemID, mock.Anything, "alice").
+					Return(nil, fmt.Errorf("%w: item has 3 available, cannot remove 9", domain.ErrInsufficientStock))
+			},
+			wantStatus: http.StatusConflict, wantBody: "cannot remove 9",
+		},
+		{
+			name: "not found",
+			body: `{"delta":1,"reason":"correction"}`,
+			user: "alice",
+			setup: func(s *mocks.StockMovementService) {
+				s.On("AdjustStock", mock.Anything, itemID, mock.Anything, "alice").Return(nil, domain.ErrItemNotFound)
+			},
+			wantStatus: http.StatusNotFound, wantBody: "not found",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewStockMovementService(t)
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+			rec := serve(t, handlerCase{method: http.MethodPost, target: "/api/v1/items/" + itemID + "/adjust-stock", body: tc.body, id: itemID, user: tc.user},
+				handler.NewStockMovementHandler(svc).AdjustStock)
+
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tc.wantBody)
+		})
+	}
+}
+
+func TestStockMovementHandler_ListItemMovements(t *testing.T) {
+	svc := mocks.NewStockMovementService(t)
+	svc.On("ListMovements", mock.Anything, itemID, 20).Return([]*domain.StockMovement{
+		{ItemID: itemID, Delta: 5, QuantityAfter: 5, Reason: domain.MovementReasonInitial},
+	}, nil)
+
+	rec := serve(t, handlerCase{method: http.MethodGet, target: "/api/v1/items/" + itemID + "/movements?limit=20", id: itemID},
+		handler.NewStockMovementHandler(svc).ListItemMovements)
+
+	assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Contains(t, rec.Body.String(), `"reason":"initial"`)
+}
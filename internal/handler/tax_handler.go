@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/httputil"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// TaxHandler handles HTTP requests for tax rates, item tax codes and order tax quotes.
+type TaxHandler struct {
+	taxService domain.TaxService
+	validate   *validator.Validate
+}
+
+// NewTaxHandler creates a new TaxHandler.
+func NewTaxHandler(ts domain.TaxService) *TaxHandler {
+	return &TaxHandler{
+		taxService: ts,
+		validate:   newValidator(),
+	}
+}
+
+// ListTaxRates godoc
+// @Summary List tax rates
+// @Description Lists the rate of every tax code in every jurisdiction, ordered by jurisdiction and code
+// @Tags tax
+// @Produce json
+// @Success 200 {array} domain.TaxRate
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /tax-rates [get]
+func (h *TaxHandler) ListTaxRates(c echo.Context) error {
+	rates, err := h.taxService.ListRates(c.Request().Context())
+	if err != nil {
+		log.Printf("ListTaxRates: Service error: %v", err)
+		return sendTaxError(c, err, "Failed to retrieve tax rates.")
+	}
+	return c.JSON(http.StatusOK, rates)
+}
+
+// SetTaxRate godoc
+// @Summary Set a tax rate
+// @Description Creates or replaces the rate of a tax code in a jurisdiction. Items without a tax code are taxed
+// @Description under 'standard'.
+// @Tags tax
+// @Accept json
+// @Produce json
+// @Param code path string true "Tax code, e.g. standard or reduced"
+// @Param jurisdiction path string true "Jurisdiction, e.g. DE or US-CA"
+// @Param rate body domain.SetTaxRateRequest true "Rate in percent"
+// @Success 200 {object} domain.TaxRate
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid code or jurisdiction)"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /tax-rates/{code}/{jurisdiction} [put]
+func (h *TaxHandler) SetTaxRate(c echo.Context) error {
+	code, jurisdiction := c.Param("code"), c.Param("jurisdiction")
+
+	var req domain.SetTaxRateRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("SetTaxRate: Bind error for %s/%s: %v", code, jurisdiction, err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("SetTaxRate: Validation error for %s/%s: %v", code, jurisdiction, err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	rate, err := h.taxService.SetRate(c.Request().Context(), code, jurisdiction, &req)
+	if err != nil {
+		log.Printf("SetTaxRate: Service error for %s/%s: %v", code, jurisdiction, err)
+		return sendTaxError(c, err, "Failed to set tax rate.")
+	}
+	return c.JSON(http.StatusOK, rate)
+}
+
+// DeleteTaxRate godoc
+// @Summary Delete a tax rate
+// @Description Removes the rate of a tax code in a jurisdiction; orders with items under that code can no longer
+// @Description be quoted there
+// @Tags tax
+// @Param code path string true "Tax code"
+// @Param jurisdiction path string true "Jurisdiction"
+// @Success 204 "Successfully deleted (No Content)"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid code or jurisdiction)"
+// @Failure 404 {object} httputil.HTTPError "Not Found"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /tax-rates/{code}/{jurisdiction} [delete]
+func (h *TaxHandler) DeleteTaxRate(c echo.Context) error {
+	code, jurisdiction := c.Param("code"), c.Param("jurisdiction")
+
+	if err := h.taxService.DeleteRate(c.Request().Context(), code, jurisdiction); err != nil {
+		log.Printf("DeleteTaxRate: Service error for %s/%s: %v", code, jurisdiction, err)
+		return sendTaxError(c, err, "Failed to delete tax rate.")
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// AssignItemTaxCode godoc
+// @Summary Set the tax code of an item
+// @Description Sets the tax code an item is taxed under, or puts it back under 'standard' when tax_code is null
+// @Tags items
+// @Accept json
+// @Param X-User-ID header string false "Calling user, recorded in the item history"
+// @Param id path string true "Item ID (UUID)"
+// @Param assignment body domain.AssignTaxCodeRequest true "Tax code of the item"
+// @Success 204 "Successfully assigned (No Content)"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID or tax code)"
+// @Failure 404 {object} httputil.HTTPError "Item not found"
+// @Failure 422 {object} httputil.HTTPError "Unprocessable Entity (validation errors)"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /items/{id}/tax-code [put]
+func (h *TaxHandler) AssignItemTaxCode(c echo.Context) error {
+	id := c.Param("id")
+
+	var req domain.AssignTaxCodeRequest
+	if err := c.Bind(&req); err != nil {
+		log.Printf("AssignItemTaxCode: Bind error for ID %s: %v", id, err)
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid request payload: "+err.Error()))
+	}
+	if err := h.validate.StructCtx(c.Request().Context(), req); err != nil {
+		log.Printf("AssignItemTaxCode: Validation error for ID %s: %v", id, err)
+		return httputil.SendErrorResponse(c, httputil.ValidationError("Input validation failed", ParseValidationErrors(err)))
+	}
+
+	if err := h.taxService.AssignItemTaxCode(c.Request().Context(), id, &req, currentUserID(c)); err != nil {
+		log.Printf("AssignItemTaxCode: Service error for ID %s: %v", id, err)
+		return sendTaxError(c, err, "Failed to set item tax code.")
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// QuoteSalesOrderTax godoc
+// @Summary Quote the tax of a sales order
+// @Description Calculates the tax of a sales order in a jurisdiction, line by line, at the prices stored on the
+// @Description order. Each line is taxed at the rate of its item's tax code. Nothing is stored.
+// @Tags sales
+// @Produce json
+// @Param id path string true "Sales order ID (UUID)"
+// @Param jurisdiction query string true "Jurisdiction, e.g. DE or US-CA"
+// @Success 200 {object} domain.TaxQuote
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID or jurisdiction)"
+// @Failure 404 {object} httputil.HTTPError "Order not found, or a tax code has no rate in the jurisdiction"
+// @Failure 500 {object} httputil.HTTPError "Internal Server Error"
+// @Router /sales-orders/{id}/tax [get]
+func (h *TaxHandler) QuoteSalesOrderTax(c echo.Context) error {
+	id := c.Param("id")
+
+	var query domain.TaxQuoteQuery
+	if httpErr := bindQuery(c, h.validate, &query); httpErr != nil {
+		log.Printf("QuoteSalesOrderTax: Invalid query parameters: %v", httpErr.Details)
+		return httputil.SendErrorResponse(c, httpErr)
+	}
+
+	quote, err := h.taxService.QuoteOrder(c.Request().Context(), id, query.Jurisdiction)
+	if err != nil {
+		log.Printf("QuoteSalesOrderTax: Service error for ID %s: %v", id, err)
+		return sendTaxError(c, err, "Failed to quote sales order tax.")
+	}
+	return c.JSON(http.StatusOK, quote)
+}
+
+// sendTaxError maps tax service errors to HTTP responses.
+func sendTaxError(c echo.Context, err error, fallback string) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidInput), errors.Is(err, domain.ErrInvalidItemID):
+		return httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+	case errors.Is(err, domain.ErrItemNotFound), errors.Is(err, domain.ErrSalesOrderNotFound), errors.Is(err, domain.ErrTaxRateNotFound):
+		return httputil.SendErrorResponse(c, httputil.NotFoundError(err.Error()))
+	}
+	return httputil.SendErrorResponse(c, httputil.InternalServerError(fallback))
+}
@@ -0,0 +1,101 @@
+package handler_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/handler"
+	"inventory-system/internal/mocks"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTaxHandler(t *testing.T) {
+	rate := 19.0
+	reduced := "reduced"
+	cases := []struct {
+		name       string
+		tc         handlerCase
+		route      func(h *handler.TaxHandler) echo.HandlerFunc
+		setup      func(s *mocks.TaxService)
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:  "set rate",
+			tc:    handlerCase{method: http.MethodPut, target: "/api/v1/tax-rates/standard/DE", params: map[string]string{"code": "standard", "jurisdiction": "DE"}, body: `{"rate_percent":19}`},
+			route: func(h *handler.TaxHandler) echo.HandlerFunc { return h.SetTaxRate },
+			setup: func(s *mocks.TaxService) {
+				s.On("SetRate", mock.Anything, "standard", "DE", &domain.SetTaxRateRequest{RatePercent: &rate}).
+					Return(&domain.TaxRate{TaxCode: "standard", Jurisdiction: "DE", RatePercent: rate}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"rate_percent":19`,
+		},
+		{
+			name:       "set rate without rate",
+			tc:         handlerCase{method: http.MethodPut, target: "/api/v1/tax-rates/standard/DE", params: map[string]string{"code": "standard", "jurisdiction": "DE"}, body: `{}`},
+			route:      func(h *handler.TaxHandler) echo.HandlerFunc { return h.SetTaxRate },
+			wantStatus: http.StatusUnprocessableEntity, wantBody: "Input validation failed",
+		},
+		{
+			name:  "delete missing rate",
+			tc:    handlerCase{method: http.MethodDelete, target: "/api/v1/tax-rates/reduced/FR", params: map[string]string{"code": "reduced", "jurisdiction": "FR"}},
+			route: func(h *handler.TaxHandler) echo.HandlerFunc { return h.DeleteTaxRate },
+			setup: func(s *mocks.TaxService) {
+				s.On("DeleteRate", mock.Anything, "reduced", "FR").Return(domain.ErrTaxRateNotFound)
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:  "assign item tax code",
+			tc:    handlerCase{method: http.MethodPut, target: "/api/v1/items/" + itemID + "/tax-code", id: itemID, body: `{"tax_code":"reduced"}`},
+			route: func(h *handler.TaxHandler) echo.HandlerFunc { return h.AssignItemTaxCode },
+			setup: func(s *mocks.TaxService) {
+				s.On("AssignItemTaxCode", mock.Anything, itemID, &domain.AssignTaxCodeRequest{TaxCode: &reduced}, "").Return(nil)
+			},
+			wantStatus: http.StatusNoContent,
+		},
+		{
+			name:  "quote order",
+			tc:    handlerCase{method: http.MethodGet, target: "/api/v1/sales-orders/" + salesOrderID + "/tax?jurisdiction=DE", id: salesOrderID},
+			route: func(h *handler.TaxHandler) echo.HandlerFunc { return h.QuoteSalesOrderTax },
+			setup: func(s *mocks.TaxService) {
+				s.On("QuoteOrder", mock.Anything, salesOrderID, "DE").Return(&domain.TaxQuote{OrderID: salesOrderID, Jurisdiction: "DE",
+					Provider: "flat-rate", Net: 100, Tax: 19, Gross: 119}, nil)
+			},
+			wantStatus: http.StatusOK, wantBody: `"gross":119`,
+		},
+		{
+			name:       "quote without jurisdiction",
+			tc:         handlerCase{method: http.MethodGet, target: "/api/v1/sales-orders/" + salesOrderID + "/tax", id: salesOrderID},
+			route:      func(h *handler.TaxHandler) echo.HandlerFunc { return h.QuoteSalesOrderTax },
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "quote with unrated tax code",
+			tc:    handlerCase{method: http.MethodGet, target: "/api/v1/sales-orders/" + salesOrderID + "/tax?jurisdiction=US-CA", id: salesOrderID},
+			route: func(h *handler.TaxHandler) echo.HandlerFunc { return h.QuoteSalesOrderTax },
+			setup: func(s *mocks.TaxService) {
+				s.On("QuoteOrder", mock.Anything, salesOrderID, "US-CA").
+					Return(nil, fmt.Errorf("%w: 'standard' in 'US-CA'", domain.ErrTaxRateNotFound))
+			},
+			wantStatus: http.StatusNotFound, wantBody: "no tax rate",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := mocks.NewTaxService(t)
+			if tc.setup != nil {
+				tc.setup(svc)
+			}
+			rec := serve(t, tc.tc, tc.route(handler.NewTaxHandler(svc)))
+
+			assert.Equal(t, tc.wantStatus, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tc.wantBody)
+		})
+	}
+}
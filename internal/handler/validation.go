@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"log"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// newValidator creates a validator with the application's custom rules registered.
+// Every handler that validates request bodies should use this so rules stay consistent.
+func newValidator() *validator.Validate {
+	validate := validator.New()
+
+	err := validate.RegisterValidation("alphanumdash", validateAlphaNumDash)
+	if err != nil {
+		// This is a critical setup error, so we stop here.
+		// The server will fail to start, which is what we want if validation can't be set up.
+		log.Fatalf("Failed to register custom validation: %v", err)
+	}
+	return validate
+}
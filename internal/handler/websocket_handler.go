@@ -2,9 +2,12 @@ package handler
 
 import (
 	"log"
+	"net/http"
 
 	"inventory-system/internal/realtime" // Our WebSocket hub
+	"inventory-system/pkg/httputil"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
 
@@ -22,15 +25,56 @@ func NewWebSocketHandler(hub *realtime.Hub) *WebSocketHandler {
 // It uses the ServeWsUpgrade helper from the realtime package.
 // @Summary Establish WebSocket connection for stock updates
 // @Description Upgrades HTTP GET request to a WebSocket connection.
+// @Description Every client receives the same broadcasts. Clients may name their user (X-User-ID header, or user_id
+// @Description query parameter since browsers cannot set headers on WebSocket requests) to appear in item presence and
+// @Description refresh their edit locks; as it is not authenticated, nothing is addressed to that user.
+// @Description Messages are JSON text frames by default; clients may request MessagePack binary frames
+// @Description (same documents, smaller) with the Sec-WebSocket-Protocol header "inventory.v1.msgpack".
 // @Tags websockets
+// @Param user_id query string false "Calling user, for presence (alternative to the X-User-ID header)"
 // @Router /ws/stock-updates [get]
 func (h *WebSocketHandler) HandleConnections(c echo.Context) error {
 	log.Printf("Incoming WebSocket connection request from: %s", c.Request().RemoteAddr)
+
+	userID := currentUserID(c)
+	if userID == "" {
+		userID = c.QueryParam("user_id")
+	}
+
 	// The ServeWsUpgrade function from realtime package handles the upgrade
 	// and client registration with the hub.
-	realtime.ServeWsUpgrade(h.hub, c.Response().Writer, c.Request())
+	realtime.ServeWsUpgrade(h.hub, c.Response().Writer, c.Request(), userID)
 	// ServeWsUpgrade doesn't return an error in a way Echo expects for its chain,
 	// as it takes over the connection. If it fails, it logs and writes an HTTP error itself.
 	// So, we typically return nil here to Echo, indicating the handler has managed the response.
 	return nil
 }
+
+// GetItemPresence godoc
+// @Summary Get who is on an item
+// @Description Lists users currently viewing or editing an item, as reported by their WebSocket clients
+// @Tags websockets
+// @Produce json
+// @Param id path string true "Item ID (UUID)"
+// @Success 200 {array} domain.PresenceEntry "Users on the item"
+// @Failure 400 {object} httputil.HTTPError "Bad Request (invalid ID format)"
+// @Router /items/{id}/presence [get]
+func (h *WebSocketHandler) GetItemPresence(c echo.Context) error {
+	id := c.Param("id")
+	if _, err := uuid.Parse(id); err != nil {
+		return httputil.SendErrorResponse(c, httputil.BadRequestError("Invalid item ID format."))
+	}
+	return c.JSON(http.StatusOK, h.hub.ItemPresence(id))
+}
+
+// ListClients godoc
+// @Summary List WebSocket clients
+// @Description Lists open WebSocket connections with their send queue depth and heartbeat round-trip latency,
+// @Description to diagnose clients on slow networks
+// @Tags admin
+// @Produce json
+// @Success 200 {array} domain.WebSocketClient "Open connections, oldest first"
+// @Router /admin/ws-clients [get]
+func (h *WebSocketHandler) ListClients(c echo.Context) error {
+	return c.JSON(http.StatusOK, h.hub.Clients())
+}
@@ -0,0 +1,158 @@
+package middleware
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"inventory-system/pkg/httputil"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ChaosRule describes the faults to inject for requests matching Method and Path.
+// It is meant for development and staging only, so frontends can exercise their
+// error handling and WebSocket reconnect logic.
+type ChaosRule struct {
+	Method string `json:"method,omitempty"` // Empty matches any method
+	// Path is matched against the Echo route template (e.g. "/api/v1/items/:id").
+	// A trailing "*" matches by prefix; "*" alone matches everything.
+	Path string `json:"path"`
+
+	Latency     Duration `json:"latency,omitempty"`      // Maximum added delay; the actual delay is random up to this
+	LatencyRate float64  `json:"latency_rate,omitempty"` // Fraction of matching requests that are delayed
+
+	ErrorRate   float64 `json:"error_rate,omitempty"`   // Fraction of matching requests that fail
+	ErrorStatus int     `json:"error_status,omitempty"` // Status for injected errors (default 503)
+
+	DropRate  float64  `json:"drop_rate,omitempty"`  // Fraction of hijacked (WebSocket) connections that get dropped
+	DropAfter Duration `json:"drop_after,omitempty"` // Dropped connections are closed after a random delay up to this (default 30s)
+}
+
+// Duration is a time.Duration that unmarshals from strings like "250ms".
+type Duration time.Duration
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("duration must be a string like \"250ms\": %w", err)
+	}
+	parsed, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(parsed)
+	return nil
+}
+
+// LoadChaosRules reads a JSON array of ChaosRule from path.
+func LoadChaosRules(path string) ([]ChaosRule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("chaos: failed to read %s: %w", path, err)
+	}
+	var rules []ChaosRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("chaos: failed to parse %s: %w", path, err)
+	}
+	return rules, nil
+}
+
+// Chaos returns middleware that injects faults according to rules.
+// The first matching rule wins.
+func Chaos(rules []ChaosRule) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			rule := matchChaosRule(rules, c.Request().Method, c.Path())
+			if rule == nil {
+				return next(c)
+			}
+
+			if rule.Latency > 0 && chance(rule.LatencyRate) {
+				delay := time.Duration(rand.Int64N(int64(rule.Latency)) + 1)
+				c.Response().Header().Add("X-Chaos", "latency="+delay.String())
+				select {
+				case <-time.After(delay):
+				case <-c.Request().Context().Done():
+					return c.Request().Context().Err()
+				}
+			}
+
+			if chance(rule.ErrorRate) {
+				status := rule.ErrorStatus
+				if status == 0 {
+					status = http.StatusServiceUnavailable
+				}
+				c.Response().Header().Add("X-Chaos", "error")
+				return httputil.NewHTTPErrorWithCode(status, "CHAOS_INJECTED", "Fault injected by chaos middleware.")
+			}
+
+			if chance(rule.DropRate) {
+				dropAfter := time.Duration(rule.DropAfter)
+				if dropAfter <= 0 {
+					dropAfter = 30 * time.Second
+				}
+				c.Response().Writer = &droppingWriter{ResponseWriter: c.Response().Writer, maxDelay: dropAfter}
+			}
+
+			return next(c)
+		}
+	}
+}
+
+func matchChaosRule(rules []ChaosRule, method, routePath string) *ChaosRule {
+	for i := range rules {
+		r := &rules[i]
+		if r.Method != "" && !strings.EqualFold(r.Method, method) {
+			continue
+		}
+		if prefix, ok := strings.CutSuffix(r.Path, "*"); ok {
+			if strings.HasPrefix(routePath, prefix) {
+				return r
+			}
+		} else if r.Path == routePath {
+			return r
+		}
+	}
+	return nil
+}
+
+func chance(rate float64) bool {
+	return rate > 0 && rand.Float64() < rate
+}
+
+// droppingWriter closes a hijacked connection (i.e. a WebSocket) after a random delay,
+// simulating a flaky network without the server noticing beforehand.
+type droppingWriter struct {
+	http.ResponseWriter
+	maxDelay time.Duration
+}
+
+// Hijack implements http.Hijacker and schedules the connection to be dropped.
+func (w *droppingWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hj, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("chaos: underlying response writer does not support hijacking")
+	}
+	conn, rw, err := hj.Hijack()
+	if err != nil {
+		return nil, nil, err
+	}
+	delay := time.Duration(rand.Int64N(int64(w.maxDelay)) + 1)
+	log.Printf("Chaos: dropping connection %s in %s", conn.RemoteAddr(), delay)
+	time.AfterFunc(delay, func() { conn.Close() })
+	return conn, rw, nil
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (w *droppingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
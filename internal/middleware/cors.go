@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+// CORSRule is the cross-origin policy for the requests under a path prefix.
+type CORSRule struct {
+	Prefix           string        // e.g. "/admin"; "" matches every path no other rule claims
+	AllowOrigins     []string      // "*" allows any origin; empty allows no cross-origin requests at all
+	AllowCredentials bool          // Let browsers send cookies and auth headers cross-origin
+	MaxAge           time.Duration // How long browsers may cache a preflight response
+}
+
+// GroupCORS applies, to each request, the rule with the longest prefix matching its path.
+// It must be installed with Echo.Use rather than on routes: preflight requests use the
+// OPTIONS method, which no route is registered for.
+func GroupCORS(rules []CORSRule, allowMethods, allowHeaders []string) echo.MiddlewareFunc {
+	type compiled struct {
+		prefix string
+		cors   echo.MiddlewareFunc // nil: no CORS headers, so browsers refuse cross-origin access
+	}
+	var table []compiled
+	for _, r := range rules {
+		entry := compiled{prefix: strings.TrimSuffix(r.Prefix, "/")}
+		if len(r.AllowOrigins) > 0 {
+			entry.cors = middleware.CORSWithConfig(middleware.CORSConfig{
+				AllowOrigins:     r.AllowOrigins,
+				AllowMethods:     allowMethods,
+				AllowHeaders:     allowHeaders,
+				AllowCredentials: r.AllowCredentials,
+				MaxAge:           int(r.MaxAge.Seconds()),
+			})
+		}
+		table = append(table, entry)
+	}
+	sort.SliceStable(table, func(i, j int) bool { return len(table[i].prefix) > len(table[j].prefix) })
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			path := c.Request().URL.Path
+			for _, entry := range table {
+				if entry.prefix != "" && path != entry.prefix && !strings.HasPrefix(path, entry.prefix+"/") {
+					continue
+				}
+				if entry.cors == nil {
+					return next(c)
+				}
+				return entry.cors(next)(c)
+			}
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestGroupCORS(t *testing.T) {
+	e := echo.New()
+	e.Use(GroupCORS([]CORSRule{
+		{Prefix: "", AllowOrigins: []string{"*"}, MaxAge: time.Hour},
+		{Prefix: "/api/v1", AllowOrigins: []string{"https://app.example.com"}, AllowCredentials: true, MaxAge: 10 * time.Minute},
+		{Prefix: "/admin"}, // No cross-origin access
+	}, []string{http.MethodGet, http.MethodPost}, []string{echo.HeaderContentType}))
+	ok := func(c echo.Context) error { return c.NoContent(http.StatusOK) }
+	e.GET("/healthz", ok)
+	e.POST("/api/v1/items", ok)
+	e.GET("/admin/config", ok)
+	e.GET("/api/v1x", ok) // Shares a string prefix with /api/v1 but is not under it
+
+	tests := []struct {
+		name            string
+		method, path    string
+		origin          string
+		wantOrigin      string
+		wantCredentials string
+		wantMaxAge      string
+	}{
+		{"api preflight from allowed origin", http.MethodOptions, "/api/v1/items", "https://app.example.com", "https://app.example.com", "true", "600"},
+		{"api preflight from other origin", http.MethodOptions, "/api/v1/items", "https://evil.example.com", "", "", ""},
+		{"admin preflight", http.MethodOptions, "/admin/config", "https://app.example.com", "", "", ""},
+		{"admin request", http.MethodGet, "/admin/config", "https://app.example.com", "", "", ""},
+		{"public request", http.MethodGet, "/healthz", "https://anyone.example.com", "*", "", ""},
+		{"public preflight", http.MethodOptions, "/healthz", "https://anyone.example.com", "*", "", "3600"},
+		{"prefix is matched per segment", http.MethodGet, "/api/v1x", "https://anyone.example.com", "*", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set(echo.HeaderOrigin, tt.origin)
+			if tt.method == http.MethodOptions {
+				req.Header.Set(echo.HeaderAccessControlRequestMethod, http.MethodPost)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			h := rec.Header()
+			if got := h.Get(echo.HeaderAccessControlAllowOrigin); got != tt.wantOrigin {
+				t.Errorf("Access-Control-Allow-Origin = %q, want %q", got, tt.wantOrigin)
+			}
+			if got := h.Get(echo.HeaderAccessControlAllowCredentials); got != tt.wantCredentials {
+				t.Errorf("Access-Control-Allow-Credentials = %q, want %q", got, tt.wantCredentials)
+			}
+			if got := h.Get(echo.HeaderAccessControlMaxAge); got != tt.wantMaxAge {
+				t.Errorf("Access-Control-Max-Age = %q, want %q", got, tt.wantMaxAge)
+			}
+		})
+	}
+}
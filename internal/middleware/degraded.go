@@ -0,0 +1,179 @@
+package middleware
+
+import (
+	"bytes"
+	"container/list"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"inventory-system/internal/handler"
+	"inventory-system/pkg/httputil"
+
+	"github.com/labstack/echo/v4"
+)
+
+// HealthChecker reports whether the primary database is reachable.
+type HealthChecker interface {
+	Healthy() bool
+}
+
+// DegradedModeConfig configures DegradedMode.
+type DegradedModeConfig struct {
+	Health       HealthChecker
+	CacheSize    int           // Maximum number of GET responses kept for degraded reads
+	MaxBodyBytes int           // Larger responses are not kept; defaults to 1 MiB
+	RetryAfter   time.Duration // Suggested client back-off while writes are refused
+	Skip         func(c echo.Context) bool
+}
+
+// DegradedMode keeps the API partially available while the database is down.
+//
+// While the database is healthy it remembers the latest successful response of every
+// GET request (per URL and calling user). During an outage those responses are replayed
+// with a "Warning: 110" (stale) header, reads without a remembered response and all
+// writes are refused with 503 and Retry-After, and everything returns to normal as soon
+// as the health checker reports the database back. Responses above MaxBodyBytes, and
+// streamed responses, which flush as they go, are passed through without being kept.
+func DegradedMode(cfg DegradedModeConfig) echo.MiddlewareFunc {
+	if cfg.MaxBodyBytes <= 0 {
+		cfg.MaxBodyBytes = 1 << 20
+	}
+	cache := newStaleCache(cfg.CacheSize)
+	retryAfter := strconv.Itoa(int(cfg.RetryAfter.Round(time.Second).Seconds()))
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			if (cfg.Skip != nil && cfg.Skip(c)) || req.Header.Get(echo.HeaderUpgrade) != "" {
+				return next(c) // WebSocket upgrades hijack the connection; never wrap them
+			}
+			// Keyed by caller too, so per-user resources (e.g. /me/...) never leak between users.
+			key := req.URL.RequestURI() + "\x00" + req.Header.Get(handler.HeaderUserID)
+			isRead := req.Method == http.MethodGet || req.Method == http.MethodHead || req.Method == http.MethodOptions
+
+			if cfg.Health.Healthy() {
+				if req.Method != http.MethodGet {
+					return next(c)
+				}
+				rec := &recordingWriter{ResponseWriter: c.Response().Writer, limit: cfg.MaxBodyBytes}
+				c.Response().Writer = rec
+				err := next(c)
+				if err == nil && c.Response().Status == http.StatusOK && !rec.dropped {
+					cache.put(key, cachedResponse{
+						contentType: c.Response().Header().Get(echo.HeaderContentType),
+						body:        rec.body.Bytes(),
+						storedAt:    time.Now(),
+					})
+				}
+				return err
+			}
+
+			c.Response().Header().Set(echo.HeaderRetryAfter, retryAfter)
+			if !isRead {
+				return httputil.SendErrorResponse(c, httputil.NewHTTPErrorWithCode(http.StatusServiceUnavailable,
+					"SERVICE_DEGRADED", "The database is temporarily unavailable; the API is read-only. Please retry later."))
+			}
+			cached, ok := cache.get(key)
+			if !ok {
+				return httputil.SendErrorResponse(c, httputil.NewHTTPErrorWithCode(http.StatusServiceUnavailable,
+					"SERVICE_DEGRADED", "The database is temporarily unavailable and no cached copy of this resource exists."))
+			}
+			h := c.Response().Header()
+			h.Set("Warning", `110 - "Response is Stale"`)
+			h.Set("Age", strconv.Itoa(int(time.Since(cached.storedAt).Seconds())))
+			return c.Blob(http.StatusOK, cached.contentType, cached.body)
+		}
+	}
+}
+
+// recordingWriter copies the response body while passing it through. It stops copying,
+// and drops what it has, once the body outgrows limit or the response is flushed.
+type recordingWriter struct {
+	http.ResponseWriter
+	body    bytes.Buffer
+	limit   int
+	dropped bool
+}
+
+func (w *recordingWriter) Write(b []byte) (int, error) {
+	if !w.dropped {
+		if w.body.Len()+len(b) > w.limit {
+			w.drop()
+		} else {
+			w.body.Write(b)
+		}
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// FlushError is used by http.ResponseController, and so by echo.Response.Flush. Only
+// streams flush, and they are never kept.
+func (w *recordingWriter) FlushError() error {
+	w.drop()
+	return http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+func (w *recordingWriter) drop() {
+	w.dropped = true
+	w.body = bytes.Buffer{}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (w *recordingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+type cachedResponse struct {
+	contentType string
+	body        []byte
+	storedAt    time.Time
+}
+
+// staleCache is a small LRU of the latest response per key.
+type staleCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List // Front is most recently used; values are keys
+	entries map[string]*list.Element
+	values  map[string]cachedResponse
+}
+
+func newStaleCache(size int) *staleCache {
+	if size < 1 {
+		size = 1
+	}
+	return &staleCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+		values:  make(map[string]cachedResponse),
+	}
+}
+
+func (s *staleCache) put(key string, v cachedResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if el, ok := s.entries[key]; ok {
+		s.order.MoveToFront(el)
+	} else {
+		s.entries[key] = s.order.PushFront(key)
+		if s.order.Len() > s.size {
+			oldest := s.order.Remove(s.order.Back()).(string)
+			delete(s.entries, oldest)
+			delete(s.values, oldest)
+		}
+	}
+	s.values[key] = v
+}
+
+func (s *staleCache) get(key string) (cachedResponse, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.values[key]
+	if ok {
+		s.order.MoveToFront(s.entries[key])
+	}
+	return v, ok
+}
@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type fakeHealth struct{ down atomic.Bool }
+
+func (f *fakeHealth) Healthy() bool { return !f.down.Load() }
+
+func TestDegradedMode(t *testing.T) {
+	health := &fakeHealth{}
+	calls := 0
+
+	e := echo.New()
+	e.Use(DegradedMode(DegradedModeConfig{Health: health, CacheSize: 10, RetryAfter: 5 * time.Second}))
+	e.GET("/items", func(c echo.Context) error {
+		calls++
+		return c.JSON(http.StatusOK, map[string]int{"calls": calls})
+	})
+	e.POST("/items", func(c echo.Context) error { return c.NoContent(http.StatusCreated) })
+
+	do := func(method, path, user string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		if user != "" {
+			req.Header.Set("X-User-ID", user)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// Healthy: requests pass through and successful reads are remembered.
+	if rec := do(http.MethodGet, "/items", "alice"); rec.Code != http.StatusOK || rec.Header().Get("Warning") != "" {
+		t.Fatalf("healthy GET = %d %q", rec.Code, rec.Header().Get("Warning"))
+	}
+	if rec := do(http.MethodPost, "/items", "alice"); rec.Code != http.StatusCreated {
+		t.Fatalf("healthy POST = %d", rec.Code)
+	}
+
+	health.down.Store(true)
+
+	rec := do(http.MethodGet, "/items", "alice")
+	if rec.Code != http.StatusOK || rec.Header().Get("Warning") == "" || rec.Body.String() != `{"calls":1}`+"\n" {
+		t.Errorf("degraded cached GET = %d %q %s", rec.Code, rec.Header().Get("Warning"), rec.Body)
+	}
+	if rec := do(http.MethodGet, "/items", "bob"); rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("degraded GET cached for another user = %d, want 503", rec.Code)
+	}
+	rec = do(http.MethodPost, "/items", "alice")
+	if rec.Code != http.StatusServiceUnavailable || rec.Header().Get("Retry-After") != "5" {
+		t.Errorf("degraded POST = %d Retry-After %q", rec.Code, rec.Header().Get("Retry-After"))
+	}
+	if calls != 1 {
+		t.Errorf("handler called %d times while degraded", calls-1)
+	}
+
+	health.down.Store(false)
+
+	if rec := do(http.MethodGet, "/items", "alice"); rec.Code != http.StatusOK || rec.Body.String() != `{"calls":2}`+"\n" {
+		t.Errorf("recovered GET = %d %s", rec.Code, rec.Body)
+	}
+}
+
+func TestDegradedModeKeepsNoLargeOrStreamedResponses(t *testing.T) {
+	health := &fakeHealth{}
+
+	e := echo.New()
+	e.Use(DegradedMode(DegradedModeConfig{Health: health, CacheSize: 10, MaxBodyBytes: 16}))
+	e.GET("/small", func(c echo.Context) error { return c.String(http.StatusOK, "ok") })
+	e.GET("/large", func(c echo.Context) error { return c.String(http.StatusOK, strings.Repeat("x", 17)) })
+	e.GET("/stream", func(c echo.Context) error {
+		c.Response().WriteHeader(http.StatusOK)
+		_, _ = c.Response().Write([]byte("a\n"))
+		c.Response().Flush()
+		_, err := c.Response().Write([]byte("b\n"))
+		return err
+	})
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+	for _, path := range []string{"/small", "/large", "/stream"} {
+		if rec := get(path); rec.Code != http.StatusOK {
+			t.Fatalf("healthy GET %s = %d", path, rec.Code)
+		}
+	}
+	if rec := get("/stream"); rec.Body.String() != "a\nb\n" {
+		t.Errorf("streamed body = %q, want it passed through whole", rec.Body)
+	}
+
+	health.down.Store(true)
+
+	if rec := get("/small"); rec.Code != http.StatusOK || rec.Body.String() != "ok" {
+		t.Errorf("degraded small GET = %d %q, want the cached copy", rec.Code, rec.Body)
+	}
+	for _, path := range []string{"/large", "/stream"} {
+		if rec := get(path); rec.Code != http.StatusServiceUnavailable {
+			t.Errorf("degraded GET %s = %d, want 503 as it was never kept", path, rec.Code)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"context"
+
+	"inventory-system/internal/handler"
+	"inventory-system/pkg/httputil"
+
+	"github.com/labstack/echo/v4"
+)
+
+// FeatureChecker reports whether a feature is on for a subject (see domain.FeatureFlagService).
+type FeatureChecker interface {
+	Enabled(ctx context.Context, key, subject string) bool
+}
+
+// RequireFeature hides the routes it wraps behind a feature flag, evaluated for the calling
+// user. While the feature is off for the caller the routes answer 404, as if they did not exist.
+func RequireFeature(flags FeatureChecker, key string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !flags.Enabled(c.Request().Context(), key, c.Request().Header.Get(handler.HeaderUserID)) {
+				return httputil.SendErrorResponse(c, httputil.NotFoundError("Not found."))
+			}
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+type fakeFlags map[string]bool // Subject -> enabled, for a single flag
+
+func (f fakeFlags) Enabled(_ context.Context, key, subject string) bool {
+	return key == "beta" && f[subject]
+}
+
+func TestRequireFeature(t *testing.T) {
+	e := echo.New()
+	e.GET("/beta", func(c echo.Context) error { return c.NoContent(http.StatusOK) },
+		RequireFeature(fakeFlags{"alice": true}, "beta"))
+
+	for user, want := range map[string]int{"alice": http.StatusOK, "bob": http.StatusNotFound, "": http.StatusNotFound} {
+		req := httptest.NewRequest(http.MethodGet, "/beta", nil)
+		if user != "" {
+			req.Header.Set("X-User-ID", user)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		if rec.Code != want {
+			t.Errorf("user %q: status = %d, want %d", user, rec.Code, want)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Package middleware contains application-specific Echo middleware.
+package middleware
+
+import (
+	"encoding/json"
+	"math/rand/v2"
+	"os"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	echomw "github.com/labstack/echo/v4/middleware"
+)
+
+// RequestLogConfig controls which requests get logged.
+type RequestLogConfig struct {
+	// SampleRate is the fraction (0..1) of successful requests to log.
+	// Client/server errors and slow requests are always logged.
+	SampleRate float64
+	// SlowThreshold marks requests at or above this latency as slow; zero disables the check.
+	SlowThreshold time.Duration
+	// Current, if set, is consulted on every request instead of the fields above,
+	// so the settings can change at runtime.
+	Current func() RequestLogConfig
+}
+
+// requestLogLine mirrors the JSON format the server has always used for access logs,
+// so log pipelines keep working when sampling is enabled.
+type requestLogLine struct {
+	Time         string `json:"time"`
+	ID           string `json:"id"`
+	RemoteIP     string `json:"remote_ip"`
+	Host         string `json:"host"`
+	Method       string `json:"method"`
+	URI          string `json:"uri"`
+	UserAgent    string `json:"user_agent"`
+	Status       int    `json:"status"`
+	Error        string `json:"error"`
+	Latency      int64  `json:"latency"`
+	LatencyHuman string `json:"latency_human"`
+	BytesIn      string `json:"bytes_in"`
+	BytesOut     int64  `json:"bytes_out"`
+	Sampled      bool   `json:"sampled,omitempty"` // True when the line was kept by sampling rather than always-log rules
+}
+
+// SampledRequestLogger logs every failed or slow request and a sample of the rest.
+func SampledRequestLogger(cfg RequestLogConfig) echo.MiddlewareFunc {
+	enc := json.NewEncoder(os.Stdout)
+
+	return echomw.RequestLoggerWithConfig(echomw.RequestLoggerConfig{
+		LogLatency:       true,
+		LogRemoteIP:      true,
+		LogHost:          true,
+		LogMethod:        true,
+		LogURI:           true,
+		LogUserAgent:     true,
+		LogStatus:        true,
+		LogError:         true,
+		LogRequestID:     true,
+		LogContentLength: true,
+		LogResponseSize:  true,
+		HandleError:      true, // Let the error handler set the final status before we log it
+		LogValuesFunc: func(c echo.Context, v echomw.RequestLoggerValues) error {
+			lc := cfg
+			if cfg.Current != nil {
+				lc = cfg.Current()
+			}
+			important := v.Status >= 400 || v.Error != nil ||
+				(lc.SlowThreshold > 0 && v.Latency >= lc.SlowThreshold)
+			sampled := false
+			if !important {
+				if lc.SampleRate <= 0 || (lc.SampleRate < 1 && rand.Float64() >= lc.SampleRate) {
+					return nil
+				}
+				sampled = lc.SampleRate < 1
+			}
+
+			line := requestLogLine{
+				Time:         v.StartTime.Format(time.RFC3339Nano),
+				ID:           v.RequestID,
+				RemoteIP:     v.RemoteIP,
+				Host:         v.Host,
+				Method:       v.Method,
+				URI:          v.URI,
+				UserAgent:    v.UserAgent,
+				Status:       v.Status,
+				Latency:      v.Latency.Nanoseconds(),
+				LatencyHuman: v.Latency.String(),
+				BytesIn:      v.ContentLength,
+				BytesOut:     v.ResponseSize,
+				Sampled:      sampled,
+			}
+			if v.Error != nil {
+				line.Error = v.Error.Error()
+			}
+			return enc.Encode(line)
+		},
+	})
+}
@@ -0,0 +1,211 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "inventory-system/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AnalyticsService is an autogenerated mock type for the AnalyticsService type
+type AnalyticsService struct {
+	mock.Mock
+}
+
+type AnalyticsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AnalyticsService) EXPECT() *AnalyticsService_Expecter {
+	return &AnalyticsService_Expecter{mock: &_m.Mock}
+}
+
+// CalculateTotalStockValue provides a mock function with given fields: ctx
+func (_m *AnalyticsService) CalculateTotalStockValue(ctx context.Context) (float64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CalculateTotalStockValue")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (float64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) float64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnalyticsService_CalculateTotalStockValue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CalculateTotalStockValue'
+type AnalyticsService_CalculateTotalStockValue_Call struct {
+	*mock.Call
+}
+
+// CalculateTotalStockValue is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AnalyticsService_Expecter) CalculateTotalStockValue(ctx interface{}) *AnalyticsService_CalculateTotalStockValue_Call {
+	return &AnalyticsService_CalculateTotalStockValue_Call{Call: _e.mock.On("CalculateTotalStockValue", ctx)}
+}
+
+func (_c *AnalyticsService_CalculateTotalStockValue_Call) Run(run func(ctx context.Context)) *AnalyticsService_CalculateTotalStockValue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AnalyticsService_CalculateTotalStockValue_Call) Return(_a0 float64, _a1 error) *AnalyticsService_CalculateTotalStockValue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnalyticsService_CalculateTotalStockValue_Call) RunAndReturn(run func(context.Context) (float64, error)) *AnalyticsService_CalculateTotalStockValue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListLowStockItems provides a mock function with given fields: ctx, globalThreshold
+func (_m *AnalyticsService) ListLowStockItems(ctx context.Context, globalThreshold int) ([]*domain.Item, error) {
+	ret := _m.Called(ctx, globalThreshold)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLowStockItems")
+	}
+
+	var r0 []*domain.Item
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*domain.Item, error)); ok {
+		return rf(ctx, globalThreshold)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*domain.Item); ok {
+		r0 = rf(ctx, globalThreshold)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Item)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, globalThreshold)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnalyticsService_ListLowStockItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLowStockItems'
+type AnalyticsService_ListLowStockItems_Call struct {
+	*mock.Call
+}
+
+// ListLowStockItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - globalThreshold int
+func (_e *AnalyticsService_Expecter) ListLowStockItems(ctx interface{}, globalThreshold interface{}) *AnalyticsService_ListLowStockItems_Call {
+	return &AnalyticsService_ListLowStockItems_Call{Call: _e.mock.On("ListLowStockItems", ctx, globalThreshold)}
+}
+
+func (_c *AnalyticsService_ListLowStockItems_Call) Run(run func(ctx context.Context, globalThreshold int)) *AnalyticsService_ListLowStockItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *AnalyticsService_ListLowStockItems_Call) Return(_a0 []*domain.Item, _a1 error) *AnalyticsService_ListLowStockItems_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnalyticsService_ListLowStockItems_Call) RunAndReturn(run func(context.Context, int) ([]*domain.Item, error)) *AnalyticsService_ListLowStockItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMostValuableItems provides a mock function with given fields: ctx, limit
+func (_m *AnalyticsService) ListMostValuableItems(ctx context.Context, limit int) ([]*domain.Item, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMostValuableItems")
+	}
+
+	var r0 []*domain.Item
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*domain.Item, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*domain.Item); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Item)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnalyticsService_ListMostValuableItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMostValuableItems'
+type AnalyticsService_ListMostValuableItems_Call struct {
+	*mock.Call
+}
+
+// ListMostValuableItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *AnalyticsService_Expecter) ListMostValuableItems(ctx interface{}, limit interface{}) *AnalyticsService_ListMostValuableItems_Call {
+	return &AnalyticsService_ListMostValuableItems_Call{Call: _e.mock.On("ListMostValuableItems", ctx, limit)}
+}
+
+func (_c *AnalyticsService_ListMostValuableItems_Call) Run(run func(ctx context.Context, limit int)) *AnalyticsService_ListMostValuableItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *AnalyticsService_ListMostValuableItems_Call) Return(_a0 []*domain.Item, _a1 error) *AnalyticsService_ListMostValuableItems_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnalyticsService_ListMostValuableItems_Call) RunAndReturn(run func(context.Context, int) ([]*domain.Item, error)) *AnalyticsService_ListMostValuableItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAnalyticsService creates a new instance of AnalyticsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAnalyticsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AnalyticsService {
+	mock := &AnalyticsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,216 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "inventory-system/internal/domain"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AnomalyService is an autogenerated mock type for the AnomalyService type
+type AnomalyService struct {
+	mock.Mock
+}
+
+type AnomalyService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AnomalyService) EXPECT() *AnomalyService_Expecter {
+	return &AnomalyService_Expecter{mock: &_m.Mock}
+}
+
+// Acknowledge provides a mock function with given fields: ctx, id, userID
+func (_m *AnomalyService) Acknowledge(ctx context.Context, id string, userID string) (*domain.Anomaly, error) {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Acknowledge")
+	}
+
+	var r0 *domain.Anomaly
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.Anomaly, error)); ok {
+		return rf(ctx, id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.Anomaly); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Anomaly)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnomalyService_Acknowledge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Acknowledge'
+type AnomalyService_Acknowledge_Call struct {
+	*mock.Call
+}
+
+// Acknowledge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *AnomalyService_Expecter) Acknowledge(ctx interface{}, id interface{}, userID interface{}) *AnomalyService_Acknowledge_Call {
+	return &AnomalyService_Acknowledge_Call{Call: _e.mock.On("Acknowledge", ctx, id, userID)}
+}
+
+func (_c *AnomalyService_Acknowledge_Call) Run(run func(ctx context.Context, id string, userID string)) *AnomalyService_Acknowledge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AnomalyService_Acknowledge_Call) Return(_a0 *domain.Anomaly, _a1 error) *AnomalyService_Acknowledge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnomalyService_Acknowledge_Call) RunAndReturn(run func(context.Context, string, string) (*domain.Anomaly, error)) *AnomalyService_Acknowledge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Detect provides a mock function with given fields: ctx, now
+func (_m *AnomalyService) Detect(ctx context.Context, now time.Time) (*domain.AnomalyDetectionResult, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Detect")
+	}
+
+	var r0 *domain.AnomalyDetectionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (*domain.AnomalyDetectionResult, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) *domain.AnomalyDetectionResult); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.AnomalyDetectionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnomalyService_Detect_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Detect'
+type AnomalyService_Detect_Call struct {
+	*mock.Call
+}
+
+// Detect is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *AnomalyService_Expecter) Detect(ctx interface{}, now interface{}) *AnomalyService_Detect_Call {
+	return &AnomalyService_Detect_Call{Call: _e.mock.On("Detect", ctx, now)}
+}
+
+func (_c *AnomalyService_Detect_Call) Run(run func(ctx context.Context, now time.Time)) *AnomalyService_Detect_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *AnomalyService_Detect_Call) Return(_a0 *domain.AnomalyDetectionResult, _a1 error) *AnomalyService_Detect_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnomalyService_Detect_Call) RunAndReturn(run func(context.Context, time.Time) (*domain.AnomalyDetectionResult, error)) *AnomalyService_Detect_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAnomalies provides a mock function with given fields: ctx, q
+func (_m *AnomalyService) ListAnomalies(ctx context.Context, q domain.ListAnomaliesQuery) ([]*domain.Anomaly, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAnomalies")
+	}
+
+	var r0 []*domain.Anomaly
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListAnomaliesQuery) ([]*domain.Anomaly, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListAnomaliesQuery) []*domain.Anomaly); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Anomaly)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListAnomaliesQuery) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AnomalyService_ListAnomalies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAnomalies'
+type AnomalyService_ListAnomalies_Call struct {
+	*mock.Call
+}
+
+// ListAnomalies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q domain.ListAnomaliesQuery
+func (_e *AnomalyService_Expecter) ListAnomalies(ctx interface{}, q interface{}) *AnomalyService_ListAnomalies_Call {
+	return &AnomalyService_ListAnomalies_Call{Call: _e.mock.On("ListAnomalies", ctx, q)}
+}
+
+func (_c *AnomalyService_ListAnomalies_Call) Run(run func(ctx context.Context, q domain.ListAnomaliesQuery)) *AnomalyService_ListAnomalies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListAnomaliesQuery))
+	})
+	return _c
+}
+
+func (_c *AnomalyService_ListAnomalies_Call) Return(_a0 []*domain.Anomaly, _a1 error) *AnomalyService_ListAnomalies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AnomalyService_ListAnomalies_Call) RunAndReturn(run func(context.Context, domain.ListAnomaliesQuery) ([]*domain.Anomaly, error)) *AnomalyService_ListAnomalies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAnomalyService creates a new instance of AnomalyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAnomalyService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AnomalyService {
+	mock := &AnomalyService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,454 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "inventory-system/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AssemblyService is an autogenerated mock type for the AssemblyService type
+type AssemblyService struct {
+	mock.Mock
+}
+
+type AssemblyService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AssemblyService) EXPECT() *AssemblyService_Expecter {
+	return &AssemblyService_Expecter{mock: &_m.Mock}
+}
+
+// CancelOrder provides a mock function with given fields: ctx, id, userID
+func (_m *AssemblyService) CancelOrder(ctx context.Context, id string, userID string) (*domain.AssemblyOrder, error) {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelOrder")
+	}
+
+	var r0 *domain.AssemblyOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.AssemblyOrder, error)); ok {
+		return rf(ctx, id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.AssemblyOrder); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.AssemblyOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssemblyService_CancelOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelOrder'
+type AssemblyService_CancelOrder_Call struct {
+	*mock.Call
+}
+
+// CancelOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *AssemblyService_Expecter) CancelOrder(ctx interface{}, id interface{}, userID interface{}) *AssemblyService_CancelOrder_Call {
+	return &AssemblyService_CancelOrder_Call{Call: _e.mock.On("CancelOrder", ctx, id, userID)}
+}
+
+func (_c *AssemblyService_CancelOrder_Call) Run(run func(ctx context.Context, id string, userID string)) *AssemblyService_CancelOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AssemblyService_CancelOrder_Call) Return(_a0 *domain.AssemblyOrder, _a1 error) *AssemblyService_CancelOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AssemblyService_CancelOrder_Call) RunAndReturn(run func(context.Context, string, string) (*domain.AssemblyOrder, error)) *AssemblyService_CancelOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteOrder provides a mock function with given fields: ctx, id, userID
+func (_m *AssemblyService) CompleteOrder(ctx context.Context, id string, userID string) (*domain.CompleteAssemblyResult, error) {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteOrder")
+	}
+
+	var r0 *domain.CompleteAssemblyResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.CompleteAssemblyResult, error)); ok {
+		return rf(ctx, id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.CompleteAssemblyResult); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.CompleteAssemblyResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssemblyService_CompleteOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteOrder'
+type AssemblyService_CompleteOrder_Call struct {
+	*mock.Call
+}
+
+// CompleteOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *AssemblyService_Expecter) CompleteOrder(ctx interface{}, id interface{}, userID interface{}) *AssemblyService_CompleteOrder_Call {
+	return &AssemblyService_CompleteOrder_Call{Call: _e.mock.On("CompleteOrder", ctx, id, userID)}
+}
+
+func (_c *AssemblyService_CompleteOrder_Call) Run(run func(ctx context.Context, id string, userID string)) *AssemblyService_CompleteOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AssemblyService_CompleteOrder_Call) Return(_a0 *domain.CompleteAssemblyResult, _a1 error) *AssemblyService_CompleteOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AssemblyService_CompleteOrder_Call) RunAndReturn(run func(context.Context, string, string) (*domain.CompleteAssemblyResult, error)) *AssemblyService_CompleteOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrder provides a mock function with given fields: ctx, req, userID
+func (_m *AssemblyService) CreateOrder(ctx context.Context, req *domain.CreateAssemblyOrderRequest, userID string) (*domain.AssemblyOrder, error) {
+	ret := _m.Called(ctx, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrder")
+	}
+
+	var r0 *domain.AssemblyOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateAssemblyOrderRequest, string) (*domain.AssemblyOrder, error)); ok {
+		return rf(ctx, req, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateAssemblyOrderRequest, string) *domain.AssemblyOrder); ok {
+		r0 = rf(ctx, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.AssemblyOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateAssemblyOrderRequest, string) error); ok {
+		r1 = rf(ctx, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssemblyService_CreateOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrder'
+type AssemblyService_CreateOrder_Call struct {
+	*mock.Call
+}
+
+// CreateOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.CreateAssemblyOrderRequest
+//   - userID string
+func (_e *AssemblyService_Expecter) CreateOrder(ctx interface{}, req interface{}, userID interface{}) *AssemblyService_CreateOrder_Call {
+	return &AssemblyService_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, req, userID)}
+}
+
+func (_c *AssemblyService_CreateOrder_Call) Run(run func(ctx context.Context, req *domain.CreateAssemblyOrderRequest, userID string)) *AssemblyService_CreateOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CreateAssemblyOrderRequest), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AssemblyService_CreateOrder_Call) Return(_a0 *domain.AssemblyOrder, _a1 error) *AssemblyService_CreateOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AssemblyService_CreateOrder_Call) RunAndReturn(run func(context.Context, *domain.CreateAssemblyOrderRequest, string) (*domain.AssemblyOrder, error)) *AssemblyService_CreateOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetComponents provides a mock function with given fields: ctx, itemID
+func (_m *AssemblyService) GetComponents(ctx context.Context, itemID string) ([]domain.Component, error) {
+	ret := _m.Called(ctx, itemID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetComponents")
+	}
+
+	var r0 []domain.Component
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]domain.Component, error)); ok {
+		return rf(ctx, itemID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []domain.Component); ok {
+		r0 = rf(ctx, itemID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Component)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, itemID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssemblyService_GetComponents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComponents'
+type AssemblyService_GetComponents_Call struct {
+	*mock.Call
+}
+
+// GetComponents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+func (_e *AssemblyService_Expecter) GetComponents(ctx interface{}, itemID interface{}) *AssemblyService_GetComponents_Call {
+	return &AssemblyService_GetComponents_Call{Call: _e.mock.On("GetComponents", ctx, itemID)}
+}
+
+func (_c *AssemblyService_GetComponents_Call) Run(run func(ctx context.Context, itemID string)) *AssemblyService_GetComponents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AssemblyService_GetComponents_Call) Return(_a0 []domain.Component, _a1 error) *AssemblyService_GetComponents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AssemblyService_GetComponents_Call) RunAndReturn(run func(context.Context, string) ([]domain.Component, error)) *AssemblyService_GetComponents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrder provides a mock function with given fields: ctx, id
+func (_m *AssemblyService) GetOrder(ctx context.Context, id string) (*domain.AssemblyOrder, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrder")
+	}
+
+	var r0 *domain.AssemblyOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.AssemblyOrder, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.AssemblyOrder); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.AssemblyOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssemblyService_GetOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrder'
+type AssemblyService_GetOrder_Call struct {
+	*mock.Call
+}
+
+// GetOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *AssemblyService_Expecter) GetOrder(ctx interface{}, id interface{}) *AssemblyService_GetOrder_Call {
+	return &AssemblyService_GetOrder_Call{Call: _e.mock.On("GetOrder", ctx, id)}
+}
+
+func (_c *AssemblyService_GetOrder_Call) Run(run func(ctx context.Context, id string)) *AssemblyService_GetOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AssemblyService_GetOrder_Call) Return(_a0 *domain.AssemblyOrder, _a1 error) *AssemblyService_GetOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AssemblyService_GetOrder_Call) RunAndReturn(run func(context.Context, string) (*domain.AssemblyOrder, error)) *AssemblyService_GetOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrders provides a mock function with given fields: ctx, q
+func (_m *AssemblyService) ListOrders(ctx context.Context, q domain.ListAssemblyOrdersQuery) ([]*domain.AssemblyOrder, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrders")
+	}
+
+	var r0 []*domain.AssemblyOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListAssemblyOrdersQuery) ([]*domain.AssemblyOrder, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListAssemblyOrdersQuery) []*domain.AssemblyOrder); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.AssemblyOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListAssemblyOrdersQuery) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssemblyService_ListOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrders'
+type AssemblyService_ListOrders_Call struct {
+	*mock.Call
+}
+
+// ListOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q domain.ListAssemblyOrdersQuery
+func (_e *AssemblyService_Expecter) ListOrders(ctx interface{}, q interface{}) *AssemblyService_ListOrders_Call {
+	return &AssemblyService_ListOrders_Call{Call: _e.mock.On("ListOrders", ctx, q)}
+}
+
+func (_c *AssemblyService_ListOrders_Call) Run(run func(ctx context.Context, q domain.ListAssemblyOrdersQuery)) *AssemblyService_ListOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListAssemblyOrdersQuery))
+	})
+	return _c
+}
+
+func (_c *AssemblyService_ListOrders_Call) Return(_a0 []*domain.AssemblyOrder, _a1 error) *AssemblyService_ListOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AssemblyService_ListOrders_Call) RunAndReturn(run func(context.Context, domain.ListAssemblyOrdersQuery) ([]*domain.AssemblyOrder, error)) *AssemblyService_ListOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetComponents provides a mock function with given fields: ctx, itemID, req
+func (_m *AssemblyService) SetComponents(ctx context.Context, itemID string, req *domain.SetComponentsRequest) ([]domain.Component, error) {
+	ret := _m.Called(ctx, itemID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetComponents")
+	}
+
+	var r0 []domain.Component
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.SetComponentsRequest) ([]domain.Component, error)); ok {
+		return rf(ctx, itemID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.SetComponentsRequest) []domain.Component); ok {
+		r0 = rf(ctx, itemID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Component)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *domain.SetComponentsRequest) error); ok {
+		r1 = rf(ctx, itemID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AssemblyService_SetComponents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetComponents'
+type AssemblyService_SetComponents_Call struct {
+	*mock.Call
+}
+
+// SetComponents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+//   - req *domain.SetComponentsRequest
+func (_e *AssemblyService_Expecter) SetComponents(ctx interface{}, itemID interface{}, req interface{}) *AssemblyService_SetComponents_Call {
+	return &AssemblyService_SetComponents_Call{Call: _e.mock.On("SetComponents", ctx, itemID, req)}
+}
+
+func (_c *AssemblyService_SetComponents_Call) Run(run func(ctx context.Context, itemID string, req *domain.SetComponentsRequest)) *AssemblyService_SetComponents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*domain.SetComponentsRequest))
+	})
+	return _c
+}
+
+func (_c *AssemblyService_SetComponents_Call) Return(_a0 []domain.Component, _a1 error) *AssemblyService_SetComponents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AssemblyService_SetComponents_Call) RunAndReturn(run func(context.Context, string, *domain.SetComponentsRequest) ([]domain.Component, error)) *AssemblyService_SetComponents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAssemblyService creates a new instance of AssemblyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAssemblyService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AssemblyService {
+	mock := &AssemblyService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
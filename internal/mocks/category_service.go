@@ -0,0 +1,370 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "inventory-system/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CategoryService is an autogenerated mock type for the CategoryService type
+type CategoryService struct {
+	mock.Mock
+}
+
+type CategoryService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CategoryService) EXPECT() *CategoryService_Expecter {
+	return &CategoryService_Expecter{mock: &_m.Mock}
+}
+
+// AssignItem provides a mock function with given fields: ctx, itemID, req, userID
+func (_m *CategoryService) AssignItem(ctx context.Context, itemID string, req *domain.AssignCategoryRequest, userID string) error {
+	ret := _m.Called(ctx, itemID, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.AssignCategoryRequest, string) error); ok {
+		r0 = rf(ctx, itemID, req, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CategoryService_AssignItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignItem'
+type CategoryService_AssignItem_Call struct {
+	*mock.Call
+}
+
+// AssignItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+//   - req *domain.AssignCategoryRequest
+//   - userID string
+func (_e *CategoryService_Expecter) AssignItem(ctx interface{}, itemID interface{}, req interface{}, userID interface{}) *CategoryService_AssignItem_Call {
+	return &CategoryService_AssignItem_Call{Call: _e.mock.On("AssignItem", ctx, itemID, req, userID)}
+}
+
+func (_c *CategoryService_AssignItem_Call) Run(run func(ctx context.Context, itemID string, req *domain.AssignCategoryRequest, userID string)) *CategoryService_AssignItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*domain.AssignCategoryRequest), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *CategoryService_AssignItem_Call) Return(_a0 error) *CategoryService_AssignItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CategoryService_AssignItem_Call) RunAndReturn(run func(context.Context, string, *domain.AssignCategoryRequest, string) error) *CategoryService_AssignItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCategory provides a mock function with given fields: ctx, req
+func (_m *CategoryService) CreateCategory(ctx context.Context, req *domain.CreateCategoryRequest) (*domain.Category, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCategory")
+	}
+
+	var r0 *domain.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateCategoryRequest) (*domain.Category, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateCategoryRequest) *domain.Category); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateCategoryRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CategoryService_CreateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCategory'
+type CategoryService_CreateCategory_Call struct {
+	*mock.Call
+}
+
+// CreateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.CreateCategoryRequest
+func (_e *CategoryService_Expecter) CreateCategory(ctx interface{}, req interface{}) *CategoryService_CreateCategory_Call {
+	return &CategoryService_CreateCategory_Call{Call: _e.mock.On("CreateCategory", ctx, req)}
+}
+
+func (_c *CategoryService_CreateCategory_Call) Run(run func(ctx context.Context, req *domain.CreateCategoryRequest)) *CategoryService_CreateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CreateCategoryRequest))
+	})
+	return _c
+}
+
+func (_c *CategoryService_CreateCategory_Call) Return(_a0 *domain.Category, _a1 error) *CategoryService_CreateCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CategoryService_CreateCategory_Call) RunAndReturn(run func(context.Context, *domain.CreateCategoryRequest) (*domain.Category, error)) *CategoryService_CreateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCategory provides a mock function with given fields: ctx, id, userID
+func (_m *CategoryService) DeleteCategory(ctx context.Context, id string, userID string) error {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CategoryService_DeleteCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCategory'
+type CategoryService_DeleteCategory_Call struct {
+	*mock.Call
+}
+
+// DeleteCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *CategoryService_Expecter) DeleteCategory(ctx interface{}, id interface{}, userID interface{}) *CategoryService_DeleteCategory_Call {
+	return &CategoryService_DeleteCategory_Call{Call: _e.mock.On("DeleteCategory", ctx, id, userID)}
+}
+
+func (_c *CategoryService_DeleteCategory_Call) Run(run func(ctx context.Context, id string, userID string)) *CategoryService_DeleteCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *CategoryService_DeleteCategory_Call) Return(_a0 error) *CategoryService_DeleteCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CategoryService_DeleteCategory_Call) RunAndReturn(run func(context.Context, string, string) error) *CategoryService_DeleteCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategory provides a mock function with given fields: ctx, id
+func (_m *CategoryService) GetCategory(ctx context.Context, id string) (*domain.Category, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategory")
+	}
+
+	var r0 *domain.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Category, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Category); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CategoryService_GetCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategory'
+type CategoryService_GetCategory_Call struct {
+	*mock.Call
+}
+
+// GetCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *CategoryService_Expecter) GetCategory(ctx interface{}, id interface{}) *CategoryService_GetCategory_Call {
+	return &CategoryService_GetCategory_Call{Call: _e.mock.On("GetCategory", ctx, id)}
+}
+
+func (_c *CategoryService_GetCategory_Call) Run(run func(ctx context.Context, id string)) *CategoryService_GetCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CategoryService_GetCategory_Call) Return(_a0 *domain.Category, _a1 error) *CategoryService_GetCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CategoryService_GetCategory_Call) RunAndReturn(run func(context.Context, string) (*domain.Category, error)) *CategoryService_GetCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCategories provides a mock function with given fields: ctx
+func (_m *CategoryService) ListCategories(ctx context.Context) ([]*domain.Category, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCategories")
+	}
+
+	var r0 []*domain.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.Category, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.Category); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CategoryService_ListCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCategories'
+type CategoryService_ListCategories_Call struct {
+	*mock.Call
+}
+
+// ListCategories is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CategoryService_Expecter) ListCategories(ctx interface{}) *CategoryService_ListCategories_Call {
+	return &CategoryService_ListCategories_Call{Call: _e.mock.On("ListCategories", ctx)}
+}
+
+func (_c *CategoryService_ListCategories_Call) Run(run func(ctx context.Context)) *CategoryService_ListCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CategoryService_ListCategories_Call) Return(_a0 []*domain.Category, _a1 error) *CategoryService_ListCategories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CategoryService_ListCategories_Call) RunAndReturn(run func(context.Context) ([]*domain.Category, error)) *CategoryService_ListCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCategory provides a mock function with given fields: ctx, id, req
+func (_m *CategoryService) UpdateCategory(ctx context.Context, id string, req *domain.UpdateCategoryRequest) (*domain.Category, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCategory")
+	}
+
+	var r0 *domain.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.UpdateCategoryRequest) (*domain.Category, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.UpdateCategoryRequest) *domain.Category); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *domain.UpdateCategoryRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CategoryService_UpdateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCategory'
+type CategoryService_UpdateCategory_Call struct {
+	*mock.Call
+}
+
+// UpdateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - req *domain.UpdateCategoryRequest
+func (_e *CategoryService_Expecter) UpdateCategory(ctx interface{}, id interface{}, req interface{}) *CategoryService_UpdateCategory_Call {
+	return &CategoryService_UpdateCategory_Call{Call: _e.mock.On("UpdateCategory", ctx, id, req)}
+}
+
+func (_c *CategoryService_UpdateCategory_Call) Run(run func(ctx context.Context, id string, req *domain.UpdateCategoryRequest)) *CategoryService_UpdateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*domain.UpdateCategoryRequest))
+	})
+	return _c
+}
+
+func (_c *CategoryService_UpdateCategory_Call) Return(_a0 *domain.Category, _a1 error) *CategoryService_UpdateCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CategoryService_UpdateCategory_Call) RunAndReturn(run func(context.Context, string, *domain.UpdateCategoryRequest) (*domain.Category, error)) *CategoryService_UpdateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCategoryService creates a new instance of CategoryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCategoryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CategoryService {
+	mock := &CategoryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
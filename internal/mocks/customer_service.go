@@ -0,0 +1,274 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "inventory-system/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CustomerService is an autogenerated mock type for the CustomerService type
+type CustomerService struct {
+	mock.Mock
+}
+
+type CustomerService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CustomerService) EXPECT() *CustomerService_Expecter {
+	return &CustomerService_Expecter{mock: &_m.Mock}
+}
+
+// CreateCustomer provides a mock function with given fields: ctx, req
+func (_m *CustomerService) CreateCustomer(ctx context.Context, req *domain.CreateCustomerRequest) (*domain.Customer, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCustomer")
+	}
+
+	var r0 *domain.Customer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateCustomerRequest) (*domain.Customer, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateCustomerRequest) *domain.Customer); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Customer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateCustomerRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CustomerService_CreateCustomer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCustomer'
+type CustomerService_CreateCustomer_Call struct {
+	*mock.Call
+}
+
+// CreateCustomer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.CreateCustomerRequest
+func (_e *CustomerService_Expecter) CreateCustomer(ctx interface{}, req interface{}) *CustomerService_CreateCustomer_Call {
+	return &CustomerService_CreateCustomer_Call{Call: _e.mock.On("CreateCustomer", ctx, req)}
+}
+
+func (_c *CustomerService_CreateCustomer_Call) Run(run func(ctx context.Context, req *domain.CreateCustomerRequest)) *CustomerService_CreateCustomer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CreateCustomerRequest))
+	})
+	return _c
+}
+
+func (_c *CustomerService_CreateCustomer_Call) Return(_a0 *domain.Customer, _a1 error) *CustomerService_CreateCustomer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CustomerService_CreateCustomer_Call) RunAndReturn(run func(context.Context, *domain.CreateCustomerRequest) (*domain.Customer, error)) *CustomerService_CreateCustomer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCustomer provides a mock function with given fields: ctx, id
+func (_m *CustomerService) GetCustomer(ctx context.Context, id string) (*domain.Customer, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCustomer")
+	}
+
+	var r0 *domain.Customer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Customer, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Customer); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Customer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CustomerService_GetCustomer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCustomer'
+type CustomerService_GetCustomer_Call struct {
+	*mock.Call
+}
+
+// GetCustomer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *CustomerService_Expecter) GetCustomer(ctx interface{}, id interface{}) *CustomerService_GetCustomer_Call {
+	return &CustomerService_GetCustomer_Call{Call: _e.mock.On("GetCustomer", ctx, id)}
+}
+
+func (_c *CustomerService_GetCustomer_Call) Run(run func(ctx context.Context, id string)) *CustomerService_GetCustomer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CustomerService_GetCustomer_Call) Return(_a0 *domain.Customer, _a1 error) *CustomerService_GetCustomer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CustomerService_GetCustomer_Call) RunAndReturn(run func(context.Context, string) (*domain.Customer, error)) *CustomerService_GetCustomer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCustomers provides a mock function with given fields: ctx, q
+func (_m *CustomerService) ListCustomers(ctx context.Context, q domain.ListCustomersQuery) ([]*domain.Customer, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCustomers")
+	}
+
+	var r0 []*domain.Customer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListCustomersQuery) ([]*domain.Customer, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListCustomersQuery) []*domain.Customer); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Customer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListCustomersQuery) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CustomerService_ListCustomers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCustomers'
+type CustomerService_ListCustomers_Call struct {
+	*mock.Call
+}
+
+// ListCustomers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q domain.ListCustomersQuery
+func (_e *CustomerService_Expecter) ListCustomers(ctx interface{}, q interface{}) *CustomerService_ListCustomers_Call {
+	return &CustomerService_ListCustomers_Call{Call: _e.mock.On("ListCustomers", ctx, q)}
+}
+
+func (_c *CustomerService_ListCustomers_Call) Run(run func(ctx context.Context, q domain.ListCustomersQuery)) *CustomerService_ListCustomers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListCustomersQuery))
+	})
+	return _c
+}
+
+func (_c *CustomerService_ListCustomers_Call) Return(_a0 []*domain.Customer, _a1 error) *CustomerService_ListCustomers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CustomerService_ListCustomers_Call) RunAndReturn(run func(context.Context, domain.ListCustomersQuery) ([]*domain.Customer, error)) *CustomerService_ListCustomers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCustomer provides a mock function with given fields: ctx, id, req
+func (_m *CustomerService) UpdateCustomer(ctx context.Context, id string, req *domain.UpdateCustomerRequest) (*domain.Customer, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCustomer")
+	}
+
+	var r0 *domain.Customer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.UpdateCustomerRequest) (*domain.Customer, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.UpdateCustomerRequest) *domain.Customer); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Customer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *domain.UpdateCustomerRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CustomerService_UpdateCustomer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCustomer'
+type CustomerService_UpdateCustomer_Call struct {
+	*mock.Call
+}
+
+// UpdateCustomer is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - req *domain.UpdateCustomerRequest
+func (_e *CustomerService_Expecter) UpdateCustomer(ctx interface{}, id interface{}, req interface{}) *CustomerService_UpdateCustomer_Call {
+	return &CustomerService_UpdateCustomer_Call{Call: _e.mock.On("UpdateCustomer", ctx, id, req)}
+}
+
+func (_c *CustomerService_UpdateCustomer_Call) Run(run func(ctx context.Context, id string, req *domain.UpdateCustomerRequest)) *CustomerService_UpdateCustomer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*domain.UpdateCustomerRequest))
+	})
+	return _c
+}
+
+func (_c *CustomerService_UpdateCustomer_Call) Return(_a0 *domain.Customer, _a1 error) *CustomerService_UpdateCustomer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CustomerService_UpdateCustomer_Call) RunAndReturn(run func(context.Context, string, *domain.UpdateCustomerRequest) (*domain.Customer, error)) *CustomerService_UpdateCustomer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCustomerService creates a new instance of CustomerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCustomerService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CustomerService {
+	mock := &CustomerService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
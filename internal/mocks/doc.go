@@ -0,0 +1,6 @@
+// Package mocks contains generated testify mocks of the domain interfaces,
+// for unit-testing layers in isolation. The interfaces to mock are listed in
+// .mockery.yaml at the repository root.
+package mocks
+
+//go:generate go run github.com/vektra/mockery/v2@v2.53.3 --config ../../.mockery.yaml
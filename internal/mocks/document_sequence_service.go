@@ -0,0 +1,155 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "inventory-system/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DocumentSequenceService is an autogenerated mock type for the DocumentSequenceService type
+type DocumentSequenceService struct {
+	mock.Mock
+}
+
+type DocumentSequenceService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DocumentSequenceService) EXPECT() *DocumentSequenceService_Expecter {
+	return &DocumentSequenceService_Expecter{mock: &_m.Mock}
+}
+
+// ListSequences provides a mock function with given fields: ctx
+func (_m *DocumentSequenceService) ListSequences(ctx context.Context) ([]*domain.DocumentSequence, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSequences")
+	}
+
+	var r0 []*domain.DocumentSequence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.DocumentSequence, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.DocumentSequence); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.DocumentSequence)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DocumentSequenceService_ListSequences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSequences'
+type DocumentSequenceService_ListSequences_Call struct {
+	*mock.Call
+}
+
+// ListSequences is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DocumentSequenceService_Expecter) ListSequences(ctx interface{}) *DocumentSequenceService_ListSequences_Call {
+	return &DocumentSequenceService_ListSequences_Call{Call: _e.mock.On("ListSequences", ctx)}
+}
+
+func (_c *DocumentSequenceService_ListSequences_Call) Run(run func(ctx context.Context)) *DocumentSequenceService_ListSequences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DocumentSequenceService_ListSequences_Call) Return(_a0 []*domain.DocumentSequence, _a1 error) *DocumentSequenceService_ListSequences_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DocumentSequenceService_ListSequences_Call) RunAndReturn(run func(context.Context) ([]*domain.DocumentSequence, error)) *DocumentSequenceService_ListSequences_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSequence provides a mock function with given fields: ctx, docType, req
+func (_m *DocumentSequenceService) SetSequence(ctx context.Context, docType string, req *domain.SetDocumentSequenceRequest) (*domain.DocumentSequence, error) {
+	ret := _m.Called(ctx, docType, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSequence")
+	}
+
+	var r0 *domain.DocumentSequence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.SetDocumentSequenceRequest) (*domain.DocumentSequence, error)); ok {
+		return rf(ctx, docType, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.SetDocumentSequenceRequest) *domain.DocumentSequence); ok {
+		r0 = rf(ctx, docType, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.DocumentSequence)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *domain.SetDocumentSequenceRequest) error); ok {
+		r1 = rf(ctx, docType, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DocumentSequenceService_SetSequence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSequence'
+type DocumentSequenceService_SetSequence_Call struct {
+	*mock.Call
+}
+
+// SetSequence is a helper method to define mock.On call
+//   - ctx context.Context
+//   - docType string
+//   - req *domain.SetDocumentSequenceRequest
+func (_e *DocumentSequenceService_Expecter) SetSequence(ctx interface{}, docType interface{}, req interface{}) *DocumentSequenceService_SetSequence_Call {
+	return &DocumentSequenceService_SetSequence_Call{Call: _e.mock.On("SetSequence", ctx, docType, req)}
+}
+
+func (_c *DocumentSequenceService_SetSequence_Call) Run(run func(ctx context.Context, docType string, req *domain.SetDocumentSequenceRequest)) *DocumentSequenceService_SetSequence_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*domain.SetDocumentSequenceRequest))
+	})
+	return _c
+}
+
+func (_c *DocumentSequenceService_SetSequence_Call) Return(_a0 *domain.DocumentSequence, _a1 error) *DocumentSequenceService_SetSequence_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DocumentSequenceService_SetSequence_Call) RunAndReturn(run func(context.Context, string, *domain.SetDocumentSequenceRequest) (*domain.DocumentSequence, error)) *DocumentSequenceService_SetSequence_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDocumentSequenceService creates a new instance of DocumentSequenceService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDocumentSequenceService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DocumentSequenceService {
+	mock := &DocumentSequenceService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "inventory-system/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IntegrityService is an autogenerated mock type for the IntegrityService type
+type IntegrityService struct {
+	mock.Mock
+}
+
+type IntegrityService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *IntegrityService) EXPECT() *IntegrityService_Expecter {
+	return &IntegrityService_Expecter{mock: &_m.Mock}
+}
+
+// Report provides a mock function with given fields: ctx, q
+func (_m *IntegrityService) Report(ctx context.Context, q domain.IntegrityQuery) (*domain.IntegrityReport, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Report")
+	}
+
+	var r0 *domain.IntegrityReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IntegrityQuery) (*domain.IntegrityReport, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.IntegrityQuery) *domain.IntegrityReport); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.IntegrityReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.IntegrityQuery) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IntegrityService_Report_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Report'
+type IntegrityService_Report_Call struct {
+	*mock.Call
+}
+
+// Report is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q domain.IntegrityQuery
+func (_e *IntegrityService_Expecter) Report(ctx interface{}, q interface{}) *IntegrityService_Report_Call {
+	return &IntegrityService_Report_Call{Call: _e.mock.On("Report", ctx, q)}
+}
+
+func (_c *IntegrityService_Report_Call) Run(run func(ctx context.Context, q domain.IntegrityQuery)) *IntegrityService_Report_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.IntegrityQuery))
+	})
+	return _c
+}
+
+func (_c *IntegrityService_Report_Call) Return(_a0 *domain.IntegrityReport, _a1 error) *IntegrityService_Report_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IntegrityService_Report_Call) RunAndReturn(run func(context.Context, domain.IntegrityQuery) (*domain.IntegrityReport, error)) *IntegrityService_Report_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewIntegrityService creates a new instance of IntegrityService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIntegrityService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IntegrityService {
+	mock := &IntegrityService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
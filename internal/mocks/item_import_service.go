@@ -0,0 +1,97 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "inventory-system/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ItemImportService is an autogenerated mock type for the ItemImportService type
+type ItemImportService struct {
+	mock.Mock
+}
+
+type ItemImportService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ItemImportService) EXPECT() *ItemImportService_Expecter {
+	return &ItemImportService_Expecter{mock: &_m.Mock}
+}
+
+// ImportItems provides a mock function with given fields: ctx, imp, userID
+func (_m *ItemImportService) ImportItems(ctx context.Context, imp *domain.ItemImport, userID string) (*domain.ItemImportResult, error) {
+	ret := _m.Called(ctx, imp, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportItems")
+	}
+
+	var r0 *domain.ItemImportResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ItemImport, string) (*domain.ItemImportResult, error)); ok {
+		return rf(ctx, imp, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.ItemImport, string) *domain.ItemImportResult); ok {
+		r0 = rf(ctx, imp, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ItemImportResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.ItemImport, string) error); ok {
+		r1 = rf(ctx, imp, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ItemImportService_ImportItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportItems'
+type ItemImportService_ImportItems_Call struct {
+	*mock.Call
+}
+
+// ImportItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - imp *domain.ItemImport
+//   - userID string
+func (_e *ItemImportService_Expecter) ImportItems(ctx interface{}, imp interface{}, userID interface{}) *ItemImportService_ImportItems_Call {
+	return &ItemImportService_ImportItems_Call{Call: _e.mock.On("ImportItems", ctx, imp, userID)}
+}
+
+func (_c *ItemImportService_ImportItems_Call) Run(run func(ctx context.Context, imp *domain.ItemImport, userID string)) *ItemImportService_ImportItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.ItemImport), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ItemImportService_ImportItems_Call) Return(_a0 *domain.ItemImportResult, _a1 error) *ItemImportService_ImportItems_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ItemImportService_ImportItems_Call) RunAndReturn(run func(context.Context, *domain.ItemImport, string) (*domain.ItemImportResult, error)) *ItemImportService_ImportItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewItemImportService creates a new instance of ItemImportService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewItemImportService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ItemImportService {
+	mock := &ItemImportService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
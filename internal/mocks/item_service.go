@@ -0,0 +1,895 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "inventory-system/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ItemService is an autogenerated mock type for the ItemService type
+type ItemService struct {
+	mock.Mock
+}
+
+type ItemService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ItemService) EXPECT() *ItemService_Expecter {
+	return &ItemService_Expecter{mock: &_m.Mock}
+}
+
+// AdjustQuantity provides a mock function with given fields: ctx, id, delta, userID
+func (_m *ItemService) AdjustQuantity(ctx context.Context, id string, delta int, userID string) (*domain.Item, error) {
+	ret := _m.Called(ctx, id, delta, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdjustQuantity")
+	}
+
+	var r0 *domain.Item
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) (*domain.Item, error)); ok {
+		return rf(ctx, id, delta, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, string) *domain.Item); ok {
+		r0 = rf(ctx, id, delta, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Item)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, string) error); ok {
+		r1 = rf(ctx, id, delta, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ItemService_AdjustQuantity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdjustQuantity'
+type ItemService_AdjustQuantity_Call struct {
+	*mock.Call
+}
+
+// AdjustQuantity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - delta int
+//   - userID string
+func (_e *ItemService_Expecter) AdjustQuantity(ctx interface{}, id interface{}, delta interface{}, userID interface{}) *ItemService_AdjustQuantity_Call {
+	return &ItemService_AdjustQuantity_Call{Call: _e.mock.On("AdjustQuantity", ctx, id, delta, userID)}
+}
+
+func (_c *ItemService_AdjustQuantity_Call) Run(run func(ctx context.Context, id string, delta int, userID string)) *ItemService_AdjustQuantity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ItemService_AdjustQuantity_Call) Return(_a0 *domain.Item, _a1 error) *ItemService_AdjustQuantity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ItemService_AdjustQuantity_Call) RunAndReturn(run func(context.Context, string, int, string) (*domain.Item, error)) *ItemService_AdjustQuantity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateItem provides a mock function with given fields: ctx, req, userID
+func (_m *ItemService) CreateItem(ctx context.Context, req *domain.CreateItemRequest, userID string) (*domain.Item, error) {
+	ret := _m.Called(ctx, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateItem")
+	}
+
+	var r0 *domain.Item
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateItemRequest, string) (*domain.Item, error)); ok {
+		return rf(ctx, req, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateItemRequest, string) *domain.Item); ok {
+		r0 = rf(ctx, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Item)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateItemRequest, string) error); ok {
+		r1 = rf(ctx, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ItemService_CreateItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateItem'
+type ItemService_CreateItem_Call struct {
+	*mock.Call
+}
+
+// CreateItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.CreateItemRequest
+//   - userID string
+func (_e *ItemService_Expecter) CreateItem(ctx interface{}, req interface{}, userID interface{}) *ItemService_CreateItem_Call {
+	return &ItemService_CreateItem_Call{Call: _e.mock.On("CreateItem", ctx, req, userID)}
+}
+
+func (_c *ItemService_CreateItem_Call) Run(run func(ctx context.Context, req *domain.CreateItemRequest, userID string)) *ItemService_CreateItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CreateItemRequest), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ItemService_CreateItem_Call) Return(_a0 *domain.Item, _a1 error) *ItemService_CreateItem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ItemService_CreateItem_Call) RunAndReturn(run func(context.Context, *domain.CreateItemRequest, string) (*domain.Item, error)) *ItemService_CreateItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteItem provides a mock function with given fields: ctx, id, userID
+func (_m *ItemService) DeleteItem(ctx context.Context, id string, userID string) error {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ItemService_DeleteItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteItem'
+type ItemService_DeleteItem_Call struct {
+	*mock.Call
+}
+
+// DeleteItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *ItemService_Expecter) DeleteItem(ctx interface{}, id interface{}, userID interface{}) *ItemService_DeleteItem_Call {
+	return &ItemService_DeleteItem_Call{Call: _e.mock.On("DeleteItem", ctx, id, userID)}
+}
+
+func (_c *ItemService_DeleteItem_Call) Run(run func(ctx context.Context, id string, userID string)) *ItemService_DeleteItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ItemService_DeleteItem_Call) Return(_a0 error) *ItemService_DeleteItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ItemService_DeleteItem_Call) RunAndReturn(run func(context.Context, string, string) error) *ItemService_DeleteItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetItemByID provides a mock function with given fields: ctx, id
+func (_m *ItemService) GetItemByID(ctx context.Context, id string) (*domain.Item, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetItemByID")
+	}
+
+	var r0 *domain.Item
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Item, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Item); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Item)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ItemService_GetItemByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetItemByID'
+type ItemService_GetItemByID_Call struct {
+	*mock.Call
+}
+
+// GetItemByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ItemService_Expecter) GetItemByID(ctx interface{}, id interface{}) *ItemService_GetItemByID_Call {
+	return &ItemService_GetItemByID_Call{Call: _e.mock.On("GetItemByID", ctx, id)}
+}
+
+func (_c *ItemService_GetItemByID_Call) Run(run func(ctx context.Context, id string)) *ItemService_GetItemByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ItemService_GetItemByID_Call) Return(_a0 *domain.Item, _a1 error) *ItemService_GetItemByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ItemService_GetItemByID_Call) RunAndReturn(run func(context.Context, string) (*domain.Item, error)) *ItemService_GetItemByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetItemBySKU provides a mock function with given fields: ctx, sku
+func (_m *ItemService) GetItemBySKU(ctx context.Context, sku string) (*domain.Item, error) {
+	ret := _m.Called(ctx, sku)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetItemBySKU")
+	}
+
+	var r0 *domain.Item
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Item, error)); ok {
+		return rf(ctx, sku)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Item); ok {
+		r0 = rf(ctx, sku)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Item)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sku)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ItemService_GetItemBySKU_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetItemBySKU'
+type ItemService_GetItemBySKU_Call struct {
+	*mock.Call
+}
+
+// GetItemBySKU is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sku string
+func (_e *ItemService_Expecter) GetItemBySKU(ctx interface{}, sku interface{}) *ItemService_GetItemBySKU_Call {
+	return &ItemService_GetItemBySKU_Call{Call: _e.mock.On("GetItemBySKU", ctx, sku)}
+}
+
+func (_c *ItemService_GetItemBySKU_Call) Run(run func(ctx context.Context, sku string)) *ItemService_GetItemBySKU_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ItemService_GetItemBySKU_Call) Return(_a0 *domain.Item, _a1 error) *ItemService_GetItemBySKU_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ItemService_GetItemBySKU_Call) RunAndReturn(run func(context.Context, string) (*domain.Item, error)) *ItemService_GetItemBySKU_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetItemChanges provides a mock function with given fields: ctx, query
+func (_m *ItemService) GetItemChanges(ctx context.Context, query domain.ItemChangesQuery) (*domain.ItemChangesPage, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetItemChanges")
+	}
+
+	var r0 *domain.ItemChangesPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ItemChangesQuery) (*domain.ItemChangesPage, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ItemChangesQuery) *domain.ItemChangesPage); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ItemChangesPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ItemChangesQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ItemService_GetItemChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetItemChanges'
+type ItemService_GetItemChanges_Call struct {
+	*mock.Call
+}
+
+// GetItemChanges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query domain.ItemChangesQuery
+func (_e *ItemService_Expecter) GetItemChanges(ctx interface{}, query interface{}) *ItemService_GetItemChanges_Call {
+	return &ItemService_GetItemChanges_Call{Call: _e.mock.On("GetItemChanges", ctx, query)}
+}
+
+func (_c *ItemService_GetItemChanges_Call) Run(run func(ctx context.Context, query domain.ItemChangesQuery)) *ItemService_GetItemChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ItemChangesQuery))
+	})
+	return _c
+}
+
+func (_c *ItemService_GetItemChanges_Call) Return(_a0 *domain.ItemChangesPage, _a1 error) *ItemService_GetItemChanges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ItemService_GetItemChanges_Call) RunAndReturn(run func(context.Context, domain.ItemChangesQuery) (*domain.ItemChangesPage, error)) *ItemService_GetItemChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetItemHistory provides a mock function with given fields: ctx, id
+func (_m *ItemService) GetItemHistory(ctx context.Context, id string) ([]*domain.ItemRevision, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetItemHistory")
+	}
+
+	var r0 []*domain.ItemRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*domain.ItemRevision, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*domain.ItemRevision); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.ItemRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ItemService_GetItemHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetItemHistory'
+type ItemService_GetItemHistory_Call struct {
+	*mock.Call
+}
+
+// GetItemHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ItemService_Expecter) GetItemHistory(ctx interface{}, id interface{}) *ItemService_GetItemHistory_Call {
+	return &ItemService_GetItemHistory_Call{Call: _e.mock.On("GetItemHistory", ctx, id)}
+}
+
+func (_c *ItemService_GetItemHistory_Call) Run(run func(ctx context.Context, id string)) *ItemService_GetItemHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ItemService_GetItemHistory_Call) Return(_a0 []*domain.ItemRevision, _a1 error) *ItemService_GetItemHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ItemService_GetItemHistory_Call) RunAndReturn(run func(context.Context, string) ([]*domain.ItemRevision, error)) *ItemService_GetItemHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetItems provides a mock function with given fields: ctx, query
+func (_m *ItemService) GetItems(ctx context.Context, query domain.ListItemsQuery) ([]*domain.Item, int, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetItems")
+	}
+
+	var r0 []*domain.Item
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListItemsQuery) ([]*domain.Item, int, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListItemsQuery) []*domain.Item); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Item)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListItemsQuery) int); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.ListItemsQuery) error); ok {
+		r2 = rf(ctx, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ItemService_GetItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetItems'
+type ItemService_GetItems_Call struct {
+	*mock.Call
+}
+
+// GetItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query domain.ListItemsQuery
+func (_e *ItemService_Expecter) GetItems(ctx interface{}, query interface{}) *ItemService_GetItems_Call {
+	return &ItemService_GetItems_Call{Call: _e.mock.On("GetItems", ctx, query)}
+}
+
+func (_c *ItemService_GetItems_Call) Run(run func(ctx context.Context, query domain.ListItemsQuery)) *ItemService_GetItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListItemsQuery))
+	})
+	return _c
+}
+
+func (_c *ItemService_GetItems_Call) Return(_a0 []*domain.Item, _a1 int, _a2 error) *ItemService_GetItems_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ItemService_GetItems_Call) RunAndReturn(run func(context.Context, domain.ListItemsQuery) ([]*domain.Item, int, error)) *ItemService_GetItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListItemOptions provides a mock function with given fields: ctx
+func (_m *ItemService) ListItemOptions(ctx context.Context) ([]domain.ItemOption, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListItemOptions")
+	}
+
+	var r0 []domain.ItemOption
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]domain.ItemOption, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []domain.ItemOption); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.ItemOption)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ItemService_ListItemOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListItemOptions'
+type ItemService_ListItemOptions_Call struct {
+	*mock.Call
+}
+
+// ListItemOptions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ItemService_Expecter) ListItemOptions(ctx interface{}) *ItemService_ListItemOptions_Call {
+	return &ItemService_ListItemOptions_Call{Call: _e.mock.On("ListItemOptions", ctx)}
+}
+
+func (_c *ItemService_ListItemOptions_Call) Run(run func(ctx context.Context)) *ItemService_ListItemOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ItemService_ListItemOptions_Call) Return(_a0 []domain.ItemOption, _a1 error) *ItemService_ListItemOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ItemService_ListItemOptions_Call) RunAndReturn(run func(context.Context) ([]domain.ItemOption, error)) *ItemService_ListItemOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTrash provides a mock function with given fields: ctx, query
+func (_m *ItemService) ListTrash(ctx context.Context, query domain.ListTrashQuery) ([]*domain.Item, int, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTrash")
+	}
+
+	var r0 []*domain.Item
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListTrashQuery) ([]*domain.Item, int, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListTrashQuery) []*domain.Item); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Item)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListTrashQuery) int); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, domain.ListTrashQuery) error); ok {
+		r2 = rf(ctx, query)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ItemService_ListTrash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTrash'
+type ItemService_ListTrash_Call struct {
+	*mock.Call
+}
+
+// ListTrash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query domain.ListTrashQuery
+func (_e *ItemService_Expecter) ListTrash(ctx interface{}, query interface{}) *ItemService_ListTrash_Call {
+	return &ItemService_ListTrash_Call{Call: _e.mock.On("ListTrash", ctx, query)}
+}
+
+func (_c *ItemService_ListTrash_Call) Run(run func(ctx context.Context, query domain.ListTrashQuery)) *ItemService_ListTrash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListTrashQuery))
+	})
+	return _c
+}
+
+func (_c *ItemService_ListTrash_Call) Return(_a0 []*domain.Item, _a1 int, _a2 error) *ItemService_ListTrash_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ItemService_ListTrash_Call) RunAndReturn(run func(context.Context, domain.ListTrashQuery) ([]*domain.Item, int, error)) *ItemService_ListTrash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PurgeItem provides a mock function with given fields: ctx, id
+func (_m *ItemService) PurgeItem(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeItem")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ItemService_PurgeItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PurgeItem'
+type ItemService_PurgeItem_Call struct {
+	*mock.Call
+}
+
+// PurgeItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ItemService_Expecter) PurgeItem(ctx interface{}, id interface{}) *ItemService_PurgeItem_Call {
+	return &ItemService_PurgeItem_Call{Call: _e.mock.On("PurgeItem", ctx, id)}
+}
+
+func (_c *ItemService_PurgeItem_Call) Run(run func(ctx context.Context, id string)) *ItemService_PurgeItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ItemService_PurgeItem_Call) Return(_a0 error) *ItemService_PurgeItem_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ItemService_PurgeItem_Call) RunAndReturn(run func(context.Context, string) error) *ItemService_PurgeItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreItem provides a mock function with given fields: ctx, id, userID
+func (_m *ItemService) RestoreItem(ctx context.Context, id string, userID string) (*domain.Item, error) {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreItem")
+	}
+
+	var r0 *domain.Item
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.Item, error)); ok {
+		return rf(ctx, id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.Item); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Item)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ItemService_RestoreItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreItem'
+type ItemService_RestoreItem_Call struct {
+	*mock.Call
+}
+
+// RestoreItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *ItemService_Expecter) RestoreItem(ctx interface{}, id interface{}, userID interface{}) *ItemService_RestoreItem_Call {
+	return &ItemService_RestoreItem_Call{Call: _e.mock.On("RestoreItem", ctx, id, userID)}
+}
+
+func (_c *ItemService_RestoreItem_Call) Run(run func(ctx context.Context, id string, userID string)) *ItemService_RestoreItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ItemService_RestoreItem_Call) Return(_a0 *domain.Item, _a1 error) *ItemService_RestoreItem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ItemService_RestoreItem_Call) RunAndReturn(run func(context.Context, string, string) (*domain.Item, error)) *ItemService_RestoreItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamItems provides a mock function with given fields: ctx, fn
+func (_m *ItemService) StreamItems(ctx context.Context, fn func(*domain.Item) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamItems")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(*domain.Item) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ItemService_StreamItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamItems'
+type ItemService_StreamItems_Call struct {
+	*mock.Call
+}
+
+// StreamItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(*domain.Item) error
+func (_e *ItemService_Expecter) StreamItems(ctx interface{}, fn interface{}) *ItemService_StreamItems_Call {
+	return &ItemService_StreamItems_Call{Call: _e.mock.On("StreamItems", ctx, fn)}
+}
+
+func (_c *ItemService_StreamItems_Call) Run(run func(ctx context.Context, fn func(*domain.Item) error)) *ItemService_StreamItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(*domain.Item) error))
+	})
+	return _c
+}
+
+func (_c *ItemService_StreamItems_Call) Return(_a0 error) *ItemService_StreamItems_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ItemService_StreamItems_Call) RunAndReturn(run func(context.Context, func(*domain.Item) error) error) *ItemService_StreamItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamMatchingItems provides a mock function with given fields: ctx, query, fn
+func (_m *ItemService) StreamMatchingItems(ctx context.Context, query domain.ListItemsQuery, fn func(*domain.Item) error) error {
+	ret := _m.Called(ctx, query, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamMatchingItems")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListItemsQuery, func(*domain.Item) error) error); ok {
+		r0 = rf(ctx, query, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ItemService_StreamMatchingItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamMatchingItems'
+type ItemService_StreamMatchingItems_Call struct {
+	*mock.Call
+}
+
+// StreamMatchingItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query domain.ListItemsQuery
+//   - fn func(*domain.Item) error
+func (_e *ItemService_Expecter) StreamMatchingItems(ctx interface{}, query interface{}, fn interface{}) *ItemService_StreamMatchingItems_Call {
+	return &ItemService_StreamMatchingItems_Call{Call: _e.mock.On("StreamMatchingItems", ctx, query, fn)}
+}
+
+func (_c *ItemService_StreamMatchingItems_Call) Run(run func(ctx context.Context, query domain.ListItemsQuery, fn func(*domain.Item) error)) *ItemService_StreamMatchingItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListItemsQuery), args[2].(func(*domain.Item) error))
+	})
+	return _c
+}
+
+func (_c *ItemService_StreamMatchingItems_Call) Return(_a0 error) *ItemService_StreamMatchingItems_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ItemService_StreamMatchingItems_Call) RunAndReturn(run func(context.Context, domain.ListItemsQuery, func(*domain.Item) error) error) *ItemService_StreamMatchingItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateItem provides a mock function with given fields: ctx, id, req, userID
+func (_m *ItemService) UpdateItem(ctx context.Context, id string, req *domain.UpdateItemRequest, userID string) (*domain.Item, error) {
+	ret := _m.Called(ctx, id, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateItem")
+	}
+
+	var r0 *domain.Item
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.UpdateItemRequest, string) (*domain.Item, error)); ok {
+		return rf(ctx, id, req, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.UpdateItemRequest, string) *domain.Item); ok {
+		r0 = rf(ctx, id, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Item)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *domain.UpdateItemRequest, string) error); ok {
+		r1 = rf(ctx, id, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ItemService_UpdateItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateItem'
+type ItemService_UpdateItem_Call struct {
+	*mock.Call
+}
+
+// UpdateItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - req *domain.UpdateItemRequest
+//   - userID string
+func (_e *ItemService_Expecter) UpdateItem(ctx interface{}, id interface{}, req interface{}, userID interface{}) *ItemService_UpdateItem_Call {
+	return &ItemService_UpdateItem_Call{Call: _e.mock.On("UpdateItem", ctx, id, req, userID)}
+}
+
+func (_c *ItemService_UpdateItem_Call) Run(run func(ctx context.Context, id string, req *domain.UpdateItemRequest, userID string)) *ItemService_UpdateItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*domain.UpdateItemRequest), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ItemService_UpdateItem_Call) Return(_a0 *domain.Item, _a1 error) *ItemService_UpdateItem_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ItemService_UpdateItem_Call) RunAndReturn(run func(context.Context, string, *domain.UpdateItemRequest, string) (*domain.Item, error)) *ItemService_UpdateItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewItemService creates a new instance of ItemService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewItemService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ItemService {
+	mock := &ItemService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
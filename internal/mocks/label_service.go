@@ -0,0 +1,275 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "inventory-system/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LabelService is an autogenerated mock type for the LabelService type
+type LabelService struct {
+	mock.Mock
+}
+
+type LabelService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LabelService) EXPECT() *LabelService_Expecter {
+	return &LabelService_Expecter{mock: &_m.Mock}
+}
+
+// ClaimJobs provides a mock function with given fields: ctx, q
+func (_m *LabelService) ClaimJobs(ctx context.Context, q domain.ClaimLabelJobsQuery) ([]*domain.LabelJob, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimJobs")
+	}
+
+	var r0 []*domain.LabelJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ClaimLabelJobsQuery) ([]*domain.LabelJob, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ClaimLabelJobsQuery) []*domain.LabelJob); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.LabelJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ClaimLabelJobsQuery) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LabelService_ClaimJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimJobs'
+type LabelService_ClaimJobs_Call struct {
+	*mock.Call
+}
+
+// ClaimJobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q domain.ClaimLabelJobsQuery
+func (_e *LabelService_Expecter) ClaimJobs(ctx interface{}, q interface{}) *LabelService_ClaimJobs_Call {
+	return &LabelService_ClaimJobs_Call{Call: _e.mock.On("ClaimJobs", ctx, q)}
+}
+
+func (_c *LabelService_ClaimJobs_Call) Run(run func(ctx context.Context, q domain.ClaimLabelJobsQuery)) *LabelService_ClaimJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ClaimLabelJobsQuery))
+	})
+	return _c
+}
+
+func (_c *LabelService_ClaimJobs_Call) Return(_a0 []*domain.LabelJob, _a1 error) *LabelService_ClaimJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LabelService_ClaimJobs_Call) RunAndReturn(run func(context.Context, domain.ClaimLabelJobsQuery) ([]*domain.LabelJob, error)) *LabelService_ClaimJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteJob provides a mock function with given fields: ctx, id, req
+func (_m *LabelService) CompleteJob(ctx context.Context, id string, req *domain.CompleteLabelJobRequest) (*domain.LabelJob, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteJob")
+	}
+
+	var r0 *domain.LabelJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.CompleteLabelJobRequest) (*domain.LabelJob, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.CompleteLabelJobRequest) *domain.LabelJob); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LabelJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *domain.CompleteLabelJobRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LabelService_CompleteJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteJob'
+type LabelService_CompleteJob_Call struct {
+	*mock.Call
+}
+
+// CompleteJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - req *domain.CompleteLabelJobRequest
+func (_e *LabelService_Expecter) CompleteJob(ctx interface{}, id interface{}, req interface{}) *LabelService_CompleteJob_Call {
+	return &LabelService_CompleteJob_Call{Call: _e.mock.On("CompleteJob", ctx, id, req)}
+}
+
+func (_c *LabelService_CompleteJob_Call) Run(run func(ctx context.Context, id string, req *domain.CompleteLabelJobRequest)) *LabelService_CompleteJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*domain.CompleteLabelJobRequest))
+	})
+	return _c
+}
+
+func (_c *LabelService_CompleteJob_Call) Return(_a0 *domain.LabelJob, _a1 error) *LabelService_CompleteJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LabelService_CompleteJob_Call) RunAndReturn(run func(context.Context, string, *domain.CompleteLabelJobRequest) (*domain.LabelJob, error)) *LabelService_CompleteJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnqueueLabels provides a mock function with given fields: ctx, req, userID
+func (_m *LabelService) EnqueueLabels(ctx context.Context, req *domain.EnqueueLabelsRequest, userID string) ([]*domain.LabelJob, error) {
+	ret := _m.Called(ctx, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueLabels")
+	}
+
+	var r0 []*domain.LabelJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.EnqueueLabelsRequest, string) ([]*domain.LabelJob, error)); ok {
+		return rf(ctx, req, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.EnqueueLabelsRequest, string) []*domain.LabelJob); ok {
+		r0 = rf(ctx, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.LabelJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.EnqueueLabelsRequest, string) error); ok {
+		r1 = rf(ctx, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LabelService_EnqueueLabels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnqueueLabels'
+type LabelService_EnqueueLabels_Call struct {
+	*mock.Call
+}
+
+// EnqueueLabels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.EnqueueLabelsRequest
+//   - userID string
+func (_e *LabelService_Expecter) EnqueueLabels(ctx interface{}, req interface{}, userID interface{}) *LabelService_EnqueueLabels_Call {
+	return &LabelService_EnqueueLabels_Call{Call: _e.mock.On("EnqueueLabels", ctx, req, userID)}
+}
+
+func (_c *LabelService_EnqueueLabels_Call) Run(run func(ctx context.Context, req *domain.EnqueueLabelsRequest, userID string)) *LabelService_EnqueueLabels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.EnqueueLabelsRequest), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *LabelService_EnqueueLabels_Call) Return(_a0 []*domain.LabelJob, _a1 error) *LabelService_EnqueueLabels_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LabelService_EnqueueLabels_Call) RunAndReturn(run func(context.Context, *domain.EnqueueLabelsRequest, string) ([]*domain.LabelJob, error)) *LabelService_EnqueueLabels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListJobs provides a mock function with given fields: ctx, q
+func (_m *LabelService) ListJobs(ctx context.Context, q domain.ListLabelJobsQuery) ([]*domain.LabelJob, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListJobs")
+	}
+
+	var r0 []*domain.LabelJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListLabelJobsQuery) ([]*domain.LabelJob, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListLabelJobsQuery) []*domain.LabelJob); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.LabelJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListLabelJobsQuery) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LabelService_ListJobs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListJobs'
+type LabelService_ListJobs_Call struct {
+	*mock.Call
+}
+
+// ListJobs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q domain.ListLabelJobsQuery
+func (_e *LabelService_Expecter) ListJobs(ctx interface{}, q interface{}) *LabelService_ListJobs_Call {
+	return &LabelService_ListJobs_Call{Call: _e.mock.On("ListJobs", ctx, q)}
+}
+
+func (_c *LabelService_ListJobs_Call) Run(run func(ctx context.Context, q domain.ListLabelJobsQuery)) *LabelService_ListJobs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListLabelJobsQuery))
+	})
+	return _c
+}
+
+func (_c *LabelService_ListJobs_Call) Return(_a0 []*domain.LabelJob, _a1 error) *LabelService_ListJobs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LabelService_ListJobs_Call) RunAndReturn(run func(context.Context, domain.ListLabelJobsQuery) ([]*domain.LabelJob, error)) *LabelService_ListJobs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLabelService creates a new instance of LabelService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLabelService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LabelService {
+	mock := &LabelService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
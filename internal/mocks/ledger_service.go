@@ -0,0 +1,215 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "inventory-system/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LedgerService is an autogenerated mock type for the LedgerService type
+type LedgerService struct {
+	mock.Mock
+}
+
+type LedgerService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LedgerService) EXPECT() *LedgerService_Expecter {
+	return &LedgerService_Expecter{mock: &_m.Mock}
+}
+
+// Check provides a mock function with given fields: ctx
+func (_m *LedgerService) Check(ctx context.Context) (*domain.LedgerCheckResult, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 *domain.LedgerCheckResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*domain.LedgerCheckResult, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *domain.LedgerCheckResult); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LedgerCheckResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LedgerService_Check_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Check'
+type LedgerService_Check_Call struct {
+	*mock.Call
+}
+
+// Check is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *LedgerService_Expecter) Check(ctx interface{}) *LedgerService_Check_Call {
+	return &LedgerService_Check_Call{Call: _e.mock.On("Check", ctx)}
+}
+
+func (_c *LedgerService_Check_Call) Run(run func(ctx context.Context)) *LedgerService_Check_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *LedgerService_Check_Call) Return(_a0 *domain.LedgerCheckResult, _a1 error) *LedgerService_Check_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LedgerService_Check_Call) RunAndReturn(run func(context.Context) (*domain.LedgerCheckResult, error)) *LedgerService_Check_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDiscrepancies provides a mock function with given fields: ctx, q
+func (_m *LedgerService) ListDiscrepancies(ctx context.Context, q domain.ListLedgerDiscrepanciesQuery) ([]*domain.LedgerDiscrepancy, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDiscrepancies")
+	}
+
+	var r0 []*domain.LedgerDiscrepancy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListLedgerDiscrepanciesQuery) ([]*domain.LedgerDiscrepancy, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListLedgerDiscrepanciesQuery) []*domain.LedgerDiscrepancy); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.LedgerDiscrepancy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListLedgerDiscrepanciesQuery) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LedgerService_ListDiscrepancies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDiscrepancies'
+type LedgerService_ListDiscrepancies_Call struct {
+	*mock.Call
+}
+
+// ListDiscrepancies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q domain.ListLedgerDiscrepanciesQuery
+func (_e *LedgerService_Expecter) ListDiscrepancies(ctx interface{}, q interface{}) *LedgerService_ListDiscrepancies_Call {
+	return &LedgerService_ListDiscrepancies_Call{Call: _e.mock.On("ListDiscrepancies", ctx, q)}
+}
+
+func (_c *LedgerService_ListDiscrepancies_Call) Run(run func(ctx context.Context, q domain.ListLedgerDiscrepanciesQuery)) *LedgerService_ListDiscrepancies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListLedgerDiscrepanciesQuery))
+	})
+	return _c
+}
+
+func (_c *LedgerService_ListDiscrepancies_Call) Return(_a0 []*domain.LedgerDiscrepancy, _a1 error) *LedgerService_ListDiscrepancies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LedgerService_ListDiscrepancies_Call) RunAndReturn(run func(context.Context, domain.ListLedgerDiscrepanciesQuery) ([]*domain.LedgerDiscrepancy, error)) *LedgerService_ListDiscrepancies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reconcile provides a mock function with given fields: ctx, id, req, userID
+func (_m *LedgerService) Reconcile(ctx context.Context, id string, req *domain.ReconcileLedgerRequest, userID string) (*domain.LedgerDiscrepancy, error) {
+	ret := _m.Called(ctx, id, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reconcile")
+	}
+
+	var r0 *domain.LedgerDiscrepancy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.ReconcileLedgerRequest, string) (*domain.LedgerDiscrepancy, error)); ok {
+		return rf(ctx, id, req, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.ReconcileLedgerRequest, string) *domain.LedgerDiscrepancy); ok {
+		r0 = rf(ctx, id, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.LedgerDiscrepancy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *domain.ReconcileLedgerRequest, string) error); ok {
+		r1 = rf(ctx, id, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LedgerService_Reconcile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reconcile'
+type LedgerService_Reconcile_Call struct {
+	*mock.Call
+}
+
+// Reconcile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - req *domain.ReconcileLedgerRequest
+//   - userID string
+func (_e *LedgerService_Expecter) Reconcile(ctx interface{}, id interface{}, req interface{}, userID interface{}) *LedgerService_Reconcile_Call {
+	return &LedgerService_Reconcile_Call{Call: _e.mock.On("Reconcile", ctx, id, req, userID)}
+}
+
+func (_c *LedgerService_Reconcile_Call) Run(run func(ctx context.Context, id string, req *domain.ReconcileLedgerRequest, userID string)) *LedgerService_Reconcile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*domain.ReconcileLedgerRequest), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *LedgerService_Reconcile_Call) Return(_a0 *domain.LedgerDiscrepancy, _a1 error) *LedgerService_Reconcile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LedgerService_Reconcile_Call) RunAndReturn(run func(context.Context, string, *domain.ReconcileLedgerRequest, string) (*domain.LedgerDiscrepancy, error)) *LedgerService_Reconcile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLedgerService creates a new instance of LedgerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLedgerService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LedgerService {
+	mock := &LedgerService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
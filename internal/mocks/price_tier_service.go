@@ -0,0 +1,265 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "inventory-system/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PriceTierService is an autogenerated mock type for the PriceTierService type
+type PriceTierService struct {
+	mock.Mock
+}
+
+type PriceTierService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PriceTierService) EXPECT() *PriceTierService_Expecter {
+	return &PriceTierService_Expecter{mock: &_m.Mock}
+}
+
+// DeleteTierPrice provides a mock function with given fields: ctx, itemID, tier
+func (_m *PriceTierService) DeleteTierPrice(ctx context.Context, itemID string, tier string) error {
+	ret := _m.Called(ctx, itemID, tier)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTierPrice")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, itemID, tier)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PriceTierService_DeleteTierPrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTierPrice'
+type PriceTierService_DeleteTierPrice_Call struct {
+	*mock.Call
+}
+
+// DeleteTierPrice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+//   - tier string
+func (_e *PriceTierService_Expecter) DeleteTierPrice(ctx interface{}, itemID interface{}, tier interface{}) *PriceTierService_DeleteTierPrice_Call {
+	return &PriceTierService_DeleteTierPrice_Call{Call: _e.mock.On("DeleteTierPrice", ctx, itemID, tier)}
+}
+
+func (_c *PriceTierService_DeleteTierPrice_Call) Run(run func(ctx context.Context, itemID string, tier string)) *PriceTierService_DeleteTierPrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *PriceTierService_DeleteTierPrice_Call) Return(_a0 error) *PriceTierService_DeleteTierPrice_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceTierService_DeleteTierPrice_Call) RunAndReturn(run func(context.Context, string, string) error) *PriceTierService_DeleteTierPrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTierPrices provides a mock function with given fields: ctx, itemID
+func (_m *PriceTierService) ListTierPrices(ctx context.Context, itemID string) ([]*domain.TierPrice, error) {
+	ret := _m.Called(ctx, itemID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTierPrices")
+	}
+
+	var r0 []*domain.TierPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*domain.TierPrice, error)); ok {
+		return rf(ctx, itemID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*domain.TierPrice); ok {
+		r0 = rf(ctx, itemID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.TierPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, itemID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PriceTierService_ListTierPrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTierPrices'
+type PriceTierService_ListTierPrices_Call struct {
+	*mock.Call
+}
+
+// ListTierPrices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+func (_e *PriceTierService_Expecter) ListTierPrices(ctx interface{}, itemID interface{}) *PriceTierService_ListTierPrices_Call {
+	return &PriceTierService_ListTierPrices_Call{Call: _e.mock.On("ListTierPrices", ctx, itemID)}
+}
+
+func (_c *PriceTierService_ListTierPrices_Call) Run(run func(ctx context.Context, itemID string)) *PriceTierService_ListTierPrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PriceTierService_ListTierPrices_Call) Return(_a0 []*domain.TierPrice, _a1 error) *PriceTierService_ListTierPrices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PriceTierService_ListTierPrices_Call) RunAndReturn(run func(context.Context, string) ([]*domain.TierPrice, error)) *PriceTierService_ListTierPrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolvePrice provides a mock function with given fields: ctx, itemID, customerID
+func (_m *PriceTierService) ResolvePrice(ctx context.Context, itemID string, customerID string) (*domain.ResolvedPrice, error) {
+	ret := _m.Called(ctx, itemID, customerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolvePrice")
+	}
+
+	var r0 *domain.ResolvedPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.ResolvedPrice, error)); ok {
+		return rf(ctx, itemID, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.ResolvedPrice); ok {
+		r0 = rf(ctx, itemID, customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ResolvedPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, itemID, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PriceTierService_ResolvePrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolvePrice'
+type PriceTierService_ResolvePrice_Call struct {
+	*mock.Call
+}
+
+// ResolvePrice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+//   - customerID string
+func (_e *PriceTierService_Expecter) ResolvePrice(ctx interface{}, itemID interface{}, customerID interface{}) *PriceTierService_ResolvePrice_Call {
+	return &PriceTierService_ResolvePrice_Call{Call: _e.mock.On("ResolvePrice", ctx, itemID, customerID)}
+}
+
+func (_c *PriceTierService_ResolvePrice_Call) Run(run func(ctx context.Context, itemID string, customerID string)) *PriceTierService_ResolvePrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *PriceTierService_ResolvePrice_Call) Return(_a0 *domain.ResolvedPrice, _a1 error) *PriceTierService_ResolvePrice_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PriceTierService_ResolvePrice_Call) RunAndReturn(run func(context.Context, string, string) (*domain.ResolvedPrice, error)) *PriceTierService_ResolvePrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTierPrice provides a mock function with given fields: ctx, itemID, tier, req
+func (_m *PriceTierService) SetTierPrice(ctx context.Context, itemID string, tier string, req *domain.SetTierPriceRequest) (*domain.TierPrice, error) {
+	ret := _m.Called(ctx, itemID, tier, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTierPrice")
+	}
+
+	var r0 *domain.TierPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *domain.SetTierPriceRequest) (*domain.TierPrice, error)); ok {
+		return rf(ctx, itemID, tier, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *domain.SetTierPriceRequest) *domain.TierPrice); ok {
+		r0 = rf(ctx, itemID, tier, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TierPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *domain.SetTierPriceRequest) error); ok {
+		r1 = rf(ctx, itemID, tier, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PriceTierService_SetTierPrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTierPrice'
+type PriceTierService_SetTierPrice_Call struct {
+	*mock.Call
+}
+
+// SetTierPrice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+//   - tier string
+//   - req *domain.SetTierPriceRequest
+func (_e *PriceTierService_Expecter) SetTierPrice(ctx interface{}, itemID interface{}, tier interface{}, req interface{}) *PriceTierService_SetTierPrice_Call {
+	return &PriceTierService_SetTierPrice_Call{Call: _e.mock.On("SetTierPrice", ctx, itemID, tier, req)}
+}
+
+func (_c *PriceTierService_SetTierPrice_Call) Run(run func(ctx context.Context, itemID string, tier string, req *domain.SetTierPriceRequest)) *PriceTierService_SetTierPrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*domain.SetTierPriceRequest))
+	})
+	return _c
+}
+
+func (_c *PriceTierService_SetTierPrice_Call) Return(_a0 *domain.TierPrice, _a1 error) *PriceTierService_SetTierPrice_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PriceTierService_SetTierPrice_Call) RunAndReturn(run func(context.Context, string, string, *domain.SetTierPriceRequest) (*domain.TierPrice, error)) *PriceTierService_SetTierPrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPriceTierService creates a new instance of PriceTierService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPriceTierService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PriceTierService {
+	mock := &PriceTierService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
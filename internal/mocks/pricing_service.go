@@ -0,0 +1,156 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "inventory-system/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PricingService is an autogenerated mock type for the PricingService type
+type PricingService struct {
+	mock.Mock
+}
+
+type PricingService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PricingService) EXPECT() *PricingService_Expecter {
+	return &PricingService_Expecter{mock: &_m.Mock}
+}
+
+// BulkUpdatePrices provides a mock function with given fields: ctx, req, changedBy
+func (_m *PricingService) BulkUpdatePrices(ctx context.Context, req *domain.BulkPriceUpdateRequest, changedBy string) (*domain.BulkPriceUpdateResult, error) {
+	ret := _m.Called(ctx, req, changedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkUpdatePrices")
+	}
+
+	var r0 *domain.BulkPriceUpdateResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.BulkPriceUpdateRequest, string) (*domain.BulkPriceUpdateResult, error)); ok {
+		return rf(ctx, req, changedBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.BulkPriceUpdateRequest, string) *domain.BulkPriceUpdateResult); ok {
+		r0 = rf(ctx, req, changedBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.BulkPriceUpdateResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.BulkPriceUpdateRequest, string) error); ok {
+		r1 = rf(ctx, req, changedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PricingService_BulkUpdatePrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkUpdatePrices'
+type PricingService_BulkUpdatePrices_Call struct {
+	*mock.Call
+}
+
+// BulkUpdatePrices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.BulkPriceUpdateRequest
+//   - changedBy string
+func (_e *PricingService_Expecter) BulkUpdatePrices(ctx interface{}, req interface{}, changedBy interface{}) *PricingService_BulkUpdatePrices_Call {
+	return &PricingService_BulkUpdatePrices_Call{Call: _e.mock.On("BulkUpdatePrices", ctx, req, changedBy)}
+}
+
+func (_c *PricingService_BulkUpdatePrices_Call) Run(run func(ctx context.Context, req *domain.BulkPriceUpdateRequest, changedBy string)) *PricingService_BulkUpdatePrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.BulkPriceUpdateRequest), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *PricingService_BulkUpdatePrices_Call) Return(_a0 *domain.BulkPriceUpdateResult, _a1 error) *PricingService_BulkUpdatePrices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PricingService_BulkUpdatePrices_Call) RunAndReturn(run func(context.Context, *domain.BulkPriceUpdateRequest, string) (*domain.BulkPriceUpdateResult, error)) *PricingService_BulkUpdatePrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPriceHistory provides a mock function with given fields: ctx, itemID
+func (_m *PricingService) GetPriceHistory(ctx context.Context, itemID string) ([]*domain.PriceHistoryEntry, error) {
+	ret := _m.Called(ctx, itemID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPriceHistory")
+	}
+
+	var r0 []*domain.PriceHistoryEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*domain.PriceHistoryEntry, error)); ok {
+		return rf(ctx, itemID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*domain.PriceHistoryEntry); ok {
+		r0 = rf(ctx, itemID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.PriceHistoryEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, itemID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PricingService_GetPriceHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPriceHistory'
+type PricingService_GetPriceHistory_Call struct {
+	*mock.Call
+}
+
+// GetPriceHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+func (_e *PricingService_Expecter) GetPriceHistory(ctx interface{}, itemID interface{}) *PricingService_GetPriceHistory_Call {
+	return &PricingService_GetPriceHistory_Call{Call: _e.mock.On("GetPriceHistory", ctx, itemID)}
+}
+
+func (_c *PricingService_GetPriceHistory_Call) Run(run func(ctx context.Context, itemID string)) *PricingService_GetPriceHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PricingService_GetPriceHistory_Call) Return(_a0 []*domain.PriceHistoryEntry, _a1 error) *PricingService_GetPriceHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PricingService_GetPriceHistory_Call) RunAndReturn(run func(context.Context, string) ([]*domain.PriceHistoryEntry, error)) *PricingService_GetPriceHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPricingService creates a new instance of PricingService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPricingService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PricingService {
+	mock := &PricingService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
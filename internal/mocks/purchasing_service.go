@@ -0,0 +1,634 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "inventory-system/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PurchasingService is an autogenerated mock type for the PurchasingService type
+type PurchasingService struct {
+	mock.Mock
+}
+
+type PurchasingService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PurchasingService) EXPECT() *PurchasingService_Expecter {
+	return &PurchasingService_Expecter{mock: &_m.Mock}
+}
+
+// CancelOrder provides a mock function with given fields: ctx, id, userID, roles
+func (_m *PurchasingService) CancelOrder(ctx context.Context, id string, userID string, roles []string) (*domain.PurchaseOrder, error) {
+	ret := _m.Called(ctx, id, userID, roles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelOrder")
+	}
+
+	var r0 *domain.PurchaseOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) (*domain.PurchaseOrder, error)); ok {
+		return rf(ctx, id, userID, roles)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) *domain.PurchaseOrder); ok {
+		r0 = rf(ctx, id, userID, roles)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PurchaseOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []string) error); ok {
+		r1 = rf(ctx, id, userID, roles)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurchasingService_CancelOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelOrder'
+type PurchasingService_CancelOrder_Call struct {
+	*mock.Call
+}
+
+// CancelOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+//   - roles []string
+func (_e *PurchasingService_Expecter) CancelOrder(ctx interface{}, id interface{}, userID interface{}, roles interface{}) *PurchasingService_CancelOrder_Call {
+	return &PurchasingService_CancelOrder_Call{Call: _e.mock.On("CancelOrder", ctx, id, userID, roles)}
+}
+
+func (_c *PurchasingService_CancelOrder_Call) Run(run func(ctx context.Context, id string, userID string, roles []string)) *PurchasingService_CancelOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *PurchasingService_CancelOrder_Call) Return(_a0 *domain.PurchaseOrder, _a1 error) *PurchasingService_CancelOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PurchasingService_CancelOrder_Call) RunAndReturn(run func(context.Context, string, string, []string) (*domain.PurchaseOrder, error)) *PurchasingService_CancelOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrder provides a mock function with given fields: ctx, req, userID
+func (_m *PurchasingService) CreateOrder(ctx context.Context, req *domain.CreatePurchaseOrderRequest, userID string) (*domain.PurchaseOrder, error) {
+	ret := _m.Called(ctx, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrder")
+	}
+
+	var r0 *domain.PurchaseOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreatePurchaseOrderRequest, string) (*domain.PurchaseOrder, error)); ok {
+		return rf(ctx, req, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreatePurchaseOrderRequest, string) *domain.PurchaseOrder); ok {
+		r0 = rf(ctx, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PurchaseOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreatePurchaseOrderRequest, string) error); ok {
+		r1 = rf(ctx, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurchasingService_CreateOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrder'
+type PurchasingService_CreateOrder_Call struct {
+	*mock.Call
+}
+
+// CreateOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.CreatePurchaseOrderRequest
+//   - userID string
+func (_e *PurchasingService_Expecter) CreateOrder(ctx interface{}, req interface{}, userID interface{}) *PurchasingService_CreateOrder_Call {
+	return &PurchasingService_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, req, userID)}
+}
+
+func (_c *PurchasingService_CreateOrder_Call) Run(run func(ctx context.Context, req *domain.CreatePurchaseOrderRequest, userID string)) *PurchasingService_CreateOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CreatePurchaseOrderRequest), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *PurchasingService_CreateOrder_Call) Return(_a0 *domain.PurchaseOrder, _a1 error) *PurchasingService_CreateOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PurchasingService_CreateOrder_Call) RunAndReturn(run func(context.Context, *domain.CreatePurchaseOrderRequest, string) (*domain.PurchaseOrder, error)) *PurchasingService_CreateOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSupplier provides a mock function with given fields: ctx, req
+func (_m *PurchasingService) CreateSupplier(ctx context.Context, req *domain.CreateSupplierRequest) (*domain.Supplier, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSupplier")
+	}
+
+	var r0 *domain.Supplier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateSupplierRequest) (*domain.Supplier, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateSupplierRequest) *domain.Supplier); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Supplier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateSupplierRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurchasingService_CreateSupplier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSupplier'
+type PurchasingService_CreateSupplier_Call struct {
+	*mock.Call
+}
+
+// CreateSupplier is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.CreateSupplierRequest
+func (_e *PurchasingService_Expecter) CreateSupplier(ctx interface{}, req interface{}) *PurchasingService_CreateSupplier_Call {
+	return &PurchasingService_CreateSupplier_Call{Call: _e.mock.On("CreateSupplier", ctx, req)}
+}
+
+func (_c *PurchasingService_CreateSupplier_Call) Run(run func(ctx context.Context, req *domain.CreateSupplierRequest)) *PurchasingService_CreateSupplier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CreateSupplierRequest))
+	})
+	return _c
+}
+
+func (_c *PurchasingService_CreateSupplier_Call) Return(_a0 *domain.Supplier, _a1 error) *PurchasingService_CreateSupplier_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PurchasingService_CreateSupplier_Call) RunAndReturn(run func(context.Context, *domain.CreateSupplierRequest) (*domain.Supplier, error)) *PurchasingService_CreateSupplier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrder provides a mock function with given fields: ctx, id
+func (_m *PurchasingService) GetOrder(ctx context.Context, id string) (*domain.PurchaseOrder, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrder")
+	}
+
+	var r0 *domain.PurchaseOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.PurchaseOrder, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.PurchaseOrder); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PurchaseOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurchasingService_GetOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrder'
+type PurchasingService_GetOrder_Call struct {
+	*mock.Call
+}
+
+// GetOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *PurchasingService_Expecter) GetOrder(ctx interface{}, id interface{}) *PurchasingService_GetOrder_Call {
+	return &PurchasingService_GetOrder_Call{Call: _e.mock.On("GetOrder", ctx, id)}
+}
+
+func (_c *PurchasingService_GetOrder_Call) Run(run func(ctx context.Context, id string)) *PurchasingService_GetOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PurchasingService_GetOrder_Call) Return(_a0 *domain.PurchaseOrder, _a1 error) *PurchasingService_GetOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PurchasingService_GetOrder_Call) RunAndReturn(run func(context.Context, string) (*domain.PurchaseOrder, error)) *PurchasingService_GetOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSupplier provides a mock function with given fields: ctx, id
+func (_m *PurchasingService) GetSupplier(ctx context.Context, id string) (*domain.Supplier, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSupplier")
+	}
+
+	var r0 *domain.Supplier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.Supplier, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.Supplier); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Supplier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurchasingService_GetSupplier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSupplier'
+type PurchasingService_GetSupplier_Call struct {
+	*mock.Call
+}
+
+// GetSupplier is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *PurchasingService_Expecter) GetSupplier(ctx interface{}, id interface{}) *PurchasingService_GetSupplier_Call {
+	return &PurchasingService_GetSupplier_Call{Call: _e.mock.On("GetSupplier", ctx, id)}
+}
+
+func (_c *PurchasingService_GetSupplier_Call) Run(run func(ctx context.Context, id string)) *PurchasingService_GetSupplier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PurchasingService_GetSupplier_Call) Return(_a0 *domain.Supplier, _a1 error) *PurchasingService_GetSupplier_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PurchasingService_GetSupplier_Call) RunAndReturn(run func(context.Context, string) (*domain.Supplier, error)) *PurchasingService_GetSupplier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrders provides a mock function with given fields: ctx, q
+func (_m *PurchasingService) ListOrders(ctx context.Context, q domain.ListPurchaseOrdersQuery) ([]*domain.PurchaseOrder, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrders")
+	}
+
+	var r0 []*domain.PurchaseOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListPurchaseOrdersQuery) ([]*domain.PurchaseOrder, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListPurchaseOrdersQuery) []*domain.PurchaseOrder); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.PurchaseOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListPurchaseOrdersQuery) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurchasingService_ListOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrders'
+type PurchasingService_ListOrders_Call struct {
+	*mock.Call
+}
+
+// ListOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q domain.ListPurchaseOrdersQuery
+func (_e *PurchasingService_Expecter) ListOrders(ctx interface{}, q interface{}) *PurchasingService_ListOrders_Call {
+	return &PurchasingService_ListOrders_Call{Call: _e.mock.On("ListOrders", ctx, q)}
+}
+
+func (_c *PurchasingService_ListOrders_Call) Run(run func(ctx context.Context, q domain.ListPurchaseOrdersQuery)) *PurchasingService_ListOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListPurchaseOrdersQuery))
+	})
+	return _c
+}
+
+func (_c *PurchasingService_ListOrders_Call) Return(_a0 []*domain.PurchaseOrder, _a1 error) *PurchasingService_ListOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PurchasingService_ListOrders_Call) RunAndReturn(run func(context.Context, domain.ListPurchaseOrdersQuery) ([]*domain.PurchaseOrder, error)) *PurchasingService_ListOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSuppliers provides a mock function with given fields: ctx
+func (_m *PurchasingService) ListSuppliers(ctx context.Context) ([]*domain.Supplier, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSuppliers")
+	}
+
+	var r0 []*domain.Supplier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.Supplier, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.Supplier); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Supplier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurchasingService_ListSuppliers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSuppliers'
+type PurchasingService_ListSuppliers_Call struct {
+	*mock.Call
+}
+
+// ListSuppliers is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PurchasingService_Expecter) ListSuppliers(ctx interface{}) *PurchasingService_ListSuppliers_Call {
+	return &PurchasingService_ListSuppliers_Call{Call: _e.mock.On("ListSuppliers", ctx)}
+}
+
+func (_c *PurchasingService_ListSuppliers_Call) Run(run func(ctx context.Context)) *PurchasingService_ListSuppliers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PurchasingService_ListSuppliers_Call) Return(_a0 []*domain.Supplier, _a1 error) *PurchasingService_ListSuppliers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PurchasingService_ListSuppliers_Call) RunAndReturn(run func(context.Context) ([]*domain.Supplier, error)) *PurchasingService_ListSuppliers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrderHistory provides a mock function with given fields: ctx, id
+func (_m *PurchasingService) OrderHistory(ctx context.Context, id string) ([]*domain.WorkflowTransition, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrderHistory")
+	}
+
+	var r0 []*domain.WorkflowTransition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*domain.WorkflowTransition, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*domain.WorkflowTransition); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.WorkflowTransition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurchasingService_OrderHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrderHistory'
+type PurchasingService_OrderHistory_Call struct {
+	*mock.Call
+}
+
+// OrderHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *PurchasingService_Expecter) OrderHistory(ctx interface{}, id interface{}) *PurchasingService_OrderHistory_Call {
+	return &PurchasingService_OrderHistory_Call{Call: _e.mock.On("OrderHistory", ctx, id)}
+}
+
+func (_c *PurchasingService_OrderHistory_Call) Run(run func(ctx context.Context, id string)) *PurchasingService_OrderHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PurchasingService_OrderHistory_Call) Return(_a0 []*domain.WorkflowTransition, _a1 error) *PurchasingService_OrderHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PurchasingService_OrderHistory_Call) RunAndReturn(run func(context.Context, string) ([]*domain.WorkflowTransition, error)) *PurchasingService_OrderHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReceiveOrder provides a mock function with given fields: ctx, id, req, userID, roles
+func (_m *PurchasingService) ReceiveOrder(ctx context.Context, id string, req *domain.ReceivePurchaseOrderRequest, userID string, roles []string) (*domain.ReceivePurchaseOrderResult, error) {
+	ret := _m.Called(ctx, id, req, userID, roles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReceiveOrder")
+	}
+
+	var r0 *domain.ReceivePurchaseOrderResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.ReceivePurchaseOrderRequest, string, []string) (*domain.ReceivePurchaseOrderResult, error)); ok {
+		return rf(ctx, id, req, userID, roles)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.ReceivePurchaseOrderRequest, string, []string) *domain.ReceivePurchaseOrderResult); ok {
+		r0 = rf(ctx, id, req, userID, roles)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ReceivePurchaseOrderResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *domain.ReceivePurchaseOrderRequest, string, []string) error); ok {
+		r1 = rf(ctx, id, req, userID, roles)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurchasingService_ReceiveOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReceiveOrder'
+type PurchasingService_ReceiveOrder_Call struct {
+	*mock.Call
+}
+
+// ReceiveOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - req *domain.ReceivePurchaseOrderRequest
+//   - userID string
+//   - roles []string
+func (_e *PurchasingService_Expecter) ReceiveOrder(ctx interface{}, id interface{}, req interface{}, userID interface{}, roles interface{}) *PurchasingService_ReceiveOrder_Call {
+	return &PurchasingService_ReceiveOrder_Call{Call: _e.mock.On("ReceiveOrder", ctx, id, req, userID, roles)}
+}
+
+func (_c *PurchasingService_ReceiveOrder_Call) Run(run func(ctx context.Context, id string, req *domain.ReceivePurchaseOrderRequest, userID string, roles []string)) *PurchasingService_ReceiveOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*domain.ReceivePurchaseOrderRequest), args[3].(string), args[4].([]string))
+	})
+	return _c
+}
+
+func (_c *PurchasingService_ReceiveOrder_Call) Return(_a0 *domain.ReceivePurchaseOrderResult, _a1 error) *PurchasingService_ReceiveOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PurchasingService_ReceiveOrder_Call) RunAndReturn(run func(context.Context, string, *domain.ReceivePurchaseOrderRequest, string, []string) (*domain.ReceivePurchaseOrderResult, error)) *PurchasingService_ReceiveOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubmitOrder provides a mock function with given fields: ctx, id, userID, roles
+func (_m *PurchasingService) SubmitOrder(ctx context.Context, id string, userID string, roles []string) (*domain.PurchaseOrder, error) {
+	ret := _m.Called(ctx, id, userID, roles)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubmitOrder")
+	}
+
+	var r0 *domain.PurchaseOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) (*domain.PurchaseOrder, error)); ok {
+		return rf(ctx, id, userID, roles)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) *domain.PurchaseOrder); ok {
+		r0 = rf(ctx, id, userID, roles)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PurchaseOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []string) error); ok {
+		r1 = rf(ctx, id, userID, roles)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurchasingService_SubmitOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmitOrder'
+type PurchasingService_SubmitOrder_Call struct {
+	*mock.Call
+}
+
+// SubmitOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+//   - roles []string
+func (_e *PurchasingService_Expecter) SubmitOrder(ctx interface{}, id interface{}, userID interface{}, roles interface{}) *PurchasingService_SubmitOrder_Call {
+	return &PurchasingService_SubmitOrder_Call{Call: _e.mock.On("SubmitOrder", ctx, id, userID, roles)}
+}
+
+func (_c *PurchasingService_SubmitOrder_Call) Run(run func(ctx context.Context, id string, userID string, roles []string)) *PurchasingService_SubmitOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]string))
+	})
+	return _c
+}
+
+func (_c *PurchasingService_SubmitOrder_Call) Return(_a0 *domain.PurchaseOrder, _a1 error) *PurchasingService_SubmitOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PurchasingService_SubmitOrder_Call) RunAndReturn(run func(context.Context, string, string, []string) (*domain.PurchaseOrder, error)) *PurchasingService_SubmitOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPurchasingService creates a new instance of PurchasingService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPurchasingService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PurchasingService {
+	mock := &PurchasingService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,261 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "inventory-system/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RebuildService is an autogenerated mock type for the RebuildService type
+type RebuildService struct {
+	mock.Mock
+}
+
+type RebuildService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RebuildService) EXPECT() *RebuildService_Expecter {
+	return &RebuildService_Expecter{mock: &_m.Mock}
+}
+
+// GetRebuild provides a mock function with given fields: ctx, id
+func (_m *RebuildService) GetRebuild(ctx context.Context, id string) (*domain.RebuildJob, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRebuild")
+	}
+
+	var r0 *domain.RebuildJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.RebuildJob, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.RebuildJob); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RebuildJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RebuildService_GetRebuild_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRebuild'
+type RebuildService_GetRebuild_Call struct {
+	*mock.Call
+}
+
+// GetRebuild is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *RebuildService_Expecter) GetRebuild(ctx interface{}, id interface{}) *RebuildService_GetRebuild_Call {
+	return &RebuildService_GetRebuild_Call{Call: _e.mock.On("GetRebuild", ctx, id)}
+}
+
+func (_c *RebuildService_GetRebuild_Call) Run(run func(ctx context.Context, id string)) *RebuildService_GetRebuild_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RebuildService_GetRebuild_Call) Return(_a0 *domain.RebuildJob, _a1 error) *RebuildService_GetRebuild_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RebuildService_GetRebuild_Call) RunAndReturn(run func(context.Context, string) (*domain.RebuildJob, error)) *RebuildService_GetRebuild_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRebuilds provides a mock function with given fields: ctx
+func (_m *RebuildService) ListRebuilds(ctx context.Context) ([]*domain.RebuildJob, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRebuilds")
+	}
+
+	var r0 []*domain.RebuildJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.RebuildJob, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.RebuildJob); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.RebuildJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RebuildService_ListRebuilds_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRebuilds'
+type RebuildService_ListRebuilds_Call struct {
+	*mock.Call
+}
+
+// ListRebuilds is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RebuildService_Expecter) ListRebuilds(ctx interface{}) *RebuildService_ListRebuilds_Call {
+	return &RebuildService_ListRebuilds_Call{Call: _e.mock.On("ListRebuilds", ctx)}
+}
+
+func (_c *RebuildService_ListRebuilds_Call) Run(run func(ctx context.Context)) *RebuildService_ListRebuilds_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RebuildService_ListRebuilds_Call) Return(_a0 []*domain.RebuildJob, _a1 error) *RebuildService_ListRebuilds_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RebuildService_ListRebuilds_Call) RunAndReturn(run func(context.Context) ([]*domain.RebuildJob, error)) *RebuildService_ListRebuilds_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartRebuild provides a mock function with given fields: ctx, store, startedBy
+func (_m *RebuildService) StartRebuild(ctx context.Context, store string, startedBy string) (*domain.RebuildJob, error) {
+	ret := _m.Called(ctx, store, startedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartRebuild")
+	}
+
+	var r0 *domain.RebuildJob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.RebuildJob, error)); ok {
+		return rf(ctx, store, startedBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.RebuildJob); ok {
+		r0 = rf(ctx, store, startedBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RebuildJob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, store, startedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RebuildService_StartRebuild_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartRebuild'
+type RebuildService_StartRebuild_Call struct {
+	*mock.Call
+}
+
+// StartRebuild is a helper method to define mock.On call
+//   - ctx context.Context
+//   - store string
+//   - startedBy string
+func (_e *RebuildService_Expecter) StartRebuild(ctx interface{}, store interface{}, startedBy interface{}) *RebuildService_StartRebuild_Call {
+	return &RebuildService_StartRebuild_Call{Call: _e.mock.On("StartRebuild", ctx, store, startedBy)}
+}
+
+func (_c *RebuildService_StartRebuild_Call) Run(run func(ctx context.Context, store string, startedBy string)) *RebuildService_StartRebuild_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RebuildService_StartRebuild_Call) Return(_a0 *domain.RebuildJob, _a1 error) *RebuildService_StartRebuild_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RebuildService_StartRebuild_Call) RunAndReturn(run func(context.Context, string, string) (*domain.RebuildJob, error)) *RebuildService_StartRebuild_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stores provides a mock function with no fields
+func (_m *RebuildService) Stores() []string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stores")
+	}
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// RebuildService_Stores_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stores'
+type RebuildService_Stores_Call struct {
+	*mock.Call
+}
+
+// Stores is a helper method to define mock.On call
+func (_e *RebuildService_Expecter) Stores() *RebuildService_Stores_Call {
+	return &RebuildService_Stores_Call{Call: _e.mock.On("Stores")}
+}
+
+func (_c *RebuildService_Stores_Call) Run(run func()) *RebuildService_Stores_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *RebuildService_Stores_Call) Return(_a0 []string) *RebuildService_Stores_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RebuildService_Stores_Call) RunAndReturn(run func() []string) *RebuildService_Stores_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRebuildService creates a new instance of RebuildService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRebuildService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RebuildService {
+	mock := &RebuildService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,335 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "inventory-system/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SalesOrderService is an autogenerated mock type for the SalesOrderService type
+type SalesOrderService struct {
+	mock.Mock
+}
+
+type SalesOrderService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SalesOrderService) EXPECT() *SalesOrderService_Expecter {
+	return &SalesOrderService_Expecter{mock: &_m.Mock}
+}
+
+// CancelOrder provides a mock function with given fields: ctx, id, userID
+func (_m *SalesOrderService) CancelOrder(ctx context.Context, id string, userID string) (*domain.SalesOrder, error) {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelOrder")
+	}
+
+	var r0 *domain.SalesOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.SalesOrder, error)); ok {
+		return rf(ctx, id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.SalesOrder); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SalesOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SalesOrderService_CancelOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelOrder'
+type SalesOrderService_CancelOrder_Call struct {
+	*mock.Call
+}
+
+// CancelOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *SalesOrderService_Expecter) CancelOrder(ctx interface{}, id interface{}, userID interface{}) *SalesOrderService_CancelOrder_Call {
+	return &SalesOrderService_CancelOrder_Call{Call: _e.mock.On("CancelOrder", ctx, id, userID)}
+}
+
+func (_c *SalesOrderService_CancelOrder_Call) Run(run func(ctx context.Context, id string, userID string)) *SalesOrderService_CancelOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *SalesOrderService_CancelOrder_Call) Return(_a0 *domain.SalesOrder, _a1 error) *SalesOrderService_CancelOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SalesOrderService_CancelOrder_Call) RunAndReturn(run func(context.Context, string, string) (*domain.SalesOrder, error)) *SalesOrderService_CancelOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOrder provides a mock function with given fields: ctx, req, userID
+func (_m *SalesOrderService) CreateOrder(ctx context.Context, req *domain.CreateSalesOrderRequest, userID string) (*domain.SalesOrder, error) {
+	ret := _m.Called(ctx, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOrder")
+	}
+
+	var r0 *domain.SalesOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateSalesOrderRequest, string) (*domain.SalesOrder, error)); ok {
+		return rf(ctx, req, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.CreateSalesOrderRequest, string) *domain.SalesOrder); ok {
+		r0 = rf(ctx, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SalesOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.CreateSalesOrderRequest, string) error); ok {
+		r1 = rf(ctx, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SalesOrderService_CreateOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOrder'
+type SalesOrderService_CreateOrder_Call struct {
+	*mock.Call
+}
+
+// CreateOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.CreateSalesOrderRequest
+//   - userID string
+func (_e *SalesOrderService_Expecter) CreateOrder(ctx interface{}, req interface{}, userID interface{}) *SalesOrderService_CreateOrder_Call {
+	return &SalesOrderService_CreateOrder_Call{Call: _e.mock.On("CreateOrder", ctx, req, userID)}
+}
+
+func (_c *SalesOrderService_CreateOrder_Call) Run(run func(ctx context.Context, req *domain.CreateSalesOrderRequest, userID string)) *SalesOrderService_CreateOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.CreateSalesOrderRequest), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *SalesOrderService_CreateOrder_Call) Return(_a0 *domain.SalesOrder, _a1 error) *SalesOrderService_CreateOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SalesOrderService_CreateOrder_Call) RunAndReturn(run func(context.Context, *domain.CreateSalesOrderRequest, string) (*domain.SalesOrder, error)) *SalesOrderService_CreateOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FulfillOrder provides a mock function with given fields: ctx, id, userID
+func (_m *SalesOrderService) FulfillOrder(ctx context.Context, id string, userID string) (*domain.FulfillSalesOrderResult, error) {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FulfillOrder")
+	}
+
+	var r0 *domain.FulfillSalesOrderResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.FulfillSalesOrderResult, error)); ok {
+		return rf(ctx, id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.FulfillSalesOrderResult); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.FulfillSalesOrderResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SalesOrderService_FulfillOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FulfillOrder'
+type SalesOrderService_FulfillOrder_Call struct {
+	*mock.Call
+}
+
+// FulfillOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *SalesOrderService_Expecter) FulfillOrder(ctx interface{}, id interface{}, userID interface{}) *SalesOrderService_FulfillOrder_Call {
+	return &SalesOrderService_FulfillOrder_Call{Call: _e.mock.On("FulfillOrder", ctx, id, userID)}
+}
+
+func (_c *SalesOrderService_FulfillOrder_Call) Run(run func(ctx context.Context, id string, userID string)) *SalesOrderService_FulfillOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *SalesOrderService_FulfillOrder_Call) Return(_a0 *domain.FulfillSalesOrderResult, _a1 error) *SalesOrderService_FulfillOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SalesOrderService_FulfillOrder_Call) RunAndReturn(run func(context.Context, string, string) (*domain.FulfillSalesOrderResult, error)) *SalesOrderService_FulfillOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrder provides a mock function with given fields: ctx, id
+func (_m *SalesOrderService) GetOrder(ctx context.Context, id string) (*domain.SalesOrder, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrder")
+	}
+
+	var r0 *domain.SalesOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*domain.SalesOrder, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *domain.SalesOrder); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.SalesOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SalesOrderService_GetOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrder'
+type SalesOrderService_GetOrder_Call struct {
+	*mock.Call
+}
+
+// GetOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *SalesOrderService_Expecter) GetOrder(ctx interface{}, id interface{}) *SalesOrderService_GetOrder_Call {
+	return &SalesOrderService_GetOrder_Call{Call: _e.mock.On("GetOrder", ctx, id)}
+}
+
+func (_c *SalesOrderService_GetOrder_Call) Run(run func(ctx context.Context, id string)) *SalesOrderService_GetOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SalesOrderService_GetOrder_Call) Return(_a0 *domain.SalesOrder, _a1 error) *SalesOrderService_GetOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SalesOrderService_GetOrder_Call) RunAndReturn(run func(context.Context, string) (*domain.SalesOrder, error)) *SalesOrderService_GetOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrders provides a mock function with given fields: ctx, q
+func (_m *SalesOrderService) ListOrders(ctx context.Context, q domain.ListSalesOrdersQuery) ([]*domain.SalesOrder, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrders")
+	}
+
+	var r0 []*domain.SalesOrder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListSalesOrdersQuery) ([]*domain.SalesOrder, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, domain.ListSalesOrdersQuery) []*domain.SalesOrder); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.SalesOrder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, domain.ListSalesOrdersQuery) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SalesOrderService_ListOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrders'
+type SalesOrderService_ListOrders_Call struct {
+	*mock.Call
+}
+
+// ListOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q domain.ListSalesOrdersQuery
+func (_e *SalesOrderService_Expecter) ListOrders(ctx interface{}, q interface{}) *SalesOrderService_ListOrders_Call {
+	return &SalesOrderService_ListOrders_Call{Call: _e.mock.On("ListOrders", ctx, q)}
+}
+
+func (_c *SalesOrderService_ListOrders_Call) Run(run func(ctx context.Context, q domain.ListSalesOrdersQuery)) *SalesOrderService_ListOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(domain.ListSalesOrdersQuery))
+	})
+	return _c
+}
+
+func (_c *SalesOrderService_ListOrders_Call) Return(_a0 []*domain.SalesOrder, _a1 error) *SalesOrderService_ListOrders_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SalesOrderService_ListOrders_Call) RunAndReturn(run func(context.Context, domain.ListSalesOrdersQuery) ([]*domain.SalesOrder, error)) *SalesOrderService_ListOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSalesOrderService creates a new instance of SalesOrderService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSalesOrderService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SalesOrderService {
+	mock := &SalesOrderService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "inventory-system/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StockAdjustmentService is an autogenerated mock type for the StockAdjustmentService type
+type StockAdjustmentService struct {
+	mock.Mock
+}
+
+type StockAdjustmentService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StockAdjustmentService) EXPECT() *StockAdjustmentService_Expecter {
+	return &StockAdjustmentService_Expecter{mock: &_m.Mock}
+}
+
+// ApplyBatch provides a mock function with given fields: ctx, req, adjustedBy
+func (_m *StockAdjustmentService) ApplyBatch(ctx context.Context, req *domain.BatchAdjustmentRequest, adjustedBy string) (*domain.BatchAdjustmentResult, error) {
+	ret := _m.Called(ctx, req, adjustedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyBatch")
+	}
+
+	var r0 *domain.BatchAdjustmentResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.BatchAdjustmentRequest, string) (*domain.BatchAdjustmentResult, error)); ok {
+		return rf(ctx, req, adjustedBy)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.BatchAdjustmentRequest, string) *domain.BatchAdjustmentResult); ok {
+		r0 = rf(ctx, req, adjustedBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.BatchAdjustmentResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.BatchAdjustmentRequest, string) error); ok {
+		r1 = rf(ctx, req, adjustedBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StockAdjustmentService_ApplyBatch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyBatch'
+type StockAdjustmentService_ApplyBatch_Call struct {
+	*mock.Call
+}
+
+// ApplyBatch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *domain.BatchAdjustmentRequest
+//   - adjustedBy string
+func (_e *StockAdjustmentService_Expecter) ApplyBatch(ctx interface{}, req interface{}, adjustedBy interface{}) *StockAdjustmentService_ApplyBatch_Call {
+	return &StockAdjustmentService_ApplyBatch_Call{Call: _e.mock.On("ApplyBatch", ctx, req, adjustedBy)}
+}
+
+func (_c *StockAdjustmentService_ApplyBatch_Call) Run(run func(ctx context.Context, req *domain.BatchAdjustmentRequest, adjustedBy string)) *StockAdjustmentService_ApplyBatch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.BatchAdjustmentRequest), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *StockAdjustmentService_ApplyBatch_Call) Return(_a0 *domain.BatchAdjustmentResult, _a1 error) *StockAdjustmentService_ApplyBatch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StockAdjustmentService_ApplyBatch_Call) RunAndReturn(run func(context.Context, *domain.BatchAdjustmentRequest, string) (*domain.BatchAdjustmentResult, error)) *StockAdjustmentService_ApplyBatch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStockAdjustmentService creates a new instance of StockAdjustmentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStockAdjustmentService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StockAdjustmentService {
+	mock := &StockAdjustmentService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,158 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "inventory-system/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StockMovementService is an autogenerated mock type for the StockMovementService type
+type StockMovementService struct {
+	mock.Mock
+}
+
+type StockMovementService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StockMovementService) EXPECT() *StockMovementService_Expecter {
+	return &StockMovementService_Expecter{mock: &_m.Mock}
+}
+
+// AdjustStock provides a mock function with given fields: ctx, itemID, req, userID
+func (_m *StockMovementService) AdjustStock(ctx context.Context, itemID string, req *domain.AdjustStockRequest, userID string) (*domain.AdjustStockResult, error) {
+	ret := _m.Called(ctx, itemID, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdjustStock")
+	}
+
+	var r0 *domain.AdjustStockResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.AdjustStockRequest, string) (*domain.AdjustStockResult, error)); ok {
+		return rf(ctx, itemID, req, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.AdjustStockRequest, string) *domain.AdjustStockResult); ok {
+		r0 = rf(ctx, itemID, req, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.AdjustStockResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *domain.AdjustStockRequest, string) error); ok {
+		r1 = rf(ctx, itemID, req, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StockMovementService_AdjustStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdjustStock'
+type StockMovementService_AdjustStock_Call struct {
+	*mock.Call
+}
+
+// AdjustStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+//   - req *domain.AdjustStockRequest
+//   - userID string
+func (_e *StockMovementService_Expecter) AdjustStock(ctx interface{}, itemID interface{}, req interface{}, userID interface{}) *StockMovementService_AdjustStock_Call {
+	return &StockMovementService_AdjustStock_Call{Call: _e.mock.On("AdjustStock", ctx, itemID, req, userID)}
+}
+
+func (_c *StockMovementService_AdjustStock_Call) Run(run func(ctx context.Context, itemID string, req *domain.AdjustStockRequest, userID string)) *StockMovementService_AdjustStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*domain.AdjustStockRequest), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *StockMovementService_AdjustStock_Call) Return(_a0 *domain.AdjustStockResult, _a1 error) *StockMovementService_AdjustStock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StockMovementService_AdjustStock_Call) RunAndReturn(run func(context.Context, string, *domain.AdjustStockRequest, string) (*domain.AdjustStockResult, error)) *StockMovementService_AdjustStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMovements provides a mock function with given fields: ctx, itemID, limit
+func (_m *StockMovementService) ListMovements(ctx context.Context, itemID string, limit int) ([]*domain.StockMovement, error) {
+	ret := _m.Called(ctx, itemID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMovements")
+	}
+
+	var r0 []*domain.StockMovement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]*domain.StockMovement, error)); ok {
+		return rf(ctx, itemID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []*domain.StockMovement); ok {
+		r0 = rf(ctx, itemID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.StockMovement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, itemID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StockMovementService_ListMovements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMovements'
+type StockMovementService_ListMovements_Call struct {
+	*mock.Call
+}
+
+// ListMovements is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+//   - limit int
+func (_e *StockMovementService_Expecter) ListMovements(ctx interface{}, itemID interface{}, limit interface{}) *StockMovementService_ListMovements_Call {
+	return &StockMovementService_ListMovements_Call{Call: _e.mock.On("ListMovements", ctx, itemID, limit)}
+}
+
+func (_c *StockMovementService_ListMovements_Call) Run(run func(ctx context.Context, itemID string, limit int)) *StockMovementService_ListMovements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *StockMovementService_ListMovements_Call) Return(_a0 []*domain.StockMovement, _a1 error) *StockMovementService_ListMovements_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StockMovementService_ListMovements_Call) RunAndReturn(run func(context.Context, string, int) ([]*domain.StockMovement, error)) *StockMovementService_ListMovements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStockMovementService creates a new instance of StockMovementService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStockMovementService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StockMovementService {
+	mock := &StockMovementService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
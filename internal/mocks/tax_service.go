@@ -0,0 +1,313 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "inventory-system/internal/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TaxService is an autogenerated mock type for the TaxService type
+type TaxService struct {
+	mock.Mock
+}
+
+type TaxService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TaxService) EXPECT() *TaxService_Expecter {
+	return &TaxService_Expecter{mock: &_m.Mock}
+}
+
+// AssignItemTaxCode provides a mock function with given fields: ctx, itemID, req, userID
+func (_m *TaxService) AssignItemTaxCode(ctx context.Context, itemID string, req *domain.AssignTaxCodeRequest, userID string) error {
+	ret := _m.Called(ctx, itemID, req, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignItemTaxCode")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *domain.AssignTaxCodeRequest, string) error); ok {
+		r0 = rf(ctx, itemID, req, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TaxService_AssignItemTaxCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignItemTaxCode'
+type TaxService_AssignItemTaxCode_Call struct {
+	*mock.Call
+}
+
+// AssignItemTaxCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - itemID string
+//   - req *domain.AssignTaxCodeRequest
+//   - userID string
+func (_e *TaxService_Expecter) AssignItemTaxCode(ctx interface{}, itemID interface{}, req interface{}, userID interface{}) *TaxService_AssignItemTaxCode_Call {
+	return &TaxService_AssignItemTaxCode_Call{Call: _e.mock.On("AssignItemTaxCode", ctx, itemID, req, userID)}
+}
+
+func (_c *TaxService_AssignItemTaxCode_Call) Run(run func(ctx context.Context, itemID string, req *domain.AssignTaxCodeRequest, userID string)) *TaxService_AssignItemTaxCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*domain.AssignTaxCodeRequest), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *TaxService_AssignItemTaxCode_Call) Return(_a0 error) *TaxService_AssignItemTaxCode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TaxService_AssignItemTaxCode_Call) RunAndReturn(run func(context.Context, string, *domain.AssignTaxCodeRequest, string) error) *TaxService_AssignItemTaxCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRate provides a mock function with given fields: ctx, code, jurisdiction
+func (_m *TaxService) DeleteRate(ctx context.Context, code string, jurisdiction string) error {
+	ret := _m.Called(ctx, code, jurisdiction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, code, jurisdiction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TaxService_DeleteRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRate'
+type TaxService_DeleteRate_Call struct {
+	*mock.Call
+}
+
+// DeleteRate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+//   - jurisdiction string
+func (_e *TaxService_Expecter) DeleteRate(ctx interface{}, code interface{}, jurisdiction interface{}) *TaxService_DeleteRate_Call {
+	return &TaxService_DeleteRate_Call{Call: _e.mock.On("DeleteRate", ctx, code, jurisdiction)}
+}
+
+func (_c *TaxService_DeleteRate_Call) Run(run func(ctx context.Context, code string, jurisdiction string)) *TaxService_DeleteRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TaxService_DeleteRate_Call) Return(_a0 error) *TaxService_DeleteRate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TaxService_DeleteRate_Call) RunAndReturn(run func(context.Context, string, string) error) *TaxService_DeleteRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRates provides a mock function with given fields: ctx
+func (_m *TaxService) ListRates(ctx context.Context) ([]*domain.TaxRate, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRates")
+	}
+
+	var r0 []*domain.TaxRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*domain.TaxRate, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*domain.TaxRate); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.TaxRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaxService_ListRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRates'
+type TaxService_ListRates_Call struct {
+	*mock.Call
+}
+
+// ListRates is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *TaxService_Expecter) ListRates(ctx interface{}) *TaxService_ListRates_Call {
+	return &TaxService_ListRates_Call{Call: _e.mock.On("ListRates", ctx)}
+}
+
+func (_c *TaxService_ListRates_Call) Run(run func(ctx context.Context)) *TaxService_ListRates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TaxService_ListRates_Call) Return(_a0 []*domain.TaxRate, _a1 error) *TaxService_ListRates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaxService_ListRates_Call) RunAndReturn(run func(context.Context) ([]*domain.TaxRate, error)) *TaxService_ListRates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QuoteOrder provides a mock function with given fields: ctx, orderID, jurisdiction
+func (_m *TaxService) QuoteOrder(ctx context.Context, orderID string, jurisdiction string) (*domain.TaxQuote, error) {
+	ret := _m.Called(ctx, orderID, jurisdiction)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QuoteOrder")
+	}
+
+	var r0 *domain.TaxQuote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*domain.TaxQuote, error)); ok {
+		return rf(ctx, orderID, jurisdiction)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *domain.TaxQuote); ok {
+		r0 = rf(ctx, orderID, jurisdiction)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TaxQuote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, orderID, jurisdiction)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaxService_QuoteOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QuoteOrder'
+type TaxService_QuoteOrder_Call struct {
+	*mock.Call
+}
+
+// QuoteOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderID string
+//   - jurisdiction string
+func (_e *TaxService_Expecter) QuoteOrder(ctx interface{}, orderID interface{}, jurisdiction interface{}) *TaxService_QuoteOrder_Call {
+	return &TaxService_QuoteOrder_Call{Call: _e.mock.On("QuoteOrder", ctx, orderID, jurisdiction)}
+}
+
+func (_c *TaxService_QuoteOrder_Call) Run(run func(ctx context.Context, orderID string, jurisdiction string)) *TaxService_QuoteOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TaxService_QuoteOrder_Call) Return(_a0 *domain.TaxQuote, _a1 error) *TaxService_QuoteOrder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaxService_QuoteOrder_Call) RunAndReturn(run func(context.Context, string, string) (*domain.TaxQuote, error)) *TaxService_QuoteOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetRate provides a mock function with given fields: ctx, code, jurisdiction, req
+func (_m *TaxService) SetRate(ctx context.Context, code string, jurisdiction string, req *domain.SetTaxRateRequest) (*domain.TaxRate, error) {
+	ret := _m.Called(ctx, code, jurisdiction, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRate")
+	}
+
+	var r0 *domain.TaxRate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *domain.SetTaxRateRequest) (*domain.TaxRate, error)); ok {
+		return rf(ctx, code, jurisdiction, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *domain.SetTaxRateRequest) *domain.TaxRate); ok {
+		r0 = rf(ctx, code, jurisdiction, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.TaxRate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *domain.SetTaxRateRequest) error); ok {
+		r1 = rf(ctx, code, jurisdiction, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaxService_SetRate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRate'
+type TaxService_SetRate_Call struct {
+	*mock.Call
+}
+
+// SetRate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code string
+//   - jurisdiction string
+//   - req *domain.SetTaxRateRequest
+func (_e *TaxService_Expecter) SetRate(ctx interface{}, code interface{}, jurisdiction interface{}, req interface{}) *TaxService_SetRate_Call {
+	return &TaxService_SetRate_Call{Call: _e.mock.On("SetRate", ctx, code, jurisdiction, req)}
+}
+
+func (_c *TaxService_SetRate_Call) Run(run func(ctx context.Context, code string, jurisdiction string, req *domain.SetTaxRateRequest)) *TaxService_SetRate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*domain.SetTaxRateRequest))
+	})
+	return _c
+}
+
+func (_c *TaxService_SetRate_Call) Return(_a0 *domain.TaxRate, _a1 error) *TaxService_SetRate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TaxService_SetRate_Call) RunAndReturn(run func(context.Context, string, string, *domain.SetTaxRateRequest) (*domain.TaxRate, error)) *TaxService_SetRate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTaxService creates a new instance of TaxService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTaxService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TaxService {
+	mock := &TaxService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
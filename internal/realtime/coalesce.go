@@ -0,0 +1,75 @@
+package realtime
+
+import (
+	"sync"
+	"time"
+
+	"inventory-system/internal/domain"
+)
+
+// stockCoalescer limits STOCK_UPDATE messages to one per item per window. The first update
+// of an item goes out at once; updates arriving within the window after it are merged, and
+// only the latest is sent when the window ends. Messages are handed to send under mu, so
+// they reach the hub in the order the coalescer decided on them.
+type stockCoalescer struct {
+	window time.Duration
+	send   func(msg domain.WebSocketMessage)
+
+	mu    sync.Mutex
+	items map[string]*coalescedItem
+}
+
+type coalescedItem struct {
+	pending *domain.WebSocketMessage // Latest update held back, if any
+}
+
+func newStockCoalescer(window time.Duration, send func(msg domain.WebSocketMessage)) *stockCoalescer {
+	return &stockCoalescer{window: window, send: send, items: make(map[string]*coalescedItem)}
+}
+
+// submit sends msg, an update of itemID, now or at the end of the item's current window.
+func (c *stockCoalescer) submit(itemID string, msg domain.WebSocketMessage) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if it, ok := c.items[itemID]; ok {
+		if it.pending != nil {
+			hubMetrics.Add("stock_updates_coalesced", 1)
+		}
+		it.pending = &msg
+		return
+	}
+	c.items[itemID] = &coalescedItem{}
+	time.AfterFunc(c.window, func() { c.flush(itemID) })
+	c.send(msg)
+}
+
+// submitBatch sends msg, a batch update of itemIDs, at once. Updates of those items held back
+// in their window are dropped, so they cannot follow msg with older quantities; the windows
+// stay open, and updates arriving in them are held back as usual.
+func (c *stockCoalescer) submitBatch(itemIDs []string, msg domain.WebSocketMessage) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, itemID := range itemIDs {
+		if it, ok := c.items[itemID]; ok && it.pending != nil {
+			it.pending = nil
+			hubMetrics.Add("stock_updates_coalesced", 1)
+		}
+	}
+	c.send(msg)
+}
+
+// flush ends an item's window: the held-back update, if any, is sent and opens a new window;
+// otherwise the item is forgotten.
+func (c *stockCoalescer) flush(itemID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	it := c.items[itemID]
+	if it.pending == nil {
+		delete(c.items, itemID)
+		return
+	}
+	msg := *it.pending
+	it.pending = nil
+	time.AfterFunc(c.window, func() { c.flush(itemID) })
+	c.send(msg)
+}
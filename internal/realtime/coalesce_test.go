@@ -0,0 +1,85 @@
+package realtime
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"inventory-system/internal/domain"
+)
+
+func TestStockCoalescer(t *testing.T) {
+	const window = 50 * time.Millisecond
+	var mu sync.Mutex
+	var sent []domain.StockUpdatePayload
+	c := newStockCoalescer(window, func(msg domain.WebSocketMessage) {
+		mu.Lock()
+		defer mu.Unlock()
+		sent = append(sent, msg.Payload.(domain.StockUpdatePayload))
+	})
+	update := func(id string, qty int) {
+		c.submit(id, domain.WebSocketMessage{Type: domain.StockUpdateMessageType, Payload: domain.StockUpdatePayload{ID: id, NewQuantity: qty}})
+	}
+	snapshot := func() []domain.StockUpdatePayload {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]domain.StockUpdatePayload(nil), sent...)
+	}
+
+	// The first update of each item goes out at once; the rest of the burst is held back.
+	for qty := 1; qty <= 5; qty++ {
+		update("a", qty)
+	}
+	update("b", 10)
+	if got := snapshot(); len(got) != 2 || got[0].NewQuantity != 1 || got[1].NewQuantity != 10 {
+		t.Fatalf("sent during the window = %+v, want the first update of a and b", got)
+	}
+
+	// At the end of the window only the latest quantity of a follows.
+	time.Sleep(window + window/2)
+	if got := snapshot(); len(got) != 3 || got[2].ID != "a" || got[2].NewQuantity != 5 {
+		t.Fatalf("sent after the window = %+v, want a single trailing update of a with 5", got)
+	}
+
+	// Once the item has been quiet for a window, it is forgotten and sent at once again.
+	time.Sleep(2 * window)
+	c.mu.Lock()
+	remaining := len(c.items)
+	c.mu.Unlock()
+	if remaining != 0 {
+		t.Errorf("%d items still tracked after going quiet", remaining)
+	}
+	update("a", 6)
+	if got := snapshot(); len(got) != 4 || got[3].NewQuantity != 6 {
+		t.Fatalf("sent after going quiet = %+v, want the new update at once", got)
+	}
+}
+
+func TestStockCoalescerBatchSupersedesPending(t *testing.T) {
+	const window = 50 * time.Millisecond
+	var mu sync.Mutex
+	var sent []domain.WebSocketMessage
+	c := newStockCoalescer(window, func(msg domain.WebSocketMessage) {
+		mu.Lock()
+		defer mu.Unlock()
+		sent = append(sent, msg)
+	})
+	snapshot := func() []domain.WebSocketMessage {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]domain.WebSocketMessage(nil), sent...)
+	}
+
+	// a=1 goes out at once and opens a window, in which a=2 is held back.
+	c.submit("a", domain.WebSocketMessage{Type: domain.StockUpdateMessageType, Payload: domain.StockUpdatePayload{ID: "a", NewQuantity: 1}})
+	c.submit("a", domain.WebSocketMessage{Type: domain.StockUpdateMessageType, Payload: domain.StockUpdatePayload{ID: "a", NewQuantity: 2}})
+	// A batch then sets a to 3: it goes out at once, and the held-back a=2 must not follow it.
+	c.submitBatch([]string{"a"}, domain.WebSocketMessage{Type: domain.StockBatchUpdateMessageType,
+		Payload: domain.StockBatchUpdatePayload{BatchID: "b-1", Updates: []domain.StockUpdatePayload{{ID: "a", NewQuantity: 3}}}})
+
+	time.Sleep(window + window/2)
+	got := snapshot()
+	if len(got) != 2 || got[0].Type != domain.StockUpdateMessageType || got[1].Type != domain.StockBatchUpdateMessageType {
+		t.Fatalf("sent = %+v, want the first update of a, then the batch, and nothing after it", got)
+	}
+}
@@ -0,0 +1,312 @@
+package realtime_test
+
+import (
+	"compress/flate"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/realtime"
+	"inventory-system/internal/service"
+
+	"github.com/gorilla/websocket"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+const testItemID = "5b0f5c2e-8a57-4d0c-9a3e-0f2b7c1d9e41"
+
+// fakeItemRepo keeps a single item in memory. Methods the tests do not need
+// panic through the embedded nil interface.
+type fakeItemRepo struct {
+	domain.ItemRepository
+	mu   sync.Mutex
+	item domain.Item
+}
+
+func (r *fakeItemRepo) GetByID(ctx context.Context, id string) (*domain.Item, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if id != r.item.ID {
+		return nil, domain.ErrRepositoryNotFound
+	}
+	item := r.item
+	return &item, nil
+}
+
+func (r *fakeItemRepo) Update(ctx context.Context, id string, item *domain.Item, userID string) (*domain.Item, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if item.Version != r.item.Version {
+		return nil, domain.ErrVersionConflict
+	}
+	r.item = *item
+	r.item.ID = id // Like the real repository, the ID comes from the WHERE clause, not the item
+	r.item.UpdatedAt = time.Now()
+	r.item.Version++
+	updated := r.item
+	return &updated, nil
+}
+
+// wsClient is a real WebSocket client connected to the hub under test.
+type wsClient struct {
+	t       *testing.T
+	conn    *websocket.Conn
+	msgpack bool // Negotiated the MessagePack subprotocol
+}
+
+// startHub serves a running hub over HTTP exactly like the /ws/stock-updates route does.
+func startHub(t *testing.T) (*realtime.Hub, string) {
+	t.Helper()
+	hub := realtime.NewHub()
+	go hub.Run()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		realtime.ServeWsUpgrade(hub, w, r, r.URL.Query().Get("user_id"))
+	}))
+	t.Cleanup(srv.Close)
+	return hub, "ws" + strings.TrimPrefix(srv.URL, "http")
+}
+
+func dial(t *testing.T, url, userID string, subprotocols ...string) *wsClient {
+	t.Helper()
+	dialer := *websocket.DefaultDialer
+	dialer.Subprotocols = subprotocols
+	conn, _, err := dialer.Dial(url+"?user_id="+userID, nil)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return &wsClient{t: t, conn: conn, msgpack: conn.Subprotocol() == realtime.SubprotocolMsgpack}
+}
+
+func (c *wsClient) send(msgType string, payload any) {
+	c.t.Helper()
+	msg := domain.WebSocketMessage{Type: msgType, Payload: payload}
+	var err error
+	if c.msgpack {
+		var data []byte
+		if data, err = marshalMsgpack(msg); err == nil {
+			err = c.conn.WriteMessage(websocket.BinaryMessage, data)
+		}
+	} else {
+		err = c.conn.WriteJSON(msg)
+	}
+	if err != nil {
+		c.t.Fatalf("send %s: %v", msgType, err)
+	}
+}
+
+// marshalMsgpack encodes v the way a MessagePack client would, using the JSON field names.
+func marshalMsgpack(v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var generic any
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return nil, err
+	}
+	return msgpack.Marshal(generic)
+}
+
+// expect reads messages until one of the wanted type arrives, checks it against the
+// committed schema and decodes its payload into out.
+func (c *wsClient) expect(schema *messageSchema, msgType string, out any) {
+	c.t.Helper()
+	c.conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for {
+		frameType, data, err := c.conn.ReadMessage()
+		if err != nil {
+			c.t.Fatalf("waiting for %s: %v", msgType, err)
+		}
+		if c.msgpack {
+			// The MessagePack encoding carries exactly the JSON document, so it is
+			// checked against the same schema once translated back.
+			if frameType != websocket.BinaryMessage {
+				c.t.Fatalf("MessagePack client received a text frame: %s", data)
+			}
+			var generic any
+			if err := msgpack.Unmarshal(data, &generic); err != nil {
+				c.t.Fatalf("decode MessagePack message: %v", err)
+			}
+			if data, err = json.Marshal(generic); err != nil {
+				c.t.Fatalf("re-encode MessagePack message: %v", err)
+			}
+		}
+		if err := schema.validateMessage(data); err != nil {
+			c.t.Fatalf("server sent a message outside the contract: %v\n%s", err, data)
+		}
+
+		var envelope struct {
+			Type    string          `json:"type"`
+			Payload json.RawMessage `json:"payload"`
+		}
+		if err := json.Unmarshal(data, &envelope); err != nil {
+			c.t.Fatalf("decode envelope: %v", err)
+		}
+		if envelope.Type != msgType {
+			continue
+		}
+		if err := json.Unmarshal(envelope.Payload, out); err != nil {
+			c.t.Fatalf("decode %s payload: %v", msgType, err)
+		}
+		return
+	}
+}
+
+// join announces presence on the test item and waits for the resulting broadcast.
+// Receiving it also proves the client is registered with the hub, so later broadcasts reach it.
+func (c *wsClient) join(schema *messageSchema, userID string) {
+	c.t.Helper()
+	c.send(domain.PresenceMessageType, domain.PresenceReport{ItemID: testItemID, Mode: domain.PresenceModeViewing})
+	for {
+		var update domain.PresenceUpdatePayload
+		c.expect(schema, domain.PresenceUpdateMessageType, &update)
+		for _, u := range update.Users {
+			if update.ItemID == testItemID && u.UserID == userID {
+				return
+			}
+		}
+	}
+}
+
+func TestStockUpdateBroadcastContract(t *testing.T) {
+	schema := loadSchema(t)
+	hub, url := startHub(t)
+	repo := &fakeItemRepo{item: domain.Item{ID: testItemID, SKU: "WIDGET-1", Name: "Widget", Quantity: 10, Price: 2.5, Version: 1}}
+	items := service.NewItemService(repo, hub, nil, nil)
+
+	alice := dial(t, url, "alice")
+	alice.join(schema, "alice")
+
+	quantity, version := 7, 1
+	if _, err := items.UpdateItem(context.Background(), testItemID, &domain.UpdateItemRequest{Quantity: &quantity, Version: &version}, ""); err != nil {
+		t.Fatalf("update item: %v", err)
+	}
+
+	var got domain.StockUpdatePayload
+	alice.expect(schema, domain.StockUpdateMessageType, &got)
+	want := domain.StockUpdatePayload{ID: testItemID, SKU: "WIDGET-1", NewQuantity: 7}
+	if got != want {
+		t.Errorf("STOCK_UPDATE payload = %+v, want %+v", got, want)
+	}
+}
+
+func TestPresenceUpdateContract(t *testing.T) {
+	schema := loadSchema(t)
+	_, url := startHub(t)
+
+	alice := dial(t, url, "alice")
+	alice.join(schema, "alice")
+	bob := dial(t, url, "bob")
+	bob.send(domain.PresenceMessageType, domain.PresenceReport{ItemID: testItemID, Mode: domain.PresenceModeEditing})
+
+	// Alice sees Bob arrive in editing mode next to herself.
+	for {
+		var update domain.PresenceUpdatePayload
+		alice.expect(schema, domain.PresenceUpdateMessageType, &update)
+		if len(update.Users) != 2 {
+			continue
+		}
+		modes := map[string]string{}
+		for _, u := range update.Users {
+			modes[u.UserID] = u.Mode
+		}
+		if modes["alice"] != domain.PresenceModeViewing || modes["bob"] != domain.PresenceModeEditing {
+			t.Errorf("PRESENCE_UPDATE users = %+v", update.Users)
+		}
+		return
+	}
+}
+
+func TestStockUpdateBroadcastContractMsgpack(t *testing.T) {
+	schema := loadSchema(t)
+	hub, url := startHub(t)
+	repo := &fakeItemRepo{item: domain.Item{ID: testItemID, SKU: "WIDGET-1", Name: "Widget", Quantity: 10, Price: 2.5, Version: 1}}
+	items := service.NewItemService(repo, hub, nil, nil)
+
+	// A client offering an unknown protocol first still gets the one it can use.
+	alice := dial(t, url, "alice", "inventory.v9.cbor", realtime.SubprotocolMsgpack)
+	if !alice.msgpack {
+		t.Fatalf("negotiated subprotocol %q, want %q", alice.conn.Subprotocol(), realtime.SubprotocolMsgpack)
+	}
+	alice.join(schema, "alice") // Presence is sent MessagePack-encoded too
+	bob := dial(t, url, "bob")  // JSON clients share broadcasts with MessagePack ones
+	bob.join(schema, "bob")
+
+	quantity, version := 7, 1
+	if _, err := items.UpdateItem(context.Background(), testItemID, &domain.UpdateItemRequest{Quantity: &quantity, Version: &version}, ""); err != nil {
+		t.Fatalf("update item: %v", err)
+	}
+
+	want := domain.StockUpdatePayload{ID: testItemID, SKU: "WIDGET-1", NewQuantity: 7}
+	for name, c := range map[string]*wsClient{"msgpack": alice, "json": bob} {
+		var got domain.StockUpdatePayload
+		c.expect(schema, domain.StockUpdateMessageType, &got)
+		if got != want {
+			t.Errorf("%s STOCK_UPDATE payload = %+v, want %+v", name, got, want)
+		}
+	}
+}
+
+func TestCompressedBroadcast(t *testing.T) {
+	schema := loadSchema(t)
+	hub, url := startHub(t)
+	if err := hub.SetCompression(flate.BestSpeed); err != nil {
+		t.Fatalf("SetCompression: %v", err)
+	}
+
+	dialer := *websocket.DefaultDialer
+	dialer.EnableCompression = true
+	conn, resp, err := dialer.Dial(url+"?user_id=alice", nil)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	if ext := resp.Header.Get("Sec-Websocket-Extensions"); !strings.Contains(ext, "permessage-deflate") {
+		t.Fatalf("Sec-WebSocket-Extensions = %q, want permessage-deflate", ext)
+	}
+	alice := &wsClient{t: t, conn: conn}
+	alice.join(schema, "alice")
+
+	// Large enough to be compressed on the wire.
+	sent := domain.StockBatchUpdatePayload{BatchID: "7c4f2d1e-0a9b-4c8d-8e7f-6a5b4c3d2e1f"}
+	for i := range 20 {
+		sent.Updates = append(sent.Updates, domain.StockUpdatePayload{ID: testItemID, SKU: "WIDGET-1", NewQuantity: i})
+	}
+	hub.BroadcastStockBatchUpdate(context.Background(), sent)
+
+	var got domain.StockBatchUpdatePayload
+	alice.expect(schema, domain.StockBatchUpdateMessageType, &got)
+	if got.BatchID != sent.BatchID || len(got.Updates) != len(sent.Updates) {
+		t.Errorf("STOCK_BATCH_UPDATE payload = %+v, want %+v", got, sent)
+	}
+}
+
+func TestSetCompressionRejectsInvalidLevel(t *testing.T) {
+	if err := realtime.NewHub().SetCompression(10); err == nil {
+		t.Error("SetCompression(10) accepted an invalid flate level")
+	}
+}
+
+func TestClientsListsConnections(t *testing.T) {
+	schema := loadSchema(t)
+	hub, url := startHub(t)
+
+	alice := dial(t, url, "alice", realtime.SubprotocolMsgpack)
+	alice.join(schema, "alice")
+
+	clients := hub.Clients()
+	if len(clients) != 1 {
+		t.Fatalf("Clients() = %+v, want one connection", clients)
+	}
+	if got := clients[0]; got.UserID != "alice" || got.Subprotocol != realtime.SubprotocolMsgpack || got.ConnectedAt.IsZero() {
+		t.Errorf("Clients()[0] = %+v", got)
+	}
+}
@@ -0,0 +1,100 @@
+package realtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/eventschema"
+
+	"github.com/gorilla/websocket"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// WebSocket subprotocols a client may request with Sec-WebSocket-Protocol. Clients that ask
+// for neither (or for something else) get JSON, so existing clients keep working unchanged.
+const (
+	SubprotocolJSON    = "inventory.v1.json"
+	SubprotocolMsgpack = "inventory.v1.msgpack" // Same messages as JSON, MessagePack-encoded in binary frames
+)
+
+// marshalMessage encodes msg as JSON, stamped with the current schema version of its type.
+func marshalMessage(msg domain.WebSocketMessage) ([]byte, error) {
+	msg.SchemaVersion = eventschema.Version(msg.Type)
+	return json.Marshal(msg)
+}
+
+// frame is one outbound message. Messages are built as JSON; the MessagePack form is derived
+// on first use and shared by every client that negotiated it, so a broadcast is converted
+// at most once however many dashboards receive it.
+type frame struct {
+	json []byte
+
+	packOnce sync.Once
+	packed   []byte
+	packErr  error
+}
+
+func newFrame(jsonMessage []byte) *frame {
+	return &frame{json: jsonMessage}
+}
+
+// encode returns the WebSocket message type and bytes to write to a client that negotiated
+// subprotocol.
+func (f *frame) encode(subprotocol string) (int, []byte, error) {
+	if subprotocol != SubprotocolMsgpack {
+		return websocket.TextMessage, f.json, nil
+	}
+	f.packOnce.Do(func() {
+		f.packed, f.packErr = jsonToMsgpack(f.json)
+	})
+	return websocket.BinaryMessage, f.packed, f.packErr
+}
+
+// jsonToMsgpack re-encodes a JSON document as MessagePack. Integers stay integers
+// (rather than becoming float64) so quantities keep their compact encoding.
+func jsonToMsgpack(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("decode JSON message: %w", err)
+	}
+	packed, err := msgpack.Marshal(normalizeNumbers(v))
+	if err != nil {
+		return nil, fmt.Errorf("encode MessagePack message: %w", err)
+	}
+	return packed, nil
+}
+
+func normalizeNumbers(v any) any {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case map[string]any:
+		for k, e := range v {
+			v[k] = normalizeNumbers(e)
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = normalizeNumbers(e)
+		}
+	}
+	return v
+}
+
+// msgpackToJSON re-encodes a MessagePack message from a client as JSON, so inbound
+// messages are handled the same way whatever the connection's encoding.
+func msgpackToJSON(data []byte) ([]byte, error) {
+	var v any
+	if err := msgpack.Unmarshal(data, &v); err != nil {
+		return nil, fmt.Errorf("decode MessagePack message: %w", err)
+	}
+	return json.Marshal(v)
+}
@@ -0,0 +1,146 @@
+package realtime
+
+import (
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"inventory-system/internal/domain"
+)
+
+const (
+	// How often clients are sent an application-level HEARTBEAT.
+	heartbeatPeriod = 15 * time.Second
+
+	// Weight of the newest round trip in a client's moving average.
+	rttSmoothing = 0.2
+)
+
+// latency tracks the heartbeat round trips of one client. Heartbeats are sent by
+// writePump and acknowledged through readPump, hence the mutex.
+type latency struct {
+	mu          sync.Mutex
+	seq         int64     // Of the last heartbeat sent
+	sentAt      time.Time // When it was sent
+	outstanding bool      // Not acknowledged yet
+	last, avg   time.Duration
+	lastAck     time.Time
+	missed      int
+}
+
+// next records that a heartbeat is being sent now and returns its sequence number.
+func (l *latency) next(now time.Time) int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.outstanding {
+		l.missed++
+		hubMetrics.Add("heartbeats_missed", 1)
+	}
+	l.seq++
+	l.sentAt = now
+	l.outstanding = true
+	return l.seq
+}
+
+// ack records the answer to heartbeat seq. Stale or unknown sequence numbers are ignored,
+// so a late answer is never mistaken for a fast one.
+func (l *latency) ack(seq int64, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.outstanding || seq != l.seq {
+		return false
+	}
+	rtt := now.Sub(l.sentAt)
+	l.outstanding = false
+	l.last = rtt
+	if l.lastAck.IsZero() {
+		l.avg = rtt
+	} else {
+		l.avg = time.Duration(rttSmoothing*float64(rtt) + (1-rttSmoothing)*float64(l.avg))
+	}
+	l.lastAck = now
+	return true
+}
+
+// describe fills in the latency fields of info.
+func (l *latency) describe(info *domain.WebSocketClient) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	info.MissedAcks = l.missed
+	if l.lastAck.IsZero() {
+		return
+	}
+	last, avg, at := millis(l.last), millis(l.avg), l.lastAck
+	info.LastRTTMillis, info.AvgRTTMillis, info.LastAckAt = &last, &avg, &at
+}
+
+// averageRTT returns the client's moving average round trip, or false before its first ack.
+func (l *latency) averageRTT() (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.avg, !l.lastAck.IsZero()
+}
+
+func millis(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
+
+// heartbeatFrame builds the HEARTBEAT message with sequence number seq.
+func heartbeatFrame(seq int64) *frame {
+	data, _ := marshalMessage(domain.WebSocketMessage{ // Cannot fail: the payload is a single integer
+		Type:    domain.HeartbeatMessageType,
+		Payload: domain.Heartbeat{Seq: seq},
+	})
+	return newFrame(data)
+}
+
+// handleHeartbeatAck records a client's answer to a heartbeat.
+func (h *Hub) handleHeartbeatAck(c *Client, ack domain.HeartbeatAck) {
+	if c.latency.ack(ack.Seq, time.Now()) {
+		hubMetrics.Add("heartbeat_acks", 1)
+	}
+}
+
+// Clients describes every open connection, oldest first.
+// This method is safe for concurrent use.
+func (h *Hub) Clients() []domain.WebSocketClient {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	clients := make([]domain.WebSocketClient, 0, len(h.clients))
+	for c := range h.clients {
+		info := domain.WebSocketClient{
+			RemoteAddr:     c.conn.RemoteAddr().String(),
+			UserID:         c.userID,
+			Subprotocol:    c.subprotocol,
+			ConnectedAt:    c.connectedAt,
+			QueuedMessages: c.send.len(),
+		}
+		c.latency.describe(&info)
+		clients = append(clients, info)
+	}
+	sort.Slice(clients, func(i, j int) bool { return clients[i].ConnectedAt.Before(clients[j].ConnectedAt) })
+	return clients
+}
+
+// rttSummary is published under websocket.rtt_ms: percentiles of the clients' average
+// heartbeat round trips, so a laggy site shows up without listing every client.
+func (h *Hub) rttSummary() any {
+	h.mu.RLock()
+	rtts := make([]time.Duration, 0, len(h.clients))
+	for c := range h.clients {
+		if avg, ok := c.latency.averageRTT(); ok {
+			rtts = append(rtts, avg)
+		}
+	}
+	h.mu.RUnlock()
+
+	summary := map[string]float64{"clients": float64(len(rtts))}
+	if len(rtts) == 0 {
+		return summary
+	}
+	slices.Sort(rtts)
+	at := func(q float64) float64 { return millis(rtts[int(q*float64(len(rtts)-1))]) }
+	summary["p50"], summary["p95"], summary["max"] = at(0.5), at(0.95), millis(rtts[len(rtts)-1])
+	return summary
+}
@@ -0,0 +1,50 @@
+package realtime
+
+import (
+	"testing"
+	"time"
+
+	"inventory-system/internal/domain"
+)
+
+func TestLatency(t *testing.T) {
+	var l latency
+	start := time.Now()
+
+	var info domain.WebSocketClient
+	l.describe(&info)
+	if info.LastRTTMillis != nil || info.AvgRTTMillis != nil {
+		t.Fatalf("latency reported before any ack: %+v", info)
+	}
+
+	seq := l.next(start)
+	if l.ack(seq+1, start.Add(time.Millisecond)) {
+		t.Fatal("ack with the wrong sequence number accepted")
+	}
+	if !l.ack(seq, start.Add(100*time.Millisecond)) {
+		t.Fatal("ack of the outstanding heartbeat rejected")
+	}
+	if l.ack(seq, start.Add(200*time.Millisecond)) {
+		t.Fatal("second ack of the same heartbeat accepted")
+	}
+
+	// An unanswered heartbeat counts as missed when the next one goes out, and its late
+	// answer is ignored.
+	stale := l.next(start.Add(time.Second))
+	seq = l.next(start.Add(2 * time.Second))
+	if l.ack(stale, start.Add(2*time.Second+time.Millisecond)) {
+		t.Fatal("late ack of a superseded heartbeat accepted")
+	}
+	l.ack(seq, start.Add(2*time.Second+600*time.Millisecond))
+
+	l.describe(&info)
+	if info.LastRTTMillis == nil || *info.LastRTTMillis != 600 {
+		t.Errorf("last RTT = %v ms, want 600", info.LastRTTMillis)
+	}
+	if want := 0.8*100 + 0.2*600; info.AvgRTTMillis == nil || *info.AvgRTTMillis != want {
+		t.Errorf("average RTT = %v ms, want %v", info.AvgRTTMillis, want)
+	}
+	if info.MissedAcks != 1 {
+		t.Errorf("missed acks = %d, want 1", info.MissedAcks)
+	}
+}
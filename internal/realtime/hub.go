@@ -1,13 +1,17 @@
 package realtime
 
 import (
-	"encoding/json"
+	"compress/flate"
+	"context"
+	"expvar"
+	"fmt"
 	"log"
 	"net/http"
 	"sync"
 	"time"
 
 	"inventory-system/internal/domain"
+	"inventory-system/pkg/requestid"
 
 	"github.com/gorilla/websocket"
 )
@@ -24,69 +28,167 @@ const (
 
 	// Maximum message size allowed from peer.
 	maxMessageSize = 512
+
+	// Number of fan-out workers. Each owns a shard of the clients and copies every broadcast
+	// into their send queues, so a broadcast to many clients is spread over several cores.
+	broadcastWorkers = 4
+
+	// Broadcasts waiting to be fanned out. When full, BroadcastJSONMessage waits up to
+	// broadcastWait before giving up on a message.
+	broadcastBuffer = 4096
+	broadcastWait   = time.Second
+
+	// Messages shorter than this are sent uncompressed even on connections that negotiated
+	// permessage-deflate; a lone STOCK_UPDATE barely shrinks and is not worth the CPU.
+	compressMinSize = 256
 )
 
 // Client represents a single WebSocket client connection.
 type Client struct {
-	hub  *Hub            // Reference to the hub.
-	conn *websocket.Conn // The WebSocket connection.
-	send chan []byte     // Buffered channel of outbound messages. (These will be JSON bytes)
+	hub    *Hub            // Reference to the hub.
+	conn   *websocket.Conn // The WebSocket connection.
+	send   *sendQueue      // Outbound messages, written by writePump.
+	userID string          // Claimed, not authenticated: shown in presence only; "" if anonymous.
+	shard  *broadcastShard // Fan-out worker delivering broadcasts to this client.
+
+	subprotocol string    // Negotiated encoding: SubprotocolMsgpack, or anything else for JSON.
+	connectedAt time.Time // When the connection was upgraded.
+	latency     latency   // Heartbeat round trips.
+}
+
+// broadcastShard is the set of clients one fan-out worker delivers broadcasts to.
+type broadcastShard struct {
+	in      chan *frame
+	mu      sync.Mutex
+	clients map[*Client]bool
+}
+
+// run delivers every message from in to the shard's clients, in order.
+func (s *broadcastShard) run() {
+	for message := range s.in {
+		s.mu.Lock()
+		for client := range s.clients {
+			client.send.push(message) // A client that overflows is cut off by its write pump
+		}
+		s.mu.Unlock()
+	}
 }
 
 // Hub maintains the set of active clients and broadcasts messages to them.
 type Hub struct {
-	clients    map[*Client]bool // Registered clients.
-	broadcast  chan []byte      // Inbound messages from the application (expecting JSON bytes).
-	register   chan *Client     // Register requests from clients.
-	unregister chan *Client     // Unregister requests from clients.
-	mu         sync.RWMutex     // For concurrent access to clients map
+	clients    map[*Client]bool  // Registered clients.
+	broadcast  chan []byte       // Inbound messages from the application (expecting JSON bytes).
+	shards     []*broadcastShard // Fan-out workers; clients are spread over them round-robin.
+	nextShard  int               // Shard for the next client; used only by Run.
+	register   chan *Client      // Register requests from clients.
+	unregister chan *Client      // Unregister requests from clients.
+	mu         sync.RWMutex      // For concurrent access to the clients map
+
+	presence   map[*Client]presence // Item each identified client is currently on.
+	presenceMu sync.RWMutex
+
+	locks domain.EditLockService // Refreshed by LOCK_HEARTBEAT messages; may be nil
+
+	stockUpdates *stockCoalescer // Merges rapid STOCK_UPDATEs per item; nil sends each one
+
+	upgrader         *websocket.Upgrader // Accepts connections; compression-enabled copy of upgrader when set
+	compressionLevel int
 }
 
 // NewHub creates a new Hub instance.
 func NewHub() *Hub {
-	return &Hub{
-		broadcast:  make(chan []byte, 256), // Buffered channel
+	h := &Hub{
+		broadcast:  make(chan []byte, broadcastBuffer),
 		register:   make(chan *Client),
 		unregister: make(chan *Client),
 		clients:    make(map[*Client]bool),
+		presence:   make(map[*Client]presence),
+		upgrader:   &upgrader,
 	}
+	for range broadcastWorkers {
+		h.shards = append(h.shards, &broadcastShard{in: make(chan *frame, 64), clients: make(map[*Client]bool)})
+	}
+	hubMetrics.Set("broadcast_queue_depth", expvar.Func(func() any { return len(h.broadcast) }))
+	hubMetrics.Set("clients", expvar.Func(func() any {
+		h.mu.RLock()
+		defer h.mu.RUnlock()
+		return len(h.clients)
+	}))
+	hubMetrics.Set("rtt_ms", expvar.Func(h.rttSummary))
+	return h
+}
+
+// SetEditLockService lets clients keep their edit locks alive with heartbeats.
+// It must be called before the server starts accepting connections.
+func (h *Hub) SetEditLockService(locks domain.EditLockService) {
+	h.locks = locks
+}
+
+// SetStockUpdateWindow makes the hub send at most one STOCK_UPDATE per item per window,
+// carrying the latest quantity, so clients are not flooded during bulk receipts.
+// Zero sends every update. It must be called before the hub is used.
+func (h *Hub) SetStockUpdateWindow(window time.Duration) {
+	if window <= 0 {
+		h.stockUpdates = nil
+		return
+	}
+	h.stockUpdates = newStockCoalescer(window, h.broadcastMessage)
+}
+
+// SetCompression negotiates permessage-deflate with clients that offer it, compressing
+// messages at the given flate level (-2 to 9; 1 is fastest and what large streams want).
+// Compression is without context takeover, so each connection only holds a compressor
+// while it writes and they are pooled across connections: memory does not grow with the
+// number of idle clients. It must be called before the server starts accepting connections.
+func (h *Hub) SetCompression(level int) error {
+	if level < flate.HuffmanOnly || level > flate.BestCompression {
+		return fmt.Errorf("compression level %d out of range [%d, %d]", level, flate.HuffmanOnly, flate.BestCompression)
+	}
+	u := upgrader
+	u.EnableCompression = true
+	h.upgrader = &u
+	h.compressionLevel = level
+	return nil
 }
 
 // Run starts the hub's event loop.
 // It must be run in a separate goroutine.
 func (h *Hub) Run() {
+	for _, shard := range h.shards {
+		go shard.run()
+	}
 	for {
 		select {
 		case client := <-h.register:
 			h.mu.Lock()
 			h.clients[client] = true
+			client.shard = h.shards[h.nextShard]
+			h.nextShard = (h.nextShard + 1) % len(h.shards)
+			client.shard.mu.Lock()
+			client.shard.clients[client] = true
+			client.shard.mu.Unlock()
 			log.Printf("Client registered: %s, total clients: %d", client.conn.RemoteAddr(), len(h.clients))
 			h.mu.Unlock()
 		case client := <-h.unregister:
 			h.mu.Lock()
 			if _, ok := h.clients[client]; ok {
 				delete(h.clients, client)
-				close(client.send) // Important to close the send channel
+				client.shard.mu.Lock()
+				delete(client.shard.clients, client)
+				client.shard.mu.Unlock()
+				client.send.close() // Lets writePump finish
 				log.Printf("Client unregistered: %s, total clients: %d", client.conn.RemoteAddr(), len(h.clients))
 			}
 			h.mu.Unlock()
+			h.clearPresence(client)
 		case message := <-h.broadcast: // message here is expected to be JSON []byte
-			h.mu.RLock()
-			for client := range h.clients {
-				select {
-				case client.send <- message:
-				default: // Don't block if client's send buffer is full
-					log.Printf("Client send buffer full or closed for %s, unregistering.", client.conn.RemoteAddr())
-					// Schedule unregistration to avoid deadlock if unregister channel is also blocked
-					// Or simply close the client's send channel and let writePump handle cleanup.
-					// For simplicity here, we'll let writePump detect the closed channel.
-					// A more robust solution might involve a separate goroutine for timed unregistration.
-					// For now, we'll just log and potentially drop the message for this client.
-					// If we delete from h.clients here, we need write lock, and to be careful with iteration.
-					// It's safer to let the client's own pumps handle their demise.
-				}
+			// Every shard sees messages in the same order, so every client does too.
+			// A busy shard slows the loop down, which in turn backs up BroadcastJSONMessage.
+			out := newFrame(message) // Shared by all shards, so it is converted to MessagePack at most once
+			for _, shard := range h.shards {
+				shard.in <- out
 			}
-			h.mu.RUnlock()
+			hubMetrics.Add("broadcasts", 1)
 		}
 	}
 }
@@ -94,56 +196,123 @@ func (h *Hub) Run() {
 // BroadcastJSONMessage sends a pre-marshalled JSON message to all connected clients.
 // This method is safe for concurrent use.
 func (h *Hub) BroadcastJSONMessage(jsonMessage []byte) {
-	// Non-blocking send to broadcast channel
 	select {
 	case h.broadcast <- jsonMessage:
+		return
 	default:
-		log.Println("Hub broadcast channel is full. Message dropped.")
+	}
+	// Backpressure: hold the caller for a while rather than dropping the message at once.
+	hubMetrics.Add("broadcast_waits", 1)
+	timer := time.NewTimer(broadcastWait)
+	defer timer.Stop()
+	select {
+	case h.broadcast <- jsonMessage:
+	case <-timer.C:
+		hubMetrics.Add("broadcasts_dropped", 1)
+		log.Printf("Hub broadcast queue still full after %s. Message dropped.", broadcastWait)
 	}
 }
 
-// BroadcastStockUpdate marshals and broadcasts a stock update message.
-func (h *Hub) BroadcastStockUpdate(payload domain.StockUpdatePayload) {
+// BroadcastStockUpdate marshals and broadcasts a stock update message, tagged with the
+// request ID carried by ctx.
+func (h *Hub) BroadcastStockUpdate(ctx context.Context, payload domain.StockUpdatePayload) {
 	wsMessage := domain.WebSocketMessage{
-		Type:    domain.StockUpdateMessageType,
-		Payload: payload,
+		Type:      domain.StockUpdateMessageType,
+		Payload:   payload,
+		RequestID: requestid.FromContext(ctx),
+	}
+	if h.stockUpdates != nil {
+		h.stockUpdates.submit(payload.ID, wsMessage)
+		return
 	}
+	h.broadcastMessage(wsMessage)
+}
 
-	jsonBytes, err := json.Marshal(wsMessage) // <<<<<<<<<<< CORRECT JSON MARSHALING
+// broadcastMessage marshals msg and broadcasts it to every client.
+func (h *Hub) broadcastMessage(msg domain.WebSocketMessage) {
+	jsonBytes, err := marshalMessage(msg)
 	if err != nil {
-		log.Printf("Error marshalling stock update WebSocket message: %v", err)
+		log.Printf("Error marshalling %s WebSocket message: %v", msg.Type, err)
 		return
 	}
 	h.BroadcastJSONMessage(jsonBytes)
 }
 
+// BroadcastStockBatchUpdate marshals and broadcasts the combined result of an adjustment batch,
+// tagged with the request ID carried by ctx. It is never held back, but it does go through the
+// coalescer, which drops STOCK_UPDATEs of the same items that would otherwise follow it.
+func (h *Hub) BroadcastStockBatchUpdate(ctx context.Context, payload domain.StockBatchUpdatePayload) {
+	wsMessage := domain.WebSocketMessage{
+		Type:      domain.StockBatchUpdateMessageType,
+		Payload:   payload,
+		RequestID: requestid.FromContext(ctx),
+	}
+	if h.stockUpdates != nil {
+		itemIDs := make([]string, 0, len(payload.Updates))
+		for _, u := range payload.Updates {
+			itemIDs = append(itemIDs, u.ID)
+		}
+		h.stockUpdates.submitBatch(itemIDs, wsMessage)
+		return
+	}
+	h.broadcastMessage(wsMessage)
+}
+
+// BroadcastLabelJobsQueued tells every client, station agents among them, that label jobs
+// were queued for a station, tagged with the request ID carried by ctx.
+func (h *Hub) BroadcastLabelJobsQueued(ctx context.Context, payload domain.LabelJobsQueuedPayload) {
+	h.broadcastMessage(domain.WebSocketMessage{
+		Type:      domain.LabelJobsQueuedMessageType,
+		Payload:   payload,
+		RequestID: requestid.FromContext(ctx),
+	})
+}
+
 // writePump pumps messages from the hub to the WebSocket connection.
 func (c *Client) writePump() {
 	ticker := time.NewTicker(pingPeriod)
+	heartbeat := time.NewTicker(heartbeatPeriod)
 	defer func() {
 		ticker.Stop()
+		heartbeat.Stop()
 		c.conn.Close() // Ensure connection is closed on exit
 		log.Printf("writePump for client %s stopped.", c.conn.RemoteAddr())
 	}()
 
 	for {
 		select {
-		case message, ok := <-c.send:
-			if !ok {
-				// The hub closed the channel (client was unregistered).
-				log.Printf("Hub closed send channel for client %s. Sending close message.", c.conn.RemoteAddr())
+		case <-c.send.wake:
+			messages, closed, overflow := c.send.pop()
+			if closed {
+				c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+				if overflow {
+					// The client fell too far behind; tell it to reconnect and refetch.
+					log.Printf("Send queue overflow for client %s. Disconnecting.", c.conn.RemoteAddr())
+					c.conn.WriteMessage(websocket.CloseMessage,
+						websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "too far behind, reconnect"))
+					return
+				}
+				// The hub closed the queue (client was unregistered).
+				log.Printf("Hub closed send queue for client %s. Sending close message.", c.conn.RemoteAddr())
 				c.conn.WriteMessage(websocket.CloseMessage, []byte{})
 				return
 			}
 
-			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
-			err := c.conn.WriteMessage(websocket.TextMessage, message) // Send as TextMessage, as it's JSON
-			if err != nil {
-				log.Printf("Error writing message to client %s: %v", c.conn.RemoteAddr(), err)
-				// Don't unregister here directly, let readPump or Run handle it
-				// to avoid race conditions with the hub's client map.
-				// The connection will likely be detected as broken by readPump or the next write.
-				return // Exit writePump
+			for _, message := range messages {
+				if err := c.write(message); err != nil {
+					log.Printf("Error writing message to client %s: %v", c.conn.RemoteAddr(), err)
+					// Don't unregister here directly, let readPump or Run handle it
+					// to avoid race conditions with the hub's client map.
+					// The connection will likely be detected as broken by readPump or the next write.
+					return // Exit writePump
+				}
+			}
+		case <-heartbeat.C:
+			// Written directly rather than queued, so the round trip measures the network and
+			// the client, not how far behind this client's queue is (queued_messages shows that).
+			if err := c.write(heartbeatFrame(c.latency.next(time.Now()))); err != nil {
+				log.Printf("Error sending heartbeat to client %s: %v", c.conn.RemoteAddr(), err)
+				return
 			}
 		case <-ticker.C:
 			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
@@ -155,6 +324,19 @@ func (c *Client) writePump() {
 	}
 }
 
+// write sends one message in the client's negotiated encoding. Messages that cannot be
+// encoded are logged and skipped; the error is only for failed writes.
+func (c *Client) write(message *frame) error {
+	messageType, data, err := message.encode(c.subprotocol)
+	if err != nil {
+		log.Printf("Error encoding message for client %s: %v", c.conn.RemoteAddr(), err)
+		return nil
+	}
+	c.conn.EnableWriteCompression(len(data) >= compressMinSize) // No-op unless negotiated
+	c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+	return c.conn.WriteMessage(messageType, data) // Text for JSON, binary for MessagePack
+}
+
 // readPump pumps messages from the WebSocket connection to the hub (if needed).
 // For this app, it mainly handles ping/pong and connection closure.
 func (c *Client) readPump() {
@@ -172,8 +354,8 @@ func (c *Client) readPump() {
 	})
 
 	for {
-		// Clients are not expected to send application messages, only control frames (pong).
-		_, _, err := c.conn.ReadMessage()
+		// Besides control frames (pong), clients may send small application messages such as presence reports.
+		messageType, message, err := c.conn.ReadMessage()
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
 				log.Printf("Unexpected WebSocket close error for client %s: %v", c.conn.RemoteAddr(), err)
@@ -184,7 +366,13 @@ func (c *Client) readPump() {
 			}
 			break // Exit loop, defer will unregister and close
 		}
-		// If we were expecting messages from the client, we'd process them here.
+		if messageType == websocket.BinaryMessage && c.subprotocol == SubprotocolMsgpack {
+			if message, err = msgpackToJSON(message); err != nil {
+				log.Printf("Ignoring malformed message from client %s: %v", c.conn.RemoteAddr(), err)
+				continue
+			}
+		}
+		c.hub.handleClientMessage(c, message)
 	}
 }
 
@@ -194,6 +382,8 @@ func (c *Client) readPump() {
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024,
+	// Encodings a client may ask for; without one it gets JSON.
+	Subprotocols: []string{SubprotocolMsgpack, SubprotocolJSON},
 	CheckOrigin: func(r *http.Request) bool {
 		// Allow all origins for development.
 		// For production, you should implement proper origin checking.
@@ -202,18 +392,28 @@ var upgrader = websocket.Upgrader{
 	},
 }
 
-func ServeWsUpgrade(hub *Hub, w http.ResponseWriter, r *http.Request) {
-	conn, err := upgrader.Upgrade(w, r, nil)
+// userID is the user the client says it is, shown in presence and checked by lock heartbeats;
+// pass "" for anonymous clients. Nothing is addressed to it: until users are authenticated,
+// every client receives the same broadcasts. Clients choose the message encoding with the
+// Sec-WebSocket-Protocol header (see SubprotocolMsgpack); the default is JSON.
+func ServeWsUpgrade(hub *Hub, w http.ResponseWriter, r *http.Request, userID string) {
+	conn, err := hub.upgrader.Upgrade(w, r, nil)
 	if err != nil {
 		log.Printf("Failed to upgrade to WebSocket for %s: %v", r.RemoteAddr, err)
 		return
 	}
-	log.Printf("WebSocket connection upgraded for: %s", conn.RemoteAddr())
+	if hub.upgrader.EnableCompression {
+		conn.SetCompressionLevel(hub.compressionLevel) // Level was validated by SetCompression
+	}
+	log.Printf("WebSocket connection upgraded for: %s (subprotocol %q)", conn.RemoteAddr(), conn.Subprotocol())
 
 	client := &Client{
-		hub:  hub,
-		conn: conn,
-		send: make(chan []byte, 256), // Buffered channel for client's outgoing messages
+		hub:         hub,
+		conn:        conn,
+		send:        newSendQueue(),
+		userID:      userID,
+		subprotocol: conn.Subprotocol(),
+		connectedAt: time.Now(),
 	}
 	client.hub.register <- client // Register the new client with the hub
 
@@ -0,0 +1,157 @@
+package realtime
+
+import (
+	"encoding/json"
+	"log"
+	"sort"
+	"time"
+
+	"inventory-system/internal/domain"
+)
+
+// presence records which item an identified client is on.
+// It is guarded by Hub.presenceMu, separately from the client maps, so presence
+// reports never contend with broadcasts.
+type presence struct {
+	itemID string
+	mode   string
+	since  time.Time
+}
+
+// inboundMessage is the envelope of messages sent by clients.
+// Payload is decoded lazily according to Type.
+type inboundMessage struct {
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// handleClientMessage dispatches an application message received from a client
+// (presence reports, edit-lock heartbeats and heartbeat acks).
+func (h *Hub) handleClientMessage(c *Client, data []byte) {
+	var msg inboundMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		log.Printf("Ignoring malformed message from client %s: %v", c.conn.RemoteAddr(), err)
+		return
+	}
+
+	switch msg.Type {
+	case domain.PresenceMessageType:
+		var report domain.PresenceReport
+		if err := json.Unmarshal(msg.Payload, &report); err != nil {
+			log.Printf("Ignoring malformed presence report from client %s: %v", c.conn.RemoteAddr(), err)
+			return
+		}
+		h.updatePresence(c, report)
+	case domain.LockHeartbeatMessageType:
+		var hb domain.LockHeartbeat
+		if err := json.Unmarshal(msg.Payload, &hb); err != nil {
+			log.Printf("Ignoring malformed lock heartbeat from client %s: %v", c.conn.RemoteAddr(), err)
+			return
+		}
+		if h.locks == nil || c.userID == "" {
+			return
+		}
+		if _, err := h.locks.Refresh(hb.ItemID, c.userID); err != nil {
+			log.Printf("Lock heartbeat from user %s for item %s rejected: %v", c.userID, hb.ItemID, err)
+		}
+	case domain.HeartbeatAckMessageType:
+		var ack domain.HeartbeatAck
+		if err := json.Unmarshal(msg.Payload, &ack); err != nil {
+			log.Printf("Ignoring malformed heartbeat ack from client %s: %v", c.conn.RemoteAddr(), err)
+			return
+		}
+		h.handleHeartbeatAck(c, ack)
+	default:
+		log.Printf("Ignoring unknown message type %q from client %s", msg.Type, c.conn.RemoteAddr())
+	}
+}
+
+// updatePresence records where a client is and broadcasts the affected items.
+func (h *Hub) updatePresence(c *Client, report domain.PresenceReport) {
+	if c.userID == "" {
+		return // Presence is only meaningful for identified users
+	}
+	if report.Mode != domain.PresenceModeEditing {
+		report.Mode = domain.PresenceModeViewing
+	}
+
+	h.presenceMu.Lock()
+	previous, had := h.presence[c]
+	if report.ItemID == "" {
+		delete(h.presence, c)
+	} else {
+		since := time.Now()
+		if had && previous.itemID == report.ItemID {
+			since = previous.since // Switching view -> edit keeps the original arrival time
+		}
+		h.presence[c] = presence{itemID: report.ItemID, mode: report.Mode, since: since}
+	}
+	h.presenceMu.Unlock()
+
+	if had && previous.itemID != report.ItemID {
+		h.broadcastPresence(previous.itemID)
+	}
+	if report.ItemID != "" {
+		h.broadcastPresence(report.ItemID)
+	}
+}
+
+// clearPresence forgets a disconnected client and tells others it left.
+func (h *Hub) clearPresence(c *Client) {
+	h.presenceMu.Lock()
+	previous, had := h.presence[c]
+	delete(h.presence, c)
+	h.presenceMu.Unlock()
+
+	if had {
+		h.broadcastPresence(previous.itemID)
+	}
+}
+
+// ItemPresence returns the users currently viewing or editing an item.
+// A user with several connections on the item appears once; editing wins over viewing.
+func (h *Hub) ItemPresence(itemID string) []domain.PresenceEntry {
+	h.presenceMu.RLock()
+	byUser := make(map[string]domain.PresenceEntry)
+	for client, p := range h.presence {
+		if p.itemID != itemID {
+			continue
+		}
+		entry, ok := byUser[client.userID]
+		if !ok {
+			entry = domain.PresenceEntry{UserID: client.userID, Mode: p.mode, Since: p.since}
+		} else {
+			if p.mode == domain.PresenceModeEditing {
+				entry.Mode = domain.PresenceModeEditing
+			}
+			if p.since.Before(entry.Since) {
+				entry.Since = p.since
+			}
+		}
+		byUser[client.userID] = entry
+	}
+	h.presenceMu.RUnlock()
+
+	entries := make([]domain.PresenceEntry, 0, len(byUser))
+	for _, e := range byUser {
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Since.Before(entries[j].Since) })
+	return entries
+}
+
+func (h *Hub) broadcastPresence(itemID string) {
+	wsMessage := domain.WebSocketMessage{
+		Type: domain.PresenceUpdateMessageType,
+		Payload: domain.PresenceUpdatePayload{
+			ItemID: itemID,
+			Users:  h.ItemPresence(itemID),
+		},
+	}
+	jsonBytes, err := marshalMessage(wsMessage)
+	if err != nil {
+		log.Printf("Error marshalling presence update WebSocket message: %v", err)
+		return
+	}
+	h.BroadcastJSONMessage(jsonBytes)
+}
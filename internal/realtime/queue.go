@@ -0,0 +1,117 @@
+package realtime
+
+import (
+	"expvar"
+	"sync"
+)
+
+// Per-client send queue limits, in messages. A client whose queue reaches sendQueueHigh is
+// marked slow until it drains below sendQueueLow; one that reaches sendQueueMax is
+// disconnected with "try again later", so it knows to reconnect and resync rather than
+// silently missing updates.
+const (
+	sendQueueLow  = 256
+	sendQueueHigh = 2048
+	sendQueueMax  = 16384
+
+	// writeBatch is the most messages the write pump takes from its queue at once.
+	writeBatch = 64
+)
+
+// hubMetrics are published through expvar under "websocket".
+var hubMetrics = expvar.NewMap("websocket")
+
+// sendQueue holds the messages waiting to be written to one client. Unlike a buffered
+// channel it never drops: it grows up to sendQueueMax and then closes with overflow set.
+type sendQueue struct {
+	mu       sync.Mutex
+	msgs     []*frame
+	wake     chan struct{} // Signalled (capacity 1) when messages are added or the queue closes
+	closed   bool
+	overflow bool // Closed because the client fell too far behind
+	slow     bool // Between crossing sendQueueHigh and draining below sendQueueLow
+}
+
+func newSendQueue() *sendQueue {
+	return &sendQueue{wake: make(chan struct{}, 1)}
+}
+
+// push appends msg. It reports false if the queue is closed, including when this message
+// overflowed it.
+func (q *sendQueue) push(msg *frame) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return false
+	}
+	if len(q.msgs) >= sendQueueMax {
+		q.overflow = true
+		q.closeLocked()
+		hubMetrics.Add("slow_disconnects", 1)
+		return false
+	}
+	q.msgs = append(q.msgs, msg)
+	hubMetrics.Add("queued_messages", 1)
+	if !q.slow && len(q.msgs) >= sendQueueHigh {
+		q.slow = true
+		hubMetrics.Add("slow_clients", 1)
+	}
+	q.signal()
+	return true
+}
+
+// pop removes up to writeBatch messages from the front of the queue. closed reports that
+// the queue was closed; messages queued before that are still returned first.
+func (q *sendQueue) pop() (msgs []*frame, closed, overflow bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	n := min(len(q.msgs), writeBatch)
+	msgs = q.msgs[:n:n]
+	q.msgs = q.msgs[n:]
+	hubMetrics.Add("queued_messages", -int64(n))
+	if q.slow && len(q.msgs) < sendQueueLow {
+		q.slow = false
+		hubMetrics.Add("slow_clients", -1)
+	}
+	if len(q.msgs) > 0 {
+		q.signal() // Come back for the rest
+	}
+	return msgs, q.closed && n == 0, q.overflow
+}
+
+// len returns the number of messages waiting.
+func (q *sendQueue) len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.msgs)
+}
+
+// close stops the queue from accepting messages and wakes the writer.
+func (q *sendQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.closeLocked()
+}
+
+func (q *sendQueue) closeLocked() {
+	if q.closed {
+		return
+	}
+	q.closed = true
+	if q.overflow {
+		hubMetrics.Add("queued_messages", -int64(len(q.msgs)))
+		q.msgs = nil // The client is being cut off; what it missed must be refetched anyway
+	}
+	if q.slow {
+		q.slow = false
+		hubMetrics.Add("slow_clients", -1)
+	}
+	q.signal()
+}
+
+func (q *sendQueue) signal() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
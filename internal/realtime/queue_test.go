@@ -0,0 +1,57 @@
+package realtime
+
+import "testing"
+
+func TestSendQueueWatermarks(t *testing.T) {
+	q := newSendQueue()
+	for i := 0; i < sendQueueHigh; i++ {
+		if !q.push(newFrame([]byte("m"))) {
+			t.Fatalf("push %d refused", i)
+		}
+	}
+	if !q.slow {
+		t.Fatal("queue at the high watermark is not marked slow")
+	}
+
+	// Draining to just above the low watermark keeps the mark; going below clears it.
+	for len(q.msgs) >= sendQueueLow+writeBatch {
+		q.pop()
+	}
+	if !q.slow {
+		t.Fatalf("queue with %d messages is no longer slow", len(q.msgs))
+	}
+	for len(q.msgs) >= sendQueueLow {
+		q.pop()
+	}
+	if q.slow {
+		t.Fatalf("queue with %d messages is still slow", len(q.msgs))
+	}
+}
+
+func TestSendQueueOverflow(t *testing.T) {
+	q := newSendQueue()
+	for i := 0; i < sendQueueMax; i++ {
+		q.push(newFrame([]byte("m")))
+	}
+	if q.push(newFrame([]byte("one too many"))) {
+		t.Fatal("push beyond sendQueueMax accepted")
+	}
+	if msgs, closed, overflow := q.pop(); len(msgs) != 0 || !closed || !overflow {
+		t.Fatalf("pop after overflow = %d messages, closed %v, overflow %v", len(msgs), closed, overflow)
+	}
+}
+
+func TestSendQueueDeliversBeforeClosing(t *testing.T) {
+	q := newSendQueue()
+	q.push(newFrame([]byte("a")))
+	q.close()
+	if q.push(newFrame([]byte("b"))) {
+		t.Fatal("push after close accepted")
+	}
+	if msgs, closed, _ := q.pop(); len(msgs) != 1 || closed {
+		t.Fatalf("first pop = %d messages, closed %v; want the queued message", len(msgs), closed)
+	}
+	if _, closed, overflow := q.pop(); !closed || overflow {
+		t.Fatalf("second pop closed %v, overflow %v; want a plain close", closed, overflow)
+	}
+}
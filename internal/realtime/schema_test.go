@@ -0,0 +1,246 @@
+package realtime_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/eventschema"
+)
+
+var update = flag.Bool("update", false, "rewrite the WebSocket message schema golden file")
+
+// schemaPath is the JSON Schema describing every WebSocket message. It is generated from the
+// event registry (internal/eventschema) and committed so that frontend and backend share one contract: any change
+// to a payload shows up as a diff of this file.
+var schemaPath = filepath.Join("testdata", "websocket_messages.schema.json")
+
+func TestMessageSchema(t *testing.T) {
+	got, err := json.MarshalIndent(eventschema.Document("Inventory System WebSocket messages", eventschema.Events()), "", "  ")
+	if err != nil {
+		t.Fatalf("marshal schema: %v", err)
+	}
+	got = append(got, '\n')
+
+	if *update {
+		if err := os.WriteFile(schemaPath, got, 0o644); err != nil {
+			t.Fatalf("write schema: %v", err)
+		}
+		return
+	}
+	want, err := os.ReadFile(schemaPath)
+	if err != nil {
+		t.Fatalf("read schema (run with -update to create it): %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("WebSocket payload types no longer match %s.\n"+
+			"If the change is intentional, run `go test ./internal/realtime -run TestMessageSchema -update`, "+
+			"review the diff and update the frontend.\n--- got ---\n%s", schemaPath, got)
+	}
+}
+
+// TestSamplePayloadsMatchSchema checks the validator itself against representative payloads.
+func TestSamplePayloadsMatchSchema(t *testing.T) {
+	schema := loadSchema(t)
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	entity := domain.CommentEntityItem
+
+	samples := []domain.WebSocketMessage{
+		{Type: domain.StockUpdateMessageType, Payload: domain.StockUpdatePayload{ID: "id", SKU: "SKU-1", NewQuantity: 3}},
+		{Type: domain.NotificationMessageType, Payload: domain.Notification{ID: "n", UserID: "bob", Type: domain.NotificationTypeMention, Message: "hi", EntityType: &entity, CreatedAt: now}},
+		{Type: domain.PresenceUpdateMessageType, Payload: domain.PresenceUpdatePayload{ItemID: "id", Users: []domain.PresenceEntry{{UserID: "alice", Mode: domain.PresenceModeViewing, Since: now}}}},
+		{Type: domain.PresenceMessageType, Payload: domain.PresenceReport{ItemID: "id", Mode: domain.PresenceModeEditing}},
+		{Type: domain.LockHeartbeatMessageType, Payload: domain.LockHeartbeat{ItemID: "id"}},
+		{Type: domain.HeartbeatMessageType, Payload: domain.Heartbeat{Seq: 1}},
+		{Type: domain.HeartbeatAckMessageType, Payload: domain.HeartbeatAck{Seq: 1}},
+	}
+	for _, msg := range samples {
+		msg.SchemaVersion = eventschema.Version(msg.Type)
+		data, err := json.Marshal(msg)
+		if err != nil {
+			t.Fatalf("marshal %s: %v", msg.Type, err)
+		}
+		if err := schema.validateMessage(data); err != nil {
+			t.Errorf("%s: %v", msg.Type, err)
+		}
+	}
+
+	for _, bad := range []string{
+		`{"type":"STOCK_UPDATE","schema_version":1,"payload":{"id":"id","sku":"SKU-1"}}`,                        // Missing field
+		`{"type":"STOCK_UPDATE","schema_version":1,"payload":{"id":"id","sku":"SKU-1","new_quantity":"3"}}`,     // Wrong type
+		`{"type":"STOCK_UPDATE","schema_version":1,"payload":{"id":"id","sku":"S","new_quantity":3,"extra":1}}`, // Unknown field
+		`{"type":"STOCK_UPDATE","payload":{"id":"id","sku":"SKU-1","new_quantity":3}}`,                          // Unversioned server message
+		`{"type":"STOCK_UPDATE","schema_version":2,"payload":{"id":"id","sku":"SKU-1","new_quantity":3}}`,       // Unknown version
+		`{"type":"UNKNOWN","payload":{}}`,
+	} {
+		if err := schema.validateMessage([]byte(bad)); err == nil {
+			t.Errorf("expected %s to be rejected", bad)
+		}
+	}
+
+	// Clients may leave the version out of what they send.
+	if err := schema.validateMessage([]byte(`{"type":"HEARTBEAT_ACK","payload":{"seq":1}}`)); err != nil {
+		t.Errorf("unversioned client message: %v", err)
+	}
+}
+
+// messageSchema validates messages against the committed schema file.
+// It understands exactly the subset of JSON Schema that eventschema.Document emits.
+type messageSchema struct {
+	root map[string]any
+	defs map[string]any
+}
+
+func loadSchema(t *testing.T) *messageSchema {
+	t.Helper()
+	data, err := os.ReadFile(schemaPath)
+	if err != nil {
+		t.Fatalf("read schema: %v", err)
+	}
+	var root map[string]any
+	if err := json.Unmarshal(data, &root); err != nil {
+		t.Fatalf("decode schema: %v", err)
+	}
+	defs, _ := root["$defs"].(map[string]any)
+	return &messageSchema{root: root, defs: defs}
+}
+
+// validateMessage checks that data matches exactly one message variant.
+func (s *messageSchema) validateMessage(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return fmt.Errorf("message is not JSON: %w", err)
+	}
+
+	var errs []string
+	for _, variant := range s.root["oneOf"].([]any) {
+		v := variant.(map[string]any)
+		err := s.validate(value, v, "$")
+		if err == nil {
+			return nil
+		}
+		errs = append(errs, fmt.Sprintf("%s: %v", v["title"], err))
+	}
+	return fmt.Errorf("message matches no variant:\n  %s", strings.Join(errs, "\n  "))
+}
+
+func (s *messageSchema) validate(value any, schema map[string]any, path string) error {
+	if ref, ok := schema["$ref"].(string); ok {
+		def, ok := s.defs[strings.TrimPrefix(ref, "#/$defs/")].(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s: unresolved $ref %s", path, ref)
+		}
+		return s.validate(value, def, path)
+	}
+	if c, ok := schema["const"]; ok && fmt.Sprint(value) != fmt.Sprint(c) { // Numbers are json.Number here, float64 in the schema
+		return fmt.Errorf("%s: want %v, got %v", path, c, value)
+	}
+	if anyOf, ok := schema["anyOf"].([]any); ok {
+		for _, alt := range anyOf {
+			if s.validate(value, alt.(map[string]any), path) == nil {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s: %v matches none of the allowed types", path, value)
+	}
+	if typ, ok := schema["type"]; ok {
+		if err := checkType(value, typ, path); err != nil {
+			return err
+		}
+	}
+	if schema["format"] == "date-time" {
+		if _, err := time.Parse(time.RFC3339Nano, value.(string)); err != nil {
+			return fmt.Errorf("%s: not a date-time: %v", path, err)
+		}
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		properties, _ := schema["properties"].(map[string]any)
+		for _, r := range asSlice(schema["required"]) {
+			if _, ok := v[r.(string)]; !ok {
+				return fmt.Errorf("%s: missing required field %q", path, r)
+			}
+		}
+		for name, field := range v {
+			prop, ok := properties[name].(map[string]any)
+			if !ok {
+				if schema["additionalProperties"] == false {
+					return fmt.Errorf("%s: unexpected field %q", path, name)
+				}
+				continue
+			}
+			if err := s.validate(field, prop, path+"."+name); err != nil {
+				return err
+			}
+		}
+	case []any:
+		if items, ok := schema["items"].(map[string]any); ok {
+			for i, elem := range v {
+				if err := s.validate(elem, items, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func checkType(value any, typ any, path string) error {
+	for _, t := range asSlice(typ) {
+		switch t {
+		case "object":
+			if _, ok := value.(map[string]any); ok {
+				return nil
+			}
+		case "array":
+			if _, ok := value.([]any); ok {
+				return nil
+			}
+		case "string":
+			if _, ok := value.(string); ok {
+				return nil
+			}
+		case "boolean":
+			if _, ok := value.(bool); ok {
+				return nil
+			}
+		case "null":
+			if value == nil {
+				return nil
+			}
+		case "number":
+			if _, ok := value.(json.Number); ok {
+				return nil
+			}
+		case "integer":
+			if n, ok := value.(json.Number); ok {
+				if _, err := n.Int64(); err == nil {
+					return nil
+				}
+			}
+		}
+	}
+	return fmt.Errorf("%s: %v (%T) is not of type %v", path, value, value, typ)
+}
+
+// asSlice normalises schema keywords that may hold a single value or a list.
+func asSlice(v any) []any {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case []any:
+		return v
+	default:
+		return []any{v}
+	}
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Quantity adjustments lock the item rows they change (SELECT ... FOR UPDATE), so concurrent
+// adjustments to the same SKU queue up instead of overwriting each other. Lock waits are bounded,
+// and transactions aborted by a deadlock or serialization failure are retried.
+const (
+	adjustmentLockTimeout = "5s" // SET LOCAL lock_timeout; a waiter gives up after this long
+	adjustmentMaxAttempts = 3
+	adjustmentRetryDelay  = 20 * time.Millisecond // Doubled on each retry
+)
+
+// PostgreSQL error codes handled by runAdjustmentTx.
+const (
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
+	pgLockNotAvailable     = "55P03" // Raised when lock_timeout expires
+)
+
+// adjustmentMetrics are published through expvar (GET /debug/vars on the admin listener).
+var adjustmentMetrics = expvar.NewMap("stock_adjustments")
+
+// runAdjustmentTx runs fn in a transaction with a bounded lock wait and commits it. When
+// PostgreSQL aborts the transaction because of a deadlock or serialization failure, the
+// whole transaction is retried; any other error from fn rolls it back and is returned as is.
+func runAdjustmentTx(ctx context.Context, db *pgxpool.Pool, fn func(tx pgx.Tx) error) error {
+	delay := adjustmentRetryDelay
+	for attempt := 1; ; attempt++ {
+		adjustmentMetrics.Add("transactions", 1)
+		start := time.Now()
+		err := adjustmentTxOnce(ctx, db, fn)
+		adjustmentMetrics.Add("duration_ms_total", time.Since(start).Milliseconds())
+		if err == nil {
+			return nil
+		}
+
+		var pgErr *pgconn.PgError
+		if !errors.As(err, &pgErr) {
+			return err
+		}
+		switch pgErr.Code {
+		case pgDeadlockDetected:
+			adjustmentMetrics.Add("deadlocks", 1)
+		case pgSerializationFailure:
+			adjustmentMetrics.Add("serialization_failures", 1)
+		case pgLockNotAvailable:
+			adjustmentMetrics.Add("lock_timeouts", 1)
+			return err
+		default:
+			return err
+		}
+		if attempt >= adjustmentMaxAttempts {
+			adjustmentMetrics.Add("retries_exhausted", 1)
+			return err
+		}
+
+		adjustmentMetrics.Add("retries", 1)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+func adjustmentTxOnce(ctx context.Context, db *pgxpool.Pool, fn func(tx pgx.Tx) error) error {
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin stock adjustment: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op after Commit
+
+	if _, err := tx.Exec(ctx, "SET LOCAL lock_timeout = '"+adjustmentLockTimeout+"'"); err != nil {
+		return fmt.Errorf("failed to set lock timeout: %w", err)
+	}
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit stock adjustment: %w", err)
+	}
+	return nil
+}
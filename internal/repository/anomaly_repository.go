@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"inventory-system/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type pgAnomalyRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPgAnomalyRepository creates a new AnomalyRepository backed by PostgreSQL.
+func NewPgAnomalyRepository(db *pgxpool.Pool) domain.AnomalyRepository {
+	return &pgAnomalyRepository{db: db}
+}
+
+const anomalyColumns = `id, kind, movement_id, item_id, user_id, score, details, detected_at, acknowledged_at, acknowledged_by`
+
+func scanAnomaly(row pgx.Row) (*domain.Anomaly, error) {
+	a := &domain.Anomaly{}
+	err := row.Scan(&a.ID, &a.Kind, &a.MovementID, &a.ItemID, &a.UserID, &a.Score, &a.Details,
+		&a.DetectedAt, &a.AcknowledgedAt, &a.AcknowledgedBy)
+	return a, err
+}
+
+// Create inserts an anomaly unless one with the same dedupe key exists.
+func (r *pgAnomalyRepository) Create(ctx context.Context, a *domain.Anomaly) (bool, error) {
+	if a.ID == "" {
+		a.ID = uuid.NewString()
+	}
+	a.DetectedAt = time.Now()
+
+	commandTag, err := r.db.Exec(ctx, `
+        INSERT INTO anomalies (id, kind, movement_id, item_id, user_id, score, details, dedupe_key, detected_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+        ON CONFLICT (dedupe_key) DO NOTHING`,
+		a.ID, a.Kind, a.MovementID, a.ItemID, a.UserID, a.Score, a.Details, a.DedupeKey, a.DetectedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to create anomaly '%s': %w", a.DedupeKey, err)
+	}
+	return commandTag.RowsAffected() == 1, nil
+}
+
+// List returns anomalies, newest first.
+func (r *pgAnomalyRepository) List(ctx context.Context, q domain.ListAnomaliesQuery) ([]*domain.Anomaly, error) {
+	if q.Limit < 1 {
+		q.Limit = 50
+	}
+	rows, err := r.db.Query(ctx, `
+        SELECT `+anomalyColumns+`
+        FROM anomalies
+        WHERE ($1 = '' OR kind = $1) AND ($2 = FALSE OR acknowledged_at IS NULL)
+        ORDER BY detected_at DESC, id
+        LIMIT $3`, q.Kind, q.Unacknowledged, q.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list anomalies: %w", err)
+	}
+	defer rows.Close()
+
+	anomalies := []*domain.Anomaly{}
+	for rows.Next() {
+		a, err := scanAnomaly(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan anomaly row: %w", err)
+		}
+		anomalies = append(anomalies, a)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating anomaly rows: %w", err)
+	}
+	return anomalies, nil
+}
+
+// Acknowledge records that userID reviewed an anomaly. Acknowledging it again keeps the first reviewer.
+func (r *pgAnomalyRepository) Acknowledge(ctx context.Context, id, userID string) (*domain.Anomaly, error) {
+	a, err := scanAnomaly(r.db.QueryRow(ctx, `
+        UPDATE anomalies
+        SET acknowledged_at = COALESCE(acknowledged_at, NOW()),
+            acknowledged_by = COALESCE(acknowledged_by, $2)
+        WHERE id = $1
+        RETURNING `+anomalyColumns, id, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: anomaly with ID '%s'", domain.ErrRepositoryNotFound, id)
+		}
+		return nil, fmt.Errorf("failed to acknowledge anomaly: %w", err)
+	}
+	return a, nil
+}
@@ -0,0 +1,325 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"inventory-system/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type pgAssemblyRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPgAssemblyRepository creates a new AssemblyRepository backed by PostgreSQL.
+func NewPgAssemblyRepository(db *pgxpool.Pool) domain.AssemblyRepository {
+	return &pgAssemblyRepository{db: db}
+}
+
+// SetComponents replaces the bill of materials of an item.
+func (r *pgAssemblyRepository) SetComponents(ctx context.Context, itemID string, components []domain.Component) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin component update: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op after Commit
+
+	commandTag, err := tx.Exec(ctx, `SELECT 1 FROM items WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, itemID)
+	if err != nil {
+		return fmt.Errorf("failed to lock item '%s': %w", itemID, err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: item with ID '%s'", domain.ErrRepositoryNotFound, itemID)
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM item_components WHERE item_id = $1`, itemID); err != nil {
+		return fmt.Errorf("failed to clear components of item '%s': %w", itemID, err)
+	}
+	for _, c := range components {
+		_, err := tx.Exec(ctx, `INSERT INTO item_components (item_id, component_id, quantity) VALUES ($1, $2, $3)`,
+			itemID, c.ComponentID, c.Quantity)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation: unknown component
+				return fmt.Errorf("%w: component item with ID '%s'", domain.ErrRepositoryNotFound, c.ComponentID)
+			}
+			return fmt.Errorf("failed to add component '%s' to item '%s': %w", c.ComponentID, itemID, err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit component update: %w", err)
+	}
+	return nil
+}
+
+// ListComponents returns the bill of materials of an item, ordered by component ID.
+func (r *pgAssemblyRepository) ListComponents(ctx context.Context, itemID string) ([]domain.Component, error) {
+	var exists bool
+	if err := r.db.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM items WHERE id = $1 AND deleted_at IS NULL)`, itemID).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("failed to look up item '%s': %w", itemID, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: item with ID '%s'", domain.ErrRepositoryNotFound, itemID)
+	}
+	return scanComponents(r.db.Query(ctx, `
+        SELECT component_id, quantity
+        FROM item_components
+        WHERE item_id = $1
+        ORDER BY component_id`, itemID))
+}
+
+// CreateOrder stores an open order and copies the item's bill of materials into its lines.
+func (r *pgAssemblyRepository) CreateOrder(ctx context.Context, o *domain.AssemblyOrder) (*domain.AssemblyOrder, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin assembly order insert: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op after Commit
+
+	o.Status = domain.AssemblyStatusOpen
+	if o.Number, err = nextDocumentNumber(ctx, tx, domain.DocumentTypeAssemblyOrder); err != nil {
+		return nil, err
+	}
+	err = tx.QueryRow(ctx, `
+        INSERT INTO assembly_orders (number, item_id, quantity, status, created_by)
+        VALUES ($1, $2, $3, $4, $5)
+        RETURNING id, created_at`,
+		o.Number, o.ItemID, o.Quantity, o.Status, o.CreatedBy).Scan(&o.ID, &o.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation: unknown item
+			return nil, fmt.Errorf("%w: item with ID '%s'", domain.ErrRepositoryNotFound, o.ItemID)
+		}
+		return nil, fmt.Errorf("failed to create assembly order: %w", err)
+	}
+
+	commandTag, err := tx.Exec(ctx, `
+        INSERT INTO assembly_order_lines (order_id, component_id, quantity)
+        SELECT $1, component_id, quantity * $2
+        FROM item_components
+        WHERE item_id = $3`, o.ID, o.Quantity, o.ItemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create lines of assembly order: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return nil, fmt.Errorf("%w: item '%s'", domain.ErrNoComponents, o.ItemID)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit assembly order: %w", err)
+	}
+	if err := r.loadLines(ctx, r.db, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// GetOrder retrieves an assembly order with its lines.
+func (r *pgAssemblyRepository) GetOrder(ctx context.Context, id string) (*domain.AssemblyOrder, error) {
+	o, err := getAssemblyOrder(ctx, r.db, id, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := r.loadLines(ctx, r.db, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// ListOrders returns the latest assembly orders, optionally of one status, newest first.
+func (r *pgAssemblyRepository) ListOrders(ctx context.Context, q domain.ListAssemblyOrdersQuery) ([]*domain.AssemblyOrder, error) {
+	if q.Limit < 1 {
+		q.Limit = 50
+	}
+	rows, err := r.db.Query(ctx, `
+        SELECT id, number, item_id, quantity, status, created_by, created_at, closed_by, closed_at
+        FROM assembly_orders
+        WHERE ($1 = '' OR status = $1)
+        ORDER BY created_at DESC, id
+        LIMIT $2`, q.Status, q.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list assembly orders: %w", err)
+	}
+	defer rows.Close()
+
+	orders := []*domain.AssemblyOrder{}
+	for rows.Next() {
+		o := &domain.AssemblyOrder{}
+		if err := rows.Scan(&o.ID, &o.Number, &o.ItemID, &o.Quantity, &o.Status, &o.CreatedBy, &o.CreatedAt, &o.ClosedBy, &o.ClosedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan assembly order row: %w", err)
+		}
+		orders = append(orders, o)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating assembly order rows: %w", err)
+	}
+	for _, o := range orders {
+		if err := r.loadLines(ctx, r.db, o); err != nil {
+			return nil, err
+		}
+	}
+	return orders, nil
+}
+
+// Complete consumes the order's components and receives the assembled items while holding
+// the row locks of all of them, and closes the order.
+func (r *pgAssemblyRepository) Complete(ctx context.Context, id, userID string) (*domain.CompleteAssemblyResult, error) {
+	var result *domain.CompleteAssemblyResult
+	err := runAdjustmentTx(ctx, r.db, func(tx pgx.Tx) error {
+		o, err := getAssemblyOrder(ctx, tx, id, "FOR UPDATE")
+		if err != nil {
+			return err
+		}
+		if o.Status != domain.AssemblyStatusOpen {
+			return fmt.Errorf("%w: order '%s' is %s", domain.ErrAssemblyOrderClosed, id, o.Status)
+		}
+		if err := r.loadLines(ctx, tx, o); err != nil {
+			return err
+		}
+
+		// Lock every item up front, in ID order, so the check below holds until commit.
+		ids := []string{o.ItemID}
+		for _, line := range o.Lines {
+			ids = append(ids, line.ComponentID)
+		}
+		stocks, err := lockStock(ctx, tx, ids)
+		if err != nil {
+			return err
+		}
+		reserved, err := reservedStock(ctx, tx, ids)
+		if err != nil {
+			return err
+		}
+		for _, line := range o.Lines {
+			s, ok := stocks[line.ComponentID]
+			if !ok {
+				return fmt.Errorf("%w: component item with ID '%s'", domain.ErrRepositoryNotFound, line.ComponentID)
+			}
+			if available := s.quantity - reserved[line.ComponentID]; available < line.Quantity {
+				return fmt.Errorf("%w: component '%s' has %d available, order '%s' needs %d",
+					domain.ErrInsufficientStock, s.sku, max(available, 0), id, line.Quantity)
+			}
+		}
+
+		result = &domain.CompleteAssemblyResult{Order: o}
+		movements := make([]*domain.StockMovement, 0, len(o.Lines)+1)
+		for _, line := range o.Lines {
+			movements = append(movements, &domain.StockMovement{ItemID: line.ComponentID, Delta: -line.Quantity,
+				Reason: domain.MovementReasonAssemblyConsume, Reference: id, MovedBy: userID})
+		}
+		movements = append(movements, &domain.StockMovement{ItemID: o.ItemID, Delta: o.Quantity,
+			Reason: domain.MovementReasonAssemblyProduce, Reference: id, MovedBy: userID})
+		for _, m := range movements {
+			item, err := adjustStock(ctx, tx, m)
+			if err != nil {
+				return err
+			}
+			result.Items = append(result.Items, item)
+		}
+		result.Movements = movements
+
+		return tx.QueryRow(ctx, `
+            UPDATE assembly_orders
+            SET status = $1, closed_by = $2, closed_at = NOW()
+            WHERE id = $3
+            RETURNING status, closed_by, closed_at`,
+			domain.AssemblyStatusCompleted, userID, id).Scan(&o.Status, &o.ClosedBy, &o.ClosedAt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Cancel closes an open order without touching stock.
+func (r *pgAssemblyRepository) Cancel(ctx context.Context, id, userID string) (*domain.AssemblyOrder, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin assembly order cancellation: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op after Commit
+
+	o, err := getAssemblyOrder(ctx, tx, id, "FOR UPDATE")
+	if err != nil {
+		return nil, err
+	}
+	if o.Status != domain.AssemblyStatusOpen {
+		return nil, fmt.Errorf("%w: order '%s' is %s", domain.ErrAssemblyOrderClosed, id, o.Status)
+	}
+	err = tx.QueryRow(ctx, `
+        UPDATE assembly_orders
+        SET status = $1, closed_by = $2, closed_at = NOW()
+        WHERE id = $3
+        RETURNING status, closed_by, closed_at`,
+		domain.AssemblyStatusCancelled, userID, id).Scan(&o.Status, &o.ClosedBy, &o.ClosedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel assembly order '%s': %w", id, err)
+	}
+	if err := r.loadLines(ctx, tx, o); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit assembly order cancellation: %w", err)
+	}
+	return o, nil
+}
+
+// querier is what assembly reads need from a pool or a transaction.
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// getAssemblyOrder reads an order without its lines; lock is appended to the query.
+func getAssemblyOrder(ctx context.Context, q querier, id, lock string) (*domain.AssemblyOrder, error) {
+	o := &domain.AssemblyOrder{}
+	err := q.QueryRow(ctx, `
+        SELECT id, number, item_id, quantity, status, created_by, created_at, closed_by, closed_at
+        FROM assembly_orders
+        WHERE id = $1 `+lock, id).Scan(&o.ID, &o.Number, &o.ItemID, &o.Quantity, &o.Status, &o.CreatedBy, &o.CreatedAt, &o.ClosedBy, &o.ClosedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: assembly order with ID '%s'", domain.ErrRepositoryNotFound, id)
+		}
+		return nil, fmt.Errorf("failed to get assembly order by ID '%s': %w", id, err)
+	}
+	return o, nil
+}
+
+// loadLines fills in the lines of o.
+func (r *pgAssemblyRepository) loadLines(ctx context.Context, q querier, o *domain.AssemblyOrder) error {
+	lines, err := scanComponents(q.Query(ctx, `
+        SELECT component_id, quantity
+        FROM assembly_order_lines
+        WHERE order_id = $1
+        ORDER BY component_id`, o.ID))
+	if err != nil {
+		return err
+	}
+	o.Lines = lines
+	return nil
+}
+
+// scanComponents reads (component_id, quantity) rows.
+func scanComponents(rows pgx.Rows, err error) ([]domain.Component, error) {
+	if err != nil {
+		return nil, fmt.Errorf("failed to list components: %w", err)
+	}
+	defer rows.Close()
+
+	components := []domain.Component{}
+	for rows.Next() {
+		var c domain.Component
+		if err := rows.Scan(&c.ComponentID, &c.Quantity); err != nil {
+			return nil, fmt.Errorf("failed to scan component row: %w", err)
+		}
+		components = append(components, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating component rows: %w", err)
+	}
+	return components, nil
+}
@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"sync"
+	"time"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/events"
+)
+
+// itemCacheMetrics are published through expvar under "item_cache": hits, misses,
+// invalidations, evictions and rebuilds.
+var itemCacheMetrics = expvar.NewMap("item_cache")
+
+// cachedItemRepository is a read-through cache in front of an ItemRepository for lookups by
+// ID and by SKU. Writes made through it drop the affected item; writes made elsewhere are
+// announced on the event bus. Entries expire after ttl, which
+// bounds staleness from changes the bus cannot see, such as those made by other instances.
+type cachedItemRepository struct {
+	domain.ItemRepository // Uncached; also serves every method not overridden here
+
+	ttl  time.Duration
+	size int
+
+	mu    sync.Mutex
+	order *list.List               // Front is most recently used; values are *cachedItem
+	byID  map[string]*list.Element // Item ID -> element
+	bySKU map[string]string        // SKU -> item ID
+	gen   uint64                   // Bumped on every invalidation; loads that straddle one are not stored
+}
+
+type cachedItem struct {
+	item    domain.Item
+	expires time.Time
+}
+
+// NewCachedItemRepository wraps repo with a cache of up to size items, each kept for ttl.
+// The cache drops items announced as changed on bus, if one is given.
+func NewCachedItemRepository(repo domain.ItemRepository, bus *events.Bus, ttl time.Duration, size int) domain.ItemRepository {
+	if size < 1 {
+		size = 1
+	}
+	r := &cachedItemRepository{
+		ItemRepository: repo,
+		ttl:            ttl,
+		size:           size,
+		order:          list.New(),
+		byID:           make(map[string]*list.Element),
+		bySKU:          make(map[string]string),
+	}
+	if bus != nil {
+		bus.SubscribeItemChanged(func(_ context.Context, itemIDs []string) { r.invalidate(itemIDs...) })
+	}
+	return r
+}
+
+func (r *cachedItemRepository) GetByID(ctx context.Context, id string) (*domain.Item, error) {
+	if item, ok := r.lookup(id); ok {
+		return item, nil
+	}
+	return r.load(func() (*domain.Item, error) { return r.ItemRepository.GetByID(ctx, id) })
+}
+
+func (r *cachedItemRepository) GetBySKU(ctx context.Context, sku string) (*domain.Item, error) {
+	r.mu.Lock()
+	id, ok := r.bySKU[sku]
+	r.mu.Unlock()
+	if ok {
+		if item, ok := r.lookup(id); ok && item.SKU == sku {
+			return item, nil
+		}
+	}
+	return r.load(func() (*domain.Item, error) { return r.ItemRepository.GetBySKU(ctx, sku) })
+}
+
+func (r *cachedItemRepository) Update(ctx context.Context, id string, item *domain.Item, userID string) (*domain.Item, error) {
+	defer r.invalidate(id) // Also on failure: the row may have changed anyway
+	return r.ItemRepository.Update(ctx, id, item, userID)
+}
+
+func (r *cachedItemRepository) Delete(ctx context.Context, id, userID string) error {
+	defer r.invalidate(id)
+	return r.ItemRepository.Delete(ctx, id, userID)
+}
+
+func (r *cachedItemRepository) AdjustQuantity(ctx context.Context, id string, delta int, userID string) (*domain.Item, error) {
+	defer r.invalidate(id)
+	return r.ItemRepository.AdjustQuantity(ctx, id, delta, userID)
+}
+
+// Name implements domain.DerivedStore.
+func (r *cachedItemRepository) Name() string { return "item-cache" }
+
+// errCacheFull stops priming once the cache holds size items.
+var errCacheFull = errors.New("item cache full")
+
+// Rebuild empties the cache and primes it with the most recently created items, up to its
+// size. Priming stops early if any item changes meanwhile: the rest of the rows come from a
+// snapshot taken when the scan started and may be stale. Either way lookups stay correct.
+func (r *cachedItemRepository) Rebuild(ctx context.Context, progress func(done, total int)) error {
+	r.mu.Lock()
+	r.gen++
+	r.order.Init()
+	clear(r.byID)
+	clear(r.bySKU)
+	gen := r.gen
+	r.mu.Unlock()
+	itemCacheMetrics.Add("rebuilds", 1)
+
+	done := 0
+	progress(done, r.size)
+	err := r.ItemRepository.StreamAll(ctx, func(item *domain.Item) error {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.gen != gen || r.order.Len() >= r.size {
+			return errCacheFull
+		}
+		if _, ok := r.byID[item.ID]; !ok {
+			r.byID[item.ID] = r.order.PushBack(&cachedItem{item: *item, expires: time.Now().Add(r.ttl)}) // Newest first stay longest
+			r.bySKU[item.SKU] = item.ID
+		}
+		done++
+		if done%1000 == 0 {
+			progress(done, r.size)
+		}
+		return nil
+	})
+	progress(done, done)
+	if err != nil && !errors.Is(err, errCacheFull) {
+		return fmt.Errorf("failed to prime item cache: %w", err)
+	}
+	return nil
+}
+
+// lookup returns a copy of the cached item, if present and fresh.
+func (r *cachedItemRepository) lookup(id string) (*domain.Item, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	el, ok := r.byID[id]
+	if !ok {
+		itemCacheMetrics.Add("misses", 1)
+		return nil, false
+	}
+	entry := el.Value.(*cachedItem)
+	if time.Now().After(entry.expires) {
+		r.remove(el)
+		itemCacheMetrics.Add("misses", 1)
+		return nil, false
+	}
+	r.order.MoveToFront(el)
+	itemCacheMetrics.Add("hits", 1)
+	item := entry.item // Callers may modify what they get (e.g. attach promotions)
+	return &item, true
+}
+
+// load fetches an item from the underlying repository and caches it, unless it was
+// invalidated while the fetch was in flight.
+func (r *cachedItemRepository) load(fetch func() (*domain.Item, error)) (*domain.Item, error) {
+	r.mu.Lock()
+	gen := r.gen
+	r.mu.Unlock()
+
+	item, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.gen != gen {
+		return item, nil
+	}
+	if el, ok := r.byID[item.ID]; ok {
+		r.remove(el)
+	}
+	r.byID[item.ID] = r.order.PushFront(&cachedItem{item: *item, expires: time.Now().Add(r.ttl)})
+	r.bySKU[item.SKU] = item.ID
+	if r.order.Len() > r.size {
+		r.remove(r.order.Back())
+		itemCacheMetrics.Add("evictions", 1)
+	}
+	cached := *item
+	return &cached, nil
+}
+
+func (r *cachedItemRepository) invalidate(ids ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.gen++
+	for _, id := range ids {
+		if el, ok := r.byID[id]; ok {
+			r.remove(el)
+			itemCacheMetrics.Add("invalidations", 1)
+		}
+	}
+}
+
+// remove deletes an entry from every index. r.mu must be held.
+func (r *cachedItemRepository) remove(el *list.Element) {
+	entry := r.order.Remove(el).(*cachedItem)
+	delete(r.byID, entry.item.ID)
+	if r.bySKU[entry.item.SKU] == entry.item.ID {
+		delete(r.bySKU, entry.item.SKU)
+	}
+}
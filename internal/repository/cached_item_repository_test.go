@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/events"
+)
+
+// countingItemRepo serves one item and counts the lookups that reach it.
+type countingItemRepo struct {
+	domain.ItemRepository
+	item    domain.Item
+	lookups int
+}
+
+func (f *countingItemRepo) GetByID(ctx context.Context, id string) (*domain.Item, error) {
+	f.lookups++
+	if id != f.item.ID {
+		return nil, domain.ErrRepositoryNotFound
+	}
+	item := f.item
+	return &item, nil
+}
+
+func (f *countingItemRepo) GetBySKU(ctx context.Context, sku string) (*domain.Item, error) {
+	f.lookups++
+	if sku != f.item.SKU {
+		return nil, domain.ErrRepositoryNotFound
+	}
+	item := f.item
+	return &item, nil
+}
+
+func (f *countingItemRepo) AdjustQuantity(ctx context.Context, id string, delta int, userID string) (*domain.Item, error) {
+	f.item.Quantity += delta
+	item := f.item
+	return &item, nil
+}
+
+func (f *countingItemRepo) StreamAll(ctx context.Context, fn func(*domain.Item) error) error {
+	item := f.item
+	return fn(&item)
+}
+
+func TestCachedItemRepository(t *testing.T) {
+	ctx := context.Background()
+	backing := &countingItemRepo{item: domain.Item{ID: "a", SKU: "WIDGET-1", Quantity: 5}}
+	bus := events.NewBus()
+	repo := NewCachedItemRepository(backing, bus, time.Minute, 10)
+
+	lookup := func(bySKU bool, wantQuantity, wantLookups int) {
+		t.Helper()
+		var item *domain.Item
+		var err error
+		if bySKU {
+			item, err = repo.GetBySKU(ctx, "WIDGET-1")
+		} else {
+			item, err = repo.GetByID(ctx, "a")
+		}
+		if err != nil {
+			t.Fatalf("lookup: %v", err)
+		}
+		if item.Quantity != wantQuantity || backing.lookups != wantLookups {
+			t.Fatalf("quantity %d after %d backing lookups, want %d after %d", item.Quantity, backing.lookups, wantQuantity, wantLookups)
+		}
+		item.Quantity = -1 // Must not leak into the cache
+	}
+
+	lookup(false, 5, 1)
+	lookup(false, 5, 1) // Served from the cache
+	lookup(true, 5, 1)  // The SKU index points at the same entry
+
+	if _, err := repo.AdjustQuantity(ctx, "a", 2, ""); err != nil {
+		t.Fatalf("adjust: %v", err)
+	}
+	lookup(true, 7, 2) // Writes through the cache drop the entry
+
+	backing.item.Quantity = 9 // Changed behind the cache's back...
+	bus.PublishItemChanged(ctx, "a")
+	lookup(false, 9, 3) // ...and announced on the bus
+
+	if _, err := repo.GetByID(ctx, "missing"); err == nil {
+		t.Error("missing item found")
+	}
+}
+
+func TestCachedItemRepositoryRebuild(t *testing.T) {
+	ctx := context.Background()
+	backing := &countingItemRepo{item: domain.Item{ID: "a", SKU: "WIDGET-1", Quantity: 5}}
+	repo := NewCachedItemRepository(backing, nil, time.Minute, 10)
+	if _, err := repo.GetByID(ctx, "a"); err != nil {
+		t.Fatalf("lookup: %v", err)
+	}
+
+	backing.item.Quantity = 8 // A change the cache never heard of
+	store := repo.(domain.DerivedStore)
+	var done, total int
+	if err := store.Rebuild(ctx, func(d, tot int) { done, total = d, tot }); err != nil {
+		t.Fatalf("Rebuild: %v", err)
+	}
+	if done != 1 || total != 1 {
+		t.Errorf("final progress %d/%d, want 1/1", done, total)
+	}
+
+	item, err := repo.GetBySKU(ctx, "WIDGET-1")
+	if err != nil {
+		t.Fatalf("lookup: %v", err)
+	}
+	if item.Quantity != 8 || backing.lookups != 1 {
+		t.Errorf("after rebuild: quantity %d after %d backing lookups, want 8 served from the primed cache", item.Quantity, backing.lookups)
+	}
+}
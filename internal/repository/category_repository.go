@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"inventory-system/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type pgCategoryRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPgCategoryRepository creates a new CategoryRepository backed by PostgreSQL.
+func NewPgCategoryRepository(db *pgxpool.Pool) domain.CategoryRepository {
+	return &pgCategoryRepository{db: db}
+}
+
+// Create inserts a category.
+func (r *pgCategoryRepository) Create(ctx context.Context, c *domain.Category) (*domain.Category, error) {
+	err := r.db.QueryRow(ctx, `
+        INSERT INTO categories (name, parent_id)
+        VALUES ($1, $2)
+        RETURNING id, created_at, updated_at`,
+		c.Name, c.ParentID).Scan(&c.ID, &c.CreatedAt, &c.UpdatedAt)
+	if err != nil {
+		return nil, categoryWriteError(err, c, "failed to create category")
+	}
+	return c, nil
+}
+
+// GetByID retrieves a category with its direct children.
+func (r *pgCategoryRepository) GetByID(ctx context.Context, id string) (*domain.Category, error) {
+	c := &domain.Category{}
+	err := r.db.QueryRow(ctx, `
+        SELECT id, name, parent_id, created_at, updated_at
+        FROM categories
+        WHERE id = $1`, id).Scan(&c.ID, &c.Name, &c.ParentID, &c.CreatedAt, &c.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: category with ID '%s'", domain.ErrRepositoryNotFound, id)
+		}
+		return nil, fmt.Errorf("failed to get category by ID '%s': %w", id, err)
+	}
+
+	c.Children, err = r.query(ctx, `
+        SELECT id, name, parent_id, created_at, updated_at
+        FROM categories
+        WHERE parent_id = $1
+        ORDER BY lower(name)`, id)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// List returns every category, ordered by name.
+func (r *pgCategoryRepository) List(ctx context.Context) ([]*domain.Category, error) {
+	return r.query(ctx, `
+        SELECT id, name, parent_id, created_at, updated_at
+        FROM categories
+        ORDER BY lower(name), id`)
+}
+
+// query runs a category SELECT and scans its rows.
+func (r *pgCategoryRepository) query(ctx context.Context, sql string, args ...any) ([]*domain.Category, error) {
+	rows, err := r.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	defer rows.Close()
+
+	categories := []*domain.Category{}
+	for rows.Next() {
+		c := &domain.Category{}
+		if err := rows.Scan(&c.ID, &c.Name, &c.ParentID, &c.CreatedAt, &c.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan category row: %w", err)
+		}
+		categories = append(categories, c)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating category rows: %w", err)
+	}
+	return categories, nil
+}
+
+// Update renames and moves a category, refusing moves that would make it its own ancestor.
+func (r *pgCategoryRepository) Update(ctx context.Context, c *domain.Category) (*domain.Category, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin category update: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op after Commit
+
+	if c.ParentID != nil {
+		// Two concurrent moves can each be fine on their own and still close a loop together
+		// (A below B, B below A), so moves are serialized.
+		if _, err := tx.Exec(ctx, `LOCK TABLE categories IN SHARE ROW EXCLUSIVE MODE`); err != nil {
+			return nil, fmt.Errorf("failed to lock categories: %w", err)
+		}
+		var cycle bool
+		err := tx.QueryRow(ctx, categorySubtreeCTE+`
+        SELECT EXISTS (SELECT 1 FROM subtree WHERE id = $2)`, c.ID, *c.ParentID).Scan(&cycle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check ancestry of category '%s': %w", c.ID, err)
+		}
+		if cycle {
+			return nil, fmt.Errorf("%w: '%s' is the category itself or one of its subcategories", domain.ErrCategoryCycle, *c.ParentID)
+		}
+	}
+
+	err = tx.QueryRow(ctx, `
+        UPDATE categories
+        SET name = $1, parent_id = $2
+        WHERE id = $3
+        RETURNING created_at, updated_at`,
+		c.Name, c.ParentID, c.ID).Scan(&c.CreatedAt, &c.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: category with ID '%s'", domain.ErrRepositoryNotFound, c.ID)
+		}
+		return nil, categoryWriteError(err, c, "failed to update category")
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit category update: %w", err)
+	}
+	return c, nil
+}
+
+// Delete removes a category without children after taking its items out of it, recording a
+// revision of each.
+func (r *pgCategoryRepository) Delete(ctx context.Context, id, userID string) ([]string, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin category delete: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op after Commit
+
+	// The foreign key would clear the items too, but this way we learn which ones changed.
+	rows, err := tx.Query(ctx, `UPDATE items SET category_id = NULL WHERE category_id = $1 RETURNING id`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to uncategorize items of category '%s': %w", id, err)
+	}
+	itemIDs, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("failed to uncategorize items of category '%s': %w", id, err)
+	}
+	revisions := make([]*domain.ItemRevision, 0, len(itemIDs))
+	for _, itemID := range itemIDs {
+		revisions = append(revisions, newRevision(itemID, domain.ItemRevisionUpdated,
+			[]domain.FieldChange{{Field: "category_id", Old: id, New: nil}}, userID))
+	}
+	if err := recordRevisions(ctx, tx, revisions...); err != nil {
+		return nil, err
+	}
+
+	commandTag, err := tx.Exec(ctx, `DELETE FROM categories WHERE id = $1`, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation: subcategories point here
+			return nil, fmt.Errorf("%w: category '%s'", domain.ErrCategoryHasChildren, id)
+		}
+		return nil, fmt.Errorf("failed to delete category: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return nil, fmt.Errorf("%w: category with ID '%s'", domain.ErrRepositoryNotFound, id)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit category delete: %w", err)
+	}
+	return itemIDs, nil
+}
+
+// AssignItem sets or clears the category of an item, recording a revision if it changed.
+func (r *pgCategoryRepository) AssignItem(ctx context.Context, itemID string, categoryID *string, userID string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin category assignment: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op after Commit
+
+	var before *string
+	err = tx.QueryRow(ctx, `SELECT category_id FROM items WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, itemID).Scan(&before)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: item with ID '%s'", domain.ErrRepositoryNotFound, itemID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock item '%s' for category assignment: %w", itemID, err)
+	}
+	if _, err := tx.Exec(ctx, `UPDATE items SET category_id = $1 WHERE id = $2`, categoryID, itemID); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation: unknown category
+			return fmt.Errorf("%w: ID %s", domain.ErrCategoryNotFound, *categoryID)
+		}
+		return fmt.Errorf("failed to assign item '%s' to category: %w", itemID, err)
+	}
+	if was, now := optionalValue(before), optionalValue(categoryID); was != now {
+		rev := newRevision(itemID, domain.ItemRevisionUpdated, []domain.FieldChange{{Field: "category_id", Old: was, New: now}}, userID)
+		if err := recordRevisions(ctx, tx, rev); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit category assignment: %w", err)
+	}
+	return nil
+}
+
+// categoryWriteError translates constraint violations raised by writing c.
+func categoryWriteError(err error, c *domain.Category, msg string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "23505": // unique_violation: sibling with the same name
+			return fmt.Errorf("%w: category '%s'", domain.ErrRepositoryDuplicateEntry, c.Name)
+		case "23503": // foreign_key_violation: unknown parent
+			return fmt.Errorf("%w: parent ID %s", domain.ErrCategoryNotFound, *c.ParentID)
+		case "23514": // check_violation: parent_id = id
+			return fmt.Errorf("%w: '%s' is the category itself", domain.ErrCategoryCycle, c.ID)
+		}
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
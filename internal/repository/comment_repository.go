@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"inventory-system/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type pgCommentRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPgCommentRepository creates a new CommentRepository backed by PostgreSQL.
+func NewPgCommentRepository(db *pgxpool.Pool) domain.CommentRepository {
+	return &pgCommentRepository{db: db}
+}
+
+// Create inserts a new comment.
+func (r *pgCommentRepository) Create(ctx context.Context, comment *domain.Comment) (*domain.Comment, error) {
+	if comment.ID == "" {
+		comment.ID = uuid.NewString()
+	}
+	if comment.Mentions == nil {
+		comment.Mentions = []string{}
+	}
+	comment.CreatedAt = time.Now()
+
+	query := `
+        INSERT INTO comments (id, entity_type, entity_id, author, body, mentions, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        RETURNING id, created_at`
+
+	err := r.db.QueryRow(ctx, query,
+		comment.ID, comment.EntityType, comment.EntityID, comment.Author, comment.Body, comment.Mentions, comment.CreatedAt,
+	).Scan(&comment.ID, &comment.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+	return comment, nil
+}
+
+// GetByID retrieves a single comment.
+func (r *pgCommentRepository) GetByID(ctx context.Context, id string) (*domain.Comment, error) {
+	query := `
+        SELECT id, entity_type, entity_id, author, body, mentions, created_at
+        FROM comments
+        WHERE id = $1`
+
+	c := &domain.Comment{}
+	err := r.db.QueryRow(ctx, query, id).Scan(&c.ID, &c.EntityType, &c.EntityID, &c.Author, &c.Body, &c.Mentions, &c.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: comment with ID '%s'", domain.ErrRepositoryNotFound, id)
+		}
+		return nil, fmt.Errorf("failed to get comment by ID '%s': %w", id, err)
+	}
+	return c, nil
+}
+
+// ListByEntity returns the comments of an entity, oldest first.
+func (r *pgCommentRepository) ListByEntity(ctx context.Context, entityType, entityID string) ([]*domain.Comment, error) {
+	query := `
+        SELECT id, entity_type, entity_id, author, body, mentions, created_at
+        FROM comments
+        WHERE entity_type = $1 AND entity_id = $2
+        ORDER BY created_at ASC`
+
+	rows, err := r.db.Query(ctx, query, entityType, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comments: %w", err)
+	}
+	defer rows.Close()
+
+	comments := []*domain.Comment{}
+	for rows.Next() {
+		c := &domain.Comment{}
+		if err := rows.Scan(&c.ID, &c.EntityType, &c.EntityID, &c.Author, &c.Body, &c.Mentions, &c.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan comment row: %w", err)
+		}
+		comments = append(comments, c)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating comment rows: %w", err)
+	}
+	return comments, nil
+}
+
+// Delete removes a comment by ID.
+func (r *pgCommentRepository) Delete(ctx context.Context, id string) error {
+	commandTag, err := r.db.Exec(ctx, `DELETE FROM comments WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: comment with ID '%s'", domain.ErrRepositoryNotFound, id)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"inventory-system/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type pgCustomerRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPgCustomerRepository creates a new CustomerRepository backed by PostgreSQL.
+func NewPgCustomerRepository(db *pgxpool.Pool) domain.CustomerRepository {
+	return &pgCustomerRepository{db: db}
+}
+
+// Create inserts a customer.
+func (r *pgCustomerRepository) Create(ctx context.Context, c *domain.Customer) (*domain.Customer, error) {
+	err := r.db.QueryRow(ctx, `
+        INSERT INTO customers (name, email, price_tier)
+        VALUES ($1, $2, $3)
+        RETURNING id, created_at, updated_at`,
+		c.Name, c.Email, c.PriceTier).Scan(&c.ID, &c.CreatedAt, &c.UpdatedAt)
+	if err != nil {
+		return nil, customerWriteError(err, c, "failed to create customer")
+	}
+	return c, nil
+}
+
+// GetByID retrieves a customer by ID.
+func (r *pgCustomerRepository) GetByID(ctx context.Context, id string) (*domain.Customer, error) {
+	c := &domain.Customer{}
+	err := r.db.QueryRow(ctx, `
+        SELECT id, name, email, price_tier, created_at, updated_at
+        FROM customers
+        WHERE id = $1`, id).Scan(&c.ID, &c.Name, &c.Email, &c.PriceTier, &c.CreatedAt, &c.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: customer with ID '%s'", domain.ErrRepositoryNotFound, id)
+		}
+		return nil, fmt.Errorf("failed to get customer by ID '%s': %w", id, err)
+	}
+	return c, nil
+}
+
+// List retrieves a page of customers, ordered by name.
+func (r *pgCustomerRepository) List(ctx context.Context, page, limit int) ([]*domain.Customer, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10 // Default limit
+	}
+	offset := (page - 1) * limit
+
+	rows, err := r.db.Query(ctx, `
+        SELECT id, name, email, price_tier, created_at, updated_at
+        FROM customers
+        ORDER BY lower(name), id
+        LIMIT $1 OFFSET $2`, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list customers: %w", err)
+	}
+	defer rows.Close()
+
+	customers := []*domain.Customer{}
+	for rows.Next() {
+		c := &domain.Customer{}
+		if err := rows.Scan(&c.ID, &c.Name, &c.Email, &c.PriceTier, &c.CreatedAt, &c.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan customer row: %w", err)
+		}
+		customers = append(customers, c)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating customer rows: %w", err)
+	}
+	return customers, nil
+}
+
+// Update replaces the name, email and price tier of a customer.
+func (r *pgCustomerRepository) Update(ctx context.Context, c *domain.Customer) (*domain.Customer, error) {
+	err := r.db.QueryRow(ctx, `
+        UPDATE customers
+        SET name = $1, email = $2, price_tier = $3
+        WHERE id = $4
+        RETURNING created_at, updated_at`,
+		c.Name, c.Email, c.PriceTier, c.ID).Scan(&c.CreatedAt, &c.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: customer with ID '%s'", domain.ErrRepositoryNotFound, c.ID)
+		}
+		return nil, customerWriteError(err, c, "failed to update customer")
+	}
+	return c, nil
+}
+
+// customerWriteError translates constraint violations raised by writing c.
+func customerWriteError(err error, c *domain.Customer, msg string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" && c.Email != nil { // unique_violation: email in use
+		return fmt.Errorf("%w: customer email '%s'", domain.ErrRepositoryDuplicateEntry, *c.Email)
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"inventory-system/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type pgDocumentSequenceRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPgDocumentSequenceRepository creates a new DocumentSequenceRepository backed by PostgreSQL.
+func NewPgDocumentSequenceRepository(db *pgxpool.Pool) domain.DocumentSequenceRepository {
+	return &pgDocumentSequenceRepository{db: db}
+}
+
+// List returns every numbering sequence, ordered by document type.
+func (r *pgDocumentSequenceRepository) List(ctx context.Context) ([]*domain.DocumentSequence, error) {
+	rows, err := r.db.Query(ctx, `
+        SELECT doc_type, prefix, yearly, padding, updated_at
+        FROM document_sequences
+        ORDER BY doc_type`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list document sequences: %w", err)
+	}
+	defer rows.Close()
+
+	sequences := []*domain.DocumentSequence{}
+	for rows.Next() {
+		seq := &domain.DocumentSequence{}
+		if err := rows.Scan(&seq.DocType, &seq.Prefix, &seq.Yearly, &seq.Padding, &seq.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan document sequence row: %w", err)
+		}
+		sequences = append(sequences, seq)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating document sequence rows: %w", err)
+	}
+	return sequences, nil
+}
+
+// Update replaces the configuration of a numbering sequence. Numbers already handed out
+// are kept.
+func (r *pgDocumentSequenceRepository) Update(ctx context.Context, seq *domain.DocumentSequence) (*domain.DocumentSequence, error) {
+	err := r.db.QueryRow(ctx, `
+        UPDATE document_sequences
+        SET prefix = $2, yearly = $3, padding = $4
+        WHERE doc_type = $1
+        RETURNING updated_at`,
+		seq.DocType, seq.Prefix, seq.Yearly, seq.Padding).Scan(&seq.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: document sequence '%s'", domain.ErrRepositoryNotFound, seq.DocType)
+		}
+		return nil, fmt.Errorf("failed to update document sequence '%s': %w", seq.DocType, err)
+	}
+	return seq, nil
+}
+
+// nextDocumentNumber draws the next number of a document type within tx. The counter row
+// stays locked until tx ends, so concurrent documents are numbered one after the other, and
+// a rolled back document gives its number back.
+func nextDocumentNumber(ctx context.Context, tx pgx.Tx, docType string) (string, error) {
+	var prefix string
+	var yearly bool
+	var padding, period int
+	err := tx.QueryRow(ctx, `
+        SELECT prefix, yearly, padding, CASE WHEN yearly THEN EXTRACT(YEAR FROM NOW())::INTEGER ELSE 0 END
+        FROM document_sequences
+        WHERE doc_type = $1`, docType).Scan(&prefix, &yearly, &padding, &period)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) { // Seeded by the migrations; not to be confused with a missing document
+			return "", fmt.Errorf("document sequence '%s' is missing", docType)
+		}
+		return "", fmt.Errorf("failed to read document sequence '%s': %w", docType, err)
+	}
+
+	var n int64
+	err = tx.QueryRow(ctx, `
+        INSERT INTO document_counters (doc_type, prefix, period, last_value)
+        VALUES ($1, $2, $3, 1)
+        ON CONFLICT (doc_type, prefix, period) DO UPDATE
+        SET last_value = document_counters.last_value + 1
+        RETURNING last_value`, docType, prefix, period).Scan(&n)
+	if err != nil {
+		return "", fmt.Errorf("failed to draw a number for '%s': %w", docType, err)
+	}
+	if yearly {
+		return fmt.Sprintf("%s-%d-%0*d", prefix, period, padding, n), nil
+	}
+	return fmt.Sprintf("%s-%0*d", prefix, padding, n), nil
+}
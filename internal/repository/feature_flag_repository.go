@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"inventory-system/internal/domain"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type pgFeatureFlagRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPgFeatureFlagRepository creates a new FeatureFlagRepository backed by PostgreSQL.
+func NewPgFeatureFlagRepository(db *pgxpool.Pool) domain.FeatureFlagRepository {
+	return &pgFeatureFlagRepository{db: db}
+}
+
+// List returns every feature flag, ordered by key.
+func (r *pgFeatureFlagRepository) List(ctx context.Context) ([]*domain.FeatureFlag, error) {
+	rows, err := r.db.Query(ctx, `
+        SELECT key, description, enabled, rollout_percent, overrides, updated_by, updated_at
+        FROM feature_flags
+        ORDER BY key`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list feature flags: %w", err)
+	}
+	defer rows.Close()
+
+	flags := []*domain.FeatureFlag{}
+	for rows.Next() {
+		f := &domain.FeatureFlag{}
+		if err := rows.Scan(&f.Key, &f.Description, &f.Enabled, &f.RolloutPercent, &f.Overrides, &f.UpdatedBy, &f.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan feature flag: %w", err)
+		}
+		flags = append(flags, f)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating feature flags: %w", err)
+	}
+	return flags, nil
+}
+
+// Upsert creates the flag or replaces its settings.
+func (r *pgFeatureFlagRepository) Upsert(ctx context.Context, flag *domain.FeatureFlag) (*domain.FeatureFlag, error) {
+	overrides := flag.Overrides
+	if overrides == nil {
+		overrides = map[string]bool{}
+	}
+	f := &domain.FeatureFlag{}
+	err := r.db.QueryRow(ctx, `
+        INSERT INTO feature_flags (key, description, enabled, rollout_percent, overrides, updated_by, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, NOW())
+        ON CONFLICT (key) DO UPDATE SET
+            description = EXCLUDED.description,
+            enabled = EXCLUDED.enabled,
+            rollout_percent = EXCLUDED.rollout_percent,
+            overrides = EXCLUDED.overrides,
+            updated_by = EXCLUDED.updated_by,
+            updated_at = EXCLUDED.updated_at
+        RETURNING key, description, enabled, rollout_percent, overrides, updated_by, updated_at`,
+		flag.Key, flag.Description, flag.Enabled, flag.RolloutPercent, overrides, flag.UpdatedBy,
+	).Scan(&f.Key, &f.Description, &f.Enabled, &f.RolloutPercent, &f.Overrides, &f.UpdatedBy, &f.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save feature flag '%s': %w", flag.Key, err)
+	}
+	return f, nil
+}
+
+// Delete removes a feature flag.
+func (r *pgFeatureFlagRepository) Delete(ctx context.Context, key string) error {
+	tag, err := r.db.Exec(ctx, `DELETE FROM feature_flags WHERE key = $1`, key)
+	if err != nil {
+		return fmt.Errorf("failed to delete feature flag '%s': %w", key, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: feature flag '%s'", domain.ErrRepositoryNotFound, key)
+	}
+	return nil
+}
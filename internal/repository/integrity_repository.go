@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"inventory-system/internal/domain"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type pgIntegrityRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPgIntegrityRepository creates a new IntegrityRepository backed by PostgreSQL.
+func NewPgIntegrityRepository(db *pgxpool.Pool) domain.IntegrityRepository {
+	return &pgIntegrityRepository{db: db}
+}
+
+// Count runs every integrity count in one statement, so they describe the same snapshot.
+// Comments and notifications refer to their entities without foreign keys, so purging an
+// item or the cascades that follow it can leave them behind.
+func (r *pgIntegrityRepository) Count(ctx context.Context, staleBefore time.Time) (*domain.IntegrityCounts, error) {
+	c := &domain.IntegrityCounts{}
+	err := r.db.QueryRow(ctx, `
+        SELECT
+            (SELECT COUNT(*) FROM comments c
+             WHERE c.entity_type = $1 AND NOT EXISTS (SELECT 1 FROM items i WHERE i.id = c.entity_id)),
+            (SELECT COUNT(*) FROM notifications n
+             WHERE (n.entity_type = $1 AND NOT EXISTS (SELECT 1 FROM items i WHERE i.id = n.entity_id))
+                OR (n.entity_type = $2 AND NOT EXISTS (SELECT 1 FROM anomalies a WHERE a.id = n.entity_id))
+                OR (n.entity_type = $3 AND NOT EXISTS (SELECT 1 FROM ledger_discrepancies d WHERE d.id = n.entity_id))),
+            (SELECT COUNT(*) FROM ledger_discrepancies WHERE resolved_at IS NULL),
+            (SELECT COUNT(*) FROM items WHERE deleted_at IS NULL AND category_id IS NULL),
+            (SELECT COUNT(*) FROM sales_orders WHERE status = $4 AND created_at < $5),
+            (SELECT COUNT(DISTINCT o.id) FROM sales_orders o
+             JOIN sales_order_lines l ON l.order_id = o.id
+             JOIN items i ON i.id = l.item_id
+             WHERE o.status = $4 AND i.deleted_at IS NOT NULL)`,
+		domain.CommentEntityItem, domain.AnomalyEntityType, domain.LedgerDiscrepancyEntityType,
+		domain.SalesOrderStatusOpen, staleBefore,
+	).Scan(&c.OrphanedComments, &c.OrphanedNotifications, &c.LedgerMismatches, &c.UncategorizedItems,
+		&c.StaleReservations, &c.TrashedReservations)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count integrity problems: %w", err)
+	}
+	return c, nil
+}
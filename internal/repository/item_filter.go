@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"inventory-system/internal/domain"
+)
+
+// itemSortColumns maps the sort fields of domain.ItemFilter to columns of items. Only these
+// names ever reach the SQL of a sort.
+var itemSortColumns = map[string]string{
+	"sku":        "sku",
+	"name":       "name",
+	"price":      "price",
+	"quantity":   "quantity",
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+}
+
+// sqlBuilder collects the WHERE conditions of a query and their arguments. The SQL is put
+// together from constant fragments only; every value reaches the database as an argument.
+type sqlBuilder struct {
+	conds []string
+	args  []any
+}
+
+// arg adds an argument and returns its placeholder.
+func (b *sqlBuilder) arg(v any) string {
+	b.args = append(b.args, v)
+	return "$" + strconv.Itoa(len(b.args))
+}
+
+// where adds a condition, in which each ? stands for the next of args. cond must be a
+// constant: it is the only part of the query not passed as an argument.
+func (b *sqlBuilder) where(cond string, args ...any) {
+	for _, v := range args {
+		cond = strings.Replace(cond, "?", b.arg(v), 1)
+	}
+	b.conds = append(b.conds, cond)
+}
+
+// whereClause returns the WHERE clause of the conditions added so far, or "" without any.
+func (b *sqlBuilder) whereClause() string {
+	if len(b.conds) == 0 {
+		return ""
+	}
+	return "WHERE " + strings.Join(b.conds, " AND ")
+}
+
+// itemFilterConditions adds the conditions of filter on the items table to b.
+func itemFilterConditions(b *sqlBuilder, filter domain.ItemFilter) {
+	b.where("deleted_at IS NULL") // Trashed items are only listed by ListTrash
+	if filter.CategoryID != "" {
+		b.where(`category_id IN (
+            WITH RECURSIVE subtree AS (
+                SELECT id FROM categories WHERE id = ?
+                UNION ALL
+                SELECT c.id FROM categories c JOIN subtree s ON c.parent_id = s.id
+            )
+            SELECT id FROM subtree)`, filter.CategoryID)
+	}
+	if filter.SupplierID != "" {
+		b.where(`EXISTS (
+            SELECT 1 FROM purchase_order_lines l JOIN purchase_orders o ON o.id = l.order_id
+            WHERE l.item_id = items.id AND o.supplier_id = ?)`, filter.SupplierID)
+	}
+	if filter.MinPrice != nil {
+		b.where("price >= ?", *filter.MinPrice)
+	}
+	if filter.MaxPrice != nil {
+		b.where("price <= ?", *filter.MaxPrice)
+	}
+	if filter.MinQuantity != nil {
+		b.where("quantity >= ?", *filter.MinQuantity)
+	}
+	if filter.MaxQuantity != nil {
+		b.where("quantity <= ?", *filter.MaxQuantity)
+	}
+	if filter.CreatedSince != nil {
+		b.where("created_at >= ?", *filter.CreatedSince)
+	}
+}
+
+// itemOrderBy returns the ORDER BY clause of sort, or of fallback if sort is empty. The id
+// breaks ties, so that pages neither repeat nor skip items.
+func itemOrderBy(sort []domain.ItemSort, fallback string) (string, error) {
+	if len(sort) == 0 {
+		return "ORDER BY " + fallback + ", id", nil
+	}
+	keys := make([]string, 0, len(sort)+1)
+	for _, s := range sort {
+		column, ok := itemSortColumns[s.Field]
+		if !ok {
+			return "", fmt.Errorf("%w: cannot sort items by '%s'", domain.ErrInvalidInput, s.Field)
+		}
+		if s.Desc {
+			column += " DESC"
+		}
+		keys = append(keys, column)
+	}
+	return "ORDER BY " + strings.Join(append(keys, "id"), ", "), nil
+}
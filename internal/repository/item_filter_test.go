@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"inventory-system/internal/domain"
+)
+
+func TestItemFilterConditions(t *testing.T) {
+	minPrice, maxQuantity := 2.5, 10
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := &sqlBuilder{}
+	b.arg("search text") // Taken by the caller before the filter
+	itemFilterConditions(b, domain.ItemFilter{SupplierID: "s", MinPrice: &minPrice, MaxQuantity: &maxQuantity, CreatedSince: &since})
+
+	where := b.whereClause()
+	for _, want := range []string{"o.supplier_id = $2)", "price >= $3", "quantity <= $4", "created_at >= $5"} {
+		if !strings.Contains(where, want) {
+			t.Errorf("WHERE clause lacks %q:\n%s", want, where)
+		}
+	}
+	if strings.Contains(where, "?") {
+		t.Errorf("unreplaced placeholder in:\n%s", where)
+	}
+	if want := []any{"search text", "s", 2.5, 10, since}; !reflect.DeepEqual(b.args, want) {
+		t.Errorf("args = %v, want %v", b.args, want)
+	}
+
+	if got := (&sqlBuilder{}).whereClause(); got != "" {
+		t.Errorf("empty filter gave %q", got)
+	}
+}
+
+func TestItemOrderBy(t *testing.T) {
+	got, err := itemOrderBy([]domain.ItemSort{{Field: "price", Desc: true}, {Field: "name"}}, "created_at DESC")
+	if err != nil || got != "ORDER BY price DESC, name, id" {
+		t.Errorf("itemOrderBy = %q, %v", got, err)
+	}
+	if got, _ := itemOrderBy(nil, "created_at DESC"); got != "ORDER BY created_at DESC, id" {
+		t.Errorf("default order = %q", got)
+	}
+	if _, err := itemOrderBy([]domain.ItemSort{{Field: "price; DROP TABLE items"}}, "created_at DESC"); !errors.Is(err, domain.ErrInvalidInput) {
+		t.Errorf("unknown field: err = %v, want ErrInvalidInput", err)
+	}
+}
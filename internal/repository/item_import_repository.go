@@ -0,0 +1,225 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"inventory-system/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// importBatchSize is the number of rows sent to the database in one round trip.
+const importBatchSize = 500
+
+const importedItemColumns = `id, sku, name, description, quantity, price, low_stock_threshold, created_at, updated_at, version, category_id`
+
+type pgItemImportRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPgItemImportRepository creates a new ItemImportRepository backed by PostgreSQL.
+func NewPgItemImportRepository(db *pgxpool.Pool) domain.ItemImportRepository {
+	return &pgItemImportRepository{db: db}
+}
+
+// ExistingSKUs returns which of the given SKUs belong to an item.
+func (r *pgItemImportRepository) ExistingSKUs(ctx context.Context, skus []string) (map[string]bool, error) {
+	rows, err := r.db.Query(ctx, `SELECT sku FROM items WHERE sku = ANY($1)`, skus)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up SKUs: %w", err)
+	}
+	defer rows.Close()
+
+	existing := make(map[string]bool)
+	for rows.Next() {
+		var sku string
+		if err := rows.Scan(&sku); err != nil {
+			return nil, fmt.Errorf("failed to scan SKU row: %w", err)
+		}
+		existing[sku] = true
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating SKU rows: %w", err)
+	}
+	return existing, nil
+}
+
+// Import writes the rows in batches of importBatchSize within one transaction.
+func (r *pgItemImportRepository) Import(ctx context.Context, rows []domain.ImportRow, upsert bool, importID, userID string) ([]*domain.Item, []*domain.Item, error) {
+	var created, updated []*domain.Item
+	err := runAdjustmentTx(ctx, r.db, func(tx pgx.Tx) error {
+		created, updated = nil, nil // The transaction may be retried
+		for start := 0; start < len(rows); start += importBatchSize {
+			c, u, err := importBatch(ctx, tx, rows[start:min(start+importBatchSize, len(rows))], upsert, importID, userID)
+			if err != nil {
+				return err
+			}
+			created, updated = append(created, c...), append(updated, u...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return created, updated, nil
+}
+
+// importBatch writes one batch of rows, sending all its statements in one round trip, and then
+// the revisions of the items it created or changed in another.
+func importBatch(ctx context.Context, tx pgx.Tx, rows []domain.ImportRow, upsert bool, importID, userID string) ([]*domain.Item, []*domain.Item, error) {
+	type lockedRow struct {
+		item    *domain.Item // As stored, for the revision listing what the import changed
+		trashed bool
+	}
+	existing := make(map[string]lockedRow)
+	if upsert {
+		skus := make([]string, 0, len(rows))
+		for _, row := range rows {
+			skus = append(skus, row.Item.SKU)
+		}
+		// Locked in ID order, like lockStock, so concurrent writers cannot deadlock on them.
+		// Trashed items are included: their SKUs are still taken, and upserting one restores it.
+		locked, err := tx.Query(ctx, `
+            SELECT `+importedItemColumns+`, deleted_at IS NOT NULL
+            FROM items
+            WHERE sku = ANY($1)
+            ORDER BY id
+            FOR UPDATE`, skus)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to lock imported items: %w", err)
+		}
+		for locked.Next() {
+			item := &domain.Item{}
+			var trashed bool
+			if err := locked.Scan(&item.ID, &item.SKU, &item.Name, &item.Description, &item.Quantity, &item.Price,
+				&item.LowStockThreshold, &item.CreatedAt, &item.UpdatedAt, &item.Version, &item.CategoryID, &trashed); err != nil {
+				locked.Close()
+				return nil, nil, fmt.Errorf("failed to scan imported item row: %w", err)
+			}
+			existing[item.SKU] = lockedRow{item: item, trashed: trashed}
+		}
+		locked.Close()
+		if err := locked.Err(); err != nil {
+			return nil, nil, fmt.Errorf("error iterating imported item rows: %w", err)
+		}
+	}
+
+	// Units on open sales orders are held for them, as in adjustStock: a row lowering an item's
+	// quantity below them fails the import.
+	var lowered []string
+	for _, row := range rows {
+		if s, ok := existing[row.Item.SKU]; ok && row.QuantitySet && row.Item.Quantity < s.item.Quantity {
+			lowered = append(lowered, s.item.ID)
+		}
+	}
+	reserved := map[string]int{}
+	if len(lowered) > 0 {
+		var err error
+		if reserved, err = reservedStock(ctx, tx, lowered); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	b := &pgx.Batch{}
+	isUpdate := make([]bool, 0, len(rows)) // Per item statement, in queue order
+	movements := make([]*domain.StockMovement, 0, len(rows))
+	for _, row := range rows {
+		req := row.Item
+		if s, ok := existing[req.SKU]; ok {
+			quantity := s.item.Quantity
+			if row.QuantitySet {
+				quantity = req.Quantity
+			}
+			if quantity < s.item.Quantity && quantity < reserved[s.item.ID] {
+				return nil, nil, fmt.Errorf("%w: line %d: item '%s' has %d reserved by open sales orders, cannot set quantity to %d",
+					domain.ErrInsufficientStock, row.Line, req.SKU, reserved[s.item.ID], quantity)
+			}
+			b.Queue(`
+                UPDATE items
+                SET name = $2, price = $3, quantity = $4,
+                    description = COALESCE($5, description),
+                    low_stock_threshold = COALESCE($6, low_stock_threshold),
+                    deleted_at = NULL
+                WHERE id = $1
+                RETURNING `+importedItemColumns,
+				s.item.ID, req.Name, req.Price, quantity, req.Description, req.LowStockThreshold)
+			isUpdate = append(isUpdate, true)
+			if quantity != s.item.Quantity {
+				movements = append(movements, &domain.StockMovement{ItemID: s.item.ID, Delta: quantity - s.item.Quantity, QuantityAfter: quantity,
+					Reason: domain.MovementReasonImport, Reference: importID, MovedBy: userID})
+			}
+			continue
+		}
+
+		id := uuid.NewString()
+		b.Queue(`
+            INSERT INTO items (id, sku, name, description, quantity, price, low_stock_threshold)
+            VALUES ($1, $2, $3, $4, $5, $6, $7)
+            RETURNING `+importedItemColumns,
+			id, req.SKU, req.Name, req.Description, req.Quantity, req.Price, req.LowStockThreshold)
+		isUpdate = append(isUpdate, false)
+		if req.Quantity != 0 {
+			movements = append(movements, &domain.StockMovement{ItemID: id, Delta: req.Quantity, QuantityAfter: req.Quantity,
+				Reason: domain.MovementReasonImport, Reference: importID, MovedBy: userID})
+		}
+	}
+	for _, m := range movements {
+		b.Queue(`
+            INSERT INTO stock_movements (item_id, delta, quantity_after, reason, reference, note, moved_by)
+            VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			m.ItemID, m.Delta, m.QuantityAfter, m.Reason, m.Reference, m.Note, m.MovedBy)
+	}
+
+	results := tx.SendBatch(ctx, b)
+	defer results.Close()
+
+	var created, updated []*domain.Item
+	for i, upd := range isUpdate {
+		item := &domain.Item{}
+		err := results.QueryRow().Scan(&item.ID, &item.SKU, &item.Name, &item.Description, &item.Quantity, &item.Price,
+			&item.LowStockThreshold, &item.CreatedAt, &item.UpdatedAt, &item.Version, &item.CategoryID)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" { // unique_violation: the SKU was taken meanwhile
+				return nil, nil, fmt.Errorf("%w: item with SKU '%s'", domain.ErrRepositoryDuplicateEntry, rows[i].Item.SKU)
+			}
+			return nil, nil, fmt.Errorf("failed to import item '%s': %w", rows[i].Item.SKU, err)
+		}
+		if upd {
+			updated = append(updated, item)
+		} else {
+			created = append(created, item)
+		}
+	}
+	for _, m := range movements {
+		if _, err := results.Exec(); err != nil {
+			return nil, nil, fmt.Errorf("failed to record stock movement of item '%s': %w", m.ItemID, err)
+		}
+	}
+	if err := results.Close(); err != nil {
+		return nil, nil, fmt.Errorf("failed to import items: %w", err)
+	}
+
+	revisions := make([]*domain.ItemRevision, 0, len(rows))
+	for _, item := range created {
+		revisions = append(revisions, newRevision(item.ID, domain.ItemRevisionCreated, itemFieldChanges(nil, item), userID))
+	}
+	for _, item := range updated {
+		before := existing[item.SKU]
+		if before.trashed { // Upserting a trashed item restores it
+			revisions = append(revisions, newRevision(item.ID, domain.ItemRevisionRestored, nil, userID))
+		}
+		if changes := itemFieldChanges(before.item, item); len(changes) > 0 {
+			revisions = append(revisions, newRevision(item.ID, domain.ItemRevisionUpdated, changes, userID))
+		}
+	}
+	if err := recordRevisions(ctx, tx, revisions...); err != nil {
+		return nil, nil, err
+	}
+	return created, updated, nil
+}
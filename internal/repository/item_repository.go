@@ -26,7 +26,7 @@ func NewPgItemRepository(db *pgxpool.Pool) domain.ItemRepository {
 }
 
 // Create inserts a new item into the database.
-func (r *pgItemRepository) Create(ctx context.Context, item *domain.Item) (*domain.Item, error) {
+func (r *pgItemRepository) Create(ctx context.Context, item *domain.Item, userID string) (*domain.Item, error) {
 	// Generate UUID if not provided (though DB default should handle it)
 	if item.ID == "" {
 		item.ID = uuid.NewString()
@@ -37,9 +37,16 @@ func (r *pgItemRepository) Create(ctx context.Context, item *domain.Item) (*doma
 	query := `
         INSERT INTO items (id, sku, name, description, quantity, price, low_stock_threshold, created_at, updated_at)
         VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
-        RETURNING id, created_at, updated_at` // Return generated/defaulted fields
+        RETURNING id, created_at, updated_at, version` // Return generated/defaulted fields
 
-	err := r.db.QueryRow(ctx, query,
+	// The item, the ledger row for its initial stock and its first revision are written together.
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin item insert: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op after Commit
+
+	err = tx.QueryRow(ctx, query,
 		item.ID,
 		item.SKU,
 		item.Name,
@@ -49,27 +56,40 @@ func (r *pgItemRepository) Create(ctx context.Context, item *domain.Item) (*doma
 		item.LowStockThreshold,
 		item.CreatedAt,
 		item.UpdatedAt,
-	).Scan(&item.ID, &item.CreatedAt, &item.UpdatedAt) // Scan the returned values
+	).Scan(&item.ID, &item.CreatedAt, &item.UpdatedAt, &item.Version) // Scan the returned values
 
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
 			// Check for unique constraint violation (e.g., duplicate SKU)
 			if pgErr.Code == "23505" { // PostgreSQL unique_violation error code
-				return nil, fmt.Errorf("item with SKU '%s' already exists: %w", item.SKU, err)
+				return nil, fmt.Errorf("%w: item with SKU '%s'", domain.ErrRepositoryDuplicateEntry, item.SKU)
 			}
 		}
 		return nil, fmt.Errorf("failed to create item: %w", err)
 	}
+	if item.Quantity != 0 {
+		movement := &domain.StockMovement{ItemID: item.ID, Delta: item.Quantity, QuantityAfter: item.Quantity, Reason: domain.MovementReasonInitial,
+			MovedBy: userID}
+		if err := recordMovement(ctx, tx, movement); err != nil {
+			return nil, err
+		}
+	}
+	if err := recordRevisions(ctx, tx, newRevision(item.ID, domain.ItemRevisionCreated, itemFieldChanges(nil, item), userID)); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit item insert: %w", err)
+	}
 	return item, nil
 }
 
 // GetByID retrieves a single item by its ID.
 func (r *pgItemRepository) GetByID(ctx context.Context, id string) (*domain.Item, error) {
 	query := `
-        SELECT id, sku, name, description, quantity, price, low_stock_threshold, created_at, updated_at
+        SELECT id, sku, name, description, quantity, price, low_stock_threshold, created_at, updated_at, version, category_id
         FROM items
-        WHERE id = $1`
+        WHERE id = $1 AND deleted_at IS NULL`
 
 	item := &domain.Item{}
 	err := r.db.QueryRow(ctx, query, id).Scan(
@@ -82,19 +102,51 @@ func (r *pgItemRepository) GetByID(ctx context.Context, id string) (*domain.Item
 		&item.LowStockThreshold,
 		&item.CreatedAt,
 		&item.UpdatedAt,
+		&item.Version,
+		&item.CategoryID,
 	)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-    return nil, fmt.Errorf("%w: item with ID '%s'", domain.ErrItemNotFound, id)
+    return nil, fmt.Errorf("%w: item with ID '%s'", domain.ErrRepositoryNotFound, id)
 }
 		return nil, fmt.Errorf("failed to get item by ID:'%s' %w",id, err)
 	}
 	return item, nil
 }
 
-// GetAll retrieves a paginated list of items and the total count.
-func (r *pgItemRepository) GetAll(ctx context.Context, page, limit int) ([]*domain.Item, int, error) {
+// GetBySKU retrieves a single item by its SKU.
+func (r *pgItemRepository) GetBySKU(ctx context.Context, sku string) (*domain.Item, error) {
+	query := `
+        SELECT id, sku, name, description, quantity, price, low_stock_threshold, created_at, updated_at, version, category_id
+        FROM items
+        WHERE sku = $1 AND deleted_at IS NULL`
+
+	item := &domain.Item{}
+	err := r.db.QueryRow(ctx, query, sku).Scan(
+		&item.ID,
+		&item.SKU,
+		&item.Name,
+		&item.Description,
+		&item.Quantity,
+		&item.Price,
+		&item.LowStockThreshold,
+		&item.CreatedAt,
+		&item.UpdatedAt,
+		&item.Version,
+		&item.CategoryID,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: item with SKU '%s'", domain.ErrRepositoryNotFound, sku)
+		}
+		return nil, fmt.Errorf("failed to get item by SKU '%s': %w", sku, err)
+	}
+	return item, nil
+}
+
+// GetAll retrieves a page of the items passing filter and their total count.
+func (r *pgItemRepository) GetAll(ctx context.Context, filter domain.ItemFilter, page, limit int) ([]*domain.Item, int, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -103,14 +155,24 @@ func (r *pgItemRepository) GetAll(ctx context.Context, page, limit int) ([]*doma
 	}
 	offset := (page - 1) * limit
 
+	b := &sqlBuilder{}
+	itemFilterConditions(b, filter)
+	where := b.whereClause()
+	countArgs := b.args
+	orderBy, err := itemOrderBy(filter.Sort, "created_at DESC")
+	if err != nil {
+		return nil, 0, err
+	}
+
 	// Query for items
 	query := `
-        SELECT id, sku, name, description, quantity, price, low_stock_threshold, created_at, updated_at
+        SELECT id, sku, name, description, quantity, price, low_stock_threshold, created_at, updated_at, version, category_id
         FROM items
-        ORDER BY created_at DESC
-        LIMIT $1 OFFSET $2`
+        ` + where + `
+        ` + orderBy + `
+        LIMIT ` + b.arg(limit) + ` OFFSET ` + b.arg(offset)
 
-	rows, err := r.db.Query(ctx, query, limit, offset)
+	rows, err := r.db.Query(ctx, query, b.args...)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get all items: %w", err)
 	}
@@ -129,6 +191,8 @@ func (r *pgItemRepository) GetAll(ctx context.Context, page, limit int) ([]*doma
 			&item.LowStockThreshold,
 			&item.CreatedAt,
 			&item.UpdatedAt,
+			&item.Version,
+			&item.CategoryID,
 		)
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to scan item row: %w", err)
@@ -141,8 +205,8 @@ func (r *pgItemRepository) GetAll(ctx context.Context, page, limit int) ([]*doma
 
 	// Query for total count
 	var totalItems int
-	countQuery := `SELECT COUNT(*) FROM items`
-	err = r.db.QueryRow(ctx, countQuery).Scan(&totalItems)
+	countQuery := `SELECT COUNT(*) FROM items ` + where
+	err = r.db.QueryRow(ctx, countQuery, countArgs...).Scan(&totalItems)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get total item count: %w", err)
 	}
@@ -150,17 +214,273 @@ func (r *pgItemRepository) GetAll(ctx context.Context, page, limit int) ([]*doma
 	return items, totalItems, nil
 }
 
+// categorySubtreeCTE selects the IDs of category $1 and of all its descendants as "subtree".
+const categorySubtreeCTE = `
+        WITH RECURSIVE subtree AS (
+            SELECT id FROM categories WHERE id = $1
+            UNION ALL
+            SELECT c.id FROM categories c JOIN subtree s ON c.parent_id = s.id
+        )`
+
+// StreamAll calls fn with every item, newest first like an unsorted GetAll, as rows arrive from the
+// database; the whole table is never held in memory. An error from fn stops the scan and
+// is returned as is. The query holds a pool connection until the scan finishes.
+func (r *pgItemRepository) StreamAll(ctx context.Context, fn func(*domain.Item) error) error {
+	query := `
+        SELECT id, sku, name, description, quantity, price, low_stock_threshold, created_at, updated_at, version, category_id
+        FROM items
+        WHERE deleted_at IS NULL
+        ORDER BY created_at DESC, id`
+
+	return r.stream(ctx, fn, query)
+}
+
+// StreamMatching is StreamAll restricted to the items passing filter and, unless search is
+// empty, matching the full-text search, in the order of GetAll or Search. Match is not filled
+// in.
+func (r *pgItemRepository) StreamMatching(ctx context.Context, search string, filter domain.ItemFilter, fn func(*domain.Item) error) error {
+	b := &sqlBuilder{}
+	with, from, fallback := "", "items", "created_at DESC"
+	if search != "" {
+		text := b.arg(search)
+		with = `WITH q AS (SELECT websearch_to_tsquery('english', ` + text + `) || websearch_to_tsquery('simple', ` + text + `) AS query)`
+		from = "items, q"
+		fallback = "ts_rank_cd(search_vector, q.query) DESC, created_at DESC"
+		b.where("search_vector @@ q.query")
+	}
+	itemFilterConditions(b, filter)
+	orderBy, err := itemOrderBy(filter.Sort, fallback)
+	if err != nil {
+		return err
+	}
+	query := with + `
+        SELECT id, sku, name, description, quantity, price, low_stock_threshold, created_at, updated_at, version, category_id
+        FROM ` + from + `
+        ` + b.whereClause() + `
+        ` + orderBy
+
+	return r.stream(ctx, fn, query, b.args...)
+}
+
+// stream calls fn with every item selected by query, in the columns of StreamAll.
+func (r *pgItemRepository) stream(ctx context.Context, fn func(*domain.Item) error, query string, args ...any) error {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to stream items: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item := &domain.Item{}
+		err := rows.Scan(
+			&item.ID,
+			&item.SKU,
+			&item.Name,
+			&item.Description,
+			&item.Quantity,
+			&item.Price,
+			&item.LowStockThreshold,
+			&item.CreatedAt,
+			&item.UpdatedAt,
+			&item.Version,
+			&item.CategoryID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to scan item row: %w", err)
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating item rows: %w", err)
+	}
+	return nil
+}
+
+// Options of ts_headline for the name, which is marked in full, and the description, of
+// which only the best fragments are returned.
+const (
+	nameHeadlineOptions        = "StartSel=<mark>, StopSel=</mark>, HighlightAll=true"
+	descriptionHeadlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxFragments=3, MaxWords=20, MinWords=5"
+)
+
+// htmlEscaped returns the SQL expression of the text column escaped for HTML, so that the
+// marks ts_headline adds are the only markup of a headline. The parser reads the entities as
+// entity tokens, which are never matched, so no mark lands inside one.
+func htmlEscaped(column string) string {
+	return `replace(replace(replace(replace(replace(` + column +
+		`, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;')`
+}
+
+// Search ranks the items whose search_vector matches the search. The tsquery matches the
+// stemmed words of names and descriptions as well as the words of SKUs as typed. The
+// headlines are only computed for the returned page, as they re-parse the text.
+func (r *pgItemRepository) Search(ctx context.Context, search domain.ItemSearch, filter domain.ItemFilter, page, limit int) ([]*domain.Item, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10
+	}
+	orderBy, err := itemOrderBy(filter.Sort, "rank DESC, created_at DESC")
+	if err != nil {
+		return nil, 0, err
+	}
+
+	b := &sqlBuilder{}
+	text := b.arg(search.Text)
+	b.where("search_vector @@ q.query")
+	itemFilterConditions(b, filter)
+	matches := `
+        WITH q AS (SELECT websearch_to_tsquery('english', ` + text + `) || websearch_to_tsquery('simple', ` + text + `) AS query)
+        SELECT COUNT(*) FROM items, q
+        ` + b.whereClause()
+	var total int
+	if err := r.db.QueryRow(ctx, matches, b.args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count item search matches: %w", err)
+	}
+
+	query := `
+        WITH q AS (SELECT websearch_to_tsquery('english', ` + text + `) || websearch_to_tsquery('simple', ` + text + `) AS query),
+        matches AS (
+            SELECT id, sku, name, description, quantity, price, low_stock_threshold, created_at, updated_at, version, category_id,
+                   ts_rank_cd(search_vector, q.query)::float8 AS rank
+            FROM items, q
+            ` + b.whereClause() + `
+            ` + orderBy + `
+            LIMIT ` + b.arg(limit) + ` OFFSET ` + b.arg((page-1)*limit) + `
+        )
+        SELECT m.id, m.sku, m.name, m.description, m.quantity, m.price, m.low_stock_threshold, m.created_at, m.updated_at,
+               m.version, m.category_id, m.rank,
+               CASE WHEN ` + b.arg(search.Highlight) + ` THEN ts_headline('english', ` + htmlEscaped("m.name") + `, q.query, ` + b.arg(nameHeadlineOptions) + `) END,
+               CASE WHEN m.description IS NOT NULL AND ` + b.arg(search.Highlight) + `
+                    THEN ts_headline('english', ` + htmlEscaped("m.description") + `, q.query, ` + b.arg(descriptionHeadlineOptions) + `) END
+        FROM matches m, q
+        ` + orderBy // q only has the query column, so the sort keys can only be those of m
+
+	rows, err := r.db.Query(ctx, query, b.args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search items: %w", err)
+	}
+	defer rows.Close()
+
+	items := []*domain.Item{}
+	for rows.Next() {
+		item := &domain.Item{Match: &domain.SearchMatch{}}
+		err := rows.Scan(
+			&item.ID,
+			&item.SKU,
+			&item.Name,
+			&item.Description,
+			&item.Quantity,
+			&item.Price,
+			&item.LowStockThreshold,
+			&item.CreatedAt,
+			&item.UpdatedAt,
+			&item.Version,
+			&item.CategoryID,
+			&item.Match.Rank,
+			&item.Match.Name,
+			&item.Match.Description,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan item search row: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating item search rows: %w", err)
+	}
+	return items, total, nil
+}
+
+// GetChangedAfter returns up to limit items after the cursor in (updated_at, id) order.
+// updated_at is the start time of the writing transaction, so a long transaction can commit
+// rows older than ones already returned; changes younger than settle are held back until
+// such transactions are done. The cut-off uses the database clock, which stamped the rows.
+func (r *pgItemRepository) GetChangedAfter(ctx context.Context, after domain.ItemChangeCursor, settle time.Duration, limit int) ([]*domain.Item, error) {
+	query := `
+        SELECT id, sku, name, description, quantity, price, low_stock_threshold, created_at, updated_at, version, category_id
+        FROM items
+        WHERE (updated_at, id) > ($1, $2)
+          AND updated_at < NOW() - make_interval(secs => $3)
+          AND deleted_at IS NULL
+        ORDER BY updated_at, id
+        LIMIT $4`
+
+	rows, err := r.db.Query(ctx, query, after.UpdatedAt, after.ID, settle.Seconds(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get changed items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*domain.Item
+	for rows.Next() {
+		item := &domain.Item{}
+		err := rows.Scan(
+			&item.ID,
+			&item.SKU,
+			&item.Name,
+			&item.Description,
+			&item.Quantity,
+			&item.Price,
+			&item.LowStockThreshold,
+			&item.CreatedAt,
+			&item.UpdatedAt,
+			&item.Version,
+			&item.CategoryID,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan item row: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating item rows: %w", err)
+	}
+	return items, nil
+}
+
 // Update modifies an existing item in the database.
 // It only updates fields that are non-nil in the input 'itemUpdate' (which should be populated from UpdateItemRequest).
-func (r *pgItemRepository) Update(ctx context.Context, id string, itemUpdate *domain.Item) (*domain.Item, error) {
-	// First, fetch the existing item to see what needs updating
-	// and to ensure it exists.
-	// This approach is a bit chatty but clear. A more optimized way
-	// would be to build a dynamic SQL query.
+func (r *pgItemRepository) Update(ctx context.Context, id string, itemUpdate *domain.Item, userID string) (*domain.Item, error) {
+	// The item is read under its row lock, so what is written, the movement recording a new
+	// quantity and the revision listing the changed fields all start from the row as stored.
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin item update: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op after Commit
 
-	existingItem, err := r.GetByID(ctx, id)
+	existingItem := &domain.Item{}
+	err = tx.QueryRow(ctx, `
+        SELECT id, sku, name, description, quantity, price, low_stock_threshold, created_at, updated_at, version, category_id
+        FROM items
+        WHERE id = $1 AND deleted_at IS NULL
+        FOR UPDATE`, id).Scan(
+		&existingItem.ID,
+		&existingItem.SKU,
+		&existingItem.Name,
+		&existingItem.Description,
+		&existingItem.Quantity,
+		&existingItem.Price,
+		&existingItem.LowStockThreshold,
+		&existingItem.CreatedAt,
+		&existingItem.UpdatedAt,
+		&existingItem.Version,
+		&existingItem.CategoryID,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%w: item with ID '%s'", domain.ErrRepositoryNotFound, id)
+	}
 	if err != nil {
-		return nil, err // GetByID already provides a good error message
+		return nil, fmt.Errorf("failed to lock item '%s' for update: %w", id, err)
+	}
+	// itemUpdate.Version is the version the caller's edit is based on. The row is locked, so
+	// it cannot change between this check and the write.
+	if itemUpdate.Version != existingItem.Version {
+		return nil, fmt.Errorf("%w: item '%s' is at version %d, not %d", domain.ErrVersionConflict, id, existingItem.Version, itemUpdate.Version)
 	}
 
 	// Build the SET clause dynamically
@@ -191,7 +511,8 @@ func (r *pgItemRepository) Update(ctx context.Context, id string, itemUpdate *do
 	}
 	// Quantity is not a pointer, so we update if it's different.
 	// The service should ensure a valid quantity.
-	if itemUpdate.Quantity != existingItem.Quantity {
+	quantityChanged := itemUpdate.Quantity != existingItem.Quantity
+	if quantityChanged {
 		setClauses = append(setClauses, fmt.Sprintf("quantity = $%d", argId))
 		args = append(args, itemUpdate.Quantity)
 		argId++
@@ -219,15 +540,29 @@ func (r *pgItemRepository) Update(ctx context.Context, id string, itemUpdate *do
 
 	args = append(args, id) // For the WHERE clause
 
+	// The version column is bumped by a trigger on every write to the row.
 	query := fmt.Sprintf(`
         UPDATE items
         SET %s
         WHERE id = $%d
-        RETURNING id, sku, name, description, quantity, price, low_stock_threshold, created_at, updated_at`,
+        RETURNING id, sku, name, description, quantity, price, low_stock_threshold, created_at, updated_at, version, category_id`,
 		strings.Join(setClauses, ", "), argId)
 
+	quantityBefore := existingItem.Quantity
+	if quantityChanged && itemUpdate.Quantity < quantityBefore {
+		// Units on open sales orders are held for them, as in adjustStock.
+		reserved, err := reservedStock(ctx, tx, []string{id})
+		if err != nil {
+			return nil, err
+		}
+		if itemUpdate.Quantity < reserved[id] {
+			return nil, fmt.Errorf("%w: item '%s' has %d reserved by open sales orders, cannot set quantity to %d",
+				domain.ErrInsufficientStock, id, reserved[id], itemUpdate.Quantity)
+		}
+	}
+
 	updatedItem := &domain.Item{}
-	err = r.db.QueryRow(ctx, query, args...).Scan(
+	err = tx.QueryRow(ctx, query, args...).Scan(
 		&updatedItem.ID,
 		&updatedItem.SKU,
 		&updatedItem.Name,
@@ -237,6 +572,8 @@ func (r *pgItemRepository) Update(ctx context.Context, id string, itemUpdate *do
 		&updatedItem.LowStockThreshold,
 		&updatedItem.CreatedAt,
 		&updatedItem.UpdatedAt,
+		&updatedItem.Version,
+		&updatedItem.CategoryID,
 	)
 
 	if err != nil {
@@ -246,18 +583,205 @@ if errors.As(err, &pgErr) && pgErr.Code == "23505" {
 }
 		return nil, fmt.Errorf("failed to update item: %w", err)
 	}
+	if updatedItem.Quantity != quantityBefore {
+		movement := &domain.StockMovement{
+			ItemID:        id,
+			Delta:         updatedItem.Quantity - quantityBefore,
+			QuantityAfter: updatedItem.Quantity,
+			Reason:        domain.MovementReasonEdit,
+			MovedBy:       userID,
+		}
+		if err := recordMovement(ctx, tx, movement); err != nil {
+			return nil, err
+		}
+	}
+	if changes := itemFieldChanges(existingItem, updatedItem); len(changes) > 0 {
+		if err := recordRevisions(ctx, tx, newRevision(id, domain.ItemRevisionUpdated, changes, userID)); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit item update: %w", err)
+	}
 	return updatedItem, nil
 }
 
-// Delete removes an item from the database by its ID.
-func (r *pgItemRepository) Delete(ctx context.Context, id string) error {
-	query := `DELETE FROM items WHERE id = $1`
-	commandTag, err := r.db.Exec(ctx, query, id)
+// Delete moves an item to the trash. It keeps its row, and so its SKU and history, but is
+// left out of every other method until restored.
+func (r *pgItemRepository) Delete(ctx context.Context, id, userID string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin item delete: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op after Commit
+
+	query := `UPDATE items SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`
+	commandTag, err := tx.Exec(ctx, query, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete item: %w", err)
 	}
 	if commandTag.RowsAffected() == 0 {
-		return fmt.Errorf("item with ID '%s' not found for deletion: %w", id, pgx.ErrNoRows) // Or a custom domain.ErrNotFound
+		return fmt.Errorf("%w: item with ID '%s' for deletion", domain.ErrRepositoryNotFound, id)
+	}
+	if err := recordRevisions(ctx, tx, newRevision(id, domain.ItemRevisionDeleted, nil, userID)); err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit item delete: %w", err)
+	}
+	return nil
+}
+
+// ListTrash returns a page of the trashed items, most recently deleted first, and their total count.
+func (r *pgItemRepository) ListTrash(ctx context.Context, page, limit int) ([]*domain.Item, int, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = 10
+	}
+	query := `
+        SELECT id, sku, name, description, quantity, price, low_stock_threshold, created_at, updated_at, version, category_id,
+               deleted_at, COUNT(*) OVER ()
+        FROM items
+        WHERE deleted_at IS NOT NULL
+        ORDER BY deleted_at DESC, id
+        LIMIT $1 OFFSET $2`
+
+	rows, err := r.db.Query(ctx, query, limit, (page-1)*limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list trashed items: %w", err)
+	}
+	defer rows.Close()
+
+	items := []*domain.Item{}
+	total := 0
+	for rows.Next() {
+		item := &domain.Item{}
+		err := rows.Scan(
+			&item.ID,
+			&item.SKU,
+			&item.Name,
+			&item.Description,
+			&item.Quantity,
+			&item.Price,
+			&item.LowStockThreshold,
+			&item.CreatedAt,
+			&item.UpdatedAt,
+			&item.Version,
+			&item.CategoryID,
+			&item.DeletedAt,
+			&total,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan trashed item row: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating trashed item rows: %w", err)
+	}
+	if len(items) == 0 && page > 1 { // Past the last page, the window count is unavailable
+		if err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM items WHERE deleted_at IS NOT NULL`).Scan(&total); err != nil {
+			return nil, 0, fmt.Errorf("failed to count trashed items: %w", err)
+		}
+	}
+	return items, total, nil
+}
+
+// Restore takes an item out of the trash.
+func (r *pgItemRepository) Restore(ctx context.Context, id, userID string) (*domain.Item, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin item restore: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op after Commit
+
+	item := &domain.Item{}
+	err = tx.QueryRow(ctx, `
+        UPDATE items
+        SET deleted_at = NULL
+        WHERE id = $1 AND deleted_at IS NOT NULL
+        RETURNING id, sku, name, description, quantity, price, low_stock_threshold, created_at, updated_at, version, category_id`,
+		id).Scan(
+		&item.ID,
+		&item.SKU,
+		&item.Name,
+		&item.Description,
+		&item.Quantity,
+		&item.Price,
+		&item.LowStockThreshold,
+		&item.CreatedAt,
+		&item.UpdatedAt,
+		&item.Version,
+		&item.CategoryID,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%w: item with ID '%s' in the trash", domain.ErrRepositoryNotFound, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore item '%s': %w", id, err)
+	}
+	if err := recordRevisions(ctx, tx, newRevision(id, domain.ItemRevisionRestored, nil, userID)); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit item restore: %w", err)
+	}
+	return item, nil
+}
+
+// itemReferences names the records that keep an item from being purged, with a query telling
+// whether any of them references item $1. Purging would delete them by the cascades of their
+// foreign keys and rewrite orders, bills of materials and the movement ledger.
+var itemReferences = []struct{ name, query string }{
+	{"purchase orders", `SELECT EXISTS (SELECT 1 FROM purchase_order_lines WHERE item_id = $1)`},
+	{"sales orders", `SELECT EXISTS (SELECT 1 FROM sales_order_lines WHERE item_id = $1)`},
+	{"bills of materials", `SELECT EXISTS (SELECT 1 FROM item_components WHERE item_id = $1 OR component_id = $1)`},
+	{"assembly orders", `SELECT EXISTS (SELECT 1 FROM assembly_orders WHERE item_id = $1)
+        OR EXISTS (SELECT 1 FROM assembly_order_lines WHERE component_id = $1)`},
+	{"stock movements", `SELECT EXISTS (SELECT 1 FROM stock_movements WHERE item_id = $1)`},
+	{"ledger discrepancies", `SELECT EXISTS (SELECT 1 FROM ledger_discrepancies WHERE item_id = $1)`},
+}
+
+// Purge deletes a trashed item for good, together with its own history (revisions, price
+// history, labels...). It refuses items still referenced by orders, assemblies or the ledger.
+// The item's row lock keeps new references out meanwhile, as inserting one locks the row too.
+func (r *pgItemRepository) Purge(ctx context.Context, id string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin item purge: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op after Commit
+
+	var locked string
+	err = tx.QueryRow(ctx, `SELECT id FROM items WHERE id = $1 AND deleted_at IS NOT NULL FOR UPDATE`, id).Scan(&locked)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: item with ID '%s' in the trash", domain.ErrRepositoryNotFound, id)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock item '%s' for purge: %w", id, err)
+	}
+
+	var referencedBy []string
+	for _, ref := range itemReferences {
+		var found bool
+		if err := tx.QueryRow(ctx, ref.query, id).Scan(&found); err != nil {
+			return fmt.Errorf("failed to look up %s of item '%s': %w", ref.name, id, err)
+		}
+		if found {
+			referencedBy = append(referencedBy, ref.name)
+		}
+	}
+	if len(referencedBy) > 0 {
+		return fmt.Errorf("%w: item '%s' is referenced by %s", domain.ErrItemInUse, id, strings.Join(referencedBy, ", "))
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM items WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to purge item '%s': %w", id, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit item purge: %w", err)
 	}
 	return nil
 }
@@ -266,7 +790,7 @@ func (r *pgItemRepository) Delete(ctx context.Context, id string) error {
 
 // GetTotalStockValue calculates the total value of all items in stock.
 func (r *pgItemRepository) GetTotalStockValue(ctx context.Context) (float64, error) {
-	query := `SELECT COALESCE(SUM(quantity * price), 0) FROM items`
+	query := `SELECT COALESCE(SUM(quantity * price), 0) FROM items WHERE deleted_at IS NULL`
 	var totalValue float64
 	err := r.db.QueryRow(ctx, query).Scan(&totalValue)
 	if err != nil {
@@ -279,9 +803,9 @@ func (r *pgItemRepository) GetTotalStockValue(ctx context.Context) (float64, err
 // If item.low_stock_threshold is NULL, it uses the globalThreshold.
 func (r *pgItemRepository) GetLowStockItems(ctx context.Context, globalThreshold int) ([]*domain.Item, error) {
 	query := `
-        SELECT id, sku, name, description, quantity, price, low_stock_threshold, created_at, updated_at
+        SELECT id, sku, name, description, quantity, price, low_stock_threshold, created_at, updated_at, version, category_id
         FROM items
-        WHERE quantity <= COALESCE(low_stock_threshold, $1)
+        WHERE quantity <= COALESCE(low_stock_threshold, $1) AND deleted_at IS NULL
         ORDER BY quantity ASC, name ASC`
 
 	rows, err := r.db.Query(ctx, query, globalThreshold)
@@ -303,6 +827,8 @@ func (r *pgItemRepository) GetLowStockItems(ctx context.Context, globalThreshold
 			&item.LowStockThreshold,
 			&item.CreatedAt,
 			&item.UpdatedAt,
+			&item.Version,
+			&item.CategoryID,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan low stock item row: %w", err)
@@ -321,8 +847,9 @@ func (r *pgItemRepository) GetMostValuableItems(ctx context.Context, limit int)
 		limit = 5 // Default limit
 	}
 	query := `
-        SELECT id, sku, name, description, quantity, price, low_stock_threshold, created_at, updated_at
+        SELECT id, sku, name, description, quantity, price, low_stock_threshold, created_at, updated_at, version, category_id
         FROM items
+        WHERE deleted_at IS NULL
         ORDER BY (quantity * price) DESC, name ASC
         LIMIT $1`
 
@@ -345,6 +872,8 @@ func (r *pgItemRepository) GetMostValuableItems(ctx context.Context, limit int)
 			&item.LowStockThreshold,
 			&item.CreatedAt,
 			&item.UpdatedAt,
+			&item.Version,
+			&item.CategoryID,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan most valuable item row: %w", err)
@@ -356,3 +885,41 @@ func (r *pgItemRepository) GetMostValuableItems(ctx context.Context, limit int)
 	}
 	return items, nil
 }
+
+// ListOptions returns id, SKU and name of every item, ordered by SKU.
+func (r *pgItemRepository) ListOptions(ctx context.Context) ([]domain.ItemOption, error) {
+	rows, err := r.db.Query(ctx, `SELECT id, sku, name FROM items WHERE deleted_at IS NULL ORDER BY sku`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list item options: %w", err)
+	}
+	defer rows.Close()
+
+	options := []domain.ItemOption{}
+	for rows.Next() {
+		var o domain.ItemOption
+		if err := rows.Scan(&o.ID, &o.SKU, &o.Name); err != nil {
+			return nil, fmt.Errorf("failed to scan item option row: %w", err)
+		}
+		options = append(options, o)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating item option rows: %w", err)
+	}
+	return options, nil
+}
+
+// AdjustQuantity adds delta to the quantity while holding the item's row lock, so concurrent
+// adjustments are serialized and never overwrite each other the way a read-modify-write would.
+// The change is recorded in the movement ledger as a plain adjustment, without a reason of its own.
+func (r *pgItemRepository) AdjustQuantity(ctx context.Context, id string, delta int, userID string) (*domain.Item, error) {
+	var item *domain.Item
+	err := runAdjustmentTx(ctx, r.db, func(tx pgx.Tx) error {
+		var err error
+		item, err = adjustStock(ctx, tx, &domain.StockMovement{ItemID: id, Delta: delta, Reason: domain.MovementReasonAdjustment, MovedBy: userID})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
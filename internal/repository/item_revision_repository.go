@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"inventory-system/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type pgItemRevisionRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPgItemRevisionRepository creates a new ItemRevisionRepository backed by PostgreSQL.
+func NewPgItemRevisionRepository(db *pgxpool.Pool) domain.ItemRevisionRepository {
+	return &pgItemRevisionRepository{db: db}
+}
+
+// ListByItem returns the revisions of an item, oldest first.
+func (r *pgItemRevisionRepository) ListByItem(ctx context.Context, itemID string) ([]*domain.ItemRevision, error) {
+	rows, err := r.db.Query(ctx, `
+        SELECT id, item_id, action, changes, changed_by, changed_at
+        FROM item_revisions
+        WHERE item_id = $1
+        ORDER BY changed_at, id`, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list item revisions: %w", err)
+	}
+	defer rows.Close()
+
+	revisions := []*domain.ItemRevision{}
+	for rows.Next() {
+		rev := &domain.ItemRevision{}
+		if err := rows.Scan(&rev.ID, &rev.ItemID, &rev.Action, &rev.Changes, &rev.ChangedBy, &rev.ChangedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan item revision row: %w", err)
+		}
+		revisions = append(revisions, rev)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating item revision rows: %w", err)
+	}
+	return revisions, nil
+}
+
+// newRevision builds a revision of an item changed by userID, which may be empty.
+func newRevision(itemID, action string, changes []domain.FieldChange, userID string) *domain.ItemRevision {
+	if changes == nil {
+		changes = []domain.FieldChange{}
+	}
+	rev := &domain.ItemRevision{ItemID: itemID, Action: action, Changes: changes}
+	if userID != "" {
+		rev.ChangedBy = &userID
+	}
+	return rev
+}
+
+// recordRevisions writes revs within tx, the transaction that changed their items, in one
+// round trip. Like the movement ledger, the history then holds every write that committed
+// and nothing that did not.
+func recordRevisions(ctx context.Context, tx pgx.Tx, revs ...*domain.ItemRevision) error {
+	if len(revs) == 0 {
+		return nil
+	}
+	b := &pgx.Batch{}
+	for _, rev := range revs {
+		b.Queue(`
+            INSERT INTO item_revisions (item_id, action, changes, changed_by)
+            VALUES ($1, $2, $3, $4)`,
+			rev.ItemID, rev.Action, rev.Changes, rev.ChangedBy)
+	}
+	results := tx.SendBatch(ctx, b)
+	defer results.Close()
+	for _, rev := range revs {
+		if _, err := results.Exec(); err != nil {
+			return fmt.Errorf("failed to record %s revision of item '%s': %w", rev.Action, rev.ItemID, err)
+		}
+	}
+	return results.Close()
+}
+
+// quantityChange is the change of a revision recording a stock movement.
+func quantityChange(before, after int) []domain.FieldChange {
+	return []domain.FieldChange{{Field: "quantity", Old: before, New: after}}
+}
+
+// itemHistoryFields are the item fields revisions record, by their JSON names. Nullable
+// fields read as nil when unset. The tax code is not part of domain.Item; AssignItemTaxCode
+// records it as "tax_code".
+var itemHistoryFields = []struct {
+	name  string
+	value func(*domain.Item) any
+}{
+	{"sku", func(i *domain.Item) any { return i.SKU }},
+	{"name", func(i *domain.Item) any { return i.Name }},
+	{"description", func(i *domain.Item) any { return optionalValue(i.Description) }},
+	{"quantity", func(i *domain.Item) any { return i.Quantity }},
+	{"price", func(i *domain.Item) any { return i.Price }},
+	{"low_stock_threshold", func(i *domain.Item) any { return optionalValue(i.LowStockThreshold) }},
+	{"category_id", func(i *domain.Item) any { return optionalValue(i.CategoryID) }},
+}
+
+// itemFieldChanges lists the fields that differ between before and after. A nil before
+// stands for an item being created: every field after has set is listed, from nil.
+func itemFieldChanges(before, after *domain.Item) []domain.FieldChange {
+	changes := []domain.FieldChange{}
+	for _, f := range itemHistoryFields {
+		var was any
+		if before != nil {
+			was = f.value(before)
+		}
+		if now := f.value(after); now != was {
+			changes = append(changes, domain.FieldChange{Field: f.name, Old: was, New: now})
+		}
+	}
+	return changes
+}
+
+// optionalValue returns what p points to, or nil.
+func optionalValue[T any](p *T) any {
+	if p == nil {
+		return nil
+	}
+	return *p
+}
@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"reflect"
+	"testing"
+
+	"inventory-system/internal/domain"
+)
+
+func TestItemFieldChanges(t *testing.T) {
+	description, threshold, category := "Blue", 5, "c1"
+	before := &domain.Item{SKU: "A-1", Name: "Pen", Description: &description, Quantity: 4, Price: 1.5, LowStockThreshold: &threshold}
+	after := *before
+	after.Name, after.Description, after.Quantity, after.CategoryID = "Blue pen", nil, 9, &category
+
+	want := []domain.FieldChange{
+		{Field: "name", Old: "Pen", New: "Blue pen"},
+		{Field: "description", Old: "Blue", New: nil},
+		{Field: "quantity", Old: 4, New: 9},
+		{Field: "category_id", Old: nil, New: "c1"},
+	}
+	if got := itemFieldChanges(before, &after); !reflect.DeepEqual(got, want) {
+		t.Errorf("changes = %v, want %v", got, want)
+	}
+	if got := itemFieldChanges(before, before); len(got) != 0 {
+		t.Errorf("changes of an unchanged item = %v, want none", got)
+	}
+
+	created := itemFieldChanges(nil, &domain.Item{SKU: "B-2", Name: "Cup", Quantity: 0, Price: 3})
+	want = []domain.FieldChange{
+		{Field: "sku", Old: nil, New: "B-2"},
+		{Field: "name", Old: nil, New: "Cup"},
+		{Field: "quantity", Old: nil, New: 0},
+		{Field: "price", Old: nil, New: 3.0},
+	}
+	if !reflect.DeepEqual(created, want) {
+		t.Errorf("changes of a created item = %v, want %v", created, want)
+	}
+}
+
+func TestNewRevision(t *testing.T) {
+	rev := newRevision("i1", domain.ItemRevisionDeleted, nil, "")
+	if rev.ChangedBy != nil || rev.Changes == nil || len(rev.Changes) != 0 {
+		t.Errorf("anonymous revision = %+v, want no user and empty changes", rev)
+	}
+	if rev := newRevision("i1", domain.ItemRevisionUpdated, quantityChange(3, 1), "alice"); rev.ChangedBy == nil || *rev.ChangedBy != "alice" {
+		t.Errorf("revision by alice = %+v", rev)
+	}
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"inventory-system/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// labelJobColumns are selected from label_jobs j joined with items i, in scanLabelJob order.
+const labelJobColumns = `j.id, j.station, j.item_id, i.sku, i.name, j.copies, j.reference, j.status, j.error,
+        j.created_by, j.created_at, j.claimed_at, j.completed_at`
+
+type pgLabelJobRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPgLabelJobRepository creates a new LabelJobRepository backed by PostgreSQL.
+func NewPgLabelJobRepository(db *pgxpool.Pool) domain.LabelJobRepository {
+	return &pgLabelJobRepository{db: db}
+}
+
+// Enqueue stores the jobs in one transaction.
+func (r *pgLabelJobRepository) Enqueue(ctx context.Context, jobs []*domain.LabelJob) ([]*domain.LabelJob, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin label job insert: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op after Commit
+
+	for _, j := range jobs {
+		j.Status = domain.LabelJobStatusQueued
+		err := tx.QueryRow(ctx, `
+            WITH inserted AS (
+                INSERT INTO label_jobs (station, item_id, copies, reference, status, created_by)
+                VALUES ($1, $2, $3, $4, $5, $6)
+                RETURNING id, item_id, created_at
+            )
+            SELECT inserted.id, inserted.created_at, i.sku, i.name
+            FROM inserted JOIN items i ON i.id = inserted.item_id`,
+			j.Station, j.ItemID, j.Copies, j.Reference, j.Status, j.CreatedBy).Scan(&j.ID, &j.CreatedAt, &j.SKU, &j.Name)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation: unknown item
+				return nil, fmt.Errorf("%w: item with ID '%s'", domain.ErrRepositoryNotFound, j.ItemID)
+			}
+			return nil, fmt.Errorf("failed to queue labels of item '%s': %w", j.ItemID, err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit label jobs: %w", err)
+	}
+	return jobs, nil
+}
+
+// List returns the latest label jobs, optionally of one station and status, newest first.
+func (r *pgLabelJobRepository) List(ctx context.Context, q domain.ListLabelJobsQuery) ([]*domain.LabelJob, error) {
+	conditions := []string{}
+	args := []any{}
+	if q.Station != "" {
+		args = append(args, q.Station)
+		conditions = append(conditions, fmt.Sprintf("j.station = $%d", len(args)))
+	}
+	if q.Status != "" {
+		args = append(args, q.Status)
+		conditions = append(conditions, fmt.Sprintf("j.status = $%d", len(args)))
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+	args = append(args, q.Limit)
+
+	rows, err := r.db.Query(ctx, `
+        SELECT `+labelJobColumns+`
+        FROM label_jobs j JOIN items i ON i.id = j.item_id
+        `+where+`
+        ORDER BY j.created_at DESC, j.id
+        LIMIT $`+fmt.Sprint(len(args)), args...)
+	return scanLabelJobs(rows, err)
+}
+
+// Claim hands the station's oldest waiting jobs to its agent. SKIP LOCKED lets concurrent
+// claims pass over each other's jobs instead of waiting for them.
+func (r *pgLabelJobRepository) Claim(ctx context.Context, station string, limit int, reclaimAfter time.Duration) ([]*domain.LabelJob, error) {
+	rows, err := r.db.Query(ctx, `
+        WITH claimed AS (
+            UPDATE label_jobs
+            SET status = $2, claimed_at = NOW()
+            WHERE id IN (
+                SELECT id FROM label_jobs
+                WHERE station = $1
+                  AND (status = $3 OR (status = $2 AND claimed_at < NOW() - make_interval(secs => $4)))
+                ORDER BY created_at, id
+                LIMIT $5
+                FOR UPDATE SKIP LOCKED
+            )
+            RETURNING *
+        )
+        SELECT `+labelJobColumns+`
+        FROM claimed j JOIN items i ON i.id = j.item_id
+        ORDER BY j.created_at, j.id`,
+		station, domain.LabelJobStatusPrinting, domain.LabelJobStatusQueued, reclaimAfter.Seconds(), limit)
+	return scanLabelJobs(rows, err)
+}
+
+// Complete closes a printing job as printed or failed.
+func (r *pgLabelJobRepository) Complete(ctx context.Context, id, status string, errMsg *string) (*domain.LabelJob, error) {
+	j := &domain.LabelJob{}
+	err := r.db.QueryRow(ctx, `
+        WITH completed AS (
+            UPDATE label_jobs
+            SET status = $2, error = $3, completed_at = NOW()
+            WHERE id = $1 AND status = $4
+            RETURNING *
+        )
+        SELECT `+labelJobColumns+`
+        FROM completed j JOIN items i ON i.id = j.item_id`,
+		id, status, errMsg, domain.LabelJobStatusPrinting).Scan(labelJobFields(j)...)
+	if err == nil {
+		return j, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to complete label job '%s': %w", id, err)
+	}
+
+	var current string
+	err = r.db.QueryRow(ctx, `SELECT status FROM label_jobs WHERE id = $1`, id).Scan(&current)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("%w: label job with ID '%s'", domain.ErrRepositoryNotFound, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get label job '%s': %w", id, err)
+	}
+	return nil, fmt.Errorf("%w: job '%s' is %s", domain.ErrLabelJobStatus, id, current)
+}
+
+// labelJobFields returns the scan destinations of labelJobColumns.
+func labelJobFields(j *domain.LabelJob) []any {
+	return []any{&j.ID, &j.Station, &j.ItemID, &j.SKU, &j.Name, &j.Copies, &j.Reference, &j.Status, &j.Error,
+		&j.CreatedBy, &j.CreatedAt, &j.ClaimedAt, &j.CompletedAt}
+}
+
+// scanLabelJobs reads the rows of a label job query, passing on the query's error.
+func scanLabelJobs(rows pgx.Rows, err error) ([]*domain.LabelJob, error) {
+	if err != nil {
+		return nil, fmt.Errorf("failed to query label jobs: %w", err)
+	}
+	defer rows.Close()
+
+	jobs := []*domain.LabelJob{}
+	for rows.Next() {
+		j := &domain.LabelJob{}
+		if err := rows.Scan(labelJobFields(j)...); err != nil {
+			return nil, fmt.Errorf("failed to scan label job row: %w", err)
+		}
+		jobs = append(jobs, j)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating label job rows: %w", err)
+	}
+	return jobs, nil
+}
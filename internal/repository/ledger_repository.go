@@ -0,0 +1,238 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"inventory-system/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type pgLedgerRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPgLedgerRepository creates a new LedgerRepository backed by PostgreSQL.
+func NewPgLedgerRepository(db *pgxpool.Pool) domain.LedgerRepository {
+	return &pgLedgerRepository{db: db}
+}
+
+const ledgerDiscrepancyColumns = `d.id, d.item_id, i.sku, d.quantity, d.ledger_quantity, d.detected_at, d.checked_at,
+        d.resolved_at, d.resolved_by, d.resolution, d.movement_id, d.note`
+
+func scanLedgerDiscrepancy(row pgx.Row) (*domain.LedgerDiscrepancy, error) {
+	d := &domain.LedgerDiscrepancy{}
+	err := row.Scan(&d.ID, &d.ItemID, &d.SKU, &d.Quantity, &d.LedgerQuantity, &d.DetectedAt, &d.CheckedAt,
+		&d.ResolvedAt, &d.ResolvedBy, &d.Resolution, &d.MovementID, &d.Note)
+	d.Difference = d.Quantity - d.LedgerQuantity
+	return d, err
+}
+
+// Check compares the items with their ledgers and records the result in one transaction.
+// It holds a lock on ledger_discrepancies that admits no other check and no reconciliation,
+// so a discrepancy reconciled while the items are read cannot be reopened from stale numbers.
+func (r *pgLedgerRepository) Check(ctx context.Context) (*domain.LedgerCheckResult, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin ledger check: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op after Commit
+
+	if _, err := tx.Exec(ctx, `LOCK TABLE ledger_discrepancies IN SHARE ROW EXCLUSIVE MODE`); err != nil {
+		return nil, fmt.Errorf("failed to lock ledger discrepancies: %w", err)
+	}
+
+	result := &domain.LedgerCheckResult{New: []*domain.LedgerDiscrepancy{}}
+	if err := tx.QueryRow(ctx, `SELECT COUNT(*) FROM items WHERE deleted_at IS NULL`).Scan(&result.Checked); err != nil {
+		return nil, fmt.Errorf("failed to count checked items: %w", err)
+	}
+	// One statement, so each item's quantity and movements come from the same snapshot: the
+	// two are always written in one transaction.
+	rows, err := tx.Query(ctx, `
+        SELECT i.id, i.quantity, COALESCE(l.total, 0), d.id IS NOT NULL
+        FROM items i
+        LEFT JOIN (SELECT item_id, SUM(delta) AS total FROM stock_movements GROUP BY item_id) l ON l.item_id = i.id
+        LEFT JOIN ledger_discrepancies d ON d.item_id = i.id AND d.resolved_at IS NULL
+        WHERE i.deleted_at IS NULL AND i.quantity <> COALESCE(l.total, 0)
+        ORDER BY i.sku`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare items with the ledger: %w", err)
+	}
+	type mismatch struct {
+		itemID           string
+		quantity, ledger int
+		open             bool
+	}
+	var found []mismatch
+	for rows.Next() {
+		var m mismatch
+		if err := rows.Scan(&m.itemID, &m.quantity, &m.ledger, &m.open); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan ledger mismatch row: %w", err)
+		}
+		found = append(found, m)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ledger mismatch rows: %w", err)
+	}
+	result.Discrepancies = len(found)
+
+	itemIDs := make([]string, 0, len(found))
+	for _, m := range found {
+		itemIDs = append(itemIDs, m.itemID)
+		d, err := scanLedgerDiscrepancy(tx.QueryRow(ctx, `
+            WITH d AS (
+                INSERT INTO ledger_discrepancies (item_id, quantity, ledger_quantity)
+                VALUES ($1, $2, $3)
+                ON CONFLICT (item_id) WHERE resolved_at IS NULL
+                DO UPDATE SET quantity = EXCLUDED.quantity, ledger_quantity = EXCLUDED.ledger_quantity, checked_at = NOW()
+                RETURNING *
+            )
+            SELECT `+ledgerDiscrepancyColumns+`
+            FROM d JOIN items i ON i.id = d.item_id`, m.itemID, m.quantity, m.ledger))
+		if err != nil {
+			return nil, fmt.Errorf("failed to record ledger discrepancy of item '%s': %w", m.itemID, err)
+		}
+		if !m.open {
+			result.New = append(result.New, d)
+		}
+	}
+
+	commandTag, err := tx.Exec(ctx, `
+        UPDATE ledger_discrepancies
+        SET resolved_at = NOW(), resolution = $2
+        WHERE resolved_at IS NULL AND item_id <> ALL($1::uuid[])`, itemIDs, domain.LedgerResolvedCleared)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clear ledger discrepancies: %w", err)
+	}
+	result.Cleared = int(commandTag.RowsAffected())
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit ledger check: %w", err)
+	}
+	return result, nil
+}
+
+// List returns ledger discrepancies, most recently detected first.
+func (r *pgLedgerRepository) List(ctx context.Context, q domain.ListLedgerDiscrepanciesQuery) ([]*domain.LedgerDiscrepancy, error) {
+	if q.Limit < 1 {
+		q.Limit = 50
+	}
+	rows, err := r.db.Query(ctx, `
+        SELECT `+ledgerDiscrepancyColumns+`
+        FROM ledger_discrepancies d JOIN items i ON i.id = d.item_id
+        WHERE $1 = FALSE OR d.resolved_at IS NULL
+        ORDER BY d.detected_at DESC, d.id
+        LIMIT $2`, q.Open, q.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ledger discrepancies: %w", err)
+	}
+	defer rows.Close()
+
+	discrepancies := []*domain.LedgerDiscrepancy{}
+	for rows.Next() {
+		d, err := scanLedgerDiscrepancy(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan ledger discrepancy row: %w", err)
+		}
+		discrepancies = append(discrepancies, d)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating ledger discrepancy rows: %w", err)
+	}
+	return discrepancies, nil
+}
+
+// Reconcile resolves an open discrepancy under the item's row lock. Keeping the quantity
+// records a reconciliation movement of the difference; keeping the ledger sets the quantity
+// without one, as the ledger already sums to it. Either way the quantity and the ledger
+// agree again, and the discrepancy keeps the numbers it was resolved from. Keeping a ledger
+// that sums to less than the units reserved by open sales orders, or to less than zero, is
+// refused as in adjustStock.
+func (r *pgLedgerRepository) Reconcile(ctx context.Context, id, keep, note, userID string) (*domain.LedgerDiscrepancy, error) {
+	var d *domain.LedgerDiscrepancy
+	err := runAdjustmentTx(ctx, r.db, func(tx pgx.Tx) error {
+		// Waits for a running check, which would otherwise record the old numbers again.
+		if _, err := tx.Exec(ctx, `LOCK TABLE ledger_discrepancies IN ROW EXCLUSIVE MODE`); err != nil {
+			return fmt.Errorf("failed to lock ledger discrepancies: %w", err)
+		}
+		var itemID string
+		var open bool
+		err := tx.QueryRow(ctx, `SELECT item_id, resolved_at IS NULL FROM ledger_discrepancies WHERE id = $1 FOR UPDATE`, id).
+			Scan(&itemID, &open)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w: ledger discrepancy with ID '%s'", domain.ErrRepositoryNotFound, id)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to lock ledger discrepancy '%s': %w", id, err)
+		}
+		if !open {
+			return fmt.Errorf("%w: '%s'", domain.ErrDiscrepancyResolved, id)
+		}
+
+		stocks, err := lockStock(ctx, tx, []string{itemID})
+		if err != nil {
+			return err
+		}
+		s, ok := stocks[itemID]
+		if !ok { // In the trash; restore it first
+			return fmt.Errorf("%w: item '%s' of ledger discrepancy '%s'", domain.ErrItemNotFound, itemID, id)
+		}
+		var ledger int
+		if err := tx.QueryRow(ctx, `SELECT COALESCE(SUM(delta), 0) FROM stock_movements WHERE item_id = $1`, itemID).Scan(&ledger); err != nil {
+			return fmt.Errorf("failed to sum the ledger of item '%s': %w", itemID, err)
+		}
+
+		var movementID *string
+		switch {
+		case s.quantity == ledger: // Fixed some other way since the check
+		case keep == domain.LedgerKeepQuantity:
+			m := &domain.StockMovement{ItemID: itemID, Delta: s.quantity - ledger, QuantityAfter: s.quantity,
+				Reason: domain.MovementReasonReconcile, Reference: id, Note: note, MovedBy: userID}
+			if err := recordMovement(ctx, tx, m); err != nil {
+				return err
+			}
+			movementID = &m.ID
+		default:
+			if ledger < s.quantity {
+				reserved, err := reservedStock(ctx, tx, []string{itemID})
+				if err != nil {
+					return err
+				}
+				if ledger < max(reserved[itemID], 0) {
+					return fmt.Errorf("%w: item '%s' has %d reserved by open sales orders, cannot set quantity to its ledger of %d",
+						domain.ErrInsufficientStock, itemID, reserved[itemID], ledger)
+				}
+			}
+			if _, err := tx.Exec(ctx, `UPDATE items SET quantity = $1 WHERE id = $2`, ledger, itemID); err != nil {
+				return fmt.Errorf("failed to set quantity of item '%s' to its ledger: %w", itemID, err)
+			}
+			if err := recordRevisions(ctx, tx, newRevision(itemID, domain.ItemRevisionUpdated, quantityChange(s.quantity, ledger), userID)); err != nil {
+				return err
+			}
+		}
+
+		d, err = scanLedgerDiscrepancy(tx.QueryRow(ctx, `
+            WITH d AS (
+                UPDATE ledger_discrepancies
+                SET quantity = $2, ledger_quantity = $3, resolved_at = NOW(), resolved_by = $4, resolution = $5,
+                    movement_id = $6, note = $7
+                WHERE id = $1
+                RETURNING *
+            )
+            SELECT `+ledgerDiscrepancyColumns+`
+            FROM d JOIN items i ON i.id = d.item_id`, id, s.quantity, ledger, userID, keep, movementID, note))
+		if err != nil {
+			return fmt.Errorf("failed to resolve ledger discrepancy '%s': %w", id, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
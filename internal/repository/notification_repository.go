@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"inventory-system/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type pgNotificationRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPgNotificationRepository creates a new NotificationRepository backed by PostgreSQL.
+func NewPgNotificationRepository(db *pgxpool.Pool) domain.NotificationRepository {
+	return &pgNotificationRepository{db: db}
+}
+
+// Create inserts a new notification.
+func (r *pgNotificationRepository) Create(ctx context.Context, n *domain.Notification) (*domain.Notification, error) {
+	if n.ID == "" {
+		n.ID = uuid.NewString()
+	}
+	n.CreatedAt = time.Now()
+
+	query := `
+        INSERT INTO notifications (id, user_id, type, message, entity_type, entity_id, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        RETURNING id, created_at`
+
+	err := r.db.QueryRow(ctx, query,
+		n.ID, n.UserID, n.Type, n.Message, n.EntityType, n.EntityID, n.CreatedAt,
+	).Scan(&n.ID, &n.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create notification: %w", err)
+	}
+	return n, nil
+}
+
+// ListByUser returns a user's notifications, newest first.
+func (r *pgNotificationRepository) ListByUser(ctx context.Context, userID string, unreadOnly bool, limit int) ([]*domain.Notification, error) {
+	if limit < 1 {
+		limit = 50
+	}
+	query := `
+        SELECT id, user_id, type, message, entity_type, entity_id, read_at, created_at
+        FROM notifications
+        WHERE user_id = $1 AND ($2 = FALSE OR read_at IS NULL)
+        ORDER BY created_at DESC
+        LIMIT $3`
+
+	rows, err := r.db.Query(ctx, query, userID, unreadOnly, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notifications: %w", err)
+	}
+	defer rows.Close()
+
+	notifications := []*domain.Notification{}
+	for rows.Next() {
+		n := &domain.Notification{}
+		if err := rows.Scan(&n.ID, &n.UserID, &n.Type, &n.Message, &n.EntityType, &n.EntityID, &n.ReadAt, &n.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan notification row: %w", err)
+		}
+		notifications = append(notifications, n)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating notification rows: %w", err)
+	}
+	return notifications, nil
+}
+
+// CountUnread returns how many unread notifications a user has.
+func (r *pgNotificationRepository) CountUnread(ctx context.Context, userID string) (int, error) {
+	var count int
+	err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM notifications WHERE user_id = $1 AND read_at IS NULL`, userID).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+	return count, nil
+}
+
+// MarkRead marks a single notification as read. Notifications of other users are treated as not found.
+func (r *pgNotificationRepository) MarkRead(ctx context.Context, userID, id string) (*domain.Notification, error) {
+	query := `
+        UPDATE notifications
+        SET read_at = COALESCE(read_at, NOW())
+        WHERE id = $1 AND user_id = $2
+        RETURNING id, user_id, type, message, entity_type, entity_id, read_at, created_at`
+
+	n := &domain.Notification{}
+	err := r.db.QueryRow(ctx, query, id, userID).Scan(&n.ID, &n.UserID, &n.Type, &n.Message, &n.EntityType, &n.EntityID, &n.ReadAt, &n.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: notification with ID '%s'", domain.ErrRepositoryNotFound, id)
+		}
+		return nil, fmt.Errorf("failed to mark notification read: %w", err)
+	}
+	return n, nil
+}
+
+// MarkAllRead marks every unread notification of a user as read.
+func (r *pgNotificationRepository) MarkAllRead(ctx context.Context, userID string) (int, error) {
+	commandTag, err := r.db.Exec(ctx, `UPDATE notifications SET read_at = NOW() WHERE user_id = $1 AND read_at IS NULL`, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark all notifications read: %w", err)
+	}
+	return int(commandTag.RowsAffected()), nil
+}
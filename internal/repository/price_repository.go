@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"inventory-system/internal/domain"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type pgPriceRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPgPriceRepository creates a new PriceRepository backed by PostgreSQL.
+func NewPgPriceRepository(db *pgxpool.Pool) domain.PriceRepository {
+	return &pgPriceRepository{db: db}
+}
+
+// ApplyPriceChanges locks the matching items, reprices them and writes the price history and
+// item revisions, all in one transaction, so a bulk update is applied completely or not at all.
+func (r *pgPriceRepository) ApplyPriceChanges(ctx context.Context, filter domain.PriceFilter, reprice func(old float64) float64,
+	reason, changedBy string, dryRun bool) (int, []domain.PriceChange, error) {
+	var conditions []string
+	var args []any
+	if len(filter.ItemIDs) > 0 {
+		args = append(args, filter.ItemIDs)
+		conditions = append(conditions, fmt.Sprintf("id = ANY($%d::uuid[])", len(args)))
+	}
+	if filter.SKUPrefix != "" {
+		args = append(args, escapeLike(filter.SKUPrefix)+"%")
+		conditions = append(conditions, fmt.Sprintf("sku LIKE $%d", len(args)))
+	}
+	if len(conditions) == 0 {
+		return 0, nil, fmt.Errorf("%w: empty price filter", domain.ErrInvalidInput) // Never reprice everything by accident
+	}
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to begin price update: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op after Commit
+
+	query := `
+        SELECT id, sku, price
+        FROM items
+        WHERE deleted_at IS NULL AND ` + strings.Join(conditions, " AND ") + `
+        ORDER BY sku
+        FOR UPDATE`
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to select items for price update: %w", err)
+	}
+	matched := 0
+	changes := []domain.PriceChange{}
+	for rows.Next() {
+		var ch domain.PriceChange
+		if err := rows.Scan(&ch.ItemID, &ch.SKU, &ch.OldPrice); err != nil {
+			rows.Close()
+			return 0, nil, fmt.Errorf("failed to scan item for price update: %w", err)
+		}
+		matched++
+		if ch.NewPrice = reprice(ch.OldPrice); ch.NewPrice != ch.OldPrice {
+			changes = append(changes, ch)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, nil, fmt.Errorf("error iterating items for price update: %w", err)
+	}
+	if dryRun {
+		return matched, changes, nil
+	}
+
+	revisions := make([]*domain.ItemRevision, 0, len(changes))
+	for _, ch := range changes {
+		if _, err := tx.Exec(ctx, `UPDATE items SET price = $1 WHERE id = $2`, ch.NewPrice, ch.ItemID); err != nil {
+			return 0, nil, fmt.Errorf("failed to update price of item '%s': %w", ch.ItemID, err)
+		}
+		_, err := tx.Exec(ctx, `
+            INSERT INTO price_history (item_id, old_price, new_price, reason, changed_by)
+            VALUES ($1, $2, $3, $4, $5)`, ch.ItemID, ch.OldPrice, ch.NewPrice, reason, changedBy)
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed to record price history of item '%s': %w", ch.ItemID, err)
+		}
+		revisions = append(revisions, newRevision(ch.ItemID, domain.ItemRevisionUpdated,
+			[]domain.FieldChange{{Field: "price", Old: ch.OldPrice, New: ch.NewPrice}}, changedBy))
+	}
+	if err := recordRevisions(ctx, tx, revisions...); err != nil {
+		return 0, nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, nil, fmt.Errorf("failed to commit price update: %w", err)
+	}
+	return matched, changes, nil
+}
+
+// ListHistory returns the price changes of an item, newest first.
+func (r *pgPriceRepository) ListHistory(ctx context.Context, itemID string) ([]*domain.PriceHistoryEntry, error) {
+	query := `
+        SELECT id, item_id, old_price, new_price, reason, changed_by, changed_at
+        FROM price_history
+        WHERE item_id = $1
+        ORDER BY changed_at DESC`
+
+	rows, err := r.db.Query(ctx, query, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list price history: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []*domain.PriceHistoryEntry{}
+	for rows.Next() {
+		e := &domain.PriceHistoryEntry{}
+		if err := rows.Scan(&e.ID, &e.ItemID, &e.OldPrice, &e.NewPrice, &e.Reason, &e.ChangedBy, &e.ChangedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan price history entry: %w", err)
+		}
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating price history: %w", err)
+	}
+	return entries, nil
+}
+
+// escapeLike escapes the LIKE wildcards in s so it matches literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"inventory-system/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type pgPriceTierRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPgPriceTierRepository creates a new PriceTierRepository backed by PostgreSQL.
+func NewPgPriceTierRepository(db *pgxpool.Pool) domain.PriceTierRepository {
+	return &pgPriceTierRepository{db: db}
+}
+
+// ListForItem returns the price rules of an item, ordered by tier.
+func (r *pgPriceTierRepository) ListForItem(ctx context.Context, itemID string) ([]*domain.TierPrice, error) {
+	var exists bool
+	if err := r.db.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM items WHERE id = $1 AND deleted_at IS NULL)`, itemID).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("failed to look up item '%s': %w", itemID, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("%w: item with ID '%s'", domain.ErrRepositoryNotFound, itemID)
+	}
+
+	rows, err := r.db.Query(ctx, `
+        SELECT item_id, tier, price, discount_percent, updated_at
+        FROM item_tier_prices
+        WHERE item_id = $1
+        ORDER BY tier`, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tier prices of item '%s': %w", itemID, err)
+	}
+	defer rows.Close()
+
+	prices := []*domain.TierPrice{}
+	for rows.Next() {
+		tp := &domain.TierPrice{}
+		if err := rows.Scan(&tp.ItemID, &tp.Tier, &tp.Price, &tp.DiscountPercent, &tp.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan tier price row: %w", err)
+		}
+		prices = append(prices, tp)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tier price rows: %w", err)
+	}
+	return prices, nil
+}
+
+// Get retrieves the price rule of an item for a tier.
+func (r *pgPriceTierRepository) Get(ctx context.Context, itemID, tier string) (*domain.TierPrice, error) {
+	tp := &domain.TierPrice{}
+	err := r.db.QueryRow(ctx, `
+        SELECT item_id, tier, price, discount_percent, updated_at
+        FROM item_tier_prices
+        WHERE item_id = $1 AND tier = $2`, itemID, tier).Scan(&tp.ItemID, &tp.Tier, &tp.Price, &tp.DiscountPercent, &tp.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s price of item '%s'", domain.ErrRepositoryNotFound, tier, itemID)
+		}
+		return nil, fmt.Errorf("failed to get %s price of item '%s': %w", tier, itemID, err)
+	}
+	return tp, nil
+}
+
+// Set creates or replaces the price rule of an item for a tier.
+func (r *pgPriceTierRepository) Set(ctx context.Context, tp *domain.TierPrice) (*domain.TierPrice, error) {
+	err := r.db.QueryRow(ctx, `
+        INSERT INTO item_tier_prices (item_id, tier, price, discount_percent)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (item_id, tier) DO UPDATE
+        SET price = EXCLUDED.price, discount_percent = EXCLUDED.discount_percent
+        RETURNING updated_at`,
+		tp.ItemID, tp.Tier, tp.Price, tp.DiscountPercent).Scan(&tp.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation: unknown item
+			return nil, fmt.Errorf("%w: item with ID '%s'", domain.ErrRepositoryNotFound, tp.ItemID)
+		}
+		return nil, fmt.Errorf("failed to set %s price of item '%s': %w", tp.Tier, tp.ItemID, err)
+	}
+	return tp, nil
+}
+
+// Delete removes the price rule of an item for a tier.
+func (r *pgPriceTierRepository) Delete(ctx context.Context, itemID, tier string) error {
+	commandTag, err := r.db.Exec(ctx, `DELETE FROM item_tier_prices WHERE item_id = $1 AND tier = $2`, itemID, tier)
+	if err != nil {
+		return fmt.Errorf("failed to delete %s price of item '%s': %w", tier, itemID, err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: %s price of item '%s'", domain.ErrRepositoryNotFound, tier, itemID)
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"inventory-system/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type pgPromotionRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPgPromotionRepository creates a new PromotionRepository backed by PostgreSQL.
+func NewPgPromotionRepository(db *pgxpool.Pool) domain.PromotionRepository {
+	return &pgPromotionRepository{db: db}
+}
+
+// Create inserts a promotion and its item prices in one transaction.
+func (r *pgPromotionRepository) Create(ctx context.Context, p *domain.Promotion) (*domain.Promotion, error) {
+	if p.ID == "" {
+		p.ID = uuid.NewString()
+	}
+	p.CreatedAt = time.Now()
+
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin promotion insert: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op after Commit
+
+	_, err = tx.Exec(ctx, `
+        INSERT INTO promotions (id, name, starts_at, ends_at, created_by, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6)`,
+		p.ID, p.Name, p.StartsAt, p.EndsAt, p.CreatedBy, p.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create promotion: %w", err)
+	}
+	for _, it := range p.Items {
+		_, err := tx.Exec(ctx, `INSERT INTO promotion_items (promotion_id, item_id, price) VALUES ($1, $2, $3)`, p.ID, it.ItemID, it.Price)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation: unknown item
+				return nil, fmt.Errorf("%w: item with ID '%s'", domain.ErrRepositoryNotFound, it.ItemID)
+			}
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+				return nil, fmt.Errorf("%w: item '%s' listed twice", domain.ErrRepositoryDuplicateEntry, it.ItemID)
+			}
+			return nil, fmt.Errorf("failed to add item '%s' to promotion: %w", it.ItemID, err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit promotion: %w", err)
+	}
+	return p, nil
+}
+
+// GetByID retrieves a promotion with its items.
+func (r *pgPromotionRepository) GetByID(ctx context.Context, id string) (*domain.Promotion, error) {
+	p := &domain.Promotion{}
+	err := r.db.QueryRow(ctx, `
+        SELECT id, name, starts_at, ends_at, created_by, created_at
+        FROM promotions
+        WHERE id = $1`, id).Scan(&p.ID, &p.Name, &p.StartsAt, &p.EndsAt, &p.CreatedBy, &p.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: promotion with ID '%s'", domain.ErrRepositoryNotFound, id)
+		}
+		return nil, fmt.Errorf("failed to get promotion by ID '%s': %w", id, err)
+	}
+	if err := r.loadItems(ctx, []*domain.Promotion{p}); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// List returns all promotions, latest start first.
+func (r *pgPromotionRepository) List(ctx context.Context) ([]*domain.Promotion, error) {
+	rows, err := r.db.Query(ctx, `
+        SELECT id, name, starts_at, ends_at, created_by, created_at
+        FROM promotions
+        ORDER BY starts_at DESC`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list promotions: %w", err)
+	}
+	defer rows.Close()
+
+	promotions := []*domain.Promotion{}
+	for rows.Next() {
+		p := &domain.Promotion{}
+		if err := rows.Scan(&p.ID, &p.Name, &p.StartsAt, &p.EndsAt, &p.CreatedBy, &p.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan promotion row: %w", err)
+		}
+		promotions = append(promotions, p)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating promotion rows: %w", err)
+	}
+	if err := r.loadItems(ctx, promotions); err != nil {
+		return nil, err
+	}
+	return promotions, nil
+}
+
+// loadItems fills in the items of promotions with a single query.
+func (r *pgPromotionRepository) loadItems(ctx context.Context, promotions []*domain.Promotion) error {
+	if len(promotions) == 0 {
+		return nil
+	}
+	byID := make(map[string]*domain.Promotion, len(promotions))
+	ids := make([]string, 0, len(promotions))
+	for _, p := range promotions {
+		p.Items = []domain.PromotionItem{}
+		byID[p.ID] = p
+		ids = append(ids, p.ID)
+	}
+
+	rows, err := r.db.Query(ctx, `
+        SELECT promotion_id, item_id, price
+        FROM promotion_items
+        WHERE promotion_id = ANY($1::uuid[])
+        ORDER BY item_id`, ids)
+	if err != nil {
+		return fmt.Errorf("failed to list promotion items: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var promotionID string
+		var it domain.PromotionItem
+		if err := rows.Scan(&promotionID, &it.ItemID, &it.Price); err != nil {
+			return fmt.Errorf("failed to scan promotion item row: %w", err)
+		}
+		byID[promotionID].Items = append(byID[promotionID].Items, it)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating promotion item rows: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a promotion; its item prices go with it.
+func (r *pgPromotionRepository) Delete(ctx context.Context, id string) error {
+	commandTag, err := r.db.Exec(ctx, `DELETE FROM promotions WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete promotion: %w", err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: promotion with ID '%s'", domain.ErrRepositoryNotFound, id)
+	}
+	return nil
+}
+
+// ActiveForItems looks up the running promotion of each item; the lowest price wins on overlap.
+func (r *pgPromotionRepository) ActiveForItems(ctx context.Context, itemIDs []string, at time.Time) (map[string]*domain.ActivePromotion, error) {
+	active := make(map[string]*domain.ActivePromotion)
+	if len(itemIDs) == 0 {
+		return active, nil
+	}
+	rows, err := r.db.Query(ctx, `
+        SELECT DISTINCT ON (pi.item_id) pi.item_id, p.id, p.name, pi.price, p.ends_at
+        FROM promotion_items pi
+        JOIN promotions p ON p.id = pi.promotion_id
+        WHERE pi.item_id = ANY($1::uuid[]) AND p.starts_at <= $2 AND p.ends_at > $2
+        ORDER BY pi.item_id, pi.price ASC, p.ends_at ASC`, itemIDs, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up active promotions: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var itemID string
+		ap := &domain.ActivePromotion{}
+		if err := rows.Scan(&itemID, &ap.ID, &ap.Name, &ap.EffectivePrice, &ap.EndsAt); err != nil {
+			return nil, fmt.Errorf("failed to scan active promotion row: %w", err)
+		}
+		active[itemID] = ap
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating active promotion rows: %w", err)
+	}
+	return active, nil
+}
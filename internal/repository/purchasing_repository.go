@@ -0,0 +1,334 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"inventory-system/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type pgPurchasingRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPgPurchasingRepository creates a new PurchasingRepository backed by PostgreSQL.
+func NewPgPurchasingRepository(db *pgxpool.Pool) domain.PurchasingRepository {
+	return &pgPurchasingRepository{db: db}
+}
+
+// CreateSupplier inserts a supplier.
+func (r *pgPurchasingRepository) CreateSupplier(ctx context.Context, s *domain.Supplier) (*domain.Supplier, error) {
+	err := r.db.QueryRow(ctx, `
+        INSERT INTO suppliers (name, email)
+        VALUES ($1, $2)
+        RETURNING id, created_at, updated_at`,
+		s.Name, s.Email).Scan(&s.ID, &s.CreatedAt, &s.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" { // unique_violation: name in use
+			return nil, fmt.Errorf("%w: supplier '%s'", domain.ErrRepositoryDuplicateEntry, s.Name)
+		}
+		return nil, fmt.Errorf("failed to create supplier: %w", err)
+	}
+	return s, nil
+}
+
+// GetSupplier retrieves a supplier by ID.
+func (r *pgPurchasingRepository) GetSupplier(ctx context.Context, id string) (*domain.Supplier, error) {
+	s := &domain.Supplier{}
+	err := r.db.QueryRow(ctx, `
+        SELECT id, name, email, created_at, updated_at
+        FROM suppliers
+        WHERE id = $1`, id).Scan(&s.ID, &s.Name, &s.Email, &s.CreatedAt, &s.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: supplier with ID '%s'", domain.ErrRepositoryNotFound, id)
+		}
+		return nil, fmt.Errorf("failed to get supplier by ID '%s': %w", id, err)
+	}
+	return s, nil
+}
+
+// ListSuppliers returns every supplier, ordered by name.
+func (r *pgPurchasingRepository) ListSuppliers(ctx context.Context) ([]*domain.Supplier, error) {
+	rows, err := r.db.Query(ctx, `
+        SELECT id, name, email, created_at, updated_at
+        FROM suppliers
+        ORDER BY lower(name)`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list suppliers: %w", err)
+	}
+	defer rows.Close()
+
+	suppliers := []*domain.Supplier{}
+	for rows.Next() {
+		s := &domain.Supplier{}
+		if err := rows.Scan(&s.ID, &s.Name, &s.Email, &s.CreatedAt, &s.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan supplier row: %w", err)
+		}
+		suppliers = append(suppliers, s)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating supplier rows: %w", err)
+	}
+	return suppliers, nil
+}
+
+// CreateOrder stores a draft order with its lines.
+func (r *pgPurchasingRepository) CreateOrder(ctx context.Context, o *domain.PurchaseOrder) (*domain.PurchaseOrder, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin purchase order insert: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op after Commit
+
+	o.Status = domain.PurchaseOrderStatusDraft
+	if o.Number, err = nextDocumentNumber(ctx, tx, domain.DocumentTypePurchaseOrder); err != nil {
+		return nil, err
+	}
+	err = tx.QueryRow(ctx, `
+        INSERT INTO purchase_orders (number, supplier_id, status, created_by)
+        VALUES ($1, $2, $3, $4)
+        RETURNING id, created_at, updated_at`,
+		o.Number, o.SupplierID, o.Status, o.CreatedBy).Scan(&o.ID, &o.CreatedAt, &o.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation: unknown supplier
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrSupplierNotFound, o.SupplierID)
+		}
+		return nil, fmt.Errorf("failed to create purchase order: %w", err)
+	}
+	for _, line := range o.Lines {
+		_, err := tx.Exec(ctx, `
+            INSERT INTO purchase_order_lines (order_id, item_id, quantity_ordered, unit_cost)
+            VALUES ($1, $2, $3, $4)`,
+			o.ID, line.ItemID, line.QuantityOrdered, line.UnitCost)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation: unknown item
+				return nil, fmt.Errorf("%w: item with ID '%s'", domain.ErrRepositoryNotFound, line.ItemID)
+			}
+			return nil, fmt.Errorf("failed to add item '%s' to purchase order: %w", line.ItemID, err)
+		}
+	}
+	if err := r.loadLines(ctx, tx, o); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit purchase order: %w", err)
+	}
+	return o, nil
+}
+
+// GetOrder retrieves a purchase order with its lines.
+func (r *pgPurchasingRepository) GetOrder(ctx context.Context, id string) (*domain.PurchaseOrder, error) {
+	o, err := getPurchaseOrder(ctx, r.db, id, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := r.loadLines(ctx, r.db, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// ListOrders returns the latest purchase orders, optionally of one status or supplier, newest first.
+func (r *pgPurchasingRepository) ListOrders(ctx context.Context, q domain.ListPurchaseOrdersQuery) ([]*domain.PurchaseOrder, error) {
+	if q.Limit < 1 {
+		q.Limit = 50
+	}
+	rows, err := r.db.Query(ctx, `
+        SELECT id, number, supplier_id, status, created_by, created_at, updated_at
+        FROM purchase_orders
+        WHERE ($1 = '' OR status = $1)
+          AND ($2 = '' OR supplier_id::text = $2)
+        ORDER BY created_at DESC, id
+        LIMIT $3`, q.Status, q.Supplier, q.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list purchase orders: %w", err)
+	}
+	defer rows.Close()
+
+	orders := []*domain.PurchaseOrder{}
+	for rows.Next() {
+		o := &domain.PurchaseOrder{}
+		if err := rows.Scan(&o.ID, &o.Number, &o.SupplierID, &o.Status, &o.CreatedBy, &o.CreatedAt, &o.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan purchase order row: %w", err)
+		}
+		orders = append(orders, o)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating purchase order rows: %w", err)
+	}
+	for _, o := range orders {
+		if err := r.loadLines(ctx, r.db, o); err != nil {
+			return nil, err
+		}
+	}
+	return orders, nil
+}
+
+// Apply resolves action against the order's status while holding its row lock, then saves
+// the new status and records the transition.
+func (r *pgPurchasingRepository) Apply(ctx context.Context, id, action string, step domain.WorkflowStep) (*domain.PurchaseOrder, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin purchase order update: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op after Commit
+
+	o, err := getPurchaseOrder(ctx, tx, id, "FOR UPDATE")
+	if err != nil {
+		return nil, err
+	}
+	t, err := step(o.Status, action)
+	if err != nil {
+		return nil, err
+	}
+	if err := setPurchaseOrderStatus(ctx, tx, o, t); err != nil {
+		return nil, err
+	}
+	if err := r.loadLines(ctx, tx, o); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit purchase order update: %w", err)
+	}
+	return o, nil
+}
+
+// Receive books a delivery: it adds the quantities to stock, writing a movement for each
+// with the order ID as reference, and to the order's lines, while holding the row locks of
+// the order and all its items. The delivery is the complete action if nothing is
+// outstanding after it, and the receive action otherwise.
+func (r *pgPurchasingRepository) Receive(ctx context.Context, id string, lines []domain.ReceiptLine, userID string, step domain.WorkflowStep) (*domain.ReceivePurchaseOrderResult, error) {
+	var result *domain.ReceivePurchaseOrderResult
+	err := runAdjustmentTx(ctx, r.db, func(tx pgx.Tx) error {
+		o, err := getPurchaseOrder(ctx, tx, id, "FOR UPDATE")
+		if err != nil {
+			return err
+		}
+		if err := r.loadLines(ctx, tx, o); err != nil {
+			return err
+		}
+		outstanding := make(map[string]int, len(o.Lines))
+		left := 0 // Units outstanding after this delivery
+		for _, line := range o.Lines {
+			outstanding[line.ItemID] = line.QuantityOrdered - line.QuantityReceived
+			left += line.QuantityOrdered - line.QuantityReceived
+		}
+		ids := make([]string, 0, len(lines))
+		for _, line := range lines {
+			left, ok := outstanding[line.ItemID]
+			if !ok {
+				return fmt.Errorf("%w: item '%s' is not on order '%s'", domain.ErrOverReceipt, line.ItemID, id)
+			}
+			if line.Quantity > left {
+				return fmt.Errorf("%w: item '%s' has %d outstanding on order '%s', received %d",
+					domain.ErrOverReceipt, line.ItemID, left, id, line.Quantity)
+			}
+			ids = append(ids, line.ItemID)
+			left -= line.Quantity
+		}
+		action := domain.PurchaseOrderActionReceive
+		if left == 0 {
+			action = domain.PurchaseOrderActionComplete
+		}
+		t, err := step(o.Status, action)
+		if err != nil {
+			return err
+		}
+
+		// Lock every item up front, in ID order, like the other multi-item adjustments.
+		if _, err := lockStock(ctx, tx, ids); err != nil {
+			return err
+		}
+		result = &domain.ReceivePurchaseOrderResult{Order: o}
+		for _, line := range lines {
+			m := &domain.StockMovement{ItemID: line.ItemID, Delta: line.Quantity,
+				Reason: domain.MovementReasonReceive, Reference: id, MovedBy: userID}
+			item, err := adjustStock(ctx, tx, m)
+			if err != nil {
+				return err
+			}
+			_, err = tx.Exec(ctx, `
+                UPDATE purchase_order_lines
+                SET quantity_received = quantity_received + $1
+                WHERE order_id = $2 AND item_id = $3`, line.Quantity, id, line.ItemID)
+			if err != nil {
+				return fmt.Errorf("failed to record receipt of item '%s': %w", line.ItemID, err)
+			}
+			result.Items = append(result.Items, item)
+			result.Movements = append(result.Movements, m)
+		}
+
+		if err := setPurchaseOrderStatus(ctx, tx, o, t); err != nil {
+			return err
+		}
+		return r.loadLines(ctx, tx, o)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// setPurchaseOrderStatus moves o, locked by the caller, to the target state of t and records t.
+func setPurchaseOrderStatus(ctx context.Context, q querier, o *domain.PurchaseOrder, t *domain.WorkflowTransition) error {
+	err := q.QueryRow(ctx, `
+        UPDATE purchase_orders
+        SET status = $1
+        WHERE id = $2
+        RETURNING status, updated_at`, t.ToState, o.ID).Scan(&o.Status, &o.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update status of purchase order '%s': %w", o.ID, err)
+	}
+	return recordTransition(ctx, q, t)
+}
+
+// getPurchaseOrder reads an order without its lines; lock is appended to the query.
+func getPurchaseOrder(ctx context.Context, q querier, id, lock string) (*domain.PurchaseOrder, error) {
+	o := &domain.PurchaseOrder{}
+	err := q.QueryRow(ctx, `
+        SELECT id, number, supplier_id, status, created_by, created_at, updated_at
+        FROM purchase_orders
+        WHERE id = $1 `+lock, id).Scan(&o.ID, &o.Number, &o.SupplierID, &o.Status, &o.CreatedBy, &o.CreatedAt, &o.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: purchase order with ID '%s'", domain.ErrRepositoryNotFound, id)
+		}
+		return nil, fmt.Errorf("failed to get purchase order by ID '%s': %w", id, err)
+	}
+	return o, nil
+}
+
+// loadLines fills in the lines of o.
+func (r *pgPurchasingRepository) loadLines(ctx context.Context, q querier, o *domain.PurchaseOrder) error {
+	rows, err := q.Query(ctx, `
+        SELECT item_id, quantity_ordered, quantity_received, unit_cost
+        FROM purchase_order_lines
+        WHERE order_id = $1
+        ORDER BY item_id`, o.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list lines of purchase order '%s': %w", o.ID, err)
+	}
+	defer rows.Close()
+
+	o.Lines = []domain.PurchaseOrderLine{}
+	for rows.Next() {
+		var line domain.PurchaseOrderLine
+		if err := rows.Scan(&line.ItemID, &line.QuantityOrdered, &line.QuantityReceived, &line.UnitCost); err != nil {
+			return fmt.Errorf("failed to scan purchase order line: %w", err)
+		}
+		o.Lines = append(o.Lines, line)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating purchase order lines: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,290 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"inventory-system/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type pgSalesOrderRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPgSalesOrderRepository creates a new SalesOrderRepository backed by PostgreSQL.
+func NewPgSalesOrderRepository(db *pgxpool.Pool) domain.SalesOrderRepository {
+	return &pgSalesOrderRepository{db: db}
+}
+
+// Create stores an open order after checking, with its items locked, that their available
+// quantity covers every line.
+func (r *pgSalesOrderRepository) Create(ctx context.Context, o *domain.SalesOrder) (*domain.SalesOrder, error) {
+	err := runAdjustmentTx(ctx, r.db, func(tx pgx.Tx) error {
+		ids := make([]string, 0, len(o.Lines))
+		for _, line := range o.Lines {
+			ids = append(ids, line.ItemID)
+		}
+		// Holding the item locks keeps concurrent orders for the same items from both
+		// reserving the last units.
+		stocks, err := lockStock(ctx, tx, ids)
+		if err != nil {
+			return err
+		}
+		reserved, err := reservedStock(ctx, tx, ids)
+		if err != nil {
+			return err
+		}
+		for _, line := range o.Lines {
+			s, ok := stocks[line.ItemID]
+			if !ok {
+				return fmt.Errorf("%w: item with ID '%s'", domain.ErrRepositoryNotFound, line.ItemID)
+			}
+			if available := s.quantity - reserved[line.ItemID]; available < line.Quantity {
+				return fmt.Errorf("%w: item '%s' has %d available, order needs %d",
+					domain.ErrInsufficientStock, s.sku, max(available, 0), line.Quantity)
+			}
+		}
+
+		o.Status = domain.SalesOrderStatusOpen
+		if o.Number, err = nextDocumentNumber(ctx, tx, domain.DocumentTypeSalesOrder); err != nil {
+			return err
+		}
+		err = tx.QueryRow(ctx, `
+            INSERT INTO sales_orders (number, customer_id, status, created_by)
+            VALUES ($1, $2, $3, $4)
+            RETURNING id, created_at`,
+			o.Number, o.CustomerID, o.Status, o.CreatedBy).Scan(&o.ID, &o.CreatedAt)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23503" { // foreign_key_violation: unknown customer
+				return fmt.Errorf("%w: ID %s", domain.ErrCustomerNotFound, *o.CustomerID)
+			}
+			return fmt.Errorf("failed to create sales order: %w", err)
+		}
+		for _, line := range o.Lines {
+			_, err := tx.Exec(ctx, `
+                INSERT INTO sales_order_lines (order_id, item_id, quantity, unit_price)
+                VALUES ($1, $2, $3, $4)`,
+				o.ID, line.ItemID, line.Quantity, line.UnitPrice)
+			if err != nil {
+				return fmt.Errorf("failed to add item '%s' to sales order: %w", line.ItemID, err)
+			}
+		}
+		return r.loadLines(ctx, tx, o)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// GetByID retrieves a sales order with its lines.
+func (r *pgSalesOrderRepository) GetByID(ctx context.Context, id string) (*domain.SalesOrder, error) {
+	o, err := getSalesOrder(ctx, r.db, id, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := r.loadLines(ctx, r.db, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// List returns the latest sales orders, optionally of one status or customer, newest first.
+func (r *pgSalesOrderRepository) List(ctx context.Context, q domain.ListSalesOrdersQuery) ([]*domain.SalesOrder, error) {
+	if q.Limit < 1 {
+		q.Limit = 50
+	}
+	rows, err := r.db.Query(ctx, `
+        SELECT id, number, customer_id, status, created_by, created_at, closed_by, closed_at
+        FROM sales_orders
+        WHERE ($1 = '' OR status = $1)
+          AND ($2 = '' OR customer_id::text = $2)
+        ORDER BY created_at DESC, id
+        LIMIT $3`, q.Status, q.Customer, q.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sales orders: %w", err)
+	}
+	defer rows.Close()
+
+	orders := []*domain.SalesOrder{}
+	for rows.Next() {
+		o := &domain.SalesOrder{}
+		if err := rows.Scan(&o.ID, &o.Number, &o.CustomerID, &o.Status, &o.CreatedBy, &o.CreatedAt, &o.ClosedBy, &o.ClosedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan sales order row: %w", err)
+		}
+		orders = append(orders, o)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating sales order rows: %w", err)
+	}
+	for _, o := range orders {
+		if err := r.loadLines(ctx, r.db, o); err != nil {
+			return nil, err
+		}
+	}
+	return orders, nil
+}
+
+// Fulfill deducts the order's lines from stock, writing a movement for each with the order ID
+// as reference, and closes the order.
+func (r *pgSalesOrderRepository) Fulfill(ctx context.Context, id, userID string) (*domain.FulfillSalesOrderResult, error) {
+	var result *domain.FulfillSalesOrderResult
+	err := runAdjustmentTx(ctx, r.db, func(tx pgx.Tx) error {
+		o, err := getSalesOrder(ctx, tx, id, "FOR UPDATE")
+		if err != nil {
+			return err
+		}
+		if o.Status != domain.SalesOrderStatusOpen {
+			return fmt.Errorf("%w: order '%s' is %s", domain.ErrSalesOrderClosed, id, o.Status)
+		}
+		if err := r.loadLines(ctx, tx, o); err != nil {
+			return err
+		}
+
+		ids := make([]string, 0, len(o.Lines))
+		for _, line := range o.Lines {
+			ids = append(ids, line.ItemID)
+		}
+		if _, err := lockStock(ctx, tx, ids); err != nil {
+			return err
+		}
+		// Closing the order first releases its reservation, so adjustStock lets the lines take
+		// the units it held while still keeping those of other open orders.
+		if err := closeSalesOrder(ctx, tx, o, domain.SalesOrderStatusFulfilled, userID); err != nil {
+			return err
+		}
+		result = &domain.FulfillSalesOrderResult{Order: o}
+		for _, line := range o.Lines {
+			m := &domain.StockMovement{ItemID: line.ItemID, Delta: -line.Quantity,
+				Reason: domain.MovementReasonSale, Reference: id, MovedBy: userID}
+			item, err := adjustStock(ctx, tx, m)
+			if err != nil {
+				return err
+			}
+			result.Items = append(result.Items, item)
+			result.Movements = append(result.Movements, m)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Cancel closes an open order, releasing its reservation.
+func (r *pgSalesOrderRepository) Cancel(ctx context.Context, id, userID string) (*domain.SalesOrder, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin sales order cancellation: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op after Commit
+
+	o, err := getSalesOrder(ctx, tx, id, "FOR UPDATE")
+	if err != nil {
+		return nil, err
+	}
+	if o.Status != domain.SalesOrderStatusOpen {
+		return nil, fmt.Errorf("%w: order '%s' is %s", domain.ErrSalesOrderClosed, id, o.Status)
+	}
+	if err := closeSalesOrder(ctx, tx, o, domain.SalesOrderStatusCancelled, userID); err != nil {
+		return nil, err
+	}
+	if err := r.loadLines(ctx, tx, o); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit sales order cancellation: %w", err)
+	}
+	return o, nil
+}
+
+// reservedStock returns the quantities on open sales order lines for the given items, by ID.
+func reservedStock(ctx context.Context, q querier, ids []string) (map[string]int, error) {
+	rows, err := q.Query(ctx, `
+        SELECT l.item_id, SUM(l.quantity)
+        FROM sales_order_lines l
+        JOIN sales_orders o ON o.id = l.order_id
+        WHERE o.status = $1 AND l.item_id = ANY($2::uuid[])
+        GROUP BY l.item_id`, domain.SalesOrderStatusOpen, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum reserved stock: %w", err)
+	}
+	defer rows.Close()
+
+	reserved := make(map[string]int, len(ids))
+	for rows.Next() {
+		var id string
+		var quantity int
+		if err := rows.Scan(&id, &quantity); err != nil {
+			return nil, fmt.Errorf("failed to scan reserved stock row: %w", err)
+		}
+		reserved[id] = quantity
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating reserved stock rows: %w", err)
+	}
+	return reserved, nil
+}
+
+// getSalesOrder reads an order without its lines; lock is appended to the query.
+func getSalesOrder(ctx context.Context, q querier, id, lock string) (*domain.SalesOrder, error) {
+	o := &domain.SalesOrder{}
+	err := q.QueryRow(ctx, `
+        SELECT id, number, customer_id, status, created_by, created_at, closed_by, closed_at
+        FROM sales_orders
+        WHERE id = $1 `+lock, id).Scan(&o.ID, &o.Number, &o.CustomerID, &o.Status, &o.CreatedBy, &o.CreatedAt, &o.ClosedBy, &o.ClosedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: sales order with ID '%s'", domain.ErrRepositoryNotFound, id)
+		}
+		return nil, fmt.Errorf("failed to get sales order by ID '%s': %w", id, err)
+	}
+	return o, nil
+}
+
+// closeSalesOrder moves o to status, recording who closed it.
+func closeSalesOrder(ctx context.Context, tx pgx.Tx, o *domain.SalesOrder, status, userID string) error {
+	err := tx.QueryRow(ctx, `
+        UPDATE sales_orders
+        SET status = $1, closed_by = $2, closed_at = NOW()
+        WHERE id = $3
+        RETURNING status, closed_by, closed_at`,
+		status, userID, o.ID).Scan(&o.Status, &o.ClosedBy, &o.ClosedAt)
+	if err != nil {
+		return fmt.Errorf("failed to close sales order '%s': %w", o.ID, err)
+	}
+	return nil
+}
+
+// loadLines fills in the lines of o.
+func (r *pgSalesOrderRepository) loadLines(ctx context.Context, q querier, o *domain.SalesOrder) error {
+	rows, err := q.Query(ctx, `
+        SELECT item_id, quantity, unit_price
+        FROM sales_order_lines
+        WHERE order_id = $1
+        ORDER BY item_id`, o.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list lines of sales order '%s': %w", o.ID, err)
+	}
+	defer rows.Close()
+
+	o.Lines = []domain.SalesOrderLine{}
+	for rows.Next() {
+		var line domain.SalesOrderLine
+		if err := rows.Scan(&line.ItemID, &line.Quantity, &line.UnitPrice); err != nil {
+			return fmt.Errorf("failed to scan sales order line: %w", err)
+		}
+		o.Lines = append(o.Lines, line)
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating sales order lines: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"expvar"
+	"log"
+	"reflect"
+	"sync"
+	"time"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/requestid"
+)
+
+// shadowTimeout bounds a candidate query; shadow work must never pile up behind a slow rewrite.
+const shadowTimeout = 5 * time.Second
+
+// shadowMetrics are published through expvar under "shadow_reads", one map per method:
+// calls, mismatches, candidate_errors and the total latency of both implementations.
+var (
+	shadowMetrics   = expvar.NewMap("shadow_reads")
+	shadowMetricsMu sync.Mutex
+)
+
+// shadowItemRepository serves every call from the primary repository. For read methods, while
+// enabled reports true, it also runs the same call against the candidate in the background and
+// records whether the two agree, so a redesigned query can be checked against production
+// traffic before it replaces the original. Responses never depend on the candidate.
+//
+// Writes go to the primary only: running a write twice would apply it twice.
+type shadowItemRepository struct {
+	domain.ItemRepository // Primary; also serves the methods not overridden here
+	candidate             domain.ItemRepository
+	enabled               func(ctx context.Context) bool
+}
+
+// NewShadowItemRepository wraps primary so that its reads are shadowed by candidate.
+func NewShadowItemRepository(primary, candidate domain.ItemRepository, enabled func(ctx context.Context) bool) domain.ItemRepository {
+	return &shadowItemRepository{ItemRepository: primary, candidate: candidate, enabled: enabled}
+}
+
+func (r *shadowItemRepository) GetByID(ctx context.Context, id string) (*domain.Item, error) {
+	start := time.Now()
+	item, err := r.ItemRepository.GetByID(ctx, id)
+	r.shadow(ctx, "GetByID", time.Since(start), result{item, err}, func(ctx context.Context) result {
+		item, err := r.candidate.GetByID(ctx, id)
+		return result{item, err}
+	})
+	return item, err
+}
+
+func (r *shadowItemRepository) GetAll(ctx context.Context, filter domain.ItemFilter, page, limit int) ([]*domain.Item, int, error) {
+	start := time.Now()
+	items, total, err := r.ItemRepository.GetAll(ctx, filter, page, limit)
+	r.shadow(ctx, "GetAll", time.Since(start), result{[]any{items, total}, err}, func(ctx context.Context) result {
+		items, total, err := r.candidate.GetAll(ctx, filter, page, limit)
+		return result{[]any{items, total}, err}
+	})
+	return items, total, err
+}
+
+func (r *shadowItemRepository) ListOptions(ctx context.Context) ([]domain.ItemOption, error) {
+	start := time.Now()
+	options, err := r.ItemRepository.ListOptions(ctx)
+	r.shadow(ctx, "ListOptions", time.Since(start), result{options, err}, func(ctx context.Context) result {
+		options, err := r.candidate.ListOptions(ctx)
+		return result{options, err}
+	})
+	return options, err
+}
+
+func (r *shadowItemRepository) GetTotalStockValue(ctx context.Context) (float64, error) {
+	start := time.Now()
+	value, err := r.ItemRepository.GetTotalStockValue(ctx)
+	r.shadow(ctx, "GetTotalStockValue", time.Since(start), result{value, err}, func(ctx context.Context) result {
+		value, err := r.candidate.GetTotalStockValue(ctx)
+		return result{value, err}
+	})
+	return value, err
+}
+
+func (r *shadowItemRepository) GetLowStockItems(ctx context.Context, globalThreshold int) ([]*domain.Item, error) {
+	start := time.Now()
+	items, err := r.ItemRepository.GetLowStockItems(ctx, globalThreshold)
+	r.shadow(ctx, "GetLowStockItems", time.Since(start), result{items, err}, func(ctx context.Context) result {
+		items, err := r.candidate.GetLowStockItems(ctx, globalThreshold)
+		return result{items, err}
+	})
+	return items, err
+}
+
+func (r *shadowItemRepository) GetMostValuableItems(ctx context.Context, limit int) ([]*domain.Item, error) {
+	start := time.Now()
+	items, err := r.ItemRepository.GetMostValuableItems(ctx, limit)
+	r.shadow(ctx, "GetMostValuableItems", time.Since(start), result{items, err}, func(ctx context.Context) result {
+		items, err := r.candidate.GetMostValuableItems(ctx, limit)
+		return result{items, err}
+	})
+	return items, err
+}
+
+// result is the outcome of one repository call, in a form that can be compared.
+type result struct {
+	value any
+	err   error
+}
+
+// shadow runs candidate in the background and compares its outcome with the primary's.
+func (r *shadowItemRepository) shadow(ctx context.Context, method string, primaryLatency time.Duration, primary result,
+	candidate func(ctx context.Context) result) {
+	if !r.enabled(ctx) {
+		return
+	}
+	m := shadowMetricsFor(method)
+	m.Add("calls", 1)
+	m.Add("primary_ms_total", primaryLatency.Milliseconds())
+
+	// Detached from the request, which may finish long before the candidate does.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shadowTimeout)
+	go func() {
+		defer cancel()
+		start := time.Now()
+		got := candidate(ctx)
+		m.Add("candidate_ms_total", time.Since(start).Milliseconds())
+
+		switch {
+		case got.err != nil && primary.err == nil:
+			m.Add("candidate_errors", 1)
+			log.Printf("Shadow %s: candidate failed where primary succeeded (request_id=%s): %v",
+				method, requestid.FromContext(ctx), got.err)
+		case (got.err == nil) != (primary.err == nil) || !reflect.DeepEqual(got.value, primary.value):
+			m.Add("mismatches", 1)
+			log.Printf("Shadow %s: results diverge (request_id=%s): primary=%+v (err %v), candidate=%+v (err %v)",
+				method, requestid.FromContext(ctx), primary.value, primary.err, got.value, got.err)
+		}
+	}()
+}
+
+// shadowMetricsFor returns the counters for method, creating them on first use.
+func shadowMetricsFor(method string) *expvar.Map {
+	shadowMetricsMu.Lock()
+	defer shadowMetricsMu.Unlock()
+	if v, ok := shadowMetrics.Get(method).(*expvar.Map); ok {
+		return v
+	}
+	m := new(expvar.Map).Init()
+	shadowMetrics.Set(method, m)
+	return m
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"expvar"
+	"testing"
+	"time"
+
+	"inventory-system/internal/domain"
+)
+
+// fakeValueRepo answers GetTotalStockValue with a fixed value.
+type fakeValueRepo struct {
+	domain.ItemRepository
+	value float64
+}
+
+func (f *fakeValueRepo) GetTotalStockValue(ctx context.Context) (float64, error) {
+	return f.value, nil
+}
+
+func shadowCounter(method, name string) int64 {
+	if v, ok := shadowMetricsFor(method).Get(name).(*expvar.Int); ok {
+		return v.Value()
+	}
+	return 0
+}
+
+// waitForCounter polls until the counter reaches want, as the comparison runs in the background.
+func waitForCounter(t *testing.T, method, name string, want int64) {
+	t.Helper()
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		if shadowCounter(method, name) >= want {
+			return
+		}
+	}
+	t.Fatalf("%s %s = %d, want %d", method, name, shadowCounter(method, name), want)
+}
+
+func TestShadowItemRepository(t *testing.T) {
+	const method = "GetTotalStockValue"
+	enabled := true
+	shadowed := func(candidate float64) float64 {
+		t.Helper()
+		repo := NewShadowItemRepository(&fakeValueRepo{value: 10}, &fakeValueRepo{value: candidate},
+			func(context.Context) bool { return enabled })
+		got, err := repo.GetTotalStockValue(context.Background())
+		if err != nil {
+			t.Fatalf("GetTotalStockValue: %v", err)
+		}
+		return got
+	}
+
+	// Agreeing candidate: counted, no mismatch.
+	calls, mismatches := shadowCounter(method, "calls"), shadowCounter(method, "mismatches")
+	if got := shadowed(10); got != 10 {
+		t.Errorf("value = %v, want 10", got)
+	}
+	if shadowCounter(method, "calls") != calls+1 {
+		t.Errorf("calls not counted")
+	}
+
+	// Diverging candidate: the primary's value is still returned and the mismatch is counted.
+	if got := shadowed(11); got != 10 {
+		t.Errorf("value = %v, want the primary's 10", got)
+	}
+	waitForCounter(t, method, "mismatches", mismatches+1)
+	if got := shadowCounter(method, "mismatches"); got != mismatches+1 {
+		t.Errorf("mismatches = %d, want %d (the agreeing candidate must not count)", got, mismatches+1)
+	}
+
+	// Disabled: the candidate does not run.
+	enabled = false
+	calls = shadowCounter(method, "calls")
+	shadowed(11)
+	if shadowCounter(method, "calls") != calls {
+		t.Error("candidate ran while shadowing was disabled")
+	}
+}
@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"inventory-system/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type pgStockAdjustmentRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPgStockAdjustmentRepository creates a new StockAdjustmentRepository backed by PostgreSQL.
+func NewPgStockAdjustmentRepository(db *pgxpool.Pool) domain.StockAdjustmentRepository {
+	return &pgStockAdjustmentRepository{db: db}
+}
+
+// ApplyBatch locks every item the batch touches, applies the lines in order and records them,
+// all in one transaction, so a batch is applied completely or not at all.
+func (r *pgStockAdjustmentRepository) ApplyBatch(ctx context.Context, batchID string, lines []domain.StockAdjustmentLine,
+	adjustedBy string) ([]domain.StockAdjustmentResult, error) {
+	ids := make([]string, 0, len(lines))
+	for _, l := range lines {
+		ids = append(ids, l.ItemID)
+	}
+
+	var results []domain.StockAdjustmentResult
+	err := runAdjustmentTx(ctx, r.db, func(tx pgx.Tx) error {
+		stocks, err := lockStock(ctx, tx, ids)
+		if err != nil {
+			return err
+		}
+		reserved, err := reservedStock(ctx, tx, ids)
+		if err != nil {
+			return err
+		}
+		quantityBefore := make(map[string]int, len(stocks))
+		for id, s := range stocks {
+			quantityBefore[id] = s.quantity
+		}
+
+		results = make([]domain.StockAdjustmentResult, len(lines))
+		rejected := false
+		for i, l := range lines {
+			res := domain.StockAdjustmentResult{Line: i, ItemID: l.ItemID, Delta: l.Delta, ReasonCode: l.ReasonCode}
+			s, ok := stocks[l.ItemID]
+			switch {
+			case !ok:
+				res.Status = domain.AdjustmentStatusNotFound
+				rejected = true
+			case l.Delta < 0 && s.quantity+l.Delta < reserved[l.ItemID]: // Units on open sales orders are held for them
+				res.SKU, res.QuantityBefore, res.QuantityAfter = s.sku, s.quantity, s.quantity
+				res.Status = domain.AdjustmentStatusInsufficientStock
+				rejected = true
+			default:
+				res.SKU, res.QuantityBefore = s.sku, s.quantity
+				s.quantity += l.Delta
+				res.QuantityAfter = s.quantity
+				res.Status = domain.AdjustmentStatusApplied
+			}
+			results[i] = res
+		}
+		if rejected {
+			return fmt.Errorf("%w: batch %s", domain.ErrBatchRejected, batchID)
+		}
+
+		revisions := make([]*domain.ItemRevision, 0, len(stocks))
+		for id, s := range stocks {
+			// Lines on the same item may cancel out. Writing the row anyway would bump its
+			// version and fail the next If-Match edit for nothing.
+			if s.quantity == quantityBefore[id] {
+				continue
+			}
+			if _, err := tx.Exec(ctx, `UPDATE items SET quantity = $1 WHERE id = $2`, s.quantity, id); err != nil {
+				return fmt.Errorf("failed to update quantity of item '%s': %w", id, err)
+			}
+			revisions = append(revisions, newRevision(id, domain.ItemRevisionUpdated, quantityChange(quantityBefore[id], s.quantity), adjustedBy))
+		}
+		if err := recordRevisions(ctx, tx, revisions...); err != nil {
+			return err
+		}
+		for i, l := range lines {
+			_, err := tx.Exec(ctx, `
+                INSERT INTO stock_adjustments (batch_id, item_id, delta, quantity_after, reason_code, note, adjusted_by)
+                VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+				batchID, l.ItemID, l.Delta, results[i].QuantityAfter, l.ReasonCode, l.Note, adjustedBy)
+			if err != nil {
+				return fmt.Errorf("failed to record stock adjustment of item '%s': %w", l.ItemID, err)
+			}
+			movement := &domain.StockMovement{
+				ItemID:        l.ItemID,
+				Delta:         l.Delta,
+				QuantityAfter: results[i].QuantityAfter,
+				Reason:        l.ReasonCode,
+				Reference:     batchID,
+				Note:          l.Note,
+				MovedBy:       adjustedBy,
+			}
+			if err := recordMovement(ctx, tx, movement); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, domain.ErrBatchRejected) {
+		return results, err
+	}
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// lockedStock is the quantity of an item row locked for adjustment.
+type lockedStock struct {
+	sku      string
+	quantity int
+}
+
+// lockStock locks the given items FOR UPDATE and returns their stock by ID; missing items
+// are left out. Rows are locked in ID order so transactions touching overlapping sets of
+// items acquire their locks in the same order and do not deadlock each other.
+func lockStock(ctx context.Context, tx pgx.Tx, ids []string) (map[string]*lockedStock, error) {
+	rows, err := tx.Query(ctx, `
+        SELECT id, sku, quantity
+        FROM items
+        WHERE id = ANY($1::uuid[]) AND deleted_at IS NULL
+        ORDER BY id
+        FOR UPDATE`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock items for stock adjustment: %w", err)
+	}
+	defer rows.Close()
+
+	stocks := make(map[string]*lockedStock, len(ids))
+	for rows.Next() {
+		var id string
+		s := &lockedStock{}
+		if err := rows.Scan(&id, &s.sku, &s.quantity); err != nil {
+			return nil, fmt.Errorf("failed to scan item for stock adjustment: %w", err)
+		}
+		stocks[id] = s
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating items for stock adjustment: %w", err)
+	}
+	return stocks, nil
+}
@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"inventory-system/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type pgStockMovementRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPgStockMovementRepository creates a new StockMovementRepository backed by PostgreSQL.
+func NewPgStockMovementRepository(db *pgxpool.Pool) domain.StockMovementRepository {
+	return &pgStockMovementRepository{db: db}
+}
+
+// Adjust applies m to its item and records it while holding the item's row lock.
+func (r *pgStockMovementRepository) Adjust(ctx context.Context, m *domain.StockMovement) (*domain.Item, *domain.StockMovement, error) {
+	var item *domain.Item
+	err := runAdjustmentTx(ctx, r.db, func(tx pgx.Tx) error {
+		var err error
+		item, err = adjustStock(ctx, tx, m)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return item, m, nil
+}
+
+// ListByItem returns the item's latest movements, newest first.
+func (r *pgStockMovementRepository) ListByItem(ctx context.Context, itemID string, limit int) ([]*domain.StockMovement, error) {
+	if limit < 1 {
+		limit = 100
+	}
+	rows, err := r.db.Query(ctx, `
+        SELECT id, item_id, delta, quantity_after, reason, reference, note, moved_by, moved_at
+        FROM stock_movements
+        WHERE item_id = $1
+        ORDER BY moved_at DESC, id DESC
+        LIMIT $2`, itemID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stock movements of item '%s': %w", itemID, err)
+	}
+	defer rows.Close()
+
+	movements := []*domain.StockMovement{}
+	for rows.Next() {
+		m := &domain.StockMovement{}
+		if err := rows.Scan(&m.ID, &m.ItemID, &m.Delta, &m.QuantityAfter, &m.Reason, &m.Reference, &m.Note,
+			&m.MovedBy, &m.MovedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan stock movement row: %w", err)
+		}
+		movements = append(movements, m)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating stock movement rows: %w", err)
+	}
+	return movements, nil
+}
+
+// ListSince returns the movements made at or after since, oldest first. Reconciliation
+// entries are left out: they correct the ledger, not the stock.
+func (r *pgStockMovementRepository) ListSince(ctx context.Context, since time.Time) ([]*domain.StockMovement, error) {
+	rows, err := r.db.Query(ctx, `
+        SELECT id, item_id, delta, quantity_after, reason, reference, note, moved_by, moved_at
+        FROM stock_movements
+        WHERE moved_at >= $1 AND reason <> $2
+        ORDER BY moved_at, id`, since, domain.MovementReasonReconcile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stock movements since %s: %w", since.Format(time.RFC3339), err)
+	}
+	defer rows.Close()
+
+	movements := []*domain.StockMovement{}
+	for rows.Next() {
+		m := &domain.StockMovement{}
+		if err := rows.Scan(&m.ID, &m.ItemID, &m.Delta, &m.QuantityAfter, &m.Reason, &m.Reference, &m.Note,
+			&m.MovedBy, &m.MovedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan stock movement row: %w", err)
+		}
+		movements = append(movements, m)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating stock movement rows: %w", err)
+	}
+	return movements, nil
+}
+
+// RemovalStats summarises the quantities removed from each item in [from, to) by movements
+// with one of the given reasons.
+func (r *pgStockMovementRepository) RemovalStats(ctx context.Context, from, to time.Time, reasons []string) (map[string]domain.AdjustmentStats, error) {
+	return r.stats(ctx, "removal", `
+        SELECT item_id::text, COUNT(*), AVG(-delta), COALESCE(STDDEV_POP(-delta), 0)
+        FROM stock_movements
+        WHERE delta < 0 AND moved_at >= $1 AND moved_at < $2 AND reason = ANY($3)
+        GROUP BY item_id`, from, to, reasons)
+}
+
+// DailyVolumeStats summarises how many movements each user made per active day in [from, to).
+// Days are UTC days; movements that did not carry a user are left out.
+func (r *pgStockMovementRepository) DailyVolumeStats(ctx context.Context, from, to time.Time) (map[string]domain.AdjustmentStats, error) {
+	return r.stats(ctx, "daily volume", `
+        SELECT moved_by, COUNT(*), AVG(lines), COALESCE(STDDEV_POP(lines), 0)
+        FROM (
+            SELECT moved_by, COUNT(*) AS lines
+            FROM stock_movements
+            WHERE moved_at >= $1 AND moved_at < $2 AND reason <> $3 AND moved_by <> ''
+            GROUP BY moved_by, DATE_TRUNC('day', moved_at AT TIME ZONE 'UTC')
+        ) AS days
+        GROUP BY moved_by`, from, to, domain.MovementReasonReconcile)
+}
+
+// stats runs a query returning (key, count, mean, standard deviation) rows.
+func (r *pgStockMovementRepository) stats(ctx context.Context, what, query string, args ...any) (map[string]domain.AdjustmentStats, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute stock movement %s stats: %w", what, err)
+	}
+	defer rows.Close()
+
+	stats := make(map[string]domain.AdjustmentStats)
+	for rows.Next() {
+		var key string
+		var s domain.AdjustmentStats
+		if err := rows.Scan(&key, &s.Count, &s.Mean, &s.StdDev); err != nil {
+			return nil, fmt.Errorf("failed to scan stock movement %s stats: %w", what, err)
+		}
+		stats[key] = s
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating stock movement %s stats: %w", what, err)
+	}
+	return stats, nil
+}
+
+// adjustStock locks m's item, adds m.Delta to its quantity and records m and a revision of
+// the item, all within tx.
+// It fills in m.QuantityAfter and returns the updated item. Units on open sales orders are
+// held for them: a removal may not take them, so fulfilling an order closes it first.
+func adjustStock(ctx context.Context, tx pgx.Tx, m *domain.StockMovement) (*domain.Item, error) {
+	stocks, err := lockStock(ctx, tx, []string{m.ItemID})
+	if err != nil {
+		return nil, err
+	}
+	s, ok := stocks[m.ItemID]
+	if !ok {
+		return nil, fmt.Errorf("%w: item with ID '%s'", domain.ErrRepositoryNotFound, m.ItemID)
+	}
+	if m.Delta < 0 {
+		reserved, err := reservedStock(ctx, tx, []string{m.ItemID})
+		if err != nil {
+			return nil, err
+		}
+		if available := s.quantity - reserved[m.ItemID]; available+m.Delta < 0 {
+			return nil, fmt.Errorf("%w: item '%s' has %d available, cannot remove %d",
+				domain.ErrInsufficientStock, m.ItemID, max(available, 0), -m.Delta)
+		}
+	}
+
+	item := &domain.Item{}
+	err = tx.QueryRow(ctx, `
+        UPDATE items
+        SET quantity = quantity + $1
+        WHERE id = $2
+        RETURNING id, sku, name, description, quantity, price, low_stock_threshold, created_at, updated_at, version, category_id`,
+		m.Delta, m.ItemID).Scan(
+		&item.ID,
+		&item.SKU,
+		&item.Name,
+		&item.Description,
+		&item.Quantity,
+		&item.Price,
+		&item.LowStockThreshold,
+		&item.CreatedAt,
+		&item.UpdatedAt,
+		&item.Version,
+		&item.CategoryID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to adjust quantity of item '%s': %w", m.ItemID, err)
+	}
+	m.QuantityAfter = item.Quantity
+	if err := recordMovement(ctx, tx, m); err != nil {
+		return nil, err
+	}
+	if err := recordRevisions(ctx, tx, newRevision(m.ItemID, domain.ItemRevisionUpdated, quantityChange(s.quantity, item.Quantity), m.MovedBy)); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// recordMovement writes m to the ledger within tx, the transaction that changed the quantity,
+// and fills in its ID and time.
+func recordMovement(ctx context.Context, tx pgx.Tx, m *domain.StockMovement) error {
+	err := tx.QueryRow(ctx, `
+        INSERT INTO stock_movements (item_id, delta, quantity_after, reason, reference, note, moved_by)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        RETURNING id, moved_at`,
+		m.ItemID, m.Delta, m.QuantityAfter, m.Reason, m.Reference, m.Note, m.MovedBy).Scan(&m.ID, &m.MovedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record stock movement of item '%s': %w", m.ItemID, err)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"inventory-system/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type pgTaxRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPgTaxRepository creates a new TaxRepository backed by PostgreSQL.
+func NewPgTaxRepository(db *pgxpool.Pool) domain.TaxRepository {
+	return &pgTaxRepository{db: db}
+}
+
+// ListRates returns all tax rates, ordered by jurisdiction and tax code.
+func (r *pgTaxRepository) ListRates(ctx context.Context) ([]*domain.TaxRate, error) {
+	rows, err := r.db.Query(ctx, `
+        SELECT tax_code, jurisdiction, rate_percent, updated_at
+        FROM tax_rates
+        ORDER BY jurisdiction, tax_code`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tax rates: %w", err)
+	}
+	defer rows.Close()
+
+	rates := []*domain.TaxRate{}
+	for rows.Next() {
+		tr := &domain.TaxRate{}
+		if err := rows.Scan(&tr.TaxCode, &tr.Jurisdiction, &tr.RatePercent, &tr.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan tax rate row: %w", err)
+		}
+		rates = append(rates, tr)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tax rate rows: %w", err)
+	}
+	return rates, nil
+}
+
+// GetRates returns the rates of the given tax codes in a jurisdiction, by code.
+func (r *pgTaxRepository) GetRates(ctx context.Context, jurisdiction string, codes []string) (map[string]float64, error) {
+	rows, err := r.db.Query(ctx, `
+        SELECT tax_code, rate_percent
+        FROM tax_rates
+        WHERE jurisdiction = $1 AND tax_code = ANY($2)`, jurisdiction, codes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tax rates in '%s': %w", jurisdiction, err)
+	}
+	defer rows.Close()
+
+	rates := make(map[string]float64, len(codes))
+	for rows.Next() {
+		var code string
+		var rate float64
+		if err := rows.Scan(&code, &rate); err != nil {
+			return nil, fmt.Errorf("failed to scan tax rate row: %w", err)
+		}
+		rates[code] = rate
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tax rate rows: %w", err)
+	}
+	return rates, nil
+}
+
+// SetRate creates or replaces the rate of a tax code in a jurisdiction.
+func (r *pgTaxRepository) SetRate(ctx context.Context, tr *domain.TaxRate) (*domain.TaxRate, error) {
+	err := r.db.QueryRow(ctx, `
+        INSERT INTO tax_rates (tax_code, jurisdiction, rate_percent)
+        VALUES ($1, $2, $3)
+        ON CONFLICT (tax_code, jurisdiction) DO UPDATE
+        SET rate_percent = EXCLUDED.rate_percent
+        RETURNING updated_at`,
+		tr.TaxCode, tr.Jurisdiction, tr.RatePercent).Scan(&tr.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set tax rate of '%s' in '%s': %w", tr.TaxCode, tr.Jurisdiction, err)
+	}
+	return tr, nil
+}
+
+// DeleteRate removes the rate of a tax code in a jurisdiction.
+func (r *pgTaxRepository) DeleteRate(ctx context.Context, code, jurisdiction string) error {
+	commandTag, err := r.db.Exec(ctx, `DELETE FROM tax_rates WHERE tax_code = $1 AND jurisdiction = $2`, code, jurisdiction)
+	if err != nil {
+		return fmt.Errorf("failed to delete tax rate of '%s' in '%s': %w", code, jurisdiction, err)
+	}
+	if commandTag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: tax rate of '%s' in '%s'", domain.ErrRepositoryNotFound, code, jurisdiction)
+	}
+	return nil
+}
+
+// AssignItemTaxCode sets the tax code of an item; nil clears it. A change is recorded as a
+// revision of the item.
+func (r *pgTaxRepository) AssignItemTaxCode(ctx context.Context, itemID string, code *string, userID string) error {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin tax code assignment: %w", err)
+	}
+	defer tx.Rollback(ctx) // No-op after Commit
+
+	var before *string
+	err = tx.QueryRow(ctx, `SELECT tax_code FROM items WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, itemID).Scan(&before)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: item with ID '%s'", domain.ErrRepositoryNotFound, itemID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock item '%s' for tax code assignment: %w", itemID, err)
+	}
+	if _, err := tx.Exec(ctx, `UPDATE items SET tax_code = $2 WHERE id = $1`, itemID, code); err != nil {
+		return fmt.Errorf("failed to set tax code of item '%s': %w", itemID, err)
+	}
+	if was, now := optionalValue(before), optionalValue(code); was != now {
+		rev := newRevision(itemID, domain.ItemRevisionUpdated, []domain.FieldChange{{Field: "tax_code", Old: was, New: now}}, userID)
+		if err := recordRevisions(ctx, tx, rev); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit tax code assignment: %w", err)
+	}
+	return nil
+}
+
+// ItemTaxCodes returns the tax codes of the given items, by ID.
+func (r *pgTaxRepository) ItemTaxCodes(ctx context.Context, itemIDs []string) (map[string]string, error) {
+	rows, err := r.db.Query(ctx, `SELECT id, tax_code FROM items WHERE id = ANY($1) AND tax_code IS NOT NULL`, itemIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tax codes of items: %w", err)
+	}
+	defer rows.Close()
+
+	codes := make(map[string]string, len(itemIDs))
+	for rows.Next() {
+		var id, code string
+		if err := rows.Scan(&id, &code); err != nil {
+			return nil, fmt.Errorf("failed to scan item tax code row: %w", err)
+		}
+		codes[id] = code
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating item tax code rows: %w", err)
+	}
+	return codes, nil
+}
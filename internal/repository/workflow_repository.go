@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"inventory-system/internal/domain"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type pgWorkflowHistoryRepository struct {
+	db *pgxpool.Pool
+}
+
+// NewPgWorkflowHistoryRepository creates a new WorkflowHistoryRepository backed by PostgreSQL.
+func NewPgWorkflowHistoryRepository(db *pgxpool.Pool) domain.WorkflowHistoryRepository {
+	return &pgWorkflowHistoryRepository{db: db}
+}
+
+// recordTransition inserts a transition into the history table, filling in its ID and time.
+// Call it in the transaction that moves the document to t.ToState.
+func recordTransition(ctx context.Context, q querier, t *domain.WorkflowTransition) error {
+	err := q.QueryRow(ctx, `
+        INSERT INTO workflow_transitions (document_type, document_id, action, from_state, to_state, actor)
+        VALUES ($1, $2, $3, $4, $5, $6)
+        RETURNING id, created_at`,
+		t.DocumentType, t.DocumentID, t.Action, t.FromState, t.ToState, t.Actor,
+	).Scan(&t.ID, &t.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record workflow transition: %w", err)
+	}
+	return nil
+}
+
+// ListByDocument returns all transitions of a document in chronological order.
+func (r *pgWorkflowHistoryRepository) ListByDocument(ctx context.Context, documentType, documentID string) ([]*domain.WorkflowTransition, error) {
+	query := `
+        SELECT id, document_type, document_id, action, from_state, to_state, actor, created_at
+        FROM workflow_transitions
+        WHERE document_type = $1 AND document_id = $2
+        ORDER BY created_at ASC, id`
+
+	rows, err := r.db.Query(ctx, query, documentType, documentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflow transitions: %w", err)
+	}
+	defer rows.Close()
+
+	transitions := []*domain.WorkflowTransition{}
+	for rows.Next() {
+		t := &domain.WorkflowTransition{}
+		if err := rows.Scan(&t.ID, &t.DocumentType, &t.DocumentID, &t.Action, &t.FromState, &t.ToState, &t.Actor, &t.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan workflow transition row: %w", err)
+		}
+		transitions = append(transitions, t)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating workflow transition rows: %w", err)
+	}
+	return transitions, nil
+}
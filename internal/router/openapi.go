@@ -0,0 +1,74 @@
+package router
+
+import (
+	"regexp"
+	"strings"
+)
+
+var echoParam = regexp.MustCompile(`:([A-Za-z0-9_]+)`)
+
+// OpenAPI describes groups as an OpenAPI 3 document. Every operation carries its
+// required scopes (x-required-scopes) and rate-limit class (x-rate-limit-class), and
+// routes behind a feature flag name it in x-feature-flag.
+// Request and response schemas are documented on the handlers themselves.
+func OpenAPI(groups []Group, version string) map[string]any {
+	paths := make(map[string]any)
+	tags := make([]any, 0, len(groups))
+	seenTags := make(map[string]bool)
+
+	for _, g := range groups {
+		if !seenTags[g.Tag] {
+			seenTags[g.Tag] = true
+			tags = append(tags, map[string]any{"name": g.Tag})
+		}
+		for _, r := range g.Routes {
+			if r.Hidden {
+				continue
+			}
+			path := echoParam.ReplaceAllString(g.Prefix+r.Path, "{$1}")
+			if path == "" {
+				path = "/"
+			}
+
+			op := map[string]any{
+				"operationId":        r.OperationID(),
+				"summary":            r.Summary,
+				"tags":               []string{g.Tag},
+				"x-rate-limit-class": r.RateClass,
+				"responses":          map[string]any{"default": map[string]any{"description": "See the handler documentation"}},
+			}
+			if len(r.Scopes) > 0 {
+				op["x-required-scopes"] = r.Scopes
+			}
+			if r.Feature != "" {
+				op["x-feature-flag"] = r.Feature
+			}
+			var params []any
+			for _, m := range echoParam.FindAllStringSubmatch(r.Path, -1) {
+				params = append(params, map[string]any{
+					"name": m[1], "in": "path", "required": true, "schema": map[string]any{"type": "string"},
+				})
+			}
+			if params != nil {
+				op["parameters"] = params
+			}
+
+			item, _ := paths[path].(map[string]any)
+			if item == nil {
+				item = make(map[string]any)
+				paths[path] = item
+			}
+			item[strings.ToLower(r.Method)] = op
+		}
+	}
+
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   "Inventory System API",
+			"version": version,
+		},
+		"tags":  tags,
+		"paths": paths,
+	}
+}
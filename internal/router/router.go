@@ -0,0 +1,136 @@
+// Package router declares every HTTP route of the application in one table.
+//
+// The table is the single source for both the running server (Register) and the
+// generated API description (OpenAPI), so the two cannot drift apart. Each route also
+// records the scopes a caller needs and the rate-limit class it belongs to; the server
+// enforces them through middleware supplied in Options.
+package router
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Scope is a permission a caller must hold to use a route.
+type Scope string
+
+const (
+	ScopeItemsRead          Scope = "items:read"
+	ScopeItemsWrite         Scope = "items:write"
+	ScopeCommentsWrite      Scope = "comments:write"
+	ScopeNotificationsRead  Scope = "notifications:read"
+	ScopeNotificationsWrite Scope = "notifications:write"
+	ScopeAnalyticsRead      Scope = "analytics:read"
+	ScopeAnomaliesRead      Scope = "anomalies:read"
+	ScopeAnomaliesWrite     Scope = "anomalies:write"
+	ScopeCustomersRead      Scope = "customers:read"
+	ScopeCustomersWrite     Scope = "customers:write"
+	ScopePurchasingRead     Scope = "purchasing:read"
+	ScopePurchasingWrite    Scope = "purchasing:write"
+	ScopeSalesRead          Scope = "sales:read"
+	ScopeSalesWrite         Scope = "sales:write"
+	ScopeAdmin              Scope = "admin"
+)
+
+// RateClass groups routes that share a rate limit budget.
+type RateClass string
+
+const (
+	RateClassUnlimited RateClass = "unlimited" // Probes; never limited
+	RateClassRead      RateClass = "read"
+	RateClassWrite     RateClass = "write"
+	RateClassExpensive RateClass = "expensive" // Aggregate queries over the whole inventory
+	RateClassStream    RateClass = "stream"    // Long-lived connections (WebSocket)
+)
+
+// Route is one endpoint.
+type Route struct {
+	Method    string
+	Path      string // Relative to the group prefix, in Echo syntax (":id")
+	Handler   echo.HandlerFunc
+	Summary   string  // One line, shown in the API description
+	Scopes    []Scope // All are required; empty means the route is public
+	RateClass RateClass
+	Hidden    bool   // Served but left out of the API description (e.g. legacy aliases)
+	Feature   string // Feature flag that must be on for the caller; empty means always served
+}
+
+// Listener selects which HTTP listener serves a group.
+type Listener int
+
+const (
+	ListenerPublic Listener = iota // The public API port (default)
+	ListenerAdmin                  // The admin port when one is configured, otherwise the public one
+	ListenerAll                    // Every listener (health probes)
+)
+
+// CORSPolicy names the cross-origin policy a group is served with. The server maps each
+// name to origins, credentials and preflight caching from its configuration.
+type CORSPolicy string
+
+const (
+	CORSPublic CORSPolicy = "public" // Anonymous endpoints any site may call
+	CORSAPI    CORSPolicy = "api"    // The application API, for the frontend
+	CORSAdmin  CORSPolicy = "admin"  // Operator endpoints
+)
+
+// Group is a set of routes under a common prefix, documented under one tag.
+type Group struct {
+	Prefix   string
+	Tag      string
+	Listener Listener
+	CORS     CORSPolicy
+	Routes   []Route
+}
+
+// ForListener returns the groups served by listener l, which is ListenerPublic or ListenerAdmin.
+func ForListener(groups []Group, l Listener) []Group {
+	var out []Group
+	for _, g := range groups {
+		if g.Listener == l || g.Listener == ListenerAll {
+			out = append(out, g)
+		}
+	}
+	return out
+}
+
+// Options supplies the middleware that enforces route metadata.
+// A nil field means that aspect is not enforced.
+type Options struct {
+	Authorize func(scopes []Scope) echo.MiddlewareFunc // Called only for routes with scopes
+	RateLimit func(class RateClass) echo.MiddlewareFunc
+	Feature   func(key string) echo.MiddlewareFunc // Called only for routes behind a feature flag
+}
+
+// Register adds every route of groups to e, wrapped in the middleware from opts.
+func Register(e *echo.Echo, groups []Group, opts Options) {
+	for _, g := range groups {
+		for _, r := range g.Routes {
+			var mws []echo.MiddlewareFunc
+			if opts.RateLimit != nil && r.RateClass != RateClassUnlimited {
+				mws = append(mws, opts.RateLimit(r.RateClass))
+			}
+			if opts.Feature != nil && r.Feature != "" {
+				mws = append(mws, opts.Feature(r.Feature)) // Before authorization, so dark routes look absent to everyone
+			}
+			if opts.Authorize != nil && len(r.Scopes) > 0 {
+				mws = append(mws, opts.Authorize(r.Scopes))
+			}
+			route := e.Add(r.Method, g.Prefix+r.Path, r.Handler, mws...)
+			route.Name = r.OperationID()
+		}
+	}
+}
+
+// OperationID names a route after its handler method, e.g. "CreateItem".
+func (r Route) OperationID() string {
+	name := runtime.FuncForPC(reflect.ValueOf(r.Handler).Pointer()).Name() // e.g. "pkg.(*ItemHandler).CreateItem-fm"
+	name = strings.TrimSuffix(name, "-fm")
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
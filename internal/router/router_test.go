@@ -0,0 +1,94 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestRoutesAreComplete(t *testing.T) {
+	seen := make(map[string]string)
+	for _, g := range Routes(Handlers{}) {
+		if g.CORS == "" {
+			t.Errorf("group %q: no CORS policy", g.Prefix)
+		}
+		for _, r := range g.Routes {
+			if r.Hidden {
+				continue
+			}
+			id := r.OperationID()
+			if id == "" {
+				t.Errorf("%s %s%s: no operation ID", r.Method, g.Prefix, r.Path)
+			}
+			if prev, dup := seen[id]; dup {
+				t.Errorf("operation ID %q used by both %s and %s%s", id, prev, g.Prefix, r.Path)
+			}
+			seen[id] = g.Prefix + r.Path
+			if r.RateClass == "" {
+				t.Errorf("%s %s%s: no rate-limit class", r.Method, g.Prefix, r.Path)
+			}
+		}
+	}
+}
+
+func TestRegisterAppliesGuards(t *testing.T) {
+	ok := func(c echo.Context) error { return c.NoContent(http.StatusOK) }
+	groups := []Group{{Prefix: "/api", Routes: []Route{
+		{Method: http.MethodGet, Path: "/public", Handler: ok, RateClass: RateClassRead},
+		{Method: http.MethodGet, Path: "/secret", Handler: ok, Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+		{Method: http.MethodGet, Path: "/probe", Handler: ok, RateClass: RateClassUnlimited},
+		{Method: http.MethodGet, Path: "/beta", Handler: ok, RateClass: RateClassUnlimited, Feature: "beta"},
+	}}}
+
+	var limited []RateClass
+	e := echo.New()
+	Register(e, groups, Options{
+		Authorize: func(scopes []Scope) echo.MiddlewareFunc {
+			return func(next echo.HandlerFunc) echo.HandlerFunc {
+				return func(c echo.Context) error { return c.NoContent(http.StatusForbidden) }
+			}
+		},
+		RateLimit: func(class RateClass) echo.MiddlewareFunc {
+			limited = append(limited, class)
+			return func(next echo.HandlerFunc) echo.HandlerFunc { return next }
+		},
+		Feature: func(key string) echo.MiddlewareFunc {
+			return func(next echo.HandlerFunc) echo.HandlerFunc {
+				return func(c echo.Context) error { return c.NoContent(http.StatusNotFound) }
+			}
+		},
+	})
+
+	for path, want := range map[string]int{"/api/public": 200, "/api/secret": 403, "/api/probe": 200, "/api/beta": 404} {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		if rec.Code != want {
+			t.Errorf("GET %s = %d, want %d", path, rec.Code, want)
+		}
+	}
+	if len(limited) != 2 || limited[0] != RateClassRead || limited[1] != RateClassWrite {
+		t.Errorf("rate limiters built for %v, want [read write]", limited)
+	}
+}
+
+func TestForListener(t *testing.T) {
+	groups := []Group{
+		{Prefix: "/api"},
+		{Prefix: "/admin", Listener: ListenerAdmin},
+		{Prefix: "/healthz", Listener: ListenerAll},
+	}
+	prefixes := func(gs []Group) (out []string) {
+		for _, g := range gs {
+			out = append(out, g.Prefix)
+		}
+		return out
+	}
+	if got := prefixes(ForListener(groups, ListenerPublic)); len(got) != 2 || got[0] != "/api" || got[1] != "/healthz" {
+		t.Errorf("public groups = %v, want [/api /healthz]", got)
+	}
+	if got := prefixes(ForListener(groups, ListenerAdmin)); len(got) != 2 || got[0] != "/admin" || got[1] != "/healthz" {
+		t.Errorf("admin groups = %v, want [/admin /healthz]", got)
+	}
+}
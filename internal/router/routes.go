@@ -0,0 +1,382 @@
+package router
+
+import (
+	"net/http"
+
+	"inventory-system/internal/handler"
+)
+
+// Handlers holds the handler instances the routes dispatch to.
+// Zero values are fine when only describing the API (see cmd/openapi).
+type Handlers struct {
+	Item         *handler.ItemHandler
+	ItemImport   *handler.ItemImportHandler
+	Lock         *handler.LockHandler
+	Comment      *handler.CommentHandler
+	Notification *handler.NotificationHandler
+	Analytics    *handler.AnalyticsHandler
+	WebSocket    *handler.WebSocketHandler
+	Pricing      *handler.PricingHandler
+	Promotion    *handler.PromotionHandler
+	Adjustment   *handler.StockAdjustmentHandler
+	Admin        *handler.AdminHandler
+	FeatureFlag  *handler.FeatureFlagHandler
+	Rebuild      *handler.RebuildHandler
+	Anomaly      *handler.AnomalyHandler
+	Movement     *handler.StockMovementHandler
+	Category     *handler.CategoryHandler
+	Assembly     *handler.AssemblyHandler
+	Customer     *handler.CustomerHandler
+	Purchasing   *handler.PurchasingHandler
+	PriceTier    *handler.PriceTierHandler
+	SalesOrder   *handler.SalesOrderHandler
+	Tax          *handler.TaxHandler
+	Sequence     *handler.DocumentSequenceHandler
+	Label        *handler.LabelHandler
+	Ledger       *handler.LedgerHandler
+	Integrity    *handler.IntegrityHandler
+}
+
+// Routes returns the route table of the application.
+func Routes(h Handlers) []Group {
+	return []Group{
+		{
+			Prefix:   "",
+			Tag:      "health",
+			Listener: ListenerAll,
+			CORS:     CORSPublic,
+			Routes: []Route{
+				{Method: http.MethodGet, Path: "/healthz", Handler: handler.HealthCheck, Summary: "Health check", RateClass: RateClassUnlimited},
+				{Method: http.MethodGet, Path: "/", Handler: handler.HealthCheck, Summary: "Health check (legacy path)", RateClass: RateClassUnlimited, Hidden: true},
+			},
+		},
+		{
+			Prefix: "/api/v1/items",
+			Tag:    "items",
+			CORS:   CORSAPI,
+			Routes: []Route{
+				{Method: http.MethodPost, Path: "", Handler: h.Item.CreateItem, Summary: "Create a new item",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodGet, Path: "", Handler: h.Item.GetItems, Summary: "Get all items (paginated)",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassRead},
+				{Method: http.MethodGet, Path: "/options", Handler: h.Item.GetItemOptions, Summary: "List item options",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassRead},
+				{Method: http.MethodGet, Path: "/changes", Handler: h.Item.GetItemChanges, Summary: "Get items changed since a cursor",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassRead},
+				{Method: http.MethodGet, Path: "/sku/:sku", Handler: h.Item.GetItemBySKU, Summary: "Get an item by SKU",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassRead},
+				{Method: http.MethodPost, Path: "/import", Handler: h.ItemImport.ImportItems, Summary: "Import items from a CSV file",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassExpensive},
+				{Method: http.MethodGet, Path: "/export", Handler: h.Item.ExportItems, Summary: "Export items as CSV or Excel",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassExpensive},
+				{Method: http.MethodPost, Path: "/bulk-price-update", Handler: h.Pricing.BulkPriceUpdate, Summary: "Bulk update item prices",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassExpensive},
+				{Method: http.MethodGet, Path: "/trash", Handler: h.Item.ListTrash, Summary: "List trashed items",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassRead},
+				{Method: http.MethodDelete, Path: "/trash/:id", Handler: h.Item.PurgeItem, Summary: "Permanently delete a trashed item",
+					Scopes: []Scope{ScopeItemsWrite, ScopeAdmin}, RateClass: RateClassWrite},
+				{Method: http.MethodGet, Path: "/:id", Handler: h.Item.GetItemByID, Summary: "Get an item by ID",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassRead},
+				{Method: http.MethodPut, Path: "/:id", Handler: h.Item.UpdateItem, Summary: "Update an existing item",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodDelete, Path: "/:id", Handler: h.Item.DeleteItem, Summary: "Delete an item by ID",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodPost, Path: "/:id/restore", Handler: h.Item.RestoreItem, Summary: "Restore a trashed item",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodGet, Path: "/:id/history", Handler: h.Item.GetItemHistory, Summary: "Get the change history of an item",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassRead},
+				{Method: http.MethodPost, Path: "/:id/quantity", Handler: h.Item.AdjustItemQuantity, Summary: "Adjust an item's quantity",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodPost, Path: "/:id/adjust-stock", Handler: h.Movement.AdjustStock, Summary: "Adjust an item's stock with a reason",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodGet, Path: "/:id/movements", Handler: h.Movement.ListItemMovements, Summary: "Get the movement history of an item",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassRead},
+				{Method: http.MethodPut, Path: "/:id/category", Handler: h.Category.AssignItemCategory, Summary: "Set the category of an item",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodGet, Path: "/:id/components", Handler: h.Assembly.GetItemComponents, Summary: "Get the components of an item",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassRead},
+				{Method: http.MethodPut, Path: "/:id/components", Handler: h.Assembly.SetItemComponents, Summary: "Set the components of an item",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodGet, Path: "/:id/price", Handler: h.PriceTier.ResolveItemPrice, Summary: "Get the price of an item for a customer",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassRead},
+				{Method: http.MethodGet, Path: "/:id/tier-prices", Handler: h.PriceTier.ListItemTierPrices, Summary: "List the tier prices of an item",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassRead},
+				{Method: http.MethodPut, Path: "/:id/tier-prices/:tier", Handler: h.PriceTier.SetItemTierPrice, Summary: "Set the tier price of an item",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodDelete, Path: "/:id/tier-prices/:tier", Handler: h.PriceTier.DeleteItemTierPrice, Summary: "Delete the tier price of an item",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodPut, Path: "/:id/tax-code", Handler: h.Tax.AssignItemTaxCode, Summary: "Set the tax code of an item",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodGet, Path: "/:id/price-history", Handler: h.Pricing.GetPriceHistory, Summary: "Get the price history of an item",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassRead},
+				{Method: http.MethodPost, Path: "/:id/comments", Handler: h.Comment.CreateItemComment, Summary: "Comment on an item",
+					Scopes: []Scope{ScopeCommentsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodGet, Path: "/:id/comments", Handler: h.Comment.ListItemComments, Summary: "List comments on an item",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassRead},
+				{Method: http.MethodGet, Path: "/:id/presence", Handler: h.WebSocket.GetItemPresence, Summary: "Get who is on an item",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassRead},
+				{Method: http.MethodPost, Path: "/:id/lock", Handler: h.Lock.AcquireItemLock, Summary: "Lock an item for editing",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodDelete, Path: "/:id/lock", Handler: h.Lock.ReleaseItemLock, Summary: "Release an item edit lock",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+			},
+		},
+		{
+			Prefix: "/api/v1/categories",
+			Tag:    "categories",
+			CORS:   CORSAPI,
+			Routes: []Route{
+				{Method: http.MethodPost, Path: "", Handler: h.Category.CreateCategory, Summary: "Create a category",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodGet, Path: "", Handler: h.Category.ListCategories, Summary: "List categories",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassRead},
+				{Method: http.MethodGet, Path: "/:id", Handler: h.Category.GetCategory, Summary: "Get a category by ID",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassRead},
+				{Method: http.MethodPut, Path: "/:id", Handler: h.Category.UpdateCategory, Summary: "Rename or move a category",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodDelete, Path: "/:id", Handler: h.Category.DeleteCategory, Summary: "Delete a category",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+			},
+		},
+		{
+			Prefix: "/api/v1/assembly-orders",
+			Tag:    "assembly",
+			CORS:   CORSAPI,
+			Routes: []Route{
+				{Method: http.MethodPost, Path: "", Handler: h.Assembly.CreateAssemblyOrder, Summary: "Create an assembly order",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodGet, Path: "", Handler: h.Assembly.ListAssemblyOrders, Summary: "List assembly orders",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassRead},
+				{Method: http.MethodGet, Path: "/:id", Handler: h.Assembly.GetAssemblyOrder, Summary: "Get an assembly order by ID",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassRead},
+				{Method: http.MethodPost, Path: "/:id/complete", Handler: h.Assembly.CompleteAssemblyOrder, Summary: "Complete an assembly order",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodPost, Path: "/:id/cancel", Handler: h.Assembly.CancelAssemblyOrder, Summary: "Cancel an assembly order",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+			},
+		},
+		{
+			Prefix: "/api/v1/customers",
+			Tag:    "customers",
+			CORS:   CORSAPI,
+			Routes: []Route{
+				{Method: http.MethodPost, Path: "", Handler: h.Customer.CreateCustomer, Summary: "Create a customer",
+					Scopes: []Scope{ScopeCustomersWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodGet, Path: "", Handler: h.Customer.ListCustomers, Summary: "List customers",
+					Scopes: []Scope{ScopeCustomersRead}, RateClass: RateClassRead},
+				{Method: http.MethodGet, Path: "/:id", Handler: h.Customer.GetCustomer, Summary: "Get a customer by ID",
+					Scopes: []Scope{ScopeCustomersRead}, RateClass: RateClassRead},
+				{Method: http.MethodPut, Path: "/:id", Handler: h.Customer.UpdateCustomer, Summary: "Update a customer",
+					Scopes: []Scope{ScopeCustomersWrite}, RateClass: RateClassWrite},
+			},
+		},
+		{
+			Prefix: "/api/v1/suppliers",
+			Tag:    "purchasing",
+			CORS:   CORSAPI,
+			Routes: []Route{
+				{Method: http.MethodPost, Path: "", Handler: h.Purchasing.CreateSupplier, Summary: "Create a supplier",
+					Scopes: []Scope{ScopePurchasingWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodGet, Path: "", Handler: h.Purchasing.ListSuppliers, Summary: "List suppliers",
+					Scopes: []Scope{ScopePurchasingRead}, RateClass: RateClassRead},
+				{Method: http.MethodGet, Path: "/:id", Handler: h.Purchasing.GetSupplier, Summary: "Get a supplier by ID",
+					Scopes: []Scope{ScopePurchasingRead}, RateClass: RateClassRead},
+			},
+		},
+		{
+			Prefix: "/api/v1/purchase-orders",
+			Tag:    "purchasing",
+			CORS:   CORSAPI,
+			Routes: []Route{
+				{Method: http.MethodPost, Path: "", Handler: h.Purchasing.CreatePurchaseOrder, Summary: "Draft a purchase order",
+					Scopes: []Scope{ScopePurchasingWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodGet, Path: "", Handler: h.Purchasing.ListPurchaseOrders, Summary: "List purchase orders",
+					Scopes: []Scope{ScopePurchasingRead}, RateClass: RateClassRead},
+				{Method: http.MethodGet, Path: "/:id", Handler: h.Purchasing.GetPurchaseOrder, Summary: "Get a purchase order by ID",
+					Scopes: []Scope{ScopePurchasingRead}, RateClass: RateClassRead},
+				{Method: http.MethodPost, Path: "/:id/submit", Handler: h.Purchasing.SubmitPurchaseOrder, Summary: "Submit a purchase order",
+					Scopes: []Scope{ScopePurchasingWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodPost, Path: "/:id/receive", Handler: h.Purchasing.ReceivePurchaseOrder, Summary: "Receive a delivery against a purchase order",
+					Scopes: []Scope{ScopePurchasingWrite, ScopeItemsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodPost, Path: "/:id/cancel", Handler: h.Purchasing.CancelPurchaseOrder, Summary: "Cancel a purchase order",
+					Scopes: []Scope{ScopePurchasingWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodGet, Path: "/:id/history", Handler: h.Purchasing.GetPurchaseOrderHistory, Summary: "Get the status history of a purchase order",
+					Scopes: []Scope{ScopePurchasingRead}, RateClass: RateClassRead},
+			},
+		},
+		{
+			Prefix: "/api/v1/sales-orders",
+			Tag:    "sales",
+			CORS:   CORSAPI,
+			Routes: []Route{
+				{Method: http.MethodPost, Path: "", Handler: h.SalesOrder.CreateSalesOrder, Summary: "Place a sales order",
+					Scopes: []Scope{ScopeSalesWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodGet, Path: "", Handler: h.SalesOrder.ListSalesOrders, Summary: "List sales orders",
+					Scopes: []Scope{ScopeSalesRead}, RateClass: RateClassRead},
+				{Method: http.MethodGet, Path: "/:id", Handler: h.SalesOrder.GetSalesOrder, Summary: "Get a sales order by ID",
+					Scopes: []Scope{ScopeSalesRead}, RateClass: RateClassRead},
+				{Method: http.MethodPost, Path: "/:id/fulfill", Handler: h.SalesOrder.FulfillSalesOrder, Summary: "Fulfill a sales order",
+					Scopes: []Scope{ScopeSalesWrite, ScopeItemsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodPost, Path: "/:id/cancel", Handler: h.SalesOrder.CancelSalesOrder, Summary: "Cancel a sales order",
+					Scopes: []Scope{ScopeSalesWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodGet, Path: "/:id/tax", Handler: h.Tax.QuoteSalesOrderTax, Summary: "Quote the tax of a sales order",
+					Scopes: []Scope{ScopeSalesRead}, RateClass: RateClassRead},
+			},
+		},
+		{
+			Prefix: "/api/v1/tax-rates",
+			Tag:    "tax",
+			CORS:   CORSAPI,
+			Routes: []Route{
+				{Method: http.MethodGet, Path: "", Handler: h.Tax.ListTaxRates, Summary: "List tax rates",
+					Scopes: []Scope{ScopeSalesRead}, RateClass: RateClassRead},
+				{Method: http.MethodPut, Path: "/:code/:jurisdiction", Handler: h.Tax.SetTaxRate, Summary: "Set a tax rate",
+					Scopes: []Scope{ScopeAdmin}, RateClass: RateClassWrite},
+				{Method: http.MethodDelete, Path: "/:code/:jurisdiction", Handler: h.Tax.DeleteTaxRate, Summary: "Delete a tax rate",
+					Scopes: []Scope{ScopeAdmin}, RateClass: RateClassWrite},
+			},
+		},
+		{
+			Prefix: "/api/v1/label-jobs",
+			Tag:    "labels",
+			CORS:   CORSAPI,
+			Routes: []Route{
+				{Method: http.MethodPost, Path: "", Handler: h.Label.EnqueueLabels, Summary: "Queue labels for printing",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodGet, Path: "", Handler: h.Label.ListLabelJobs, Summary: "List label jobs",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassRead},
+				{Method: http.MethodPost, Path: "/claim", Handler: h.Label.ClaimLabelJobs, Summary: "Claim label jobs of a station",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodPost, Path: "/:id/complete", Handler: h.Label.CompleteLabelJob, Summary: "Report a label job done",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+			},
+		},
+		{
+			Prefix: "/api/v1/promotions",
+			Tag:    "promotions",
+			CORS:   CORSAPI,
+			Routes: []Route{
+				{Method: http.MethodPost, Path: "", Handler: h.Promotion.CreatePromotion, Summary: "Schedule a promotion",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodGet, Path: "", Handler: h.Promotion.ListPromotions, Summary: "List promotions",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassRead},
+				{Method: http.MethodGet, Path: "/:id", Handler: h.Promotion.GetPromotion, Summary: "Get a promotion by ID",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassRead},
+				{Method: http.MethodDelete, Path: "/:id", Handler: h.Promotion.DeletePromotion, Summary: "Cancel a promotion",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassWrite},
+			},
+		},
+		{
+			Prefix: "/api/v1/stock-adjustments",
+			Tag:    "stock",
+			CORS:   CORSAPI,
+			Routes: []Route{
+				{Method: http.MethodPost, Path: "/batch", Handler: h.Adjustment.ApplyBatch, Summary: "Apply a batch of stock adjustments",
+					Scopes: []Scope{ScopeItemsWrite}, RateClass: RateClassExpensive},
+			},
+		},
+		{
+			Prefix: "/api/v1/comments",
+			Tag:    "comments",
+			CORS:   CORSAPI,
+			Routes: []Route{
+				{Method: http.MethodDelete, Path: "/:commentId", Handler: h.Comment.DeleteComment, Summary: "Delete a comment",
+					Scopes: []Scope{ScopeCommentsWrite}, RateClass: RateClassWrite},
+			},
+		},
+		{
+			Prefix: "/api/v1/me",
+			Tag:    "notifications",
+			CORS:   CORSAPI,
+			Routes: []Route{
+				{Method: http.MethodGet, Path: "/notifications", Handler: h.Notification.ListMyNotifications, Summary: "List my notifications",
+					Scopes: []Scope{ScopeNotificationsRead}, RateClass: RateClassRead},
+				{Method: http.MethodPost, Path: "/notifications/read-all", Handler: h.Notification.MarkAllNotificationsRead, Summary: "Mark all my notifications as read",
+					Scopes: []Scope{ScopeNotificationsWrite}, RateClass: RateClassWrite},
+				{Method: http.MethodPost, Path: "/notifications/:id/read", Handler: h.Notification.MarkNotificationRead, Summary: "Mark a notification as read",
+					Scopes: []Scope{ScopeNotificationsWrite}, RateClass: RateClassWrite},
+			},
+		},
+		{
+			Prefix: "/api/v1/analytics",
+			Tag:    "analytics",
+			CORS:   CORSAPI,
+			Routes: []Route{
+				{Method: http.MethodGet, Path: "/stock-value", Handler: h.Analytics.GetTotalStockValue, Summary: "Get total stock value",
+					Scopes: []Scope{ScopeAnalyticsRead}, RateClass: RateClassExpensive},
+				{Method: http.MethodGet, Path: "/low-stock", Handler: h.Analytics.GetLowStockItems, Summary: "Get low stock items",
+					Scopes: []Scope{ScopeAnalyticsRead}, RateClass: RateClassExpensive},
+				{Method: http.MethodGet, Path: "/most-valuable", Handler: h.Analytics.GetMostValuableItems, Summary: "Get most valuable items",
+					Scopes: []Scope{ScopeAnalyticsRead}, RateClass: RateClassExpensive},
+			},
+		},
+		{
+			Prefix: "/api/v1/anomalies",
+			Tag:    "anomalies",
+			CORS:   CORSAPI,
+			Routes: []Route{
+				{Method: http.MethodGet, Path: "", Handler: h.Anomaly.ListAnomalies, Summary: "List anomalies",
+					Scopes: []Scope{ScopeAnomaliesRead}, RateClass: RateClassRead},
+				{Method: http.MethodPost, Path: "/:id/acknowledge", Handler: h.Anomaly.AcknowledgeAnomaly, Summary: "Acknowledge an anomaly",
+					Scopes: []Scope{ScopeAnomaliesWrite}, RateClass: RateClassWrite},
+			},
+		},
+		{
+			Prefix: "/api/v1/ledger",
+			Tag:    "ledger",
+			CORS:   CORSAPI,
+			Routes: []Route{
+				{Method: http.MethodGet, Path: "/discrepancies", Handler: h.Ledger.ListLedgerDiscrepancies, Summary: "List ledger discrepancies",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassRead},
+				{Method: http.MethodPost, Path: "/discrepancies/:id/reconcile", Handler: h.Ledger.ReconcileLedgerDiscrepancy, Summary: "Reconcile a ledger discrepancy",
+					Scopes: []Scope{ScopeItemsWrite, ScopeAdmin}, RateClass: RateClassWrite},
+			},
+		},
+		{
+			// The WebSocket endpoint lives outside /api/v1, but can be anywhere.
+			Prefix: "/ws",
+			Tag:    "websockets",
+			CORS:   CORSAPI,
+			Routes: []Route{
+				{Method: http.MethodGet, Path: "/stock-updates", Handler: h.WebSocket.HandleConnections, Summary: "Establish WebSocket connection for stock updates",
+					Scopes: []Scope{ScopeItemsRead}, RateClass: RateClassStream},
+			},
+		},
+		{
+			Prefix:   "/admin",
+			Tag:      "admin",
+			Listener: ListenerAdmin,
+			CORS:     CORSAdmin,
+			Routes: []Route{
+				{Method: http.MethodGet, Path: "/config", Handler: h.Admin.GetEffectiveConfig, Summary: "Show effective configuration",
+					Scopes: []Scope{ScopeAdmin}, RateClass: RateClassRead},
+				{Method: http.MethodPost, Path: "/config/reload", Handler: h.Admin.ReloadConfig, Summary: "Reload tunable configuration",
+					Scopes: []Scope{ScopeAdmin}, RateClass: RateClassWrite},
+				{Method: http.MethodGet, Path: "/feature-flags", Handler: h.FeatureFlag.ListFeatureFlags, Summary: "List feature flags",
+					Scopes: []Scope{ScopeAdmin}, RateClass: RateClassRead},
+				{Method: http.MethodPut, Path: "/feature-flags/:key", Handler: h.FeatureFlag.SetFeatureFlag, Summary: "Create or replace a feature flag",
+					Scopes: []Scope{ScopeAdmin}, RateClass: RateClassWrite},
+				{Method: http.MethodDelete, Path: "/feature-flags/:key", Handler: h.FeatureFlag.DeleteFeatureFlag, Summary: "Delete a feature flag",
+					Scopes: []Scope{ScopeAdmin}, RateClass: RateClassWrite},
+				{Method: http.MethodGet, Path: "/document-sequences", Handler: h.Sequence.ListDocumentSequences, Summary: "List document numbering sequences",
+					Scopes: []Scope{ScopeAdmin}, RateClass: RateClassRead},
+				{Method: http.MethodPut, Path: "/document-sequences/:type", Handler: h.Sequence.SetDocumentSequence, Summary: "Configure a document numbering sequence",
+					Scopes: []Scope{ScopeAdmin}, RateClass: RateClassWrite},
+				{Method: http.MethodPost, Path: "/rebuilds", Handler: h.Rebuild.StartRebuild, Summary: "Rebuild a derived store",
+					Scopes: []Scope{ScopeAdmin}, RateClass: RateClassWrite},
+				{Method: http.MethodGet, Path: "/rebuilds", Handler: h.Rebuild.ListRebuilds, Summary: "List rebuild jobs",
+					Scopes: []Scope{ScopeAdmin}, RateClass: RateClassRead},
+				{Method: http.MethodGet, Path: "/rebuilds/:id", Handler: h.Rebuild.GetRebuild, Summary: "Get a rebuild job",
+					Scopes: []Scope{ScopeAdmin}, RateClass: RateClassRead},
+				{Method: http.MethodPost, Path: "/anomalies/detect", Handler: h.Anomaly.DetectAnomalies, Summary: "Run anomaly detection now",
+					Scopes: []Scope{ScopeAdmin}, RateClass: RateClassExpensive},
+				{Method: http.MethodPost, Path: "/ledger/check", Handler: h.Ledger.CheckLedger, Summary: "Run the ledger check now",
+					Scopes: []Scope{ScopeAdmin}, RateClass: RateClassExpensive},
+				{Method: http.MethodGet, Path: "/integrity", Handler: h.Integrity.GetIntegrityReport, Summary: "Check data integrity",
+					Scopes: []Scope{ScopeAdmin}, RateClass: RateClassExpensive},
+				{Method: http.MethodGet, Path: "/ws-clients", Handler: h.WebSocket.ListClients, Summary: "List WebSocket clients",
+					Scopes: []Scope{ScopeAdmin}, RateClass: RateClassRead},
+			},
+		},
+	}
+}
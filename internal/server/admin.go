@@ -0,0 +1,34 @@
+package server
+
+import (
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+// newAdminEcho builds the application served on the admin port. Besides the admin route
+// groups it exposes runtime metrics (expvar) and profiling (pprof), which must never be
+// reachable from the public network.
+func newAdminEcho() *echo.Echo {
+	e := echo.New()
+	e.HideBanner = true
+	e.Use(middleware.RequestID())
+	e.Use(middleware.Recover())
+	e.Use(appVersionHeader)
+	e.HTTPErrorHandler = customHTTPErrorHandler
+
+	e.GET("/debug/vars", echo.WrapHandler(expvar.Handler()))
+	e.GET("/debug/pprof/", echo.WrapHandler(http.HandlerFunc(pprof.Index)))
+	e.GET("/debug/pprof/cmdline", echo.WrapHandler(http.HandlerFunc(pprof.Cmdline)))
+	e.GET("/debug/pprof/profile", echo.WrapHandler(http.HandlerFunc(pprof.Profile)))
+	e.GET("/debug/pprof/symbol", echo.WrapHandler(http.HandlerFunc(pprof.Symbol)))
+	e.GET("/debug/pprof/trace", echo.WrapHandler(http.HandlerFunc(pprof.Trace)))
+	e.GET("/debug/pprof/:profile", func(c echo.Context) error { // heap, goroutine, allocs, block, mutex, ...
+		pprof.Handler(c.Param("profile")).ServeHTTP(c.Response(), c.Request())
+		return nil
+	})
+	return e
+}
@@ -0,0 +1,82 @@
+package server
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"inventory-system/internal/buildinfo"
+	"inventory-system/internal/domain" // For domain errors that bubble up unhandled
+	"inventory-system/internal/handler"
+	"inventory-system/pkg/httputil" // For custom HTTP error handler
+
+	"github.com/go-playground/validator/v10" // Same major version the handlers validate with
+	"github.com/labstack/echo/v4"
+)
+
+// appVersionHeader sets X-App-Version on every response so clients and logs can tell
+// which build served a request.
+func appVersionHeader(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		c.Response().Header().Set("X-App-Version", buildinfo.Version)
+		return next(c)
+	}
+}
+
+// customHTTPErrorHandler provides centralized error handling.
+func customHTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return // If response already sent, do nothing
+	}
+
+	var he *httputil.HTTPError // Our custom error type
+	var echoHE *echo.HTTPError // Echo's built-in HTTPError
+
+	if errors.As(err, &he) {
+		// This is already our structured HTTPError, just send it
+		if c.Request().Method == http.MethodHead { // Echo's default behavior
+			_ = c.NoContent(he.StatusCode)
+		} else {
+			_ = httputil.SendErrorResponse(c, he) // Use our sender
+		}
+		return
+	} else if errors.As(err, &echoHE) {
+		// Convert Echo's HTTPError to our httputil.HTTPError format
+		// This handles errors from Echo's internals (e.g., routing not found, method not allowed)
+		appErr := httputil.NewHTTPError(echoHE.Code, echoHE.Message.(string))
+		// If echoHE.Internal is not nil, it might contain the original error.
+		// You could log it or add parts of it to details if safe.
+		// log.Printf("Echo internal error: %v", echoHE.Internal)
+		_ = httputil.SendErrorResponse(c, appErr)
+		return
+	}
+
+	// Handle validation errors from go-playground/validator if not handled in specific handlers
+	var ve validator.ValidationErrors
+	if errors.As(err, &ve) {
+		validationErrors := handler.ParseValidationErrors(err) // Use the parser from the handler package
+		appErr := httputil.ValidationError("Input validation failed", validationErrors)
+		_ = httputil.SendErrorResponse(c, appErr)
+		return
+	}
+
+	// Handle domain-specific errors that might bubble up if not caught by handlers
+	// This provides a last line of defense for known error types.
+	if errors.Is(err, domain.ErrItemNotFound) || errors.Is(err, domain.ErrRepositoryNotFound) {
+		_ = httputil.SendErrorResponse(c, httputil.NotFoundError(err.Error()))
+		return
+	}
+	if errors.Is(err, domain.ErrSKUAlreadyExists) || errors.Is(err, domain.ErrRepositoryDuplicateEntry) {
+		_ = httputil.SendErrorResponse(c, httputil.ConflictError(err.Error()))
+		return
+	}
+	if errors.Is(err, domain.ErrInvalidInput) || errors.Is(err, domain.ErrInvalidItemID) {
+		_ = httputil.SendErrorResponse(c, httputil.BadRequestError(err.Error()))
+		return
+	}
+
+	// For any other unhandled errors, return a generic 500
+	log.Printf("Unhandled error: %v", err) // Log the full error for debugging
+	appErr := httputil.InternalServerError("An unexpected internal error occurred.")
+	_ = httputil.SendErrorResponse(c, appErr)
+}
@@ -0,0 +1,310 @@
+// Package server assembles the HTTP application: middleware, dependency wiring and routes.
+// It is shared by cmd/server and the end-to-end tests so both run exactly the same app.
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"inventory-system/internal/config"
+	"inventory-system/internal/database"
+	"inventory-system/internal/domain"
+	"inventory-system/internal/events"
+	analyticshandler "inventory-system/internal/handler" // Alias to avoid name collision
+	itemhandler "inventory-system/internal/handler"      // Alias for clarity
+	wshandler "inventory-system/internal/handler"        // Alias for clarity
+	appmiddleware "inventory-system/internal/middleware"
+	"inventory-system/internal/realtime"
+	itemrepo "inventory-system/internal/repository"
+	"inventory-system/internal/router"
+	analyticsservice "inventory-system/internal/service"
+	itemservice "inventory-system/internal/service"
+	"inventory-system/internal/workflow"
+	"inventory-system/pkg/requestid"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+// shadowItemReadsFlag samples requests for shadow reads: its rollout percentage, evaluated
+// per request ID, is the share of requests whose item reads also run the candidate queries.
+const shadowItemReadsFlag = "shadow-item-reads"
+
+// App is the assembled application: the public API and, when cfg.AdminPort is set,
+// a separate admin application for that port.
+type App struct {
+	Public *echo.Echo
+	Admin  *echo.Echo // Nil when admin routes are served on the public listener
+}
+
+// New builds the Echo application on top of an open database pool.
+// It also starts the real-time hub, which lives for the rest of the process.
+func New(cfg *config.Config, dbPool *pgxpool.Pool) (*App, error) {
+	// --- Echo Instance ---
+	e := echo.New()
+
+	// --- Runtime-tunable settings (reloaded on SIGHUP or POST /admin/config/reload) ---
+	live := config.NewLive(cfg)
+	live.OnChange(func(t config.Tunables) { database.SetSlowQueryThreshold(dbPool, t.SlowQueryThreshold) })
+	go live.WatchSignals(context.Background()) // Lives for the rest of the process, like the hub
+
+	// --- Middleware ---
+	e.Use(middleware.RequestIDWithConfig(middleware.RequestIDConfig{ // Add request ID to the response header...
+		RequestIDHandler: func(c echo.Context, id string) { // ...and to the context, for the layers below handlers
+			c.SetRequest(c.Request().WithContext(requestid.NewContext(c.Request().Context(), id)))
+		},
+	}))
+	// Structured logging, sampled for high volume
+	e.Use(appmiddleware.SampledRequestLogger(appmiddleware.RequestLogConfig{
+		Current: func() appmiddleware.RequestLogConfig {
+			t := live.Get()
+			return appmiddleware.RequestLogConfig{SampleRate: t.LogSampleRate, SlowThreshold: t.SlowRequestThreshold}
+		},
+	}))
+	e.Use(middleware.Recover()) // Recover from panics anywhere in the chain
+	e.Use(appVersionHeader)     // Tag every response with the running version
+
+	// Fault injection for frontend resilience testing. Dev/staging only.
+	if cfg.ChaosEnabled {
+		rules, err := appmiddleware.LoadChaosRules(cfg.ChaosConfigPath)
+		if err != nil {
+			return nil, fmt.Errorf("could not load chaos rules: %w", err)
+		}
+		log.Printf("WARNING: Chaos middleware ENABLED with %d rule(s) from %s. Do not use in production!", len(rules), cfg.ChaosConfigPath)
+		e.Use(appmiddleware.Chaos(rules))
+	}
+
+	// CORS policies per route group (public, api, admin), from config. Only the prefixes
+	// and policy names of the route table are needed here, so handlers can be left out.
+	var corsRules []appmiddleware.CORSRule
+	for _, g := range router.Routes(router.Handlers{}) {
+		policy, ok := cfg.CORS[string(g.CORS)]
+		if !ok {
+			return nil, fmt.Errorf("route group %q uses CORS policy %q, which is not configured", g.Prefix, g.CORS)
+		}
+		corsRules = append(corsRules, appmiddleware.CORSRule{
+			Prefix:           g.Prefix,
+			AllowOrigins:     policy.AllowOrigins,
+			AllowCredentials: policy.AllowCredentials,
+			MaxAge:           policy.MaxAge,
+		})
+	}
+	e.Use(appmiddleware.GroupCORS(corsRules,
+		[]string{http.MethodGet, http.MethodPut, http.MethodPost, http.MethodDelete, http.MethodOptions},
+		[]string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, itemhandler.HeaderUserID},
+	))
+
+	// Degraded read-only mode: keep serving reads from memory while the database is down.
+	if cfg.DBHealthCheckInterval > 0 {
+		dbHealth := database.NewHealthMonitor(dbPool, cfg.DBHealthCheckInterval)
+		go dbHealth.Run(context.Background()) // Lives for the rest of the process, like the hub
+		e.Use(appmiddleware.DegradedMode(appmiddleware.DegradedModeConfig{
+			Health:     dbHealth,
+			CacheSize:  cfg.DegradedCacheSize,
+			RetryAfter: cfg.DBHealthCheckInterval,
+			Skip: func(c echo.Context) bool {
+				switch c.Path() {
+				case "/", "/healthz": // Probes must report the live state
+					return true
+				case "/api/v1/items/export": // Streams are unbounded; never record them
+					return true
+				}
+				return itemhandler.AcceptsNDJSON(c)
+			},
+		}))
+	}
+
+	// Custom HTTP Error Handler
+	// This allows us to centralize how errors (especially those from validation or unhandled ones)
+	// are converted into our httputil.HTTPError format.
+	e.HTTPErrorHandler = customHTTPErrorHandler
+
+	// --- Real-time Hub ---
+	hub := realtime.NewHub()
+	hub.SetStockUpdateWindow(cfg.StockUpdateWindow)
+	if cfg.WSCompression {
+		if err := hub.SetCompression(cfg.WSCompressionLevel); err != nil {
+			return nil, fmt.Errorf("invalid WebSocket compression settings: %w", err)
+		}
+	}
+	go hub.Run() // Start the hub in its own goroutine
+	log.Println("Realtime Hub started.")
+
+	// --- Dependency Injection (Repositories, Services, Handlers) ---
+	bus := events.NewBus() // In-process; tells caches about changes made by other services
+
+	// Feature flags (routes opt in with Route.Feature; services check FeatureFlagService.Enabled)
+	featureFlagSvc := itemservice.NewFeatureFlagService(itemrepo.NewPgFeatureFlagRepository(dbPool))
+	featureFlagHdlr := itemhandler.NewFeatureFlagHandler(featureFlagSvc)
+
+	// Item
+	// Reads are shadowed by the redesigned queries for the share of requests the
+	// "shadow-item-reads" flag is rolled out to; responses always come from the current ones.
+	itemRepository := itemrepo.NewShadowItemRepository(
+		itemrepo.NewPgItemRepository(dbPool),
+		itemrepo.NewPgCandidateItemRepository(dbPool),
+		func(ctx context.Context) bool {
+			return featureFlagSvc.Enabled(ctx, shadowItemReadsFlag, requestid.FromContext(ctx))
+		},
+	)
+	var derivedStores []domain.DerivedStore // Rebuildable from the database through /admin/rebuilds
+	if cfg.ItemCacheTTL > 0 {
+		// Lookups by ID and SKU are served from memory; changes made by other services arrive on the bus.
+		itemRepository = itemrepo.NewCachedItemRepository(itemRepository, bus, cfg.ItemCacheTTL, cfg.ItemCacheSize)
+		derivedStores = append(derivedStores, itemRepository.(domain.DerivedStore))
+	}
+	promotionSvc := itemservice.NewPromotionService(itemrepo.NewPgPromotionRepository(dbPool))
+	// The repositories record every change to an item as a revision; GET /items/{id}/history lists them
+	itemSvc := itemservice.NewItemService(itemRepository, hub, promotionSvc, itemrepo.NewPgItemRevisionRepository(dbPool)) // Pass hub to item service; promotions adjust prices on reads
+	editLockSvc := itemservice.NewInMemoryEditLockService(itemRepository, cfg.EditLockTTL)
+	hub.SetEditLockService(editLockSvc) // Lets clients refresh locks via WebSocket heartbeats
+	itemHdlr := itemhandler.NewItemHandler(itemSvc, editLockSvc)
+	lockHdlr := itemhandler.NewLockHandler(editLockSvc)
+	itemImportHdlr := itemhandler.NewItemImportHandler(itemservice.NewItemImportService(itemrepo.NewPgItemImportRepository(dbPool), hub, bus))
+
+	// Analytics (ItemRepository is used for analytics queries as per our design)
+	analyticsSvc := analyticsservice.NewAnalyticsService(itemRepository)
+	analyticsHdlr := analyticshandler.NewAnalyticsHandler(analyticsSvc)
+
+	// Notifications (per-user inbox). Not pushed live: WebSocket clients only claim a user, so the
+	// hub could not tell the recipient from anyone else; this needs authentication first.
+	notificationRepository := itemrepo.NewPgNotificationRepository(dbPool)
+	notificationSvc := itemservice.NewNotificationService(notificationRepository, nil)
+	notificationHdlr := itemhandler.NewNotificationHandler(notificationSvc)
+
+	// Comments (polymorphic; items are the only commentable entity so far)
+	commentRepository := itemrepo.NewPgCommentRepository(dbPool)
+	commentSvc := itemservice.NewCommentService(commentRepository, itemRepository, notificationSvc) // Mentions feed the inbox
+	commentHdlr := itemhandler.NewCommentHandler(commentSvc)
+
+	// Pricing (bulk repricing with a price history)
+	priceRepository := itemrepo.NewPgPriceRepository(dbPool)
+	pricingSvc := itemservice.NewPricingService(priceRepository, itemRepository, bus)
+	pricingHdlr := itemhandler.NewPricingHandler(pricingSvc)
+
+	// Promotions (temporary price overrides, applied by the item service on reads)
+	promotionHdlr := itemhandler.NewPromotionHandler(promotionSvc)
+
+	// Stock adjustments (batched, atomic, broadcast as one message)
+	adjustmentSvc := itemservice.NewStockAdjustmentService(itemrepo.NewPgStockAdjustmentRepository(dbPool), hub, bus)
+	adjustmentHdlr := itemhandler.NewStockAdjustmentHandler(adjustmentSvc)
+
+	// Stock movements (reasoned adjustments; every quantity change is written to the movement ledger)
+	movementRepository := itemrepo.NewPgStockMovementRepository(dbPool)
+	movementSvc := itemservice.NewStockMovementService(movementRepository, hub, bus)
+	movementHdlr := itemhandler.NewStockMovementHandler(movementSvc)
+
+	// Categories (a tree; items belong to at most one category)
+	categoryHdlr := itemhandler.NewCategoryHandler(itemservice.NewCategoryService(itemrepo.NewPgCategoryRepository(dbPool), bus))
+
+	// Assembly (bills of materials; completing an order consumes components and receives the assembled item)
+	assemblyHdlr := itemhandler.NewAssemblyHandler(itemservice.NewAssemblyService(itemrepo.NewPgAssemblyRepository(dbPool), hub, bus))
+
+	// Customers and their price tiers
+	customerRepository := itemrepo.NewPgCustomerRepository(dbPool)
+	customerHdlr := itemhandler.NewCustomerHandler(itemservice.NewCustomerService(customerRepository))
+	priceTierSvc := itemservice.NewPriceTierService(itemrepo.NewPgPriceTierRepository(dbPool), itemRepository, customerRepository)
+	priceTierHdlr := itemhandler.NewPriceTierHandler(priceTierSvc)
+
+	// Sales orders (open orders reserve stock; fulfilling one deducts it)
+	salesOrderRepository := itemrepo.NewPgSalesOrderRepository(dbPool)
+	salesOrderSvc := itemservice.NewSalesOrderService(salesOrderRepository, priceTierSvc, hub, bus)
+	salesOrderHdlr := itemhandler.NewSalesOrderHandler(salesOrderSvc)
+
+	// Taxes (rates per tax code and jurisdiction; orders are quoted by the flat-rate provider)
+	taxRepository := itemrepo.NewPgTaxRepository(dbPool)
+	taxHdlr := itemhandler.NewTaxHandler(itemservice.NewTaxService(taxRepository, salesOrderRepository, itemservice.NewFlatRateTaxProvider(taxRepository)))
+
+	// Document numbering (PO-2024-0001 and the like; drawn when an order is created)
+	documentSequenceHdlr := itemhandler.NewDocumentSequenceHandler(itemservice.NewDocumentSequenceService(itemrepo.NewPgDocumentSequenceRepository(dbPool)))
+
+	// Label print queue (station agents claim jobs; they are told about new ones over WebSocket)
+	labelHdlr := itemhandler.NewLabelHandler(itemservice.NewLabelService(itemrepo.NewPgLabelJobRepository(dbPool), hub))
+
+	// Workflows (allowed status changes of documents, by role; WORKFLOW_FILE replaces the built-in ones)
+	workflows := workflow.NewDefaultRegistry()
+	if cfg.WorkflowFile != "" {
+		if err := workflows.LoadFile(cfg.WorkflowFile); err != nil {
+			return nil, fmt.Errorf("could not load workflow definitions: %w", err)
+		}
+		log.Printf("Workflow definitions loaded from %s", cfg.WorkflowFile)
+	}
+	workflowEngine := workflow.NewEngine(workflows, itemrepo.NewPgWorkflowHistoryRepository(dbPool))
+
+	// Purchasing (suppliers and purchase orders; receiving a delivery adds it to stock)
+	purchasingHdlr := itemhandler.NewPurchasingHandler(itemservice.NewPurchasingService(itemrepo.NewPgPurchasingRepository(dbPool), workflowEngine, hub, bus))
+
+	// Anomalies (unusual stock movements flagged for loss prevention; the configured users are notified)
+	anomalySvc := itemservice.NewAnomalyService(itemrepo.NewPgAnomalyRepository(dbPool), movementRepository, notificationSvc,
+		itemservice.AnomalyRules{
+			BusinessHoursStart: cfg.AnomalyBusinessHours[0],
+			BusinessHoursEnd:   cfg.AnomalyBusinessHours[1],
+			Location:           cfg.AnomalyLocation,
+			NotifyUsers:        cfg.AnomalyNotifyUsers,
+		})
+	if cfg.AnomalyDetectionInterval > 0 {
+		go itemservice.RunAnomalyDetection(context.Background(), anomalySvc, cfg.AnomalyDetectionInterval) // Lives for the rest of the process
+	}
+	anomalyHdlr := itemhandler.NewAnomalyHandler(anomalySvc)
+
+	// Ledger check (quantities that no longer match the movement ledger; the configured users are notified)
+	ledgerSvc := itemservice.NewLedgerService(itemrepo.NewPgLedgerRepository(dbPool), notificationSvc, cfg.LedgerNotifyUsers, hub, bus)
+	if cfg.LedgerCheckInterval > 0 {
+		go itemservice.RunLedgerCheck(context.Background(), ledgerSvc, cfg.LedgerCheckInterval) // Lives for the rest of the process
+	}
+	ledgerHdlr := itemhandler.NewLedgerHandler(ledgerSvc)
+
+	// WebSocket
+	wsHdlr := wshandler.NewWebSocketHandler(hub)
+
+	// Admin
+	adminHdlr := itemhandler.NewAdminHandler(live)
+	rebuildHdlr := itemhandler.NewRebuildHandler(itemservice.NewRebuildService(derivedStores...))
+	integrityHdlr := itemhandler.NewIntegrityHandler(itemservice.NewIntegrityService(itemrepo.NewPgIntegrityRepository(dbPool)))
+
+	// --- Routes ---
+	// Declared once in internal/router, which also feeds the generated API description.
+	// Scopes and rate-limit classes are recorded per route; nothing enforces them yet.
+	routes := router.Routes(router.Handlers{
+		Item:         itemHdlr,
+		ItemImport:   itemImportHdlr,
+		Lock:         lockHdlr,
+		Comment:      commentHdlr,
+		Notification: notificationHdlr,
+		Analytics:    analyticsHdlr,
+		WebSocket:    wsHdlr,
+		Pricing:      pricingHdlr,
+		Promotion:    promotionHdlr,
+		Adjustment:   adjustmentHdlr,
+		Admin:        adminHdlr,
+		FeatureFlag:  featureFlagHdlr,
+		Rebuild:      rebuildHdlr,
+		Anomaly:      anomalyHdlr,
+		Movement:     movementHdlr,
+		Category:     categoryHdlr,
+		Assembly:     assemblyHdlr,
+		Customer:     customerHdlr,
+		Purchasing:   purchasingHdlr,
+		PriceTier:    priceTierHdlr,
+		SalesOrder:   salesOrderHdlr,
+		Tax:          taxHdlr,
+		Sequence:     documentSequenceHdlr,
+		Label:        labelHdlr,
+		Ledger:       ledgerHdlr,
+		Integrity:    integrityHdlr,
+	})
+	opts := router.Options{
+		Feature: func(key string) echo.MiddlewareFunc { return appmiddleware.RequireFeature(featureFlagSvc, key) },
+	}
+	if cfg.AdminPort == "" {
+		router.Register(e, routes, opts)
+		return &App{Public: e}, nil
+	}
+	admin := newAdminEcho()
+	router.Register(e, router.ForListener(routes, router.ListenerPublic), opts)
+	router.Register(admin, router.ForListener(routes, router.ListenerAdmin), opts)
+	return &App{Public: e, Admin: admin}, nil
+}
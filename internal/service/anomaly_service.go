@@ -0,0 +1,254 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"slices"
+	"time"
+
+	"inventory-system/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+const (
+	anomalyScanWindow     = 24 * time.Hour      // Stock movements looked at by each run
+	anomalyBaselineWindow = 90 * 24 * time.Hour // History they are compared with, ending where the scan window starts
+	anomalyMinSamples     = 5                   // Observations needed before a baseline is trusted
+	anomalyThreshold      = 3.0                 // Standard deviations above the mean that count as anomalous
+)
+
+// anomalyWriteOffReasons are the reasons of the movements the large write-off rule looks at:
+// stock written off or counted down by hand. Sales and assembly consumption remove stock too,
+// but a large order is business, not loss.
+var anomalyWriteOffReasons = []string{
+	domain.MovementReasonDamage,
+	domain.MovementReasonCorrection,
+	domain.MovementReasonEdit,
+	domain.MovementReasonAdjustment,
+	domain.AdjustmentReasonCycleCount,
+	domain.AdjustmentReasonShrinkage,
+}
+
+// AnomalyRules configures what the anomaly detection job flags and whom it tells.
+type AnomalyRules struct {
+	BusinessHoursStart int            // First hour of the working day, 0-23
+	BusinessHoursEnd   int            // Hour the working day ends, 1-24; movements from then until the start are after hours
+	Location           *time.Location // Time zone of the business hours; nil means UTC
+	NotifyUsers        []string       // Receive a notification for every new anomaly, e.g. the loss prevention team
+}
+
+type anomalyService struct {
+	repo      domain.AnomalyRepository
+	movements domain.StockMovementRepository
+	notifier  domain.NotificationService // May be nil
+	rules     AnomalyRules
+}
+
+// NewAnomalyService creates a new AnomalyService. notifier may be nil, in which case
+// anomalies are only recorded.
+func NewAnomalyService(repo domain.AnomalyRepository, movements domain.StockMovementRepository,
+	notifier domain.NotificationService, rules AnomalyRules) domain.AnomalyService {
+	if rules.Location == nil {
+		rules.Location = time.UTC
+	}
+	return &anomalyService{
+		repo:      repo,
+		movements: movements,
+		notifier:  notifier,
+		rules:     rules,
+	}
+}
+
+// RunAnomalyDetection runs svc.Detect every interval until ctx is cancelled.
+// It must be run in a separate goroutine.
+func RunAnomalyDetection(ctx context.Context, svc domain.AnomalyService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		result, err := svc.Detect(ctx, time.Now())
+		if err != nil {
+			log.Printf("Anomaly detection failed: %v", err)
+		} else if len(result.Flagged) > 0 {
+			log.Printf("Anomaly detection flagged %d of %d stock movement(s)", len(result.Flagged), result.Scanned)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Detect compares the stock movements of the last day with the 90 days before, so every
+// quantity change counts, whichever endpoint made it. Runs overlap, so anomalies are
+// deduplicated by what was flagged; only new ones are returned and notified.
+func (s *anomalyService) Detect(ctx context.Context, now time.Time) (*domain.AnomalyDetectionResult, error) {
+	scanFrom := now.Add(-anomalyScanWindow)
+	baselineFrom := scanFrom.Add(-anomalyBaselineWindow)
+
+	recent, err := s.movements.ListSince(ctx, scanFrom)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to load recent stock movements: %w", err)
+	}
+	removals, err := s.movements.RemovalStats(ctx, baselineFrom, scanFrom, anomalyWriteOffReasons)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to load removal baseline: %w", err)
+	}
+	volumes, err := s.movements.DailyVolumeStats(ctx, baselineFrom, scanFrom)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to load volume baseline: %w", err)
+	}
+
+	candidates := s.flag(recent, removals, volumes, now)
+	result := &domain.AnomalyDetectionResult{Scanned: len(recent), Flagged: []*domain.Anomaly{}}
+	for _, a := range candidates {
+		created, err := s.repo.Create(ctx, a)
+		if err != nil {
+			return nil, fmt.Errorf("service: failed to record anomaly: %w", err)
+		}
+		if created {
+			result.Flagged = append(result.Flagged, a)
+			s.notify(ctx, a)
+		}
+	}
+	return result, nil
+}
+
+// flag applies the detection rules to the recent movements. Movements that did not carry a
+// user, e.g. the opening balance, are only checked for their size: nobody was at work for them.
+func (s *anomalyService) flag(recent []*domain.StockMovement, removals, volumes map[string]domain.AdjustmentStats, now time.Time) []*domain.Anomaly {
+	var anomalies []*domain.Anomaly
+	linesByUser := make(map[string]int)
+	for _, m := range recent {
+		movementID, itemID := m.ID, m.ItemID
+
+		if m.Delta < 0 && slices.Contains(anomalyWriteOffReasons, m.Reason) {
+			stats := removals[m.ItemID]
+			if score, ok := zScore(float64(-m.Delta), stats); ok {
+				anomalies = append(anomalies, &domain.Anomaly{
+					Kind:       domain.AnomalyKindLargeWriteOff,
+					MovementID: &movementID,
+					ItemID:     &itemID,
+					UserID:     m.MovedBy,
+					Score:      score,
+					Details: fmt.Sprintf("%s removed %d from item %s (%s); removals are usually %.1f ± %.1f",
+						movedBy(m), -m.Delta, m.ItemID, m.Reason, stats.Mean, stats.StdDev),
+					DedupeKey: domain.AnomalyKindLargeWriteOff + ":" + m.ID,
+				})
+			}
+		}
+
+		if m.MovedBy == "" {
+			continue
+		}
+		linesByUser[m.MovedBy]++
+		if local := m.MovedAt.In(s.rules.Location); !s.duringBusinessHours(local) {
+			anomalies = append(anomalies, &domain.Anomaly{
+				Kind:       domain.AnomalyKindAfterHours,
+				MovementID: &movementID,
+				ItemID:     &itemID,
+				UserID:     m.MovedBy,
+				Details: fmt.Sprintf("%s adjusted item %s by %+d at %s, outside business hours (%02d:00-%02d:00 %s)",
+					m.MovedBy, m.ItemID, m.Delta, local.Format("2006-01-02 15:04"),
+					s.rules.BusinessHoursStart, s.rules.BusinessHoursEnd, s.rules.Location),
+				DedupeKey: domain.AnomalyKindAfterHours + ":" + m.ID,
+			})
+		}
+	}
+
+	day := now.In(s.rules.Location).Format(time.DateOnly)
+	for user, lines := range linesByUser {
+		stats := volumes[user]
+		if score, ok := zScore(float64(lines), stats); ok {
+			anomalies = append(anomalies, &domain.Anomaly{
+				Kind:   domain.AnomalyKindUserVolume,
+				UserID: user,
+				Score:  score,
+				Details: fmt.Sprintf("%s made %d stock movements in the last 24 hours; usually %.1f ± %.1f per active day",
+					user, lines, stats.Mean, stats.StdDev),
+				DedupeKey: domain.AnomalyKindUserVolume + ":" + user + ":" + day, // At most one per user and day
+			})
+		}
+	}
+	return anomalies
+}
+
+// movedBy names who made m in anomaly details.
+func movedBy(m *domain.StockMovement) string {
+	if m.MovedBy == "" {
+		return "An unknown user"
+	}
+	return m.MovedBy
+}
+
+func (s *anomalyService) duringBusinessHours(t time.Time) bool {
+	return t.Hour() >= s.rules.BusinessHoursStart && t.Hour() < s.rules.BusinessHoursEnd
+}
+
+// zScore returns how many standard deviations value lies above the mean of stats, and
+// whether that is anomalous. Quantities are whole numbers, so deviations below one are
+// treated as one: an item always written off one at a time is not flagged for losing two.
+func zScore(value float64, stats domain.AdjustmentStats) (float64, bool) {
+	if stats.Count < anomalyMinSamples {
+		return 0, false
+	}
+	score := (value - stats.Mean) / math.Max(stats.StdDev, 1)
+	return math.Round(score*100) / 100, score >= anomalyThreshold
+}
+
+// notify tells the configured users about a new anomaly. Failures are logged: the anomaly
+// itself is recorded and can be found through the API.
+func (s *anomalyService) notify(ctx context.Context, a *domain.Anomaly) {
+	if s.notifier == nil {
+		return
+	}
+	entityType, entityID := domain.AnomalyEntityType, a.ID
+	for _, userID := range s.rules.NotifyUsers {
+		_, err := s.notifier.Notify(ctx, &domain.Notification{
+			UserID:     userID,
+			Type:       domain.NotificationTypeAnomaly,
+			Message:    a.Details,
+			EntityType: &entityType,
+			EntityID:   &entityID,
+		})
+		if err != nil {
+			log.Printf("Service: failed to notify %s about anomaly %s: %v", userID, a.ID, err)
+		}
+	}
+}
+
+// ListAnomalies returns flagged anomalies, newest first.
+func (s *anomalyService) ListAnomalies(ctx context.Context, q domain.ListAnomaliesQuery) ([]*domain.Anomaly, error) {
+	if q.Limit <= 0 {
+		q.Limit = 50
+	} else if q.Limit > 200 {
+		q.Limit = 200
+	}
+	anomalies, err := s.repo.List(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to list anomalies: %w", err)
+	}
+	return anomalies, nil
+}
+
+// Acknowledge marks an anomaly as reviewed by userID.
+func (s *anomalyService) Acknowledge(ctx context.Context, id, userID string) (*domain.Anomaly, error) {
+	if userID == "" {
+		return nil, domain.ErrMissingUser
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	a, err := s.repo.Acknowledge(ctx, id, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrAnomalyNotFound, id)
+		}
+		return nil, fmt.Errorf("service: failed to acknowledge anomaly '%s': %w", id, err)
+	}
+	return a, nil
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/realtime"
+
+	"github.com/google/uuid"
+)
+
+type assemblyService struct {
+	repo    domain.AssemblyRepository
+	hub     *realtime.Hub              // Receives the new quantities after an assembly
+	changes domain.ItemChangePublisher // Told about items changed by an assembly; may be nil
+}
+
+// NewAssemblyService creates a new AssemblyService.
+func NewAssemblyService(repo domain.AssemblyRepository, hub *realtime.Hub, changes domain.ItemChangePublisher) domain.AssemblyService {
+	return &assemblyService{
+		repo:    repo,
+		hub:     hub,
+		changes: changes,
+	}
+}
+
+// SetComponents replaces the bill of materials of an item. Orders already created keep the
+// components they were created with.
+func (s *assemblyService) SetComponents(ctx context.Context, itemID string, req *domain.SetComponentsRequest) ([]domain.Component, error) {
+	if _, err := uuid.Parse(itemID); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidItemID, itemID)
+	}
+	seen := make(map[string]bool, len(req.Components))
+	for _, c := range req.Components {
+		if c.ComponentID == itemID {
+			return nil, fmt.Errorf("%w: an item cannot be a component of itself", domain.ErrInvalidInput)
+		}
+		if seen[c.ComponentID] {
+			return nil, fmt.Errorf("%w: component '%s' listed twice", domain.ErrInvalidInput, c.ComponentID)
+		}
+		seen[c.ComponentID] = true
+	}
+
+	if err := s.repo.SetComponents(ctx, itemID, req.Components); err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: %v", domain.ErrItemNotFound, err)
+		}
+		return nil, fmt.Errorf("service: failed to set components of item '%s': %w", itemID, err)
+	}
+	return s.GetComponents(ctx, itemID)
+}
+
+// GetComponents returns the bill of materials of an item.
+func (s *assemblyService) GetComponents(ctx context.Context, itemID string) ([]domain.Component, error) {
+	if _, err := uuid.Parse(itemID); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidItemID, itemID)
+	}
+	components, err := s.repo.ListComponents(ctx, itemID)
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrItemNotFound, itemID)
+		}
+		return nil, fmt.Errorf("service: failed to get components of item '%s': %w", itemID, err)
+	}
+	return components, nil
+}
+
+// CreateOrder opens an assembly order. Stock is not touched until the order is completed.
+func (s *assemblyService) CreateOrder(ctx context.Context, req *domain.CreateAssemblyOrderRequest, userID string) (*domain.AssemblyOrder, error) {
+	if userID == "" {
+		return nil, domain.ErrMissingUser
+	}
+	created, err := s.repo.CreateOrder(ctx, &domain.AssemblyOrder{ItemID: req.ItemID, Quantity: req.Quantity, CreatedBy: userID})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRepositoryNotFound):
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrItemNotFound, req.ItemID)
+		case errors.Is(err, domain.ErrNoComponents):
+			return nil, err
+		}
+		return nil, fmt.Errorf("service: failed to create assembly order: %w", err)
+	}
+	return created, nil
+}
+
+// GetOrder retrieves an assembly order by ID.
+func (s *assemblyService) GetOrder(ctx context.Context, id string) (*domain.AssemblyOrder, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	o, err := s.repo.GetOrder(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrAssemblyOrderNotFound, id)
+		}
+		return nil, fmt.Errorf("service: failed to get assembly order '%s': %w", id, err)
+	}
+	return o, nil
+}
+
+// ListOrders returns the latest assembly orders, newest first.
+func (s *assemblyService) ListOrders(ctx context.Context, q domain.ListAssemblyOrdersQuery) ([]*domain.AssemblyOrder, error) {
+	orders, err := s.repo.ListOrders(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to list assembly orders: %w", err)
+	}
+	return orders, nil
+}
+
+// CompleteOrder consumes the components of an open order, receives the assembled items and
+// broadcasts the new stock levels.
+func (s *assemblyService) CompleteOrder(ctx context.Context, id, userID string) (*domain.CompleteAssemblyResult, error) {
+	if userID == "" {
+		return nil, domain.ErrMissingUser
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	result, err := s.repo.Complete(ctx, id, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRepositoryNotFound):
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrAssemblyOrderNotFound, id)
+		case errors.Is(err, domain.ErrAssemblyOrderClosed), errors.Is(err, domain.ErrInsufficientStock):
+			return nil, err
+		}
+		return nil, fmt.Errorf("service: failed to complete assembly order '%s': %w", id, err)
+	}
+
+	itemIDs := make([]string, 0, len(result.Items))
+	for _, item := range result.Items {
+		itemIDs = append(itemIDs, item.ID)
+		if s.hub != nil {
+			s.hub.BroadcastStockUpdate(ctx, domain.StockUpdatePayload{
+				ID:          item.ID,
+				SKU:         item.SKU,
+				NewQuantity: item.Quantity,
+			})
+		}
+	}
+	if s.changes != nil {
+		s.changes.PublishItemChanged(ctx, itemIDs...)
+	}
+	return result, nil
+}
+
+// CancelOrder closes an open order without touching stock.
+func (s *assemblyService) CancelOrder(ctx context.Context, id, userID string) (*domain.AssemblyOrder, error) {
+	if userID == "" {
+		return nil, domain.ErrMissingUser
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	o, err := s.repo.Cancel(ctx, id, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRepositoryNotFound):
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrAssemblyOrderNotFound, id)
+		case errors.Is(err, domain.ErrAssemblyOrderClosed):
+			return nil, err
+		}
+		return nil, fmt.Errorf("service: failed to cancel assembly order '%s': %w", id, err)
+	}
+	return o, nil
+}
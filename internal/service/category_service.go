@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"inventory-system/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type categoryService struct {
+	repo    domain.CategoryRepository
+	changes domain.ItemChangePublisher // Told about items whose category changed; may be nil
+}
+
+// NewCategoryService creates a new CategoryService.
+func NewCategoryService(repo domain.CategoryRepository, changes domain.ItemChangePublisher) domain.CategoryService {
+	return &categoryService{repo: repo, changes: changes}
+}
+
+// CreateCategory creates a category, as a root or below an existing one.
+func (s *categoryService) CreateCategory(ctx context.Context, req *domain.CreateCategoryRequest) (*domain.Category, error) {
+	created, err := s.repo.Create(ctx, &domain.Category{Name: req.Name, ParentID: req.ParentID})
+	if err != nil {
+		return nil, categoryWriteError(err, req.Name, "create category")
+	}
+	return created, nil
+}
+
+// GetCategory retrieves a category with its direct children.
+func (s *categoryService) GetCategory(ctx context.Context, id string) (*domain.Category, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	c, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrCategoryNotFound, id)
+		}
+		return nil, fmt.Errorf("service: failed to get category '%s': %w", id, err)
+	}
+	return c, nil
+}
+
+// ListCategories returns every category, ordered by name. Clients build the tree from parent_id.
+func (s *categoryService) ListCategories(ctx context.Context) ([]*domain.Category, error) {
+	categories, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to list categories: %w", err)
+	}
+	return categories, nil
+}
+
+// UpdateCategory renames a category and moves it below another one, or to the root.
+// Its subcategories and items move along with it.
+func (s *categoryService) UpdateCategory(ctx context.Context, id string, req *domain.UpdateCategoryRequest) (*domain.Category, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	updated, err := s.repo.Update(ctx, &domain.Category{ID: id, Name: req.Name, ParentID: req.ParentID})
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrCategoryNotFound, id)
+		}
+		return nil, categoryWriteError(err, req.Name, "update category '"+id+"'")
+	}
+	return updated, nil
+}
+
+// DeleteCategory deletes a category without subcategories. Its items become uncategorized.
+func (s *categoryService) DeleteCategory(ctx context.Context, id, userID string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	itemIDs, err := s.repo.Delete(ctx, id, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRepositoryNotFound):
+			return fmt.Errorf("%w: ID %s", domain.ErrCategoryNotFound, id)
+		case errors.Is(err, domain.ErrCategoryHasChildren):
+			return err
+		}
+		return fmt.Errorf("service: failed to delete category '%s': %w", id, err)
+	}
+	if s.changes != nil && len(itemIDs) > 0 {
+		s.changes.PublishItemChanged(ctx, itemIDs...)
+	}
+	return nil
+}
+
+// AssignItem puts an item into a category, or takes it out of its category.
+func (s *categoryService) AssignItem(ctx context.Context, itemID string, req *domain.AssignCategoryRequest, userID string) error {
+	if _, err := uuid.Parse(itemID); err != nil {
+		return fmt.Errorf("%w: %s", domain.ErrInvalidItemID, itemID)
+	}
+	if err := s.repo.AssignItem(ctx, itemID, req.CategoryID, userID); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRepositoryNotFound):
+			return fmt.Errorf("%w: ID %s", domain.ErrItemNotFound, itemID)
+		case errors.Is(err, domain.ErrCategoryNotFound):
+			return err
+		}
+		return fmt.Errorf("service: failed to assign item '%s' to category: %w", itemID, err)
+	}
+	if s.changes != nil {
+		s.changes.PublishItemChanged(ctx, itemID)
+	}
+	return nil
+}
+
+// categoryWriteError maps repository errors of category writes to service errors.
+func categoryWriteError(err error, name, action string) error {
+	switch {
+	case errors.Is(err, domain.ErrRepositoryDuplicateEntry):
+		return fmt.Errorf("%w: '%s'", domain.ErrCategoryNameTaken, name)
+	case errors.Is(err, domain.ErrCategoryNotFound), errors.Is(err, domain.ErrCategoryCycle):
+		return err
+	}
+	return fmt.Errorf("service: failed to %s: %w", action, err)
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"inventory-system/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// mentionRegex matches @handles such as "@alice" or "@j.doe-2".
+// Go's regexp has no lookbehind, so the handle must follow the start of the text or a
+// non-handle character; this keeps e-mail addresses ("bob@example.com") from matching.
+var mentionRegex = regexp.MustCompile(`(?:^|[^a-zA-Z0-9._-])@([a-zA-Z0-9][a-zA-Z0-9._-]*)`)
+
+type commentService struct {
+	repo     domain.CommentRepository
+	itemRepo domain.ItemRepository // Used to verify that commented items exist
+	notifier domain.MentionNotifier
+}
+
+// NewCommentService creates a new CommentService.
+// notifier may be nil if mention notifications are not wired up.
+func NewCommentService(repo domain.CommentRepository, itemRepo domain.ItemRepository, notifier domain.MentionNotifier) domain.CommentService {
+	return &commentService{
+		repo:     repo,
+		itemRepo: itemRepo,
+		notifier: notifier,
+	}
+}
+
+// AddComment validates the target entity, extracts @mentions and stores the comment.
+func (s *commentService) AddComment(ctx context.Context, entityType, entityID, author string, req *domain.CreateCommentRequest) (*domain.Comment, error) {
+	if author == "" {
+		return nil, domain.ErrMissingUser
+	}
+	if err := s.ensureEntityExists(ctx, entityType, entityID); err != nil {
+		return nil, err
+	}
+
+	comment := &domain.Comment{
+		EntityType: entityType,
+		EntityID:   entityID,
+		Author:     author,
+		Body:       strings.TrimSpace(req.Body),
+		Mentions:   parseMentions(req.Body),
+	}
+	created, err := s.repo.Create(ctx, comment)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to add comment: %w", err)
+	}
+
+	if s.notifier != nil && len(created.Mentions) > 0 {
+		s.notifier.NotifyMentions(ctx, created)
+	}
+	return created, nil
+}
+
+// ListComments returns all comments on an entity.
+func (s *commentService) ListComments(ctx context.Context, entityType, entityID string) ([]*domain.Comment, error) {
+	if err := s.ensureEntityExists(ctx, entityType, entityID); err != nil {
+		return nil, err
+	}
+	comments, err := s.repo.ListByEntity(ctx, entityType, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to list comments: %w", err)
+	}
+	return comments, nil
+}
+
+// DeleteComment removes a comment. Only its author may delete it.
+func (s *commentService) DeleteComment(ctx context.Context, id, requester string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	if requester == "" {
+		return domain.ErrMissingUser
+	}
+
+	comment, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return fmt.Errorf("%w: ID %s", domain.ErrCommentNotFound, id)
+		}
+		return fmt.Errorf("service: failed to get comment '%s': %w", id, err)
+	}
+	if comment.Author != requester {
+		return domain.ErrNotCommentAuthor
+	}
+
+	if err := s.repo.Delete(ctx, id); err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return fmt.Errorf("%w: ID %s", domain.ErrCommentNotFound, id)
+		}
+		return fmt.Errorf("service: failed to delete comment '%s': %w", id, err)
+	}
+	return nil
+}
+
+// ensureEntityExists checks that the commented entity is known and exists.
+func (s *commentService) ensureEntityExists(ctx context.Context, entityType, entityID string) error {
+	switch entityType {
+	case domain.CommentEntityItem:
+		if _, err := uuid.Parse(entityID); err != nil {
+			return fmt.Errorf("%w: %s", domain.ErrInvalidItemID, entityID)
+		}
+		if _, err := s.itemRepo.GetByID(ctx, entityID); err != nil {
+			if errors.Is(err, domain.ErrItemNotFound) || errors.Is(err, domain.ErrRepositoryNotFound) {
+				return fmt.Errorf("%w: ID %s", domain.ErrItemNotFound, entityID)
+			}
+			return fmt.Errorf("service: failed to look up item '%s': %w", entityID, err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", domain.ErrUnsupportedEntityType, entityType)
+	}
+}
+
+// parseMentions returns the unique @handles found in body, in order of appearance.
+func parseMentions(body string) []string {
+	matches := mentionRegex.FindAllStringSubmatch(body, -1)
+	mentions := make([]string, 0, len(matches))
+	seen := make(map[string]bool, len(matches))
+	for _, m := range matches {
+		handle := strings.TrimRight(m[1], ".-_") // Drop trailing punctuation, e.g. "@alice."
+		if handle == "" || seen[handle] {
+			continue
+		}
+		seen[handle] = true
+		mentions = append(mentions, handle)
+	}
+	return mentions
+}
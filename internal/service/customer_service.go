@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"inventory-system/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type customerService struct {
+	repo domain.CustomerRepository
+}
+
+// NewCustomerService creates a new CustomerService.
+func NewCustomerService(repo domain.CustomerRepository) domain.CustomerService {
+	return &customerService{repo: repo}
+}
+
+// CreateCustomer creates a customer, in the retail tier unless another one is given.
+func (s *customerService) CreateCustomer(ctx context.Context, req *domain.CreateCustomerRequest) (*domain.Customer, error) {
+	created, err := s.repo.Create(ctx, &domain.Customer{Name: req.Name, Email: req.Email, PriceTier: priceTierOrRetail(req.PriceTier)})
+	if err != nil {
+		return nil, customerWriteError(err, req.Email, "create customer")
+	}
+	return created, nil
+}
+
+// GetCustomer retrieves a customer by ID.
+func (s *customerService) GetCustomer(ctx context.Context, id string) (*domain.Customer, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	c, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrCustomerNotFound, id)
+		}
+		return nil, fmt.Errorf("service: failed to get customer '%s': %w", id, err)
+	}
+	return c, nil
+}
+
+// ListCustomers returns a page of customers, ordered by name.
+func (s *customerService) ListCustomers(ctx context.Context, q domain.ListCustomersQuery) ([]*domain.Customer, error) {
+	customers, err := s.repo.List(ctx, q.Page, q.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to list customers: %w", err)
+	}
+	return customers, nil
+}
+
+// UpdateCustomer replaces the name, email and price tier of a customer.
+func (s *customerService) UpdateCustomer(ctx context.Context, id string, req *domain.UpdateCustomerRequest) (*domain.Customer, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	updated, err := s.repo.Update(ctx, &domain.Customer{ID: id, Name: req.Name, Email: req.Email, PriceTier: priceTierOrRetail(req.PriceTier)})
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrCustomerNotFound, id)
+		}
+		return nil, customerWriteError(err, req.Email, "update customer '"+id+"'")
+	}
+	return updated, nil
+}
+
+// customerWriteError maps repository errors of customer writes to service errors.
+func customerWriteError(err error, email *string, action string) error {
+	if errors.Is(err, domain.ErrRepositoryDuplicateEntry) && email != nil {
+		return fmt.Errorf("%w: '%s'", domain.ErrCustomerEmailTaken, *email)
+	}
+	return fmt.Errorf("service: failed to %s: %w", action, err)
+}
+
+// priceTierOrRetail returns tier, or the retail tier if it is empty.
+func priceTierOrRetail(tier string) string {
+	if tier == "" {
+		return domain.PriceTierRetail
+	}
+	return tier
+}
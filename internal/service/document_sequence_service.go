@@ -0,0 +1,40 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"inventory-system/internal/domain"
+)
+
+type documentSequenceService struct {
+	repo domain.DocumentSequenceRepository
+}
+
+// NewDocumentSequenceService creates a new DocumentSequenceService.
+func NewDocumentSequenceService(repo domain.DocumentSequenceRepository) domain.DocumentSequenceService {
+	return &documentSequenceService{repo: repo}
+}
+
+// ListSequences returns the numbering sequence of every document type.
+func (s *documentSequenceService) ListSequences(ctx context.Context) ([]*domain.DocumentSequence, error) {
+	sequences, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to list document sequences: %w", err)
+	}
+	return sequences, nil
+}
+
+// SetSequence configures how documents of a type are numbered from now on. Documents keep the
+// numbers they have; a changed prefix starts its own counter.
+func (s *documentSequenceService) SetSequence(ctx context.Context, docType string, req *domain.SetDocumentSequenceRequest) (*domain.DocumentSequence, error) {
+	seq, err := s.repo.Update(ctx, &domain.DocumentSequence{DocType: docType, Prefix: req.Prefix, Yearly: req.Yearly, Padding: req.Padding})
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: '%s'", domain.ErrDocumentSequenceNotFound, docType)
+		}
+		return nil, fmt.Errorf("service: failed to set document sequence '%s': %w", docType, err)
+	}
+	return seq, nil
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"regexp"
+	"sync"
+	"time"
+
+	"inventory-system/internal/domain"
+)
+
+// flagsTTL bounds how long a flag change made on another instance takes to apply here.
+const flagsTTL = 30 * time.Second
+
+// flagKeyPattern restricts flag keys to lower-case words separated by dots, dashes or underscores.
+var flagKeyPattern = regexp.MustCompile(`^[a-z0-9]+([._-][a-z0-9]+)*$`)
+
+type featureFlagService struct {
+	repo domain.FeatureFlagRepository
+
+	mu       sync.Mutex
+	flags    map[string]*domain.FeatureFlag // Cached flags by key; nil until first loaded
+	loadedAt time.Time
+}
+
+// NewFeatureFlagService creates a new FeatureFlagService. Flags are read from the repository
+// at most once per flagsTTL, so checking a flag on a hot path costs no database round trip.
+func NewFeatureFlagService(repo domain.FeatureFlagRepository) domain.FeatureFlagService {
+	return &featureFlagService{repo: repo}
+}
+
+// Enabled reports whether the feature is on for subject. If the flags cannot be loaded, the
+// last known state is used; if they were never loaded, every feature is off.
+func (s *featureFlagService) Enabled(ctx context.Context, key, subject string) bool {
+	f, ok := s.cached(ctx)[key]
+	if !ok {
+		return false
+	}
+	if on, ok := f.Overrides[subject]; ok && subject != "" {
+		return on
+	}
+	if !f.Enabled {
+		return false
+	}
+	return rolloutBucket(key, subject) < f.RolloutPercent
+}
+
+// ListFlags returns every flag, read from the repository rather than the cache.
+func (s *featureFlagService) ListFlags(ctx context.Context) ([]*domain.FeatureFlag, error) {
+	flags, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to list feature flags: %w", err)
+	}
+	return flags, nil
+}
+
+// SetFlag creates or replaces a flag. The change applies on this instance immediately.
+func (s *featureFlagService) SetFlag(ctx context.Context, key string, req *domain.SetFeatureFlagRequest, updatedBy string) (*domain.FeatureFlag, error) {
+	if updatedBy == "" {
+		return nil, domain.ErrMissingUser
+	}
+	if len(key) > 100 || !flagKeyPattern.MatchString(key) {
+		return nil, fmt.Errorf("%w: invalid flag key %q", domain.ErrInvalidInput, key)
+	}
+	if req.RolloutPercent < 0 || req.RolloutPercent > 100 {
+		return nil, fmt.Errorf("%w: rollout_percent must be between 0 and 100", domain.ErrInvalidInput)
+	}
+
+	flag, err := s.repo.Upsert(ctx, &domain.FeatureFlag{
+		Key:            key,
+		Description:    req.Description,
+		Enabled:        req.Enabled,
+		RolloutPercent: req.RolloutPercent,
+		Overrides:      req.Overrides,
+		UpdatedBy:      updatedBy,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to save feature flag: %w", err)
+	}
+	s.invalidate()
+	return flag, nil
+}
+
+// DeleteFlag removes a flag, which turns the feature off for everyone.
+func (s *featureFlagService) DeleteFlag(ctx context.Context, key string) error {
+	if err := s.repo.Delete(ctx, key); err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return fmt.Errorf("%w: %s", domain.ErrFeatureFlagNotFound, key)
+		}
+		return fmt.Errorf("service: failed to delete feature flag: %w", err)
+	}
+	s.invalidate()
+	return nil
+}
+
+// cached returns the flags by key, reloading them when older than flagsTTL.
+func (s *featureFlagService) cached(ctx context.Context) map[string]*domain.FeatureFlag {
+	s.mu.Lock()
+	defer s.mu.Unlock() // Held during the load so concurrent misses query only once
+	if s.flags != nil && time.Since(s.loadedAt) < flagsTTL {
+		return s.flags
+	}
+	flags, err := s.repo.List(ctx)
+	if err != nil {
+		log.Printf("FeatureFlags: failed to load flags, keeping last known state: %v", err)
+		s.loadedAt = time.Now() // Do not hammer a failing database on every check
+		return s.flags
+	}
+	s.flags = make(map[string]*domain.FeatureFlag, len(flags))
+	for _, f := range flags {
+		s.flags[f.Key] = f
+	}
+	s.loadedAt = time.Now()
+	return s.flags
+}
+
+func (s *featureFlagService) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.loadedAt = time.Time{}
+}
+
+// rolloutBucket maps a subject to a stable bucket in [0, 100) for the given flag. Hashing the
+// key too means the same users are not always the first to get every new feature.
+func rolloutBucket(key, subject string) int {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	h.Write([]byte{0})
+	h.Write([]byte(subject))
+	return int(h.Sum32() % 100)
+}
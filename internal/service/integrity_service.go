@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"inventory-system/internal/domain"
+)
+
+type integrityService struct {
+	repo domain.IntegrityRepository
+}
+
+// NewIntegrityService creates a new IntegrityService.
+func NewIntegrityService(repo domain.IntegrityRepository) domain.IntegrityService {
+	return &integrityService{repo: repo}
+}
+
+// Report runs the integrity checks. Open sales orders older than q.StaleDays count as stale
+// reservations.
+func (s *integrityService) Report(ctx context.Context, q domain.IntegrityQuery) (*domain.IntegrityReport, error) {
+	if q.StaleDays <= 0 {
+		q.StaleDays = 30
+	}
+	now := time.Now().UTC()
+	counts, err := s.repo.Count(ctx, now.AddDate(0, 0, -q.StaleDays))
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to check data integrity: %w", err)
+	}
+
+	report := &domain.IntegrityReport{
+		CheckedAt: now,
+		Checks: []domain.IntegrityCheck{
+			{
+				Name:        domain.IntegrityOrphanedComments,
+				Description: "Comments on items that were purged",
+				Count:       counts.OrphanedComments,
+			},
+			{
+				Name:        domain.IntegrityOrphanedNotifications,
+				Description: "Notifications about items, anomalies or ledger discrepancies that no longer exist",
+				Count:       counts.OrphanedNotifications,
+			},
+			{
+				Name:        domain.IntegrityLedgerMismatches,
+				Description: "Items whose quantity differs from the sum of their stock movements, as of the last ledger check",
+				Count:       counts.LedgerMismatches,
+				Link:        "/api/v1/ledger/discrepancies?open=true",
+			},
+			{
+				Name:        domain.IntegrityUncategorizedItems,
+				Description: "Items without a category",
+				Count:       counts.UncategorizedItems,
+			},
+			{
+				Name:        domain.IntegrityStaleReservations,
+				Description: fmt.Sprintf("Open sales orders placed more than %d days ago, still reserving their stock", q.StaleDays),
+				Count:       counts.StaleReservations,
+				Link:        "/api/v1/sales-orders?status=" + domain.SalesOrderStatusOpen,
+			},
+			{
+				Name:        domain.IntegrityTrashedReservations,
+				Description: "Open sales orders reserving stock of a trashed item, which cannot be fulfilled until it is restored",
+				Count:       counts.TrashedReservations,
+				Link:        "/api/v1/sales-orders?status=" + domain.SalesOrderStatusOpen,
+			},
+		},
+	}
+	report.Healthy = true
+	for _, check := range report.Checks {
+		if check.Count > 0 {
+			report.Healthy = false
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/realtime"
+
+	"github.com/google/uuid"
+)
+
+type itemImportService struct {
+	repo    domain.ItemImportRepository
+	hub     *realtime.Hub              // Receives one combined update per import
+	changes domain.ItemChangePublisher // Told about updated items; may be nil
+}
+
+// NewItemImportService creates a new ItemImportService.
+func NewItemImportService(repo domain.ItemImportRepository, hub *realtime.Hub, changes domain.ItemChangePublisher) domain.ItemImportService {
+	return &itemImportService{
+		repo:    repo,
+		hub:     hub,
+		changes: changes,
+	}
+}
+
+// ImportItems imports the rows of imp in one transaction. Rows repeating the SKU of an earlier
+// row are rejected, and so, in insert mode, are rows whose SKU is taken. If any row is
+// rejected, nothing is written and the report is returned with ErrImportRejected.
+func (s *itemImportService) ImportItems(ctx context.Context, imp *domain.ItemImport, userID string) (*domain.ItemImportResult, error) {
+	if len(imp.Rows)+len(imp.Rejected) == 0 {
+		return nil, fmt.Errorf("%w: the file has no rows", domain.ErrInvalidInput)
+	}
+	result := &domain.ItemImportResult{Mode: imp.Mode, Rows: len(imp.Rows) + len(imp.Rejected), Errors: imp.Rejected}
+
+	firstLine := make(map[string]int, len(imp.Rows))
+	skus := make([]string, 0, len(imp.Rows))
+	for _, row := range imp.Rows {
+		if line, ok := firstLine[row.Item.SKU]; ok {
+			result.Errors = append(result.Errors, domain.ImportRowError{Line: row.Line, SKU: row.Item.SKU,
+				Errors: map[string]string{"sku": fmt.Sprintf("Repeats the SKU of line %d", line)}})
+			continue
+		}
+		firstLine[row.Item.SKU] = row.Line
+		skus = append(skus, row.Item.SKU)
+	}
+	if imp.Mode == domain.ImportModeInsert && len(skus) > 0 {
+		existing, err := s.repo.ExistingSKUs(ctx, skus)
+		if err != nil {
+			return nil, fmt.Errorf("service: failed to check imported SKUs: %w", err)
+		}
+		for _, row := range imp.Rows {
+			if existing[row.Item.SKU] && firstLine[row.Item.SKU] == row.Line {
+				result.Errors = append(result.Errors, domain.ImportRowError{Line: row.Line, SKU: row.Item.SKU,
+					Errors: map[string]string{"sku": "An item with this SKU already exists"}})
+			}
+		}
+	}
+	if len(result.Errors) > 0 {
+		slices.SortFunc(result.Errors, func(a, b domain.ImportRowError) int { return cmp.Compare(a.Line, b.Line) })
+		return result, domain.ErrImportRejected
+	}
+
+	importID := uuid.NewString()
+	created, updated, err := s.repo.Import(ctx, imp.Rows, imp.Mode == domain.ImportModeUpsert, importID, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryDuplicateEntry) {
+			return nil, fmt.Errorf("%w: %v", domain.ErrSKUAlreadyExists, err)
+		}
+		if errors.Is(err, domain.ErrInsufficientStock) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("service: failed to import items: %w", err)
+	}
+	result.ImportID, result.Created, result.Updated = importID, len(created), len(updated)
+
+	updates := make([]domain.StockUpdatePayload, 0, len(created)+len(updated))
+	ids := make([]string, 0, len(updated))
+	for _, item := range created {
+		updates = append(updates, domain.StockUpdatePayload{ID: item.ID, SKU: item.SKU, NewQuantity: item.Quantity})
+	}
+	for _, item := range updated {
+		updates = append(updates, domain.StockUpdatePayload{ID: item.ID, SKU: item.SKU, NewQuantity: item.Quantity})
+		ids = append(ids, item.ID)
+	}
+	if s.changes != nil && len(ids) > 0 {
+		s.changes.PublishItemChanged(ctx, ids...)
+	}
+	if s.hub != nil {
+		s.hub.BroadcastStockBatchUpdate(ctx, domain.StockBatchUpdatePayload{BatchID: importID, Updates: updates})
+	}
+	return result, nil
+}
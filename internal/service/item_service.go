@@ -2,10 +2,14 @@ package service
 
 import (
 	"context"
-	"errors" // For domain-specific errors if you define them
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"log"
-	// "time" // Not directly needed here anymore unless for specific logic
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"inventory-system/internal/domain"
 	"inventory-system/internal/realtime" // For WebSocket Hub
@@ -13,30 +17,36 @@ import (
 	"github.com/google/uuid"
 )
 
-// Predefined errors (optional, but good practice for domain errors)
-var (
-	ErrItemNotFound      = errors.New("item not found")
-	ErrInvalidItemID     = errors.New("invalid item ID format")
-	ErrSKUAlreadyExists  = errors.New("item with this SKU already exists")
-	ErrUpdateNoChanges   = errors.New("no changes provided for update")
-)
 
+// optionsTTL bounds how stale cached item options get when items are changed by another
+// server instance. Changes made through this instance invalidate the cache at once.
+const optionsTTL = 30 * time.Second
 
 type itemService struct {
-	repo domain.ItemRepository
-	hub  *realtime.Hub // WebSocket hub for real-time updates
+	repo       domain.ItemRepository
+	hub        *realtime.Hub                 // WebSocket hub for real-time updates
+	promotions domain.PromotionPricer        // Fills in running promotions on reads; may be nil
+	revisions  domain.ItemRevisionRepository // Serves item histories; may be nil
+
+	optionsMu       sync.Mutex
+	options         []domain.ItemOption // Cached result of ListItemOptions; nil when invalid
+	optionsLoadedAt time.Time
 }
 
-// NewItemService creates a new ItemService.
-func NewItemService(repo domain.ItemRepository, hub *realtime.Hub) domain.ItemService {
+// NewItemService creates a new ItemService. Without revisions, item histories are empty.
+// The repositories record the revisions themselves, as they write.
+func NewItemService(repo domain.ItemRepository, hub *realtime.Hub, promotions domain.PromotionPricer,
+	revisions domain.ItemRevisionRepository) domain.ItemService {
 	return &itemService{
-		repo: repo,
-		hub:  hub,
+		repo:       repo,
+		hub:        hub,
+		promotions: promotions,
+		revisions:  revisions,
 	}
 }
 
 // CreateItem handles the business logic for creating a new item.
-func (s *itemService) CreateItem(ctx context.Context, req *domain.CreateItemRequest) (*domain.Item, error) {
+func (s *itemService) CreateItem(ctx context.Context, req *domain.CreateItemRequest, userID string) (*domain.Item, error) {
 	// Validation (e.g., using struct tags) should ideally occur in the handler layer
 	// before reaching the service. If basic checks are done here, ensure they are minimal.
 
@@ -51,16 +61,18 @@ func (s *itemService) CreateItem(ctx context.Context, req *domain.CreateItemRequ
 		// CreatedAt and UpdatedAt are set by the repository or database.
 	}
 
-	createdItem, err := s.repo.Create(ctx, newItem)
+	createdItem, err := s.repo.Create(ctx, newItem, userID)
 	if err != nil {
 		// Check if the error from repository indicates a duplicate SKU
 		// This depends on how the repository wraps the pgconn.PgError
 		if errors.Is(err, domain.ErrRepositoryDuplicateEntry) { // Assuming repo wraps pgErr.Code == "23505"
-		    return nil, fmt.Errorf("%w: SKU %s", ErrSKUAlreadyExists, req.SKU)
+		    return nil, fmt.Errorf("%w: SKU %s", domain.ErrSKUAlreadyExists, req.SKU)
 		}
 		return nil, fmt.Errorf("service: failed to create item: %w", err)
 	}
 
+	s.invalidateOptions()
+
 	// Example: Broadcast an event if necessary (e.g., "NEW_ITEM_ADDED")
 	// This depends on frontend requirements. For now, only stock quantity changes are broadcasted.
 
@@ -70,21 +82,44 @@ func (s *itemService) CreateItem(ctx context.Context, req *domain.CreateItemRequ
 // GetItemByID retrieves an item by its ID.
 func (s *itemService) GetItemByID(ctx context.Context, id string) (*domain.Item, error) {
 	if _, err := uuid.Parse(id); err != nil {
-		return nil, fmt.Errorf("%w: %s", ErrInvalidItemID, id)
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidItemID, id)
 	}
 
 	item, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, domain.ErrRepositoryNotFound) { // Assuming repo wraps pgx.ErrNoRows
-			return nil, fmt.Errorf("%w: ID %s", ErrItemNotFound, id)
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrItemNotFound, id)
 		}
 		return nil, fmt.Errorf("service: failed to get item by ID '%s': %w", id, err)
 	}
+	s.applyPromotions(ctx, item)
 	return item, nil
 }
 
-// GetItems retrieves a paginated list of items.
-func (s *itemService) GetItems(ctx context.Context, page, limit int) ([]*domain.Item, int, error) {
+// GetItemBySKU retrieves an item by its SKU.
+func (s *itemService) GetItemBySKU(ctx context.Context, sku string) (*domain.Item, error) {
+	if sku == "" {
+		return nil, fmt.Errorf("%w: SKU must not be empty", domain.ErrInvalidInput)
+	}
+
+	item, err := s.repo.GetBySKU(ctx, sku)
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: SKU %s", domain.ErrItemNotFound, sku)
+		}
+		return nil, fmt.Errorf("service: failed to get item by SKU '%s': %w", sku, err)
+	}
+	s.applyPromotions(ctx, item)
+	return item, nil
+}
+
+// GetItems retrieves a page of items, filtered, sorted and searched as the query says.
+func (s *itemService) GetItems(ctx context.Context, query domain.ListItemsQuery) ([]*domain.Item, int, error) {
+	filter, err := itemFilter(query)
+	if err != nil {
+		return nil, 0, err
+	}
+	page, limit := query.Page, query.Limit
 	if page <= 0 {
 		page = 1
 	}
@@ -95,17 +130,227 @@ func (s *itemService) GetItems(ctx context.Context, page, limit int) ([]*domain.
 		limit = 100
 	}
 
-	items, total, err := s.repo.GetAll(ctx, page, limit)
+	var items []*domain.Item
+	var total int
+	if query.Search != "" {
+		if strings.TrimSpace(query.Search) == "" {
+			return nil, 0, fmt.Errorf("%w: search text must not be empty", domain.ErrInvalidInput)
+		}
+		search := domain.ItemSearch{Text: query.Search, Highlight: query.Highlight}
+		items, total, err = s.repo.Search(ctx, search, filter, page, limit)
+	} else {
+		items, total, err = s.repo.GetAll(ctx, filter, page, limit)
+	}
 	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return nil, 0, err
+		}
 		return nil, 0, fmt.Errorf("service: failed to get items: %w", err)
 	}
+	s.applyPromotions(ctx, items...)
 	return items, total, nil
 }
 
+// itemFilter checks the filters and sort order of query and converts them for the repository.
+func itemFilter(query domain.ListItemsQuery) (domain.ItemFilter, error) {
+	filter := domain.ItemFilter{
+		CategoryID:  query.Category,
+		SupplierID:  query.Supplier,
+		MinPrice:    query.MinPrice,
+		MaxPrice:    query.MaxPrice,
+		MinQuantity: query.MinQuantity,
+		MaxQuantity: query.MaxQuantity,
+	}
+	for _, id := range []string{query.Category, query.Supplier} {
+		if id == "" {
+			continue
+		}
+		if _, err := uuid.Parse(id); err != nil {
+			return filter, fmt.Errorf("%w: ID %s", domain.ErrInvalidInput, id)
+		}
+	}
+	if query.MinPrice != nil && query.MaxPrice != nil && *query.MinPrice > *query.MaxPrice {
+		return filter, fmt.Errorf("%w: min_price is above max_price", domain.ErrInvalidInput)
+	}
+	if query.MinQuantity != nil && query.MaxQuantity != nil && *query.MinQuantity > *query.MaxQuantity {
+		return filter, fmt.Errorf("%w: min_quantity is above max_quantity", domain.ErrInvalidInput)
+	}
+	if query.CreatedSince != "" {
+		since, err := time.Parse(time.RFC3339, query.CreatedSince)
+		if err != nil {
+			return filter, fmt.Errorf("%w: created_since must be an RFC 3339 time", domain.ErrInvalidInput)
+		}
+		filter.CreatedSince = &since
+	}
+
+	if query.Sort == "" {
+		return filter, nil
+	}
+	seen := make(map[string]bool)
+	for _, key := range strings.Split(query.Sort, ",") {
+		key = strings.TrimSpace(key)
+		field, desc := strings.CutPrefix(key, "-")
+		if !slices.Contains(domain.ItemSortFields, field) {
+			return filter, fmt.Errorf("%w: cannot sort by '%s'; fields are %s", domain.ErrInvalidInput, key,
+				strings.Join(domain.ItemSortFields, ", "))
+		}
+		if seen[field] {
+			return filter, fmt.Errorf("%w: sort field '%s' given twice", domain.ErrInvalidInput, field)
+		}
+		seen[field] = true
+		filter.Sort = append(filter.Sort, domain.ItemSort{Field: field, Desc: desc})
+	}
+	return filter, nil
+}
+
+// streamBatchSize is how many streamed items share one promotions lookup.
+const streamBatchSize = 200
+
+// StreamItems calls fn with every item. Rows are passed on in small batches, so promotions
+// are looked up once per batch rather than once per item.
+func (s *itemService) StreamItems(ctx context.Context, fn func(*domain.Item) error) error {
+	if err := s.streamBatched(ctx, s.repo.StreamAll, fn); err != nil {
+		return fmt.Errorf("service: failed to stream items: %w", err)
+	}
+	return nil
+}
+
+// StreamMatchingItems is StreamItems restricted to the items GetItems would list for query,
+// on any page and in the same order. The filters are checked before any item is passed on.
+func (s *itemService) StreamMatchingItems(ctx context.Context, query domain.ListItemsQuery, fn func(*domain.Item) error) error {
+	filter, err := itemFilter(query)
+	if err != nil {
+		return err
+	}
+	if query.Search != "" && strings.TrimSpace(query.Search) == "" {
+		return fmt.Errorf("%w: search text must not be empty", domain.ErrInvalidInput)
+	}
+	stream := func(ctx context.Context, fn func(*domain.Item) error) error {
+		return s.repo.StreamMatching(ctx, query.Search, filter, fn)
+	}
+	if err := s.streamBatched(ctx, stream, fn); err != nil {
+		return fmt.Errorf("service: failed to stream matching items: %w", err)
+	}
+	return nil
+}
+
+// streamBatched passes the items of stream on to fn in batches of streamBatchSize, with
+// their running promotions.
+func (s *itemService) streamBatched(ctx context.Context, stream func(context.Context, func(*domain.Item) error) error, fn func(*domain.Item) error) error {
+	batch := make([]*domain.Item, 0, streamBatchSize)
+	flush := func() error {
+		s.applyPromotions(ctx, batch...)
+		for _, item := range batch {
+			if err := fn(item); err != nil {
+				return err
+			}
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	err := stream(ctx, func(item *domain.Item) error {
+		batch = append(batch, item)
+		if len(batch) < streamBatchSize {
+			return nil
+		}
+		return flush()
+	})
+	if err != nil {
+		return err
+	}
+	return flush()
+}
+
+// changeFeedSettle holds back changes this young from the change feed. Rows are stamped
+// with their transaction's start time, so one committing later than this could otherwise
+// slip in behind a cursor that has already moved past it.
+const changeFeedSettle = 5 * time.Second
+
+// GetItemChanges returns the items changed after the query's cursor (or since its Since
+// time, for a new sync), with their running promotions. Deleted items are not reported.
+func (s *itemService) GetItemChanges(ctx context.Context, query domain.ItemChangesQuery) (*domain.ItemChangesPage, error) {
+	if query.Cursor != "" && query.Since != "" {
+		return nil, fmt.Errorf("%w: since and cursor cannot be combined", domain.ErrInvalidInput)
+	}
+	after := domain.ItemChangeCursor{UpdatedAt: time.Unix(0, 0).UTC(), ID: uuid.Nil.String()}
+	switch {
+	case query.Cursor != "":
+		var err error
+		if after, err = decodeChangeCursor(query.Cursor); err != nil {
+			return nil, err
+		}
+	case query.Since != "":
+		since, err := time.Parse(time.RFC3339, query.Since)
+		if err != nil {
+			return nil, fmt.Errorf("%w: since must be an RFC 3339 time", domain.ErrInvalidInput)
+		}
+		after.UpdatedAt = since // With the nil ID, items changed exactly at since are included
+	}
+
+	items, err := s.repo.GetChangedAfter(ctx, after, changeFeedSettle, query.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to get item changes: %w", err)
+	}
+	s.applyPromotions(ctx, items...)
+
+	if len(items) > 0 {
+		last := items[len(items)-1]
+		after = domain.ItemChangeCursor{UpdatedAt: last.UpdatedAt, ID: last.ID}
+	}
+	if items == nil {
+		items = []*domain.Item{}
+	}
+	return &domain.ItemChangesPage{
+		Items:      items,
+		NextCursor: encodeChangeCursor(after),
+		HasMore:    len(items) == query.Limit,
+	}, nil
+}
+
+// Change feed cursors are opaque to clients: "v1|<updated_at>|<id>", base64url-encoded.
+func encodeChangeCursor(c domain.ItemChangeCursor) string {
+	raw := "v1|" + c.UpdatedAt.UTC().Format(time.RFC3339Nano) + "|" + c.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeChangeCursor(cursor string) (domain.ItemChangeCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return domain.ItemChangeCursor{}, domain.ErrInvalidCursor
+	}
+	parts := strings.Split(string(raw), "|")
+	if len(parts) != 3 || parts[0] != "v1" {
+		return domain.ItemChangeCursor{}, domain.ErrInvalidCursor
+	}
+	updatedAt, err := time.Parse(time.RFC3339Nano, parts[1])
+	if err != nil {
+		return domain.ItemChangeCursor{}, domain.ErrInvalidCursor
+	}
+	if _, err := uuid.Parse(parts[2]); err != nil {
+		return domain.ItemChangeCursor{}, domain.ErrInvalidCursor
+	}
+	return domain.ItemChangeCursor{UpdatedAt: updatedAt, ID: parts[2]}, nil
+}
+
+// applyPromotions fills in running promotions. A failure is logged rather than returned:
+// items are still worth showing at their regular price.
+func (s *itemService) applyPromotions(ctx context.Context, items ...*domain.Item) {
+	if s.promotions == nil || len(items) == 0 {
+		return
+	}
+	if err := s.promotions.ApplyPromotions(ctx, items...); err != nil {
+		log.Printf("Service: could not apply promotions: %v", err)
+	}
+}
+
 // UpdateItem handles the business logic for updating an item.
-func (s *itemService) UpdateItem(ctx context.Context, id string, req *domain.UpdateItemRequest) (*domain.Item, error) {
+func (s *itemService) UpdateItem(ctx context.Context, id string, req *domain.UpdateItemRequest, userID string) (*domain.Item, error) {
 	if _, err := uuid.Parse(id); err != nil {
-		return nil, fmt.Errorf("%w: %s for update", ErrInvalidItemID, id)
+		return nil, fmt.Errorf("%w: %s for update", domain.ErrInvalidItemID, id)
+	}
+	if req.Version == nil {
+		return nil, fmt.Errorf("%w: the version of the item being edited is required", domain.ErrInvalidInput)
 	}
 
 	// Fetch the existing item. This is crucial for:
@@ -115,7 +360,7 @@ func (s *itemService) UpdateItem(ctx context.Context, id string, req *domain.Upd
 	existingItem, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, domain.ErrRepositoryNotFound) {
-			return nil, fmt.Errorf("%w: ID %s for update", ErrItemNotFound, id)
+			return nil, fmt.Errorf("%w: ID %s for update", domain.ErrItemNotFound, id)
 		}
 		return nil, fmt.Errorf("service: error fetching item for update (ID: %s): %w", id, err)
 	}
@@ -145,6 +390,7 @@ func (s *itemService) UpdateItem(ctx context.Context, id string, req *domain.Upd
 		Quantity:          existingItem.Quantity,
 		Price:             existingItem.Price,
 		LowStockThreshold: existingItem.LowStockThreshold,
+		Version:           *req.Version, // Checked against the stored row by the repository, not the possibly cached existingItem
 		// Timestamps (CreatedAt, UpdatedAt) are handled by repo/DB.
 	}
 	
@@ -199,13 +445,22 @@ func (s *itemService) UpdateItem(ctx context.Context, id string, req *domain.Upd
 	// Now, `itemForUpdate` contains the full desired state after applying changes.
 	// The repository's Update method will compare this with the current DB state (or re-fetch)
 	// to build the SET clauses.
-	updatedItem, err := s.repo.Update(ctx, id, itemForUpdate)
+	updatedItem, err := s.repo.Update(ctx, id, itemForUpdate, userID)
 	if err != nil {
         if errors.Is(err, domain.ErrRepositoryDuplicateEntry) { // SKU conflict during update
-		    return nil, fmt.Errorf("%w: SKU %s", ErrSKUAlreadyExists, itemForUpdate.SKU)
+		    return nil, fmt.Errorf("%w: SKU %s", domain.ErrSKUAlreadyExists, itemForUpdate.SKU)
+		}
+		if errors.Is(err, domain.ErrVersionConflict) || errors.Is(err, domain.ErrInsufficientStock) {
+			return nil, err
+		}
+		if errors.Is(err, domain.ErrRepositoryNotFound) { // Deleted since it was read
+			return nil, fmt.Errorf("%w: ID %s for update", domain.ErrItemNotFound, id)
 		}
 		return nil, fmt.Errorf("service: failed to update item ID '%s': %w", id, err)
 	}
+	if updatedItem.SKU != existingItem.SKU || updatedItem.Name != existingItem.Name {
+		s.invalidateOptions()
+	}
 
 	// If quantity changed, broadcast the update via WebSocket
 	if s.hub != nil && updatedItem.Quantity != originalQuantity {
@@ -217,27 +472,28 @@ func (s *itemService) UpdateItem(ctx context.Context, id string, req *domain.Upd
 			SKU:         updatedItem.SKU,
 			NewQuantity: updatedItem.Quantity,
 		}
-		s.hub.BroadcastStockUpdate(payload)
+		s.hub.BroadcastStockUpdate(ctx, payload)
 	}
 
 	return updatedItem, nil
 }
 
-// DeleteItem handles the business logic for deleting an item.
-func (s *itemService) DeleteItem(ctx context.Context, id string) error {
+// DeleteItem moves an item to the trash, from which it can be restored until purged.
+func (s *itemService) DeleteItem(ctx context.Context, id, userID string) error {
 	if _, err := uuid.Parse(id); err != nil {
-		return fmt.Errorf("%w: %s for deletion", ErrInvalidItemID, id)
+		return fmt.Errorf("%w: %s for deletion", domain.ErrInvalidItemID, id)
 	}
 
 	// Optional: Check existence first to provide a clearer "not found" vs. "delete failed"
 	// For simplicity, we let the repository handle the "not found" on delete.
-	err := s.repo.Delete(ctx, id)
+	err := s.repo.Delete(ctx, id, userID)
 	if err != nil {
 		if errors.Is(err, domain.ErrRepositoryNotFound) {
-			return fmt.Errorf("%w: ID %s for deletion", ErrItemNotFound, id)
+			return fmt.Errorf("%w: ID %s for deletion", domain.ErrItemNotFound, id)
 		}
 		return fmt.Errorf("service: failed to delete item ID '%s': %w", id, err)
 	}
+	s.invalidateOptions()
 
 	// Optionally, broadcast "ITEM_DELETED" event via WebSocket
 	// if s.hub != nil {
@@ -246,3 +502,124 @@ func (s *itemService) DeleteItem(ctx context.Context, id string) error {
 
 	return nil
 }
+
+// ListTrash returns a page of the trashed items, most recently deleted first.
+func (s *itemService) ListTrash(ctx context.Context, query domain.ListTrashQuery) ([]*domain.Item, int, error) {
+	items, total, err := s.repo.ListTrash(ctx, query.Page, query.Limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("service: failed to list trashed items: %w", err)
+	}
+	return items, total, nil
+}
+
+// RestoreItem takes an item out of the trash.
+func (s *itemService) RestoreItem(ctx context.Context, id, userID string) (*domain.Item, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidItemID, id)
+	}
+	item, err := s.repo.Restore(ctx, id, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: ID %s in the trash", domain.ErrItemNotFound, id)
+		}
+		return nil, fmt.Errorf("service: failed to restore item ID '%s': %w", id, err)
+	}
+	s.invalidateOptions()
+	s.applyPromotions(ctx, item)
+	return item, nil
+}
+
+// PurgeItem deletes a trashed item for good. Items that are not in the trash are not found,
+// so a live item cannot be purged by mistake. Nor can one still on orders, assemblies or in
+// the movement ledger: that returns ErrItemInUse.
+func (s *itemService) PurgeItem(ctx context.Context, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("%w: %s", domain.ErrInvalidItemID, id)
+	}
+	if err := s.repo.Purge(ctx, id); err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return fmt.Errorf("%w: ID %s in the trash", domain.ErrItemNotFound, id)
+		}
+		if errors.Is(err, domain.ErrItemInUse) {
+			return err
+		}
+		return fmt.Errorf("service: failed to purge item ID '%s': %w", id, err)
+	}
+	return nil
+}
+
+// AdjustQuantity changes an item's quantity by delta on behalf of userID, which may be empty,
+// and broadcasts the new stock level.
+func (s *itemService) AdjustQuantity(ctx context.Context, id string, delta int, userID string) (*domain.Item, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidItemID, id)
+	}
+	if delta == 0 {
+		return nil, fmt.Errorf("%w: delta must not be zero", domain.ErrInvalidInput)
+	}
+
+	item, err := s.repo.AdjustQuantity(ctx, id, delta, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRepositoryNotFound):
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrItemNotFound, id)
+		case errors.Is(err, domain.ErrInsufficientStock):
+			return nil, err
+		}
+		return nil, fmt.Errorf("service: failed to adjust quantity of item '%s': %w", id, err)
+	}
+
+	if s.hub != nil {
+		s.hub.BroadcastStockUpdate(ctx, domain.StockUpdatePayload{
+			ID:          item.ID,
+			SKU:         item.SKU,
+			NewQuantity: item.Quantity,
+		})
+	}
+	return item, nil
+}
+
+// ListItemOptions returns id, SKU and name of every item, served from memory for up to optionsTTL.
+func (s *itemService) ListItemOptions(ctx context.Context) ([]domain.ItemOption, error) {
+	s.optionsMu.Lock()
+	defer s.optionsMu.Unlock() // Held during the load so concurrent misses query only once
+	if s.options != nil && time.Since(s.optionsLoadedAt) < optionsTTL {
+		return s.options, nil
+	}
+	options, err := s.repo.ListOptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to list item options: %w", err)
+	}
+	s.options, s.optionsLoadedAt = options, time.Now()
+	return options, nil
+}
+
+// GetItemHistory returns the revisions of an item, oldest first. Items changed only before
+// revisions were recorded have an empty history; trashed items keep theirs.
+func (s *itemService) GetItemHistory(ctx context.Context, id string) ([]*domain.ItemRevision, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidItemID, id)
+	}
+	revisions := []*domain.ItemRevision{}
+	if s.revisions != nil {
+		var err error
+		if revisions, err = s.revisions.ListByItem(ctx, id); err != nil {
+			return nil, fmt.Errorf("service: failed to get history of item '%s': %w", id, err)
+		}
+	}
+	if len(revisions) == 0 { // Tell an item without history from one that does not exist
+		if _, err := s.repo.GetByID(ctx, id); err != nil {
+			if errors.Is(err, domain.ErrRepositoryNotFound) {
+				return nil, fmt.Errorf("%w: ID %s", domain.ErrItemNotFound, id)
+			}
+			return nil, fmt.Errorf("service: failed to get history of item '%s': %w", id, err)
+		}
+	}
+	return revisions, nil
+}
+
+func (s *itemService) invalidateOptions() {
+	s.optionsMu.Lock()
+	defer s.optionsMu.Unlock()
+	s.options = nil
+}
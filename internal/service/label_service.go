@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/realtime"
+
+	"github.com/google/uuid"
+)
+
+// labelReclaimAfter is how long a claimed job may go without being completed before another
+// claim of its station picks it up again, e.g. after the agent crashed mid-print.
+const labelReclaimAfter = 5 * time.Minute
+
+type labelService struct {
+	repo domain.LabelJobRepository
+	hub  *realtime.Hub // Tells station agents about queued jobs; may be nil
+}
+
+// NewLabelService creates a new LabelService.
+func NewLabelService(repo domain.LabelJobRepository, hub *realtime.Hub) domain.LabelService {
+	return &labelService{
+		repo: repo,
+		hub:  hub,
+	}
+}
+
+// EnqueueLabels queues one job per line at the station and announces them over WebSocket.
+func (s *labelService) EnqueueLabels(ctx context.Context, req *domain.EnqueueLabelsRequest, userID string) ([]*domain.LabelJob, error) {
+	if userID == "" {
+		return nil, domain.ErrMissingUser
+	}
+	jobs := make([]*domain.LabelJob, 0, len(req.Items))
+	for _, line := range req.Items {
+		jobs = append(jobs, &domain.LabelJob{
+			Station:   req.Station,
+			ItemID:    line.ItemID,
+			Copies:    line.Copies,
+			Reference: req.Reference,
+			CreatedBy: userID,
+		})
+	}
+
+	queued, err := s.repo.Enqueue(ctx, jobs)
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: %v", domain.ErrItemNotFound, err)
+		}
+		return nil, fmt.Errorf("service: failed to queue labels: %w", err)
+	}
+	if s.hub != nil {
+		s.hub.BroadcastLabelJobsQueued(ctx, domain.LabelJobsQueuedPayload{Station: req.Station, Jobs: len(queued)})
+	}
+	return queued, nil
+}
+
+// ListJobs returns the latest label jobs, newest first.
+func (s *labelService) ListJobs(ctx context.Context, q domain.ListLabelJobsQuery) ([]*domain.LabelJob, error) {
+	jobs, err := s.repo.List(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to list label jobs: %w", err)
+	}
+	return jobs, nil
+}
+
+// ClaimJobs hands the station's oldest waiting jobs to its agent, oldest first.
+func (s *labelService) ClaimJobs(ctx context.Context, q domain.ClaimLabelJobsQuery) ([]*domain.LabelJob, error) {
+	jobs, err := s.repo.Claim(ctx, q.Station, q.Limit, labelReclaimAfter)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to claim label jobs of station '%s': %w", q.Station, err)
+	}
+	return jobs, nil
+}
+
+// CompleteJob records the outcome of a claimed job. The error message is kept for failed jobs only.
+func (s *labelService) CompleteJob(ctx context.Context, id string, req *domain.CompleteLabelJobRequest) (*domain.LabelJob, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	var errMsg *string
+	if req.Status == domain.LabelJobStatusFailed && req.Error != "" {
+		errMsg = &req.Error
+	}
+
+	j, err := s.repo.Complete(ctx, id, req.Status, errMsg)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRepositoryNotFound):
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrLabelJobNotFound, id)
+		case errors.Is(err, domain.ErrLabelJobStatus):
+			return nil, err
+		}
+		return nil, fmt.Errorf("service: failed to complete label job '%s': %w", id, err)
+	}
+	return j, nil
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/realtime"
+
+	"github.com/google/uuid"
+)
+
+type ledgerService struct {
+	repo        domain.LedgerRepository
+	notifier    domain.NotificationService // May be nil
+	notifyUsers []string                   // Receive a notification for every new discrepancy
+	hub         *realtime.Hub              // Receives quantities set to their ledger
+	changes     domain.ItemChangePublisher // Told about items whose quantity a reconciliation set
+}
+
+// NewLedgerService creates a new LedgerService. notifier, hub and changes may be nil, in
+// which case discrepancies are only recorded and reconciliations are not announced.
+func NewLedgerService(repo domain.LedgerRepository, notifier domain.NotificationService, notifyUsers []string,
+	hub *realtime.Hub, changes domain.ItemChangePublisher) domain.LedgerService {
+	return &ledgerService{
+		repo:        repo,
+		notifier:    notifier,
+		notifyUsers: notifyUsers,
+		hub:         hub,
+		changes:     changes,
+	}
+}
+
+// RunLedgerCheck runs svc.Check every interval until ctx is cancelled.
+// It must be run in a separate goroutine.
+func RunLedgerCheck(ctx context.Context, svc domain.LedgerService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		result, err := svc.Check(ctx)
+		if err != nil {
+			log.Printf("Ledger check failed: %v", err)
+		} else if result.Discrepancies > 0 {
+			log.Printf("Ledger check found %d of %d item(s) out of line with their ledger (%d new)",
+				result.Discrepancies, result.Checked, len(result.New))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check compares every item with its ledger. Discrepancies already open are not notified again.
+func (s *ledgerService) Check(ctx context.Context) (*domain.LedgerCheckResult, error) {
+	result, err := s.repo.Check(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to check the ledger: %w", err)
+	}
+	for _, d := range result.New {
+		s.notify(ctx, d)
+	}
+	return result, nil
+}
+
+// notify tells the configured users about a new discrepancy. Failures are logged: the
+// discrepancy itself is recorded and can be found through the API.
+func (s *ledgerService) notify(ctx context.Context, d *domain.LedgerDiscrepancy) {
+	if s.notifier == nil {
+		return
+	}
+	entityType, entityID := domain.LedgerDiscrepancyEntityType, d.ID
+	for _, userID := range s.notifyUsers {
+		_, err := s.notifier.Notify(ctx, &domain.Notification{
+			UserID: userID,
+			Type:   domain.NotificationTypeLedger,
+			Message: fmt.Sprintf("Item %s has a quantity of %d, but its stock movements add up to %d",
+				d.SKU, d.Quantity, d.LedgerQuantity),
+			EntityType: &entityType,
+			EntityID:   &entityID,
+		})
+		if err != nil {
+			log.Printf("Service: failed to notify %s about ledger discrepancy %s: %v", userID, d.ID, err)
+		}
+	}
+}
+
+// ListDiscrepancies returns ledger discrepancies, most recently detected first.
+func (s *ledgerService) ListDiscrepancies(ctx context.Context, q domain.ListLedgerDiscrepanciesQuery) ([]*domain.LedgerDiscrepancy, error) {
+	if q.Limit <= 0 {
+		q.Limit = 50
+	} else if q.Limit > 200 {
+		q.Limit = 200
+	}
+	discrepancies, err := s.repo.List(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to list ledger discrepancies: %w", err)
+	}
+	return discrepancies, nil
+}
+
+// Reconcile resolves an open discrepancy on behalf of userID, keeping the side req names.
+func (s *ledgerService) Reconcile(ctx context.Context, id string, req *domain.ReconcileLedgerRequest, userID string) (*domain.LedgerDiscrepancy, error) {
+	if userID == "" {
+		return nil, domain.ErrMissingUser
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	d, err := s.repo.Reconcile(ctx, id, req.Keep, req.Note, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrDiscrepancyNotFound, id)
+		}
+		if errors.Is(err, domain.ErrDiscrepancyResolved) || errors.Is(err, domain.ErrItemNotFound) ||
+			errors.Is(err, domain.ErrInsufficientStock) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("service: failed to reconcile ledger discrepancy '%s': %w", id, err)
+	}
+
+	if req.Keep == domain.LedgerKeepLedger && d.Difference != 0 {
+		if s.changes != nil {
+			s.changes.PublishItemChanged(ctx, d.ItemID)
+		}
+		if s.hub != nil {
+			s.hub.BroadcastStockUpdate(ctx, domain.StockUpdatePayload{ID: d.ItemID, SKU: d.SKU, NewQuantity: d.LedgerQuantity})
+		}
+	}
+	return d, nil
+}
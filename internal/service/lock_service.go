@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"inventory-system/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// inMemoryEditLockService keeps edit locks in process memory.
+// Locks are short-lived and advisory, so losing them on restart is acceptable.
+// Expired locks are dropped lazily whenever they are looked at.
+type inMemoryEditLockService struct {
+	itemRepo domain.ItemRepository
+	ttl      time.Duration
+	mu       sync.Mutex
+	locks    map[string]*domain.EditLock // Keyed by item ID
+}
+
+// NewInMemoryEditLockService creates an EditLockService whose locks expire after ttl
+// unless refreshed.
+func NewInMemoryEditLockService(itemRepo domain.ItemRepository, ttl time.Duration) domain.EditLockService {
+	if ttl <= 0 {
+		ttl = 2 * time.Minute
+	}
+	return &inMemoryEditLockService{
+		itemRepo: itemRepo,
+		ttl:      ttl,
+		locks:    make(map[string]*domain.EditLock),
+	}
+}
+
+// Acquire takes the lock on an item for userID, or extends it if the user already holds it.
+func (s *inMemoryEditLockService) Acquire(ctx context.Context, itemID, userID string) (*domain.EditLock, error) {
+	if userID == "" {
+		return nil, domain.ErrMissingUser
+	}
+	if _, err := uuid.Parse(itemID); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidItemID, itemID)
+	}
+	if _, err := s.itemRepo.GetByID(ctx, itemID); err != nil {
+		if errors.Is(err, domain.ErrItemNotFound) || errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrItemNotFound, itemID)
+		}
+		return nil, fmt.Errorf("service: failed to look up item '%s' for locking: %w", itemID, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if lock := s.activeLocked(itemID, now); lock != nil && lock.UserID != userID {
+		return copyLock(lock), fmt.Errorf("%w: held by %s until %s", domain.ErrItemLocked, lock.UserID, lock.ExpiresAt.Format(time.RFC3339))
+	}
+
+	lock, ok := s.locks[itemID]
+	if !ok {
+		lock = &domain.EditLock{ItemID: itemID, UserID: userID, AcquiredAt: now}
+		s.locks[itemID] = lock
+	}
+	lock.ExpiresAt = now.Add(s.ttl)
+	return copyLock(lock), nil
+}
+
+// Release drops the lock if userID holds it.
+func (s *inMemoryEditLockService) Release(ctx context.Context, itemID, userID string) error {
+	if userID == "" {
+		return domain.ErrMissingUser
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lock := s.activeLocked(itemID, time.Now())
+	if lock == nil || lock.UserID != userID {
+		return fmt.Errorf("%w: item %s", domain.ErrLockNotHeld, itemID)
+	}
+	delete(s.locks, itemID)
+	return nil
+}
+
+// Refresh extends a lock held by userID.
+func (s *inMemoryEditLockService) Refresh(itemID, userID string) (*domain.EditLock, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	lock := s.activeLocked(itemID, now)
+	if lock == nil || lock.UserID != userID {
+		return nil, fmt.Errorf("%w: item %s", domain.ErrLockNotHeld, itemID)
+	}
+	lock.ExpiresAt = now.Add(s.ttl)
+	return copyLock(lock), nil
+}
+
+// Get returns the current lock on an item, or nil.
+func (s *inMemoryEditLockService) Get(itemID string) *domain.EditLock {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return copyLock(s.activeLocked(itemID, time.Now()))
+}
+
+// activeLocked returns the unexpired lock for itemID, removing it if it has expired.
+// Callers must hold s.mu.
+func (s *inMemoryEditLockService) activeLocked(itemID string, now time.Time) *domain.EditLock {
+	lock, ok := s.locks[itemID]
+	if !ok {
+		return nil
+	}
+	if !now.Before(lock.ExpiresAt) {
+		delete(s.locks, itemID)
+		return nil
+	}
+	return lock
+}
+
+// copyLock hands out copies so callers can't mutate the stored lock.
+func copyLock(lock *domain.EditLock) *domain.EditLock {
+	if lock == nil {
+		return nil
+	}
+	c := *lock
+	return &c
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"inventory-system/internal/domain"
+	"inventory-system/pkg/requestid"
+
+	"github.com/google/uuid"
+)
+
+type notificationService struct {
+	repo   domain.NotificationRepository
+	sender domain.UserMessageSender // Real-time delivery; may be nil
+}
+
+// NewNotificationService creates a new NotificationService.
+// sender may be nil, in which case notifications are only stored in the inbox.
+func NewNotificationService(repo domain.NotificationRepository, sender domain.UserMessageSender) domain.NotificationService {
+	return &notificationService{
+		repo:   repo,
+		sender: sender,
+	}
+}
+
+// Notify stores a notification and, with a sender, pushes it to the recipient's live connections.
+func (s *notificationService) Notify(ctx context.Context, n *domain.Notification) (*domain.Notification, error) {
+	if n.UserID == "" {
+		return nil, domain.ErrMissingUser
+	}
+	created, err := s.repo.Create(ctx, n)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to create notification: %w", err)
+	}
+
+	if s.sender != nil {
+		s.sender.SendToUser(created.UserID, domain.WebSocketMessage{
+			Type:      domain.NotificationMessageType,
+			Payload:   created,
+			RequestID: requestid.FromContext(ctx),
+		})
+	}
+	return created, nil
+}
+
+// NotifyMentions creates a notification for every user mentioned in a comment.
+// Failures are logged rather than returned: a comment must not fail because its
+// notifications could not be delivered.
+func (s *notificationService) NotifyMentions(ctx context.Context, comment *domain.Comment) {
+	entityType := comment.EntityType
+	entityID := comment.EntityID
+	for _, userID := range comment.Mentions {
+		if userID == comment.Author {
+			continue // Mentioning yourself is not news
+		}
+		_, err := s.Notify(ctx, &domain.Notification{
+			UserID:     userID,
+			Type:       domain.NotificationTypeMention,
+			Message:    fmt.Sprintf("%s mentioned you in a comment on %s", comment.Author, entityType),
+			EntityType: &entityType,
+			EntityID:   &entityID,
+		})
+		if err != nil {
+			log.Printf("Service: failed to notify %s about mention in comment %s: %v", userID, comment.ID, err)
+		}
+	}
+}
+
+// ListNotifications returns a user's notifications along with their unread count.
+func (s *notificationService) ListNotifications(ctx context.Context, userID string, unreadOnly bool, limit int) ([]*domain.Notification, int, error) {
+	if userID == "" {
+		return nil, 0, domain.ErrMissingUser
+	}
+	if limit <= 0 {
+		limit = 50
+	} else if limit > 200 {
+		limit = 200
+	}
+
+	notifications, err := s.repo.ListByUser(ctx, userID, unreadOnly, limit)
+	if err != nil {
+		return nil, 0, fmt.Errorf("service: failed to list notifications: %w", err)
+	}
+	unread, err := s.repo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("service: failed to count unread notifications: %w", err)
+	}
+	return notifications, unread, nil
+}
+
+// MarkRead marks one of the user's notifications as read.
+func (s *notificationService) MarkRead(ctx context.Context, userID, id string) (*domain.Notification, error) {
+	if userID == "" {
+		return nil, domain.ErrMissingUser
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	n, err := s.repo.MarkRead(ctx, userID, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrNotificationNotFound, id)
+		}
+		return nil, fmt.Errorf("service: failed to mark notification '%s' read: %w", id, err)
+	}
+	return n, nil
+}
+
+// MarkAllRead marks all of the user's notifications as read.
+func (s *notificationService) MarkAllRead(ctx context.Context, userID string) (int, error) {
+	if userID == "" {
+		return 0, domain.ErrMissingUser
+	}
+	count, err := s.repo.MarkAllRead(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("service: failed to mark all notifications read: %w", err)
+	}
+	return count, nil
+}
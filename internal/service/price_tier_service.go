@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"inventory-system/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type priceTierService struct {
+	repo         domain.PriceTierRepository
+	itemRepo     domain.ItemRepository
+	customerRepo domain.CustomerRepository
+}
+
+// NewPriceTierService creates a new PriceTierService.
+func NewPriceTierService(repo domain.PriceTierRepository, itemRepo domain.ItemRepository, customerRepo domain.CustomerRepository) domain.PriceTierService {
+	return &priceTierService{repo: repo, itemRepo: itemRepo, customerRepo: customerRepo}
+}
+
+// ListTierPrices returns the price rules of an item.
+func (s *priceTierService) ListTierPrices(ctx context.Context, itemID string) ([]*domain.TierPrice, error) {
+	if _, err := uuid.Parse(itemID); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidItemID, itemID)
+	}
+	prices, err := s.repo.ListForItem(ctx, itemID)
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrItemNotFound, itemID)
+		}
+		return nil, fmt.Errorf("service: failed to list tier prices of item '%s': %w", itemID, err)
+	}
+	return prices, nil
+}
+
+// SetTierPrice sets the price rule of an item for a tier: a fixed price or a discount.
+func (s *priceTierService) SetTierPrice(ctx context.Context, itemID, tier string, req *domain.SetTierPriceRequest) (*domain.TierPrice, error) {
+	if err := checkTier(itemID, tier); err != nil {
+		return nil, err
+	}
+	if (req.Price == nil) == (req.DiscountPercent == nil) {
+		return nil, fmt.Errorf("%w: give either price or discount_percent", domain.ErrInvalidInput)
+	}
+	tp, err := s.repo.Set(ctx, &domain.TierPrice{ItemID: itemID, Tier: tier, Price: req.Price, DiscountPercent: req.DiscountPercent})
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrItemNotFound, itemID)
+		}
+		return nil, fmt.Errorf("service: failed to set %s price of item '%s': %w", tier, itemID, err)
+	}
+	return tp, nil
+}
+
+// DeleteTierPrice removes the price rule of an item for a tier; the tier then pays the item's price.
+func (s *priceTierService) DeleteTierPrice(ctx context.Context, itemID, tier string) error {
+	if err := checkTier(itemID, tier); err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, itemID, tier); err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return fmt.Errorf("%w: %s price of item '%s'", domain.ErrTierPriceNotFound, tier, itemID)
+		}
+		return fmt.Errorf("service: failed to delete %s price of item '%s': %w", tier, itemID, err)
+	}
+	return nil
+}
+
+// ResolvePrice applies the price rule of the customer's tier to the item's price. Tier rules
+// start from the item's list price; promotions are not combined with them.
+func (s *priceTierService) ResolvePrice(ctx context.Context, itemID, customerID string) (*domain.ResolvedPrice, error) {
+	if _, err := uuid.Parse(itemID); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidItemID, itemID)
+	}
+	resolved := &domain.ResolvedPrice{ItemID: itemID, CustomerID: customerID, Tier: domain.PriceTierRetail}
+	if customerID != "" {
+		customer, err := s.customerRepo.GetByID(ctx, customerID)
+		if err != nil {
+			if errors.Is(err, domain.ErrRepositoryNotFound) {
+				return nil, fmt.Errorf("%w: ID %s", domain.ErrCustomerNotFound, customerID)
+			}
+			return nil, fmt.Errorf("service: failed to get customer '%s': %w", customerID, err)
+		}
+		resolved.Tier = customer.PriceTier
+	}
+
+	item, err := s.itemRepo.GetByID(ctx, itemID)
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrItemNotFound, itemID)
+		}
+		return nil, fmt.Errorf("service: failed to get item '%s': %w", itemID, err)
+	}
+	resolved.BasePrice, resolved.Price, resolved.Source = item.Price, item.Price, domain.PriceSourceBase
+
+	tp, err := s.repo.Get(ctx, itemID, resolved.Tier)
+	switch {
+	case errors.Is(err, domain.ErrRepositoryNotFound):
+		return resolved, nil
+	case err != nil:
+		return nil, fmt.Errorf("service: failed to get %s price of item '%s': %w", resolved.Tier, itemID, err)
+	case tp.Price != nil:
+		resolved.Price, resolved.Source = *tp.Price, domain.PriceSourceTierPrice
+	default:
+		resolved.Price = math.Round(item.Price*(100-*tp.DiscountPercent)) / 100
+		resolved.Source = domain.PriceSourceTierDiscount
+	}
+	return resolved, nil
+}
+
+// checkTier validates the item ID and tier of a price rule.
+func checkTier(itemID, tier string) error {
+	if _, err := uuid.Parse(itemID); err != nil {
+		return fmt.Errorf("%w: %s", domain.ErrInvalidItemID, itemID)
+	}
+	switch tier {
+	case domain.PriceTierRetail, domain.PriceTierWholesale, domain.PriceTierContract:
+		return nil
+	}
+	return fmt.Errorf("%w: unknown price tier '%s'", domain.ErrInvalidInput, tier)
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"inventory-system/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type pricingService struct {
+	repo     domain.PriceRepository
+	itemRepo domain.ItemRepository      // Used to verify that items exist
+	changes  domain.ItemChangePublisher // Told about repriced items; may be nil
+}
+
+// NewPricingService creates a new PricingService.
+func NewPricingService(repo domain.PriceRepository, itemRepo domain.ItemRepository, changes domain.ItemChangePublisher) domain.PricingService {
+	return &pricingService{
+		repo:     repo,
+		itemRepo: itemRepo,
+		changes:  changes,
+	}
+}
+
+// BulkUpdatePrices reprices every item selected by the request's filter.
+func (s *pricingService) BulkUpdatePrices(ctx context.Context, req *domain.BulkPriceUpdateRequest, changedBy string) (*domain.BulkPriceUpdateResult, error) {
+	if changedBy == "" {
+		return nil, domain.ErrMissingUser
+	}
+	if len(req.Filter.ItemIDs) == 0 && req.Filter.SKUPrefix == "" {
+		return nil, fmt.Errorf("%w: filter must select items by item_ids or sku_prefix", domain.ErrInvalidInput)
+	}
+	reprice, err := repriceFunc(req.Operation, req.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	matched, changes, err := s.repo.ApplyPriceChanges(ctx, req.Filter, reprice, "bulk:"+req.Operation, changedBy, req.DryRun)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to update prices: %w", err)
+	}
+	if !req.DryRun && s.changes != nil {
+		ids := make([]string, len(changes))
+		for i, ch := range changes {
+			ids[i] = ch.ItemID
+		}
+		s.changes.PublishItemChanged(ctx, ids...)
+	}
+	return &domain.BulkPriceUpdateResult{DryRun: req.DryRun, Matched: matched, Changes: changes}, nil
+}
+
+// GetPriceHistory returns the price changes of an item, newest first.
+func (s *pricingService) GetPriceHistory(ctx context.Context, itemID string) ([]*domain.PriceHistoryEntry, error) {
+	if _, err := uuid.Parse(itemID); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidItemID, itemID)
+	}
+	if _, err := s.itemRepo.GetByID(ctx, itemID); err != nil {
+		if errors.Is(err, domain.ErrItemNotFound) || errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrItemNotFound, itemID)
+		}
+		return nil, fmt.Errorf("service: failed to look up item '%s': %w", itemID, err)
+	}
+	history, err := s.repo.ListHistory(ctx, itemID)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to get price history: %w", err)
+	}
+	return history, nil
+}
+
+// repriceFunc turns a bulk price operation into a function from old to new price.
+// Results are rounded to cents and never drop below one cent.
+func repriceFunc(op string, value float64) (func(float64) float64, error) {
+	var f func(float64) float64
+	switch op {
+	case domain.PriceOpSet:
+		if value <= 0 {
+			return nil, fmt.Errorf("%w: price must be greater than 0", domain.ErrInvalidInput)
+		}
+		f = func(float64) float64 { return value }
+	case domain.PriceOpIncreasePercent:
+		f = func(old float64) float64 { return old * (1 + value/100) }
+	case domain.PriceOpDecreasePercent:
+		if value >= 100 {
+			return nil, fmt.Errorf("%w: cannot decrease prices by 100%% or more", domain.ErrInvalidInput)
+		}
+		f = func(old float64) float64 { return old * (1 - value/100) }
+	case domain.PriceOpRound99:
+		f = func(old float64) float64 { return math.Max(math.Round(old), 1) - 0.01 } // 12.40 -> 11.99, 12.60 -> 12.99
+	default:
+		return nil, fmt.Errorf("%w: unknown price operation %q", domain.ErrInvalidInput, op)
+	}
+	return func(old float64) float64 {
+		return math.Max(math.Round(f(old)*100)/100, 0.01)
+	}, nil
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"inventory-system/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type promotionService struct {
+	repo domain.PromotionRepository
+	now  func() time.Time
+}
+
+// NewPromotionService creates a new PromotionService.
+//
+// Promotions never overwrite an item's regular price: the promotional price is looked up
+// whenever items are read, so a promotion takes effect and reverts exactly at its start
+// and end time without any scheduled job.
+func NewPromotionService(repo domain.PromotionRepository) domain.PromotionService {
+	return &promotionService{repo: repo, now: time.Now}
+}
+
+// CreatePromotion schedules a promotion.
+func (s *promotionService) CreatePromotion(ctx context.Context, req *domain.CreatePromotionRequest, createdBy string) (*domain.Promotion, error) {
+	if createdBy == "" {
+		return nil, domain.ErrMissingUser
+	}
+	if !req.EndsAt.After(req.StartsAt) {
+		return nil, fmt.Errorf("%w: ends_at must be after starts_at", domain.ErrInvalidInput)
+	}
+	if !req.EndsAt.After(s.now()) {
+		return nil, fmt.Errorf("%w: promotion would already be over", domain.ErrInvalidInput)
+	}
+
+	created, err := s.repo.Create(ctx, &domain.Promotion{
+		Name:      req.Name,
+		StartsAt:  req.StartsAt,
+		EndsAt:    req.EndsAt,
+		Items:     req.Items,
+		CreatedBy: createdBy,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRepositoryNotFound):
+			return nil, fmt.Errorf("%w: %v", domain.ErrItemNotFound, err)
+		case errors.Is(err, domain.ErrRepositoryDuplicateEntry):
+			return nil, fmt.Errorf("%w: %v", domain.ErrInvalidInput, err)
+		}
+		return nil, fmt.Errorf("service: failed to create promotion: %w", err)
+	}
+	return created, nil
+}
+
+// GetPromotion retrieves a promotion by ID.
+func (s *promotionService) GetPromotion(ctx context.Context, id string) (*domain.Promotion, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	p, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrPromotionNotFound, id)
+		}
+		return nil, fmt.Errorf("service: failed to get promotion '%s': %w", id, err)
+	}
+	return p, nil
+}
+
+// ListPromotions returns all promotions, latest start first.
+func (s *promotionService) ListPromotions(ctx context.Context) ([]*domain.Promotion, error) {
+	promotions, err := s.repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to list promotions: %w", err)
+	}
+	return promotions, nil
+}
+
+// DeletePromotion cancels a promotion. Affected items go back to their regular price at once.
+func (s *promotionService) DeletePromotion(ctx context.Context, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	if err := s.repo.Delete(ctx, id); err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return fmt.Errorf("%w: ID %s", domain.ErrPromotionNotFound, id)
+		}
+		return fmt.Errorf("service: failed to delete promotion '%s': %w", id, err)
+	}
+	return nil
+}
+
+// ApplyPromotions sets Item.Promotion on every item with a running promotion.
+func (s *promotionService) ApplyPromotions(ctx context.Context, items ...*domain.Item) error {
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	active, err := s.repo.ActiveForItems(ctx, ids, s.now())
+	if err != nil {
+		return fmt.Errorf("service: failed to apply promotions: %w", err)
+	}
+	for _, item := range items {
+		item.Promotion = active[item.ID]
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/realtime"
+	"inventory-system/internal/workflow"
+
+	"github.com/google/uuid"
+)
+
+type purchasingService struct {
+	repo    domain.PurchasingRepository
+	engine  *workflow.Engine           // Decides which status changes are allowed, and for whom
+	hub     *realtime.Hub              // Receives the new quantities after a delivery
+	changes domain.ItemChangePublisher // Told about items changed by a delivery; may be nil
+}
+
+// NewPurchasingService creates a new PurchasingService.
+func NewPurchasingService(repo domain.PurchasingRepository, engine *workflow.Engine, hub *realtime.Hub, changes domain.ItemChangePublisher) domain.PurchasingService {
+	return &purchasingService{
+		repo:    repo,
+		engine:  engine,
+		hub:     hub,
+		changes: changes,
+	}
+}
+
+// CreateSupplier creates a supplier.
+func (s *purchasingService) CreateSupplier(ctx context.Context, req *domain.CreateSupplierRequest) (*domain.Supplier, error) {
+	created, err := s.repo.CreateSupplier(ctx, &domain.Supplier{Name: req.Name, Email: req.Email})
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryDuplicateEntry) {
+			return nil, fmt.Errorf("%w: '%s'", domain.ErrSupplierNameTaken, req.Name)
+		}
+		return nil, fmt.Errorf("service: failed to create supplier: %w", err)
+	}
+	return created, nil
+}
+
+// GetSupplier retrieves a supplier by ID.
+func (s *purchasingService) GetSupplier(ctx context.Context, id string) (*domain.Supplier, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	supplier, err := s.repo.GetSupplier(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrSupplierNotFound, id)
+		}
+		return nil, fmt.Errorf("service: failed to get supplier '%s': %w", id, err)
+	}
+	return supplier, nil
+}
+
+// ListSuppliers returns every supplier, ordered by name.
+func (s *purchasingService) ListSuppliers(ctx context.Context) ([]*domain.Supplier, error) {
+	suppliers, err := s.repo.ListSuppliers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to list suppliers: %w", err)
+	}
+	return suppliers, nil
+}
+
+// CreateOrder drafts a purchase order. Each item may appear on one line only.
+func (s *purchasingService) CreateOrder(ctx context.Context, req *domain.CreatePurchaseOrderRequest, userID string) (*domain.PurchaseOrder, error) {
+	if userID == "" {
+		return nil, domain.ErrMissingUser
+	}
+	o := &domain.PurchaseOrder{SupplierID: req.SupplierID, CreatedBy: userID}
+	seen := make(map[string]bool, len(req.Lines))
+	for _, line := range req.Lines {
+		if seen[line.ItemID] {
+			return nil, fmt.Errorf("%w: item '%s' listed twice", domain.ErrInvalidInput, line.ItemID)
+		}
+		seen[line.ItemID] = true
+		o.Lines = append(o.Lines, domain.PurchaseOrderLine{ItemID: line.ItemID, QuantityOrdered: line.Quantity, UnitCost: line.UnitCost})
+	}
+
+	created, err := s.repo.CreateOrder(ctx, o)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRepositoryNotFound):
+			return nil, fmt.Errorf("%w: %v", domain.ErrItemNotFound, err)
+		case errors.Is(err, domain.ErrSupplierNotFound):
+			return nil, err
+		}
+		return nil, fmt.Errorf("service: failed to create purchase order: %w", err)
+	}
+	return created, nil
+}
+
+// GetOrder retrieves a purchase order by ID.
+func (s *purchasingService) GetOrder(ctx context.Context, id string) (*domain.PurchaseOrder, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	o, err := s.repo.GetOrder(ctx, id)
+	if err != nil {
+		return nil, purchaseOrderError(err, id, "get")
+	}
+	return o, nil
+}
+
+// ListOrders returns the latest purchase orders, newest first.
+func (s *purchasingService) ListOrders(ctx context.Context, q domain.ListPurchaseOrdersQuery) ([]*domain.PurchaseOrder, error) {
+	orders, err := s.repo.ListOrders(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to list purchase orders: %w", err)
+	}
+	return orders, nil
+}
+
+// SubmitOrder marks a draft as sent to the supplier. Only submitted orders can be received.
+func (s *purchasingService) SubmitOrder(ctx context.Context, id, userID string, roles []string) (*domain.PurchaseOrder, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	o, err := s.repo.Apply(ctx, id, domain.PurchaseOrderActionSubmit, s.step(id, userID, roles))
+	if err != nil {
+		return nil, purchaseOrderError(err, id, "submit")
+	}
+	return o, nil
+}
+
+// ReceiveOrder books a delivery against a submitted order and broadcasts the new stock levels.
+func (s *purchasingService) ReceiveOrder(ctx context.Context, id string, req *domain.ReceivePurchaseOrderRequest, userID string, roles []string) (*domain.ReceivePurchaseOrderResult, error) {
+	if userID == "" {
+		return nil, domain.ErrMissingUser
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	seen := make(map[string]bool, len(req.Lines))
+	for _, line := range req.Lines {
+		if seen[line.ItemID] {
+			return nil, fmt.Errorf("%w: item '%s' listed twice", domain.ErrInvalidInput, line.ItemID)
+		}
+		seen[line.ItemID] = true
+	}
+
+	result, err := s.repo.Receive(ctx, id, req.Lines, userID, s.step(id, userID, roles))
+	if err != nil {
+		if errors.Is(err, domain.ErrOverReceipt) {
+			return nil, err
+		}
+		return nil, purchaseOrderError(err, id, "receive")
+	}
+
+	itemIDs := make([]string, 0, len(result.Items))
+	for _, item := range result.Items {
+		itemIDs = append(itemIDs, item.ID)
+		if s.hub != nil {
+			s.hub.BroadcastStockUpdate(ctx, domain.StockUpdatePayload{
+				ID:          item.ID,
+				SKU:         item.SKU,
+				NewQuantity: item.Quantity,
+			})
+		}
+	}
+	if s.changes != nil {
+		s.changes.PublishItemChanged(ctx, itemIDs...)
+	}
+	return result, nil
+}
+
+// CancelOrder cancels an order that has not been fully received. Stock already received stays.
+func (s *purchasingService) CancelOrder(ctx context.Context, id, userID string, roles []string) (*domain.PurchaseOrder, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	o, err := s.repo.Apply(ctx, id, domain.PurchaseOrderActionCancel, s.step(id, userID, roles))
+	if err != nil {
+		return nil, purchaseOrderError(err, id, "cancel")
+	}
+	return o, nil
+}
+
+// OrderHistory returns the status changes of a purchase order, oldest first.
+func (s *purchasingService) OrderHistory(ctx context.Context, id string) ([]*domain.WorkflowTransition, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	if _, err := s.repo.GetOrder(ctx, id); err != nil {
+		return nil, purchaseOrderError(err, id, "get")
+	}
+	history, err := s.engine.History(ctx, domain.WorkflowDocumentPurchaseOrder, id)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to get history of purchase order '%s': %w", id, err)
+	}
+	return history, nil
+}
+
+// step resolves purchase order actions for the calling user through the workflow engine.
+func (s *purchasingService) step(id, userID string, roles []string) domain.WorkflowStep {
+	return func(current, action string) (*domain.WorkflowTransition, error) {
+		return s.engine.Resolve(domain.WorkflowDocumentPurchaseOrder, id, current, action, userID, roles)
+	}
+}
+
+// purchaseOrderError maps repository errors of a purchase order operation to service errors.
+func purchaseOrderError(err error, id, action string) error {
+	switch {
+	case errors.Is(err, domain.ErrRepositoryNotFound):
+		return fmt.Errorf("%w: ID %s", domain.ErrPurchaseOrderNotFound, id)
+	case errors.Is(err, domain.ErrInvalidTransition):
+		return fmt.Errorf("%w: order '%s': %v", domain.ErrPurchaseOrderStatus, id, err)
+	case errors.Is(err, domain.ErrTransitionForbidden):
+		return err
+	}
+	return fmt.Errorf("service: failed to %s purchase order '%s': %w", action, id, err)
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"inventory-system/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// maxRebuildJobs is how many finished jobs are remembered for GET /admin/rebuilds.
+const maxRebuildJobs = 50
+
+type rebuildService struct {
+	stores map[string]domain.DerivedStore
+
+	mu      sync.Mutex
+	jobs    map[string]*domain.RebuildJob
+	order   []string          // Job IDs, oldest first
+	running map[string]string // Store name -> ID of its running job
+}
+
+// NewRebuildService creates a new RebuildService for the given stores.
+func NewRebuildService(stores ...domain.DerivedStore) domain.RebuildService {
+	s := &rebuildService{
+		stores:  make(map[string]domain.DerivedStore),
+		jobs:    make(map[string]*domain.RebuildJob),
+		running: make(map[string]string),
+	}
+	for _, store := range stores {
+		s.stores[store.Name()] = store
+	}
+	return s
+}
+
+func (s *rebuildService) Stores() []string {
+	names := make([]string, 0, len(s.stores))
+	for name := range s.stores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// StartRebuild runs the rebuild in the background. It deliberately does not inherit ctx:
+// the job outlives the request that started it.
+func (s *rebuildService) StartRebuild(ctx context.Context, store, startedBy string) (*domain.RebuildJob, error) {
+	ds, ok := s.stores[store]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", domain.ErrDerivedStoreNotFound, store)
+	}
+
+	s.mu.Lock()
+	if id, busy := s.running[store]; busy {
+		s.mu.Unlock()
+		return nil, fmt.Errorf("%w: job %s", domain.ErrRebuildRunning, id)
+	}
+	job := &domain.RebuildJob{
+		ID:        uuid.NewString(),
+		Store:     store,
+		Status:    domain.RebuildStatusRunning,
+		StartedBy: startedBy,
+		StartedAt: time.Now().UTC(),
+	}
+	s.jobs[job.ID] = job
+	s.order = append(s.order, job.ID)
+	s.running[store] = job.ID
+	s.forgetOldJobs()
+	snapshot := *job
+	s.mu.Unlock()
+
+	log.Printf("Rebuild %s of %s started by %q", job.ID, store, startedBy)
+	go s.run(ds, job)
+	return &snapshot, nil
+}
+
+func (s *rebuildService) run(ds domain.DerivedStore, job *domain.RebuildJob) {
+	err := ds.Rebuild(context.Background(), func(done, total int) {
+		s.mu.Lock()
+		job.Done, job.Total = done, total
+		s.mu.Unlock()
+	})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	finished := time.Now().UTC()
+	job.FinishedAt = &finished
+	job.Status = domain.RebuildStatusSucceeded
+	if err != nil {
+		job.Status = domain.RebuildStatusFailed
+		job.Error = err.Error()
+	}
+	delete(s.running, job.Store)
+	log.Printf("Rebuild %s of %s %s after %s (%d/%d): %v", job.ID, job.Store, job.Status,
+		finished.Sub(job.StartedAt).Round(time.Millisecond), job.Done, job.Total, err)
+}
+
+// forgetOldJobs drops the oldest finished jobs beyond maxRebuildJobs. s.mu must be held.
+func (s *rebuildService) forgetOldJobs() {
+	for i := 0; len(s.order) > maxRebuildJobs && i < len(s.order); {
+		id := s.order[i]
+		if s.jobs[id].Status == domain.RebuildStatusRunning {
+			i++
+			continue
+		}
+		delete(s.jobs, id)
+		s.order = append(s.order[:i], s.order[i+1:]...)
+	}
+}
+
+func (s *rebuildService) GetRebuild(ctx context.Context, id string) (*domain.RebuildJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	job, ok := s.jobs[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", domain.ErrRebuildJobNotFound, id)
+	}
+	snapshot := *job
+	return &snapshot, nil
+}
+
+func (s *rebuildService) ListRebuilds(ctx context.Context) ([]*domain.RebuildJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	jobs := make([]*domain.RebuildJob, 0, len(s.order))
+	for i := len(s.order) - 1; i >= 0; i-- {
+		snapshot := *s.jobs[s.order[i]]
+		jobs = append(jobs, &snapshot)
+	}
+	return jobs, nil
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/realtime"
+
+	"github.com/google/uuid"
+)
+
+type salesOrderService struct {
+	repo    domain.SalesOrderRepository
+	prices  domain.PriceTierService    // Prices the lines of new orders
+	hub     *realtime.Hub              // Receives the new quantities after a fulfillment
+	changes domain.ItemChangePublisher // Told about items changed by a fulfillment; may be nil
+}
+
+// NewSalesOrderService creates a new SalesOrderService.
+func NewSalesOrderService(repo domain.SalesOrderRepository, prices domain.PriceTierService, hub *realtime.Hub, changes domain.ItemChangePublisher) domain.SalesOrderService {
+	return &salesOrderService{
+		repo:    repo,
+		prices:  prices,
+		hub:     hub,
+		changes: changes,
+	}
+}
+
+// CreateOrder places an order, pricing each line for the customer's tier and reserving the
+// stock. Each item may appear on one line only.
+func (s *salesOrderService) CreateOrder(ctx context.Context, req *domain.CreateSalesOrderRequest, userID string) (*domain.SalesOrder, error) {
+	if userID == "" {
+		return nil, domain.ErrMissingUser
+	}
+	customerID := ""
+	if req.CustomerID != nil {
+		customerID = *req.CustomerID
+	}
+
+	o := &domain.SalesOrder{CustomerID: req.CustomerID, CreatedBy: userID}
+	seen := make(map[string]bool, len(req.Lines))
+	for _, line := range req.Lines {
+		if seen[line.ItemID] {
+			return nil, fmt.Errorf("%w: item '%s' listed twice", domain.ErrInvalidInput, line.ItemID)
+		}
+		seen[line.ItemID] = true
+
+		price, err := s.prices.ResolvePrice(ctx, line.ItemID, customerID)
+		if err != nil {
+			return nil, err
+		}
+		o.Lines = append(o.Lines, domain.SalesOrderLine{ItemID: line.ItemID, Quantity: line.Quantity, UnitPrice: price.Price})
+	}
+
+	created, err := s.repo.Create(ctx, o)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRepositoryNotFound):
+			return nil, fmt.Errorf("%w: %v", domain.ErrItemNotFound, err)
+		case errors.Is(err, domain.ErrInsufficientStock), errors.Is(err, domain.ErrCustomerNotFound):
+			return nil, err
+		}
+		return nil, fmt.Errorf("service: failed to create sales order: %w", err)
+	}
+	return created, nil
+}
+
+// GetOrder retrieves a sales order by ID.
+func (s *salesOrderService) GetOrder(ctx context.Context, id string) (*domain.SalesOrder, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	o, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrSalesOrderNotFound, id)
+		}
+		return nil, fmt.Errorf("service: failed to get sales order '%s': %w", id, err)
+	}
+	return o, nil
+}
+
+// ListOrders returns the latest sales orders, newest first.
+func (s *salesOrderService) ListOrders(ctx context.Context, q domain.ListSalesOrdersQuery) ([]*domain.SalesOrder, error) {
+	orders, err := s.repo.List(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to list sales orders: %w", err)
+	}
+	return orders, nil
+}
+
+// FulfillOrder ships an open order, deducting its stock, and broadcasts the new stock levels.
+func (s *salesOrderService) FulfillOrder(ctx context.Context, id, userID string) (*domain.FulfillSalesOrderResult, error) {
+	if userID == "" {
+		return nil, domain.ErrMissingUser
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	result, err := s.repo.Fulfill(ctx, id, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRepositoryNotFound):
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrSalesOrderNotFound, id)
+		case errors.Is(err, domain.ErrSalesOrderClosed), errors.Is(err, domain.ErrInsufficientStock):
+			return nil, err
+		}
+		return nil, fmt.Errorf("service: failed to fulfill sales order '%s': %w", id, err)
+	}
+
+	itemIDs := make([]string, 0, len(result.Items))
+	for _, item := range result.Items {
+		itemIDs = append(itemIDs, item.ID)
+		if s.hub != nil {
+			s.hub.BroadcastStockUpdate(ctx, domain.StockUpdatePayload{
+				ID:          item.ID,
+				SKU:         item.SKU,
+				NewQuantity: item.Quantity,
+			})
+		}
+	}
+	if s.changes != nil {
+		s.changes.PublishItemChanged(ctx, itemIDs...)
+	}
+	return result, nil
+}
+
+// CancelOrder closes an open order and releases its reservation.
+func (s *salesOrderService) CancelOrder(ctx context.Context, id, userID string) (*domain.SalesOrder, error) {
+	if userID == "" {
+		return nil, domain.ErrMissingUser
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidInput, id)
+	}
+	o, err := s.repo.Cancel(ctx, id, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRepositoryNotFound):
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrSalesOrderNotFound, id)
+		case errors.Is(err, domain.ErrSalesOrderClosed):
+			return nil, err
+		}
+		return nil, fmt.Errorf("service: failed to cancel sales order '%s': %w", id, err)
+	}
+	return o, nil
+}
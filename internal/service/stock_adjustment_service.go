@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/realtime"
+
+	"github.com/google/uuid"
+)
+
+type stockAdjustmentService struct {
+	repo    domain.StockAdjustmentRepository
+	hub     *realtime.Hub              // Receives one combined update per applied batch
+	changes domain.ItemChangePublisher // Told about adjusted items; may be nil
+}
+
+// NewStockAdjustmentService creates a new StockAdjustmentService.
+func NewStockAdjustmentService(repo domain.StockAdjustmentRepository, hub *realtime.Hub, changes domain.ItemChangePublisher) domain.StockAdjustmentService {
+	return &stockAdjustmentService{
+		repo:    repo,
+		hub:     hub,
+		changes: changes,
+	}
+}
+
+// ApplyBatch applies a batch of adjustments atomically and broadcasts the resulting quantities
+// in a single message. A rejected batch returns its per-line results along with ErrBatchRejected.
+func (s *stockAdjustmentService) ApplyBatch(ctx context.Context, req *domain.BatchAdjustmentRequest, adjustedBy string) (*domain.BatchAdjustmentResult, error) {
+	if adjustedBy == "" {
+		return nil, domain.ErrMissingUser
+	}
+	if len(req.Adjustments) == 0 || len(req.Adjustments) > domain.MaxBatchAdjustments {
+		return nil, fmt.Errorf("%w: a batch must have between 1 and %d adjustments", domain.ErrInvalidInput, domain.MaxBatchAdjustments)
+	}
+	for i, l := range req.Adjustments {
+		if _, err := uuid.Parse(l.ItemID); err != nil {
+			return nil, fmt.Errorf("%w: line %d: %s", domain.ErrInvalidItemID, i, l.ItemID)
+		}
+		if l.Delta == 0 {
+			return nil, fmt.Errorf("%w: line %d: delta must not be zero", domain.ErrInvalidInput, i)
+		}
+	}
+
+	batchID := uuid.NewString()
+	results, err := s.repo.ApplyBatch(ctx, batchID, req.Adjustments, adjustedBy)
+	if err != nil {
+		if errors.Is(err, domain.ErrBatchRejected) {
+			return &domain.BatchAdjustmentResult{BatchID: batchID, Results: results}, err
+		}
+		return nil, fmt.Errorf("service: failed to apply adjustment batch: %w", err)
+	}
+
+	updates := finalQuantities(results)
+	if s.changes != nil {
+		ids := make([]string, len(updates))
+		for i, u := range updates {
+			ids[i] = u.ID
+		}
+		s.changes.PublishItemChanged(ctx, ids...)
+	}
+	if s.hub != nil {
+		s.hub.BroadcastStockBatchUpdate(ctx, domain.StockBatchUpdatePayload{BatchID: batchID, Updates: updates})
+	}
+	return &domain.BatchAdjustmentResult{BatchID: batchID, Applied: true, Results: results}, nil
+}
+
+// finalQuantities returns the last quantity of each item in results, in order of first appearance.
+func finalQuantities(results []domain.StockAdjustmentResult) []domain.StockUpdatePayload {
+	updates := []domain.StockUpdatePayload{}
+	index := make(map[string]int, len(results))
+	for _, r := range results {
+		if i, ok := index[r.ItemID]; ok {
+			updates[i].NewQuantity = r.QuantityAfter
+			continue
+		}
+		index[r.ItemID] = len(updates)
+		updates = append(updates, domain.StockUpdatePayload{ID: r.ItemID, SKU: r.SKU, NewQuantity: r.QuantityAfter})
+	}
+	return updates
+}
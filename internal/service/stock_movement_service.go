@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"inventory-system/internal/domain"
+	"inventory-system/internal/realtime"
+
+	"github.com/google/uuid"
+)
+
+type stockMovementService struct {
+	repo    domain.StockMovementRepository
+	hub     *realtime.Hub              // Receives the new quantity of adjusted items
+	changes domain.ItemChangePublisher // Told about adjusted items; may be nil
+}
+
+// NewStockMovementService creates a new StockMovementService.
+func NewStockMovementService(repo domain.StockMovementRepository, hub *realtime.Hub, changes domain.ItemChangePublisher) domain.StockMovementService {
+	return &stockMovementService{
+		repo:    repo,
+		hub:     hub,
+		changes: changes,
+	}
+}
+
+// AdjustStock changes an item's quantity by req.Delta, records why in the movement ledger
+// and broadcasts the new stock level.
+func (s *stockMovementService) AdjustStock(ctx context.Context, itemID string, req *domain.AdjustStockRequest, userID string) (*domain.AdjustStockResult, error) {
+	if userID == "" {
+		return nil, domain.ErrMissingUser
+	}
+	if _, err := uuid.Parse(itemID); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidItemID, itemID)
+	}
+	if req.Delta == 0 {
+		return nil, fmt.Errorf("%w: delta must not be zero", domain.ErrInvalidInput)
+	}
+
+	item, movement, err := s.repo.Adjust(ctx, &domain.StockMovement{
+		ItemID:  itemID,
+		Delta:   req.Delta,
+		Reason:  req.Reason,
+		Note:    req.Note,
+		MovedBy: userID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRepositoryNotFound):
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrItemNotFound, itemID)
+		case errors.Is(err, domain.ErrInsufficientStock):
+			return nil, err
+		}
+		return nil, fmt.Errorf("service: failed to adjust stock of item '%s': %w", itemID, err)
+	}
+
+	if s.changes != nil {
+		s.changes.PublishItemChanged(ctx, item.ID)
+	}
+	if s.hub != nil {
+		s.hub.BroadcastStockUpdate(ctx, domain.StockUpdatePayload{
+			ID:          item.ID,
+			SKU:         item.SKU,
+			NewQuantity: item.Quantity,
+		})
+	}
+	return &domain.AdjustStockResult{Item: item, Movement: movement}, nil
+}
+
+// ListMovements returns an item's latest movements, newest first.
+func (s *stockMovementService) ListMovements(ctx context.Context, itemID string, limit int) ([]*domain.StockMovement, error) {
+	if _, err := uuid.Parse(itemID); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidItemID, itemID)
+	}
+	if limit <= 0 {
+		limit = 100
+	} else if limit > 500 {
+		limit = 500
+	}
+	movements, err := s.repo.ListByItem(ctx, itemID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to list stock movements of item '%s': %w", itemID, err)
+	}
+	return movements, nil
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+
+	"inventory-system/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// taxCodePattern restricts tax codes and jurisdictions to path-safe identifiers such as
+// 'reduced' or 'US-CA'.
+var taxCodePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,32}$`)
+
+type taxService struct {
+	repo     domain.TaxRepository
+	orders   domain.SalesOrderRepository
+	provider domain.TaxProvider // Taxes the lines of quoted orders
+}
+
+// NewTaxService creates a new TaxService. Orders are taxed by provider; use
+// NewFlatRateTaxProvider for the stored rates.
+func NewTaxService(repo domain.TaxRepository, orders domain.SalesOrderRepository, provider domain.TaxProvider) domain.TaxService {
+	return &taxService{repo: repo, orders: orders, provider: provider}
+}
+
+// ListRates returns all tax rates.
+func (s *taxService) ListRates(ctx context.Context) ([]*domain.TaxRate, error) {
+	rates, err := s.repo.ListRates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to list tax rates: %w", err)
+	}
+	return rates, nil
+}
+
+// SetRate sets the rate of a tax code in a jurisdiction.
+func (s *taxService) SetRate(ctx context.Context, code, jurisdiction string, req *domain.SetTaxRateRequest) (*domain.TaxRate, error) {
+	if err := checkTaxKey(code, jurisdiction); err != nil {
+		return nil, err
+	}
+	tr, err := s.repo.SetRate(ctx, &domain.TaxRate{TaxCode: code, Jurisdiction: jurisdiction, RatePercent: *req.RatePercent})
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to set tax rate of '%s' in '%s': %w", code, jurisdiction, err)
+	}
+	return tr, nil
+}
+
+// DeleteRate removes the rate of a tax code in a jurisdiction. Orders with items under that
+// code can no longer be quoted there.
+func (s *taxService) DeleteRate(ctx context.Context, code, jurisdiction string) error {
+	if err := checkTaxKey(code, jurisdiction); err != nil {
+		return err
+	}
+	if err := s.repo.DeleteRate(ctx, code, jurisdiction); err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return fmt.Errorf("%w: '%s' in '%s'", domain.ErrTaxRateNotFound, code, jurisdiction)
+		}
+		return fmt.Errorf("service: failed to delete tax rate of '%s' in '%s': %w", code, jurisdiction, err)
+	}
+	return nil
+}
+
+// AssignItemTaxCode sets the tax code of an item; a nil code puts it back under the standard code.
+func (s *taxService) AssignItemTaxCode(ctx context.Context, itemID string, req *domain.AssignTaxCodeRequest, userID string) error {
+	if _, err := uuid.Parse(itemID); err != nil {
+		return fmt.Errorf("%w: %s", domain.ErrInvalidItemID, itemID)
+	}
+	if req.TaxCode != nil && !taxCodePattern.MatchString(*req.TaxCode) {
+		return fmt.Errorf("%w: invalid tax code '%s'", domain.ErrInvalidInput, *req.TaxCode)
+	}
+	if err := s.repo.AssignItemTaxCode(ctx, itemID, req.TaxCode, userID); err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return fmt.Errorf("%w: ID %s", domain.ErrItemNotFound, itemID)
+		}
+		return fmt.Errorf("service: failed to set tax code of item '%s': %w", itemID, err)
+	}
+	return nil
+}
+
+// QuoteOrder calculates the tax of a sales order in a jurisdiction, line by line, at the
+// prices stored on the order. Nothing is stored.
+func (s *taxService) QuoteOrder(ctx context.Context, orderID, jurisdiction string) (*domain.TaxQuote, error) {
+	if _, err := uuid.Parse(orderID); err != nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidInput, orderID)
+	}
+	if !taxCodePattern.MatchString(jurisdiction) {
+		return nil, fmt.Errorf("%w: invalid jurisdiction '%s'", domain.ErrInvalidInput, jurisdiction)
+	}
+	o, err := s.orders.GetByID(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, domain.ErrRepositoryNotFound) {
+			return nil, fmt.Errorf("%w: ID %s", domain.ErrSalesOrderNotFound, orderID)
+		}
+		return nil, fmt.Errorf("service: failed to get sales order '%s': %w", orderID, err)
+	}
+
+	itemIDs := make([]string, 0, len(o.Lines))
+	for _, line := range o.Lines {
+		itemIDs = append(itemIDs, line.ItemID)
+	}
+	codes, err := s.repo.ItemTaxCodes(ctx, itemIDs)
+	if err != nil {
+		return nil, fmt.Errorf("service: failed to get tax codes of sales order '%s': %w", orderID, err)
+	}
+	lines := make([]domain.TaxableLine, 0, len(o.Lines))
+	for _, line := range o.Lines {
+		code, ok := codes[line.ItemID]
+		if !ok {
+			code = domain.TaxCodeStandard
+		}
+		lines = append(lines, domain.TaxableLine{ItemID: line.ItemID, TaxCode: code, Net: roundCents(float64(line.Quantity) * line.UnitPrice)})
+	}
+
+	taxed, err := s.provider.Calculate(ctx, jurisdiction, lines)
+	if err != nil {
+		if errors.Is(err, domain.ErrTaxRateNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("service: failed to calculate tax of sales order '%s' with %s: %w", orderID, s.provider.Name(), err)
+	}
+	quote := &domain.TaxQuote{OrderID: orderID, Jurisdiction: jurisdiction, Provider: s.provider.Name(), Lines: taxed}
+	for _, line := range taxed {
+		quote.Net += line.Net
+		quote.Tax += line.Tax
+	}
+	quote.Net, quote.Tax = roundCents(quote.Net), roundCents(quote.Tax)
+	quote.Gross = roundCents(quote.Net + quote.Tax)
+	return quote, nil
+}
+
+// flatRateTaxProvider taxes each line at the stored rate of its tax code in the jurisdiction.
+type flatRateTaxProvider struct {
+	repo domain.TaxRepository
+}
+
+// NewFlatRateTaxProvider creates the default TaxProvider, which applies the rates stored per
+// tax code and jurisdiction. Tax is rounded to cents per line.
+func NewFlatRateTaxProvider(repo domain.TaxRepository) domain.TaxProvider {
+	return &flatRateTaxProvider{repo: repo}
+}
+
+// Name identifies the provider on quotes.
+func (p *flatRateTaxProvider) Name() string { return "flat-rate" }
+
+// Calculate taxes the lines, failing with ErrTaxRateNotFound if a tax code has no rate in the
+// jurisdiction.
+func (p *flatRateTaxProvider) Calculate(ctx context.Context, jurisdiction string, lines []domain.TaxableLine) ([]domain.TaxedLine, error) {
+	codes := make([]string, 0, len(lines))
+	for _, line := range lines {
+		codes = append(codes, line.TaxCode)
+	}
+	rates, err := p.repo.GetRates(ctx, jurisdiction, codes)
+	if err != nil {
+		return nil, err
+	}
+
+	taxed := make([]domain.TaxedLine, 0, len(lines))
+	for _, line := range lines {
+		rate, ok := rates[line.TaxCode]
+		if !ok {
+			return nil, fmt.Errorf("%w: '%s' in '%s'", domain.ErrTaxRateNotFound, line.TaxCode, jurisdiction)
+		}
+		taxed = append(taxed, domain.TaxedLine{TaxableLine: line, RatePercent: rate, Tax: roundCents(line.Net * rate / 100)})
+	}
+	return taxed, nil
+}
+
+// checkTaxKey validates the tax code and jurisdiction of a tax rate.
+func checkTaxKey(code, jurisdiction string) error {
+	if !taxCodePattern.MatchString(code) {
+		return fmt.Errorf("%w: invalid tax code '%s'", domain.ErrInvalidInput, code)
+	}
+	if !taxCodePattern.MatchString(jurisdiction) {
+		return fmt.Errorf("%w: invalid jurisdiction '%s'", domain.ErrInvalidInput, jurisdiction)
+	}
+	return nil
+}
+
+// roundCents rounds an amount to cents.
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
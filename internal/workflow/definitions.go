@@ -0,0 +1,41 @@
+package workflow
+
+import "inventory-system/internal/domain"
+
+// PurchaseOrder is the built-in purchase order workflow. It requires no roles; deployments
+// that want approval steps replace it with LoadFile.
+func PurchaseOrder() *Definition {
+	const (
+		draft     = domain.PurchaseOrderStatusDraft
+		submitted = domain.PurchaseOrderStatusSubmitted
+		partial   = domain.PurchaseOrderStatusPartiallyReceived
+		received  = domain.PurchaseOrderStatusReceived
+		cancelled = domain.PurchaseOrderStatusCancelled
+	)
+	return &Definition{
+		DocumentType: domain.WorkflowDocumentPurchaseOrder,
+		Initial:      draft,
+		States:       []string{draft, submitted, partial, received, cancelled},
+		Transitions: []Transition{
+			{Name: domain.PurchaseOrderActionSubmit, From: draft, To: submitted},
+			{Name: domain.PurchaseOrderActionReceive, From: submitted, To: partial},
+			{Name: domain.PurchaseOrderActionReceive, From: partial, To: partial},
+			{Name: domain.PurchaseOrderActionComplete, From: submitted, To: received},
+			{Name: domain.PurchaseOrderActionComplete, From: partial, To: received},
+			{Name: domain.PurchaseOrderActionCancel, From: draft, To: cancelled},
+			{Name: domain.PurchaseOrderActionCancel, From: submitted, To: cancelled},
+			{Name: domain.PurchaseOrderActionCancel, From: partial, To: cancelled},
+		},
+	}
+}
+
+// NewDefaultRegistry creates a Registry holding the built-in workflows.
+func NewDefaultRegistry() *Registry {
+	r := NewRegistry()
+	for _, def := range []*Definition{PurchaseOrder()} {
+		if err := r.Register(def); err != nil {
+			panic(err) // The built-in definitions are covered by tests
+		}
+	}
+	return r
+}
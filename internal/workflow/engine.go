@@ -0,0 +1,88 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"inventory-system/internal/domain"
+)
+
+// Engine resolves workflow transitions and reads their history.
+type Engine struct {
+	registry *Registry
+	history  domain.WorkflowHistoryRepository
+}
+
+// NewEngine creates a new Engine. history may be nil, in which case every document has an
+// empty history.
+func NewEngine(registry *Registry, history domain.WorkflowHistoryRepository) *Engine {
+	return &Engine{registry: registry, history: history}
+}
+
+// Registry exposes the underlying definitions.
+func (e *Engine) Registry() *Registry {
+	return e.registry
+}
+
+// Resolve checks that action is allowed from currentState for a caller with the given
+// roles and returns the transition to record, made by actor (empty when unknown).
+// It records nothing: the caller stores the transition together with the document's new
+// state, in one database transaction, so that neither is kept without the other.
+func (e *Engine) Resolve(documentType, documentID, currentState, action, actor string, roles []string) (*domain.WorkflowTransition, error) {
+	def, err := e.registry.Get(documentType)
+	if err != nil {
+		return nil, err
+	}
+	t, err := def.Apply(currentState, action, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	transition := &domain.WorkflowTransition{
+		DocumentType: documentType,
+		DocumentID:   documentID,
+		Action:       t.Name,
+		FromState:    t.From,
+		ToState:      t.To,
+	}
+	if actor != "" {
+		transition.Actor = &actor
+	}
+	return transition, nil
+}
+
+// History returns the recorded transitions of a document, oldest first.
+func (e *Engine) History(ctx context.Context, documentType, documentID string) ([]*domain.WorkflowTransition, error) {
+	if e.history == nil {
+		return []*domain.WorkflowTransition{}, nil
+	}
+	return e.history.ListByDocument(ctx, documentType, documentID)
+}
+
+// LoadFile reads a JSON array of definitions from path and registers them, replacing the
+// definitions already registered for the same document types. Nothing is registered if
+// any of them is invalid. This is how deployments customise approval steps without a code
+// change.
+func (r *Registry) LoadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("workflow: failed to read %s: %w", path, err)
+	}
+	var defs []*Definition
+	if err := json.Unmarshal(data, &defs); err != nil {
+		return fmt.Errorf("workflow: failed to parse %s: %w", path, err)
+	}
+	for _, def := range defs {
+		if err := def.Validate(); err != nil {
+			return fmt.Errorf("workflow: %s: %w", path, err)
+		}
+	}
+	for _, def := range defs {
+		if err := r.Register(def); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+// Package workflow implements a small, generic state machine used to drive
+// approval flows on documents (purchase orders, adjustments, stocktakes, ...).
+//
+// A Definition lists the states a document can be in and the named transitions
+// between them. Each transition can require a role; the engine only checks the
+// role, it does not know where roles come from (that is the caller's concern).
+package workflow
+
+import (
+	"fmt"
+	"sync"
+
+	"inventory-system/internal/domain"
+)
+
+// Transition describes a named move from one state to another.
+type Transition struct {
+	Name         string `json:"name"`                    // Action name, e.g. "submit", "approve"
+	From         string `json:"from"`                    // State the document must be in
+	To           string `json:"to"`                      // State the document ends up in
+	RequiredRole string `json:"required_role,omitempty"` // Empty means anyone may apply it
+}
+
+// Definition is a complete workflow for one document type.
+type Definition struct {
+	DocumentType string       `json:"document_type"`
+	Initial      string       `json:"initial"`
+	States       []string     `json:"states"`
+	Transitions  []Transition `json:"transitions"`
+}
+
+// Validate checks that the definition is internally consistent.
+func (d *Definition) Validate() error {
+	if d.DocumentType == "" {
+		return fmt.Errorf("workflow: document type is required")
+	}
+	known := make(map[string]bool, len(d.States))
+	for _, s := range d.States {
+		known[s] = true
+	}
+	if !known[d.Initial] {
+		return fmt.Errorf("workflow %s: initial state %q is not a declared state", d.DocumentType, d.Initial)
+	}
+	seen := make(map[string]bool, len(d.Transitions))
+	for _, t := range d.Transitions {
+		if !known[t.From] || !known[t.To] {
+			return fmt.Errorf("workflow %s: transition %q references an undeclared state", d.DocumentType, t.Name)
+		}
+		key := t.From + "|" + t.Name
+		if seen[key] {
+			return fmt.Errorf("workflow %s: duplicate transition %q from state %q", d.DocumentType, t.Name, t.From)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// Apply resolves the action against the current state and returns the transition
+// to perform. roles are the roles held by the caller.
+func (d *Definition) Apply(current, action string, roles []string) (*Transition, error) {
+	for i := range d.Transitions {
+		t := &d.Transitions[i]
+		if t.From != current || t.Name != action {
+			continue
+		}
+		if t.RequiredRole != "" && !hasRole(roles, t.RequiredRole) {
+			return nil, fmt.Errorf("%w: %s requires role %q", domain.ErrTransitionForbidden, action, t.RequiredRole)
+		}
+		return t, nil
+	}
+	return nil, fmt.Errorf("%w: %s from %q", domain.ErrInvalidTransition, action, current)
+}
+
+// Available lists the transitions that can be applied from the given state,
+// regardless of role. Useful for rendering action buttons in the UI.
+func (d *Definition) Available(current string) []Transition {
+	var out []Transition
+	for _, t := range d.Transitions {
+		if t.From == current {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+func hasRole(roles []string, required string) bool {
+	for _, r := range roles {
+		if r == required {
+			return true
+		}
+	}
+	return false
+}
+
+// Registry holds the active workflow definition per document type.
+// Definitions can be replaced at runtime, so it is safe for concurrent use.
+type Registry struct {
+	mu   sync.RWMutex
+	defs map[string]*Definition
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{defs: make(map[string]*Definition)}
+}
+
+// Register validates and installs a definition, replacing any existing one
+// for the same document type.
+func (r *Registry) Register(def *Definition) error {
+	if err := def.Validate(); err != nil {
+		return err
+	}
+	r.mu.Lock()
+	r.defs[def.DocumentType] = def
+	r.mu.Unlock()
+	return nil
+}
+
+// Get returns the definition for a document type.
+func (r *Registry) Get(documentType string) (*Definition, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	def, ok := r.defs[documentType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", domain.ErrUnknownWorkflow, documentType)
+	}
+	return def, nil
+}
@@ -0,0 +1,229 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"inventory-system/internal/domain"
+)
+
+// approval is a two-step approval flow in which only managers may approve.
+func approval() *Definition {
+	return &Definition{
+		DocumentType: "stocktake",
+		Initial:      "open",
+		States:       []string{"open", "counted", "approved"},
+		Transitions: []Transition{
+			{Name: "count", From: "open", To: "counted"},
+			{Name: "recount", From: "counted", To: "counted"},
+			{Name: "approve", From: "counted", To: "approved", RequiredRole: "manager"},
+		},
+	}
+}
+
+func TestDefinitionValidate(t *testing.T) {
+	if err := approval().Validate(); err != nil {
+		t.Fatalf("Validate(approval) = %v", err)
+	}
+
+	cases := map[string]func(d *Definition){
+		"no document type":   func(d *Definition) { d.DocumentType = "" },
+		"undeclared initial": func(d *Definition) { d.Initial = "draft" },
+		"undeclared target":  func(d *Definition) { d.Transitions[0].To = "done" },
+		"duplicate": func(d *Definition) {
+			d.Transitions = append(d.Transitions, Transition{Name: "count", From: "open", To: "approved"})
+		},
+	}
+	for name, breakIt := range cases {
+		d := approval()
+		breakIt(d)
+		if err := d.Validate(); err == nil {
+			t.Errorf("Validate(%s) = nil, want an error", name)
+		}
+	}
+}
+
+func TestDefinitionApply(t *testing.T) {
+	d := approval()
+
+	if tr, err := d.Apply("open", "count", nil); err != nil || tr.To != "counted" {
+		t.Errorf("Apply(open, count) = %+v, %v, want counted", tr, err)
+	}
+	if tr, err := d.Apply("counted", "recount", nil); err != nil || tr.To != "counted" {
+		t.Errorf("Apply(counted, recount) = %+v, %v, want counted", tr, err)
+	}
+	if _, err := d.Apply("open", "approve", []string{"manager"}); !errors.Is(err, domain.ErrInvalidTransition) {
+		t.Errorf("Apply(open, approve) = %v, want ErrInvalidTransition", err)
+	}
+	if _, err := d.Apply("counted", "archive", nil); !errors.Is(err, domain.ErrInvalidTransition) {
+		t.Errorf("Apply(unknown action) = %v, want ErrInvalidTransition", err)
+	}
+
+	// Roles are only checked once the transition itself is allowed.
+	if _, err := d.Apply("counted", "approve", nil); !errors.Is(err, domain.ErrTransitionForbidden) {
+		t.Errorf("Apply(approve without roles) = %v, want ErrTransitionForbidden", err)
+	}
+	if _, err := d.Apply("counted", "approve", []string{"clerk"}); !errors.Is(err, domain.ErrTransitionForbidden) {
+		t.Errorf("Apply(approve as clerk) = %v, want ErrTransitionForbidden", err)
+	}
+	if tr, err := d.Apply("counted", "approve", []string{"clerk", "manager"}); err != nil || tr.To != "approved" {
+		t.Errorf("Apply(approve as manager) = %+v, %v, want approved", tr, err)
+	}
+}
+
+func TestDefinitionAvailable(t *testing.T) {
+	got := approval().Available("counted")
+	if len(got) != 2 || got[0].Name != "recount" || got[1].Name != "approve" {
+		t.Errorf("Available(counted) = %+v, want recount and approve", got)
+	}
+	if got := approval().Available("approved"); len(got) != 0 {
+		t.Errorf("Available(approved) = %+v, want none", got)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	if _, err := r.Get("stocktake"); !errors.Is(err, domain.ErrUnknownWorkflow) {
+		t.Errorf("Get(unregistered) = %v, want ErrUnknownWorkflow", err)
+	}
+	invalid := approval()
+	invalid.Initial = "draft"
+	if err := r.Register(invalid); err == nil {
+		t.Error("Register(invalid) = nil, want an error")
+	}
+	if err := r.Register(approval()); err != nil {
+		t.Fatalf("Register = %v", err)
+	}
+	if d, err := r.Get("stocktake"); err != nil || d.Initial != "open" {
+		t.Errorf("Get = %+v, %v", d, err)
+	}
+}
+
+func TestRegistryLoadFile(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	r := NewRegistry()
+	if err := r.Register(approval()); err != nil {
+		t.Fatal(err)
+	}
+	custom := write("custom.json", `[{"document_type": "stocktake", "initial": "open", "states": ["open", "closed"],
+		"transitions": [{"name": "close", "from": "open", "to": "closed", "required_role": "auditor"}]}]`)
+	if err := r.LoadFile(custom); err != nil {
+		t.Fatalf("LoadFile = %v", err)
+	}
+	d, _ := r.Get("stocktake")
+	if _, err := d.Apply("open", "count", nil); !errors.Is(err, domain.ErrInvalidTransition) {
+		t.Errorf("replaced definition still counts: %v", err)
+	}
+	if _, err := d.Apply("open", "close", []string{"auditor"}); err != nil {
+		t.Errorf("Apply(close as auditor) = %v", err)
+	}
+
+	// A file with an invalid definition changes nothing, not even its valid ones.
+	partly := write("partly.json", `[{"document_type": "transfer", "initial": "new", "states": ["new"]},
+		{"document_type": "stocktake", "initial": "missing", "states": ["open"]}]`)
+	if err := r.LoadFile(partly); err == nil {
+		t.Error("LoadFile(invalid definition) = nil, want an error")
+	}
+	if _, err := r.Get("transfer"); !errors.Is(err, domain.ErrUnknownWorkflow) {
+		t.Errorf("valid definition of a rejected file was registered: %v", err)
+	}
+	if err := r.LoadFile(write("broken.json", `{`)); err == nil {
+		t.Error("LoadFile(malformed) = nil, want an error")
+	}
+	if err := r.LoadFile(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("LoadFile(missing) = nil, want an error")
+	}
+}
+
+func TestPurchaseOrderWorkflow(t *testing.T) {
+	d, err := NewDefaultRegistry().Get(domain.WorkflowDocumentPurchaseOrder)
+	if err != nil {
+		t.Fatalf("Get(purchase_order) = %v", err)
+	}
+	cases := []struct {
+		from, action, want string // want is empty when the action is not allowed
+	}{
+		{domain.PurchaseOrderStatusDraft, domain.PurchaseOrderActionSubmit, domain.PurchaseOrderStatusSubmitted},
+		{domain.PurchaseOrderStatusDraft, domain.PurchaseOrderActionReceive, ""},
+		{domain.PurchaseOrderStatusSubmitted, domain.PurchaseOrderActionSubmit, ""},
+		{domain.PurchaseOrderStatusSubmitted, domain.PurchaseOrderActionReceive, domain.PurchaseOrderStatusPartiallyReceived},
+		{domain.PurchaseOrderStatusSubmitted, domain.PurchaseOrderActionComplete, domain.PurchaseOrderStatusReceived},
+		{domain.PurchaseOrderStatusPartiallyReceived, domain.PurchaseOrderActionReceive, domain.PurchaseOrderStatusPartiallyReceived},
+		{domain.PurchaseOrderStatusPartiallyReceived, domain.PurchaseOrderActionComplete, domain.PurchaseOrderStatusReceived},
+		{domain.PurchaseOrderStatusPartiallyReceived, domain.PurchaseOrderActionCancel, domain.PurchaseOrderStatusCancelled},
+		{domain.PurchaseOrderStatusReceived, domain.PurchaseOrderActionCancel, ""},
+		{domain.PurchaseOrderStatusCancelled, domain.PurchaseOrderActionReceive, ""},
+	}
+	for _, tc := range cases {
+		tr, err := d.Apply(tc.from, tc.action, nil)
+		switch {
+		case tc.want == "" && !errors.Is(err, domain.ErrInvalidTransition):
+			t.Errorf("Apply(%s, %s) = %+v, %v, want ErrInvalidTransition", tc.from, tc.action, tr, err)
+		case tc.want != "" && (err != nil || tr.To != tc.want):
+			t.Errorf("Apply(%s, %s) = %+v, %v, want %s", tc.from, tc.action, tr, err, tc.want)
+		}
+	}
+}
+
+type fakeHistory []*domain.WorkflowTransition
+
+func (f fakeHistory) ListByDocument(_ context.Context, documentType, documentID string) ([]*domain.WorkflowTransition, error) {
+	var out []*domain.WorkflowTransition
+	for _, t := range f {
+		if t.DocumentType == documentType && t.DocumentID == documentID {
+			out = append(out, t)
+		}
+	}
+	return out, nil
+}
+
+func TestEngineResolve(t *testing.T) {
+	r := NewRegistry()
+	if err := r.Register(approval()); err != nil {
+		t.Fatal(err)
+	}
+	e := NewEngine(r, nil)
+
+	got, err := e.Resolve("stocktake", "s-1", "counted", "approve", "alice", []string{"manager"})
+	if err != nil {
+		t.Fatalf("Resolve = %v", err)
+	}
+	if got.DocumentType != "stocktake" || got.DocumentID != "s-1" || got.Action != "approve" ||
+		got.FromState != "counted" || got.ToState != "approved" || got.Actor == nil || *got.Actor != "alice" {
+		t.Errorf("Resolve = %+v", got)
+	}
+	if got, err := e.Resolve("stocktake", "s-1", "open", "count", "", nil); err != nil || got.Actor != nil {
+		t.Errorf("Resolve(anonymous) = %+v, %v, want no actor", got, err)
+	}
+	if _, err := e.Resolve("stocktake", "s-1", "counted", "approve", "bob", nil); !errors.Is(err, domain.ErrTransitionForbidden) {
+		t.Errorf("Resolve(approve without role) = %v, want ErrTransitionForbidden", err)
+	}
+	if _, err := e.Resolve("transfer", "t-1", "new", "send", "bob", nil); !errors.Is(err, domain.ErrUnknownWorkflow) {
+		t.Errorf("Resolve(unknown type) = %v, want ErrUnknownWorkflow", err)
+	}
+}
+
+func TestEngineHistory(t *testing.T) {
+	if got, err := NewEngine(NewRegistry(), nil).History(context.Background(), "stocktake", "s-1"); err != nil || len(got) != 0 {
+		t.Errorf("History without a store = %v, %v, want empty", got, err)
+	}
+	history := fakeHistory{
+		{DocumentType: "stocktake", DocumentID: "s-1", Action: "count"},
+		{DocumentType: "stocktake", DocumentID: "s-2", Action: "count"},
+	}
+	got, err := NewEngine(NewRegistry(), history).History(context.Background(), "stocktake", "s-1")
+	if err != nil || len(got) != 1 || got[0].DocumentID != "s-1" {
+		t.Errorf("History = %v, %v, want the transition of s-1", got, err)
+	}
+}
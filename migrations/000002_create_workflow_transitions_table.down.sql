@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_workflow_transitions_document;
+DROP TABLE IF EXISTS workflow_transitions;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS workflow_transitions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    document_type VARCHAR(50) NOT NULL,
+    document_id UUID NOT NULL,
+    action VARCHAR(50) NOT NULL,
+    from_state VARCHAR(50) NOT NULL,
+    to_state VARCHAR(50) NOT NULL,
+    actor VARCHAR(255),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- History is always read per document, in order.
+CREATE INDEX IF NOT EXISTS idx_workflow_transitions_document
+    ON workflow_transitions (document_type, document_id, created_at);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_comments_entity;
+DROP TABLE IF EXISTS comments;
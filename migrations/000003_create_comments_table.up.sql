@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS comments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    entity_type VARCHAR(50) NOT NULL, -- e.g. 'item'; polymorphic, so no foreign key
+    entity_id UUID NOT NULL,
+    author VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    mentions TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_comments_entity ON comments (entity_type, entity_id, created_at);
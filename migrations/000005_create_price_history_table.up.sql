@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS price_history (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    item_id UUID NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    old_price NUMERIC(10, 2) NOT NULL,
+    new_price NUMERIC(10, 2) NOT NULL,
+    reason VARCHAR(100) NOT NULL, -- e.g. 'bulk:increase_percent'
+    changed_by VARCHAR(255) NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_price_history_item ON price_history (item_id, changed_at DESC);
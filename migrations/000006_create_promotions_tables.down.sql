@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_promotions_window;
+DROP INDEX IF EXISTS idx_promotion_items_item;
+DROP TABLE IF EXISTS promotion_items;
+DROP TABLE IF EXISTS promotions;
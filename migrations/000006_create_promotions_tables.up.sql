@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS promotions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (ends_at > starts_at)
+);
+
+-- Promotional price of each item in a promotion. Regular prices are never overwritten:
+-- the promotional price applies only while the promotion is running.
+CREATE TABLE IF NOT EXISTS promotion_items (
+    promotion_id UUID NOT NULL REFERENCES promotions (id) ON DELETE CASCADE,
+    item_id UUID NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    price NUMERIC(10, 2) NOT NULL CHECK (price > 0),
+    PRIMARY KEY (promotion_id, item_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_promotion_items_item ON promotion_items (item_id);
+CREATE INDEX IF NOT EXISTS idx_promotions_window ON promotions (starts_at, ends_at);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_stock_adjustments_batch;
+DROP INDEX IF EXISTS idx_stock_adjustments_item;
+DROP TABLE IF EXISTS stock_adjustments;
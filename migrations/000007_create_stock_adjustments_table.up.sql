@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS stock_adjustments (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    batch_id UUID NOT NULL,
+    item_id UUID NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    delta INTEGER NOT NULL,
+    quantity_after INTEGER NOT NULL,
+    reason_code VARCHAR(50) NOT NULL, -- e.g. 'cycle_count', 'damage'
+    note TEXT NOT NULL DEFAULT '',
+    adjusted_by VARCHAR(255) NOT NULL,
+    adjusted_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_adjustments_item ON stock_adjustments (item_id, adjusted_at DESC);
+CREATE INDEX IF NOT EXISTS idx_stock_adjustments_batch ON stock_adjustments (batch_id);
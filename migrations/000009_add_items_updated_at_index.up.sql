@@ -0,0 +1,2 @@
+-- Serves the connector change feed, which pages through items in (updated_at, id) order.
+CREATE INDEX IF NOT EXISTS idx_items_updated_at_id ON items (updated_at, id);
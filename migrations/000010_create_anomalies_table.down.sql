@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_stock_adjustments_adjusted_at;
+DROP INDEX IF EXISTS idx_anomalies_detected;
+DROP TABLE IF EXISTS anomalies;
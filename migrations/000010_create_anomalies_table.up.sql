@@ -0,0 +1,17 @@
+-- Stock adjustments flagged for loss prevention by the anomaly detection job.
+CREATE TABLE IF NOT EXISTS anomalies (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    kind VARCHAR(50) NOT NULL, -- 'large_write_off', 'after_hours' or 'user_volume'
+    adjustment_id UUID REFERENCES stock_adjustments (id) ON DELETE CASCADE,
+    item_id UUID REFERENCES items (id) ON DELETE CASCADE,
+    user_id VARCHAR(255) NOT NULL,
+    score DOUBLE PRECISION NOT NULL DEFAULT 0,
+    details TEXT NOT NULL DEFAULT '',
+    dedupe_key VARCHAR(255) NOT NULL UNIQUE, -- What was flagged; runs overlap, so the same thing is seen more than once
+    detected_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    acknowledged_at TIMESTAMPTZ,
+    acknowledged_by VARCHAR(255)
+);
+
+CREATE INDEX IF NOT EXISTS idx_anomalies_detected ON anomalies (detected_at DESC);
+CREATE INDEX IF NOT EXISTS idx_stock_adjustments_adjusted_at ON stock_adjustments (adjusted_at);
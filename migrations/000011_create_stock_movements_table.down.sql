@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_stock_movements_item;
+DROP TABLE IF EXISTS stock_movements;
@@ -0,0 +1,20 @@
+-- Ledger of every change to an item's quantity, written in the same transaction as the change.
+CREATE TABLE IF NOT EXISTS stock_movements (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    item_id UUID NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    delta INTEGER NOT NULL,
+    quantity_after INTEGER NOT NULL,
+    reason VARCHAR(50) NOT NULL, -- e.g. 'sale', 'receive', 'edit', or the reason code of an adjustment batch line
+    reference VARCHAR(255) NOT NULL DEFAULT '', -- e.g. the adjustment batch ID
+    note TEXT NOT NULL DEFAULT '',
+    moved_by VARCHAR(255) NOT NULL DEFAULT '',
+    moved_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_movements_item ON stock_movements (item_id, moved_at DESC, id);
+
+-- Open the ledger with the current quantities, so every item's quantity is the sum of its movements.
+INSERT INTO stock_movements (item_id, delta, quantity_after, reason)
+SELECT id, quantity, quantity, 'opening'
+FROM items
+WHERE quantity <> 0;
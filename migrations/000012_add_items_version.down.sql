@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS bump_items_version ON items;
+DROP FUNCTION IF EXISTS trigger_bump_version();
+ALTER TABLE items DROP COLUMN IF EXISTS version;
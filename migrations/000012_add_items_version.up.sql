@@ -0,0 +1,16 @@
+ALTER TABLE items ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+-- Every write to an item bumps its version, whichever code path makes it (edits, stock
+-- adjustments, repricing), so an update based on a stale read can be detected and refused.
+CREATE OR REPLACE FUNCTION trigger_bump_version()
+RETURNS TRIGGER AS $$
+BEGIN
+  NEW.version = OLD.version + 1;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER bump_items_version
+BEFORE UPDATE ON items
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_bump_version();
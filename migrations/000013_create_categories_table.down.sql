@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_items_category;
+ALTER TABLE items DROP COLUMN IF EXISTS category_id;
+DROP TRIGGER IF EXISTS set_categories_timestamp ON categories;
+DROP INDEX IF EXISTS idx_categories_parent_name;
+DROP TABLE IF EXISTS categories;
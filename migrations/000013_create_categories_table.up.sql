@@ -0,0 +1,22 @@
+-- Categories form a tree: a category without a parent is a root.
+CREATE TABLE IF NOT EXISTS categories (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL,
+    parent_id UUID REFERENCES categories (id) ON DELETE RESTRICT, -- Categories with children cannot be deleted
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (parent_id <> id)
+);
+
+-- Sibling names are unique, ignoring case. Roots count as siblings of each other.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_categories_parent_name
+    ON categories (COALESCE(parent_id, '00000000-0000-0000-0000-000000000000'), lower(name));
+
+CREATE TRIGGER set_categories_timestamp
+BEFORE UPDATE ON categories
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+ALTER TABLE items ADD COLUMN IF NOT EXISTS category_id UUID REFERENCES categories (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_items_category ON items (category_id);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS assembly_order_lines;
+DROP INDEX IF EXISTS idx_assembly_orders_created;
+DROP TABLE IF EXISTS assembly_orders;
+DROP INDEX IF EXISTS idx_item_components_component;
+DROP TABLE IF EXISTS item_components;
@@ -0,0 +1,32 @@
+-- Bill of materials: the components consumed to assemble one unit of an item.
+CREATE TABLE IF NOT EXISTS item_components (
+    item_id UUID NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    component_id UUID NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL CHECK (quantity > 0), -- Per assembled unit
+    PRIMARY KEY (item_id, component_id),
+    CHECK (component_id <> item_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_item_components_component ON item_components (component_id);
+
+CREATE TABLE IF NOT EXISTS assembly_orders (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    item_id UUID NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'open', -- 'open', 'completed' or 'cancelled'
+    created_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    closed_by VARCHAR(255),
+    closed_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_assembly_orders_created ON assembly_orders (created_at DESC);
+
+-- Components an order consumes, copied from the bill of materials when the order is created
+-- so later changes to it do not affect open orders.
+CREATE TABLE IF NOT EXISTS assembly_order_lines (
+    order_id UUID NOT NULL REFERENCES assembly_orders (id) ON DELETE CASCADE,
+    component_id UUID NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL CHECK (quantity > 0), -- For the whole order
+    PRIMARY KEY (order_id, component_id)
+);
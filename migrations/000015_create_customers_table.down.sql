@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS set_customers_timestamp ON customers;
+DROP INDEX IF EXISTS idx_customers_name;
+DROP INDEX IF EXISTS idx_customers_email;
+DROP TABLE IF EXISTS customers;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS customers (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL,
+    email VARCHAR(255),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Email addresses identify customers, ignoring case. Customers without one are allowed.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_customers_email ON customers (lower(email)) WHERE email IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_customers_name ON customers (lower(name), id);
+
+CREATE TRIGGER set_customers_timestamp
+BEFORE UPDATE ON customers
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS purchase_order_lines;
+DROP TRIGGER IF EXISTS set_purchase_orders_timestamp ON purchase_orders;
+DROP TABLE IF EXISTS purchase_orders;
+DROP TRIGGER IF EXISTS set_suppliers_timestamp ON suppliers;
+DROP INDEX IF EXISTS idx_suppliers_name;
+DROP TABLE IF EXISTS suppliers;
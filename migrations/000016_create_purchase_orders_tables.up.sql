@@ -0,0 +1,43 @@
+CREATE TABLE IF NOT EXISTS suppliers (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL,
+    email VARCHAR(255),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_suppliers_name ON suppliers (lower(name));
+
+CREATE TRIGGER set_suppliers_timestamp
+BEFORE UPDATE ON suppliers
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+CREATE TABLE IF NOT EXISTS purchase_orders (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    supplier_id UUID NOT NULL REFERENCES suppliers (id) ON DELETE RESTRICT,
+    status VARCHAR(20) NOT NULL DEFAULT 'draft', -- 'draft', 'submitted', 'partially_received', 'received' or 'cancelled'
+    created_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_purchase_orders_created ON purchase_orders (created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_purchase_orders_supplier ON purchase_orders (supplier_id);
+
+CREATE TRIGGER set_purchase_orders_timestamp
+BEFORE UPDATE ON purchase_orders
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+CREATE TABLE IF NOT EXISTS purchase_order_lines (
+    order_id UUID NOT NULL REFERENCES purchase_orders (id) ON DELETE CASCADE,
+    item_id UUID NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    quantity_ordered INTEGER NOT NULL CHECK (quantity_ordered > 0),
+    quantity_received INTEGER NOT NULL DEFAULT 0 CHECK (quantity_received >= 0),
+    unit_cost NUMERIC(10, 2) NOT NULL CHECK (unit_cost >= 0),
+    PRIMARY KEY (order_id, item_id),
+    CHECK (quantity_received <= quantity_ordered)
+);
+
+CREATE INDEX IF NOT EXISTS idx_purchase_order_lines_item ON purchase_order_lines (item_id);
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS set_item_tier_prices_timestamp ON item_tier_prices;
+DROP TABLE IF EXISTS item_tier_prices;
+ALTER TABLE customers DROP COLUMN IF EXISTS price_tier;
@@ -0,0 +1,19 @@
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS price_tier VARCHAR(20) NOT NULL DEFAULT 'retail'
+    CHECK (price_tier IN ('retail', 'wholesale', 'contract'));
+
+-- Price rules per item and tier: either a fixed price or a discount off the item's price.
+-- Tiers without a rule pay the item's price.
+CREATE TABLE IF NOT EXISTS item_tier_prices (
+    item_id UUID NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    tier VARCHAR(20) NOT NULL CHECK (tier IN ('retail', 'wholesale', 'contract')),
+    price NUMERIC(10, 2) CHECK (price > 0),
+    discount_percent NUMERIC(5, 2) CHECK (discount_percent > 0 AND discount_percent <= 100),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (item_id, tier),
+    CHECK ((price IS NULL) <> (discount_percent IS NULL))
+);
+
+CREATE TRIGGER set_item_tier_prices_timestamp
+BEFORE UPDATE ON item_tier_prices
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
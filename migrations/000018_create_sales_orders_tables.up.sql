@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS sales_orders (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    customer_id UUID REFERENCES customers (id) ON DELETE RESTRICT,
+    status VARCHAR(20) NOT NULL DEFAULT 'open', -- 'open', 'fulfilled' or 'cancelled'
+    created_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    closed_by VARCHAR(255),
+    closed_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_sales_orders_created ON sales_orders (created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_sales_orders_customer ON sales_orders (customer_id, created_at DESC);
+
+-- Lines of open orders reserve stock: an item's available quantity is its quantity minus
+-- the quantities on open lines.
+CREATE TABLE IF NOT EXISTS sales_order_lines (
+    order_id UUID NOT NULL REFERENCES sales_orders (id) ON DELETE CASCADE,
+    item_id UUID NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    unit_price NUMERIC(10, 2) NOT NULL CHECK (unit_price >= 0), -- Resolved from the customer's price tier when ordered
+    PRIMARY KEY (order_id, item_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_sales_order_lines_item ON sales_order_lines (item_id);
@@ -0,0 +1,3 @@
+ALTER TABLE items DROP COLUMN IF EXISTS tax_code;
+DROP TRIGGER IF EXISTS set_tax_rates_timestamp ON tax_rates;
+DROP TABLE IF EXISTS tax_rates;
@@ -0,0 +1,16 @@
+-- Tax rates by tax code and jurisdiction (e.g. 'standard' in 'DE', 'reduced' in 'US-CA').
+CREATE TABLE IF NOT EXISTS tax_rates (
+    tax_code VARCHAR(32) NOT NULL,
+    jurisdiction VARCHAR(32) NOT NULL,
+    rate_percent NUMERIC(6, 3) NOT NULL CHECK (rate_percent >= 0 AND rate_percent <= 100),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tax_code, jurisdiction)
+);
+
+CREATE TRIGGER set_tax_rates_timestamp
+BEFORE UPDATE ON tax_rates
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+-- Items without a tax code are taxed under 'standard'.
+ALTER TABLE items ADD COLUMN IF NOT EXISTS tax_code VARCHAR(32);
@@ -0,0 +1,6 @@
+ALTER TABLE assembly_orders DROP COLUMN IF EXISTS number;
+ALTER TABLE sales_orders DROP COLUMN IF EXISTS number;
+ALTER TABLE purchase_orders DROP COLUMN IF EXISTS number;
+DROP TABLE IF EXISTS document_counters;
+DROP TRIGGER IF EXISTS set_document_sequences_timestamp ON document_sequences;
+DROP TABLE IF EXISTS document_sequences;
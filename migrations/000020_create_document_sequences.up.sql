@@ -0,0 +1,71 @@
+-- Numbering of user-facing documents (e.g. PO-2024-0001). IDs stay the primary keys.
+CREATE TABLE IF NOT EXISTS document_sequences (
+    doc_type VARCHAR(30) PRIMARY KEY, -- 'purchase_order', 'sales_order' or 'assembly_order'
+    prefix VARCHAR(20) NOT NULL,
+    yearly BOOLEAN NOT NULL DEFAULT FALSE, -- Numbers carry the year and restart from 1 every year
+    padding INTEGER NOT NULL DEFAULT 4 CHECK (padding BETWEEN 1 AND 12), -- Minimum digits of the counter
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER set_document_sequences_timestamp
+BEFORE UPDATE ON document_sequences
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+-- Last number handed out per sequence, prefix and year (0 for sequences that are not yearly).
+-- Keying by prefix keeps numbers unique when a prefix is changed and changed back.
+CREATE TABLE IF NOT EXISTS document_counters (
+    doc_type VARCHAR(30) NOT NULL REFERENCES document_sequences (doc_type) ON DELETE CASCADE,
+    prefix VARCHAR(20) NOT NULL,
+    period INTEGER NOT NULL,
+    last_value BIGINT NOT NULL,
+    PRIMARY KEY (doc_type, prefix, period)
+);
+
+INSERT INTO document_sequences (doc_type, prefix, yearly) VALUES
+    ('purchase_order', 'PO', TRUE),
+    ('sales_order', 'SO', TRUE),
+    ('assembly_order', 'AO', FALSE)
+ON CONFLICT (doc_type) DO NOTHING;
+
+-- Existing documents are numbered in creation order, as if the sequences had always existed.
+ALTER TABLE purchase_orders ADD COLUMN IF NOT EXISTS number VARCHAR(60);
+WITH numbered AS (
+    SELECT id, EXTRACT(YEAR FROM created_at)::INTEGER AS period,
+           ROW_NUMBER() OVER (PARTITION BY EXTRACT(YEAR FROM created_at) ORDER BY created_at, id) AS n
+    FROM purchase_orders
+)
+UPDATE purchase_orders o
+SET number = 'PO-' || numbered.period || '-' || LPAD(numbered.n::TEXT, GREATEST(4, LENGTH(numbered.n::TEXT)), '0')
+FROM numbered
+WHERE o.id = numbered.id;
+INSERT INTO document_counters (doc_type, prefix, period, last_value)
+SELECT 'purchase_order', 'PO', EXTRACT(YEAR FROM created_at)::INTEGER, COUNT(*) FROM purchase_orders GROUP BY 3;
+ALTER TABLE purchase_orders ALTER COLUMN number SET NOT NULL, ADD CONSTRAINT purchase_orders_number_key UNIQUE (number);
+
+ALTER TABLE sales_orders ADD COLUMN IF NOT EXISTS number VARCHAR(60);
+WITH numbered AS (
+    SELECT id, EXTRACT(YEAR FROM created_at)::INTEGER AS period,
+           ROW_NUMBER() OVER (PARTITION BY EXTRACT(YEAR FROM created_at) ORDER BY created_at, id) AS n
+    FROM sales_orders
+)
+UPDATE sales_orders o
+SET number = 'SO-' || numbered.period || '-' || LPAD(numbered.n::TEXT, GREATEST(4, LENGTH(numbered.n::TEXT)), '0')
+FROM numbered
+WHERE o.id = numbered.id;
+INSERT INTO document_counters (doc_type, prefix, period, last_value)
+SELECT 'sales_order', 'SO', EXTRACT(YEAR FROM created_at)::INTEGER, COUNT(*) FROM sales_orders GROUP BY 3;
+ALTER TABLE sales_orders ALTER COLUMN number SET NOT NULL, ADD CONSTRAINT sales_orders_number_key UNIQUE (number);
+
+ALTER TABLE assembly_orders ADD COLUMN IF NOT EXISTS number VARCHAR(60);
+WITH numbered AS (
+    SELECT id, ROW_NUMBER() OVER (ORDER BY created_at, id) AS n
+    FROM assembly_orders
+)
+UPDATE assembly_orders o
+SET number = 'AO-' || LPAD(numbered.n::TEXT, GREATEST(4, LENGTH(numbered.n::TEXT)), '0')
+FROM numbered
+WHERE o.id = numbered.id;
+INSERT INTO document_counters (doc_type, prefix, period, last_value)
+SELECT 'assembly_order', 'AO', 0, COUNT(*) FROM assembly_orders HAVING COUNT(*) > 0;
+ALTER TABLE assembly_orders ALTER COLUMN number SET NOT NULL, ADD CONSTRAINT assembly_orders_number_key UNIQUE (number);
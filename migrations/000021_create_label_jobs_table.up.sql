@@ -0,0 +1,16 @@
+-- Label print queue: jobs wait for the agent of their station to claim and print them.
+CREATE TABLE IF NOT EXISTS label_jobs (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    station VARCHAR(50) NOT NULL,
+    item_id UUID NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    copies INTEGER NOT NULL CHECK (copies > 0),
+    reference VARCHAR(100), -- E.g. the purchase order the labels are printed for
+    status VARCHAR(20) NOT NULL DEFAULT 'queued', -- 'queued', 'printing', 'printed' or 'failed'
+    error TEXT, -- Reported by the agent when printing failed
+    created_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    claimed_at TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_label_jobs_station_status ON label_jobs (station, status, created_at);
@@ -0,0 +1,11 @@
+-- Full-text search over items (GET /items?search=...). SKUs go through the 'simple'
+-- configuration so their parts are matched as typed; names and descriptions are stemmed
+-- as English. The weights rank SKU matches above name matches above description matches.
+ALTER TABLE items ADD COLUMN IF NOT EXISTS search_vector TSVECTOR
+    GENERATED ALWAYS AS (
+        setweight(to_tsvector('simple', sku), 'A') ||
+        setweight(to_tsvector('english', name), 'B') ||
+        setweight(to_tsvector('english', COALESCE(description, '')), 'C')
+    ) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_items_search_vector ON items USING GIN (search_vector);
@@ -0,0 +1,5 @@
+-- Deleting an item moves it to the trash: it is hidden everywhere but keeps its SKU, stock
+-- ledger and order lines until it is restored or purged.
+ALTER TABLE items ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_items_deleted_at ON items (deleted_at DESC) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,18 @@
+-- Items whose quantity differs from the sum of their stock movements, found by the ledger
+-- check job. An item has at most one open discrepancy, which later checks update.
+CREATE TABLE IF NOT EXISTS ledger_discrepancies (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    item_id UUID NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL,
+    ledger_quantity INTEGER NOT NULL,
+    detected_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    checked_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMPTZ,
+    resolved_by VARCHAR(255),
+    resolution VARCHAR(20), -- 'quantity', 'ledger' or 'cleared'
+    movement_id UUID REFERENCES stock_movements (id) ON DELETE SET NULL,
+    note TEXT NOT NULL DEFAULT ''
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_ledger_discrepancies_open ON ledger_discrepancies (item_id) WHERE resolved_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_ledger_discrepancies_detected ON ledger_discrepancies (detected_at DESC);
@@ -0,0 +1,12 @@
+-- Changes made to items, with the fields each one changed, for the
+-- item history timeline.
+CREATE TABLE IF NOT EXISTS item_revisions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    item_id UUID NOT NULL REFERENCES items (id) ON DELETE CASCADE,
+    action VARCHAR(20) NOT NULL, -- 'created', 'updated', 'deleted' or 'restored'
+    changes JSONB NOT NULL DEFAULT '[]',
+    changed_by VARCHAR(255),
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_item_revisions_item ON item_revisions (item_id, changed_at);
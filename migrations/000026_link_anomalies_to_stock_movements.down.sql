@@ -0,0 +1,18 @@
+DROP INDEX IF EXISTS idx_stock_movements_moved_at;
+CREATE INDEX IF NOT EXISTS idx_stock_adjustments_adjusted_at ON stock_adjustments (adjusted_at);
+
+ALTER TABLE anomalies ADD COLUMN IF NOT EXISTS adjustment_id UUID REFERENCES stock_adjustments (id) ON DELETE CASCADE;
+
+UPDATE anomalies a
+SET adjustment_id = s.id,
+    dedupe_key = a.kind || ':' || s.id
+FROM stock_movements m
+JOIN stock_adjustments s
+    ON m.reference = s.batch_id::text AND m.item_id = s.item_id
+    AND m.delta = s.delta AND m.quantity_after = s.quantity_after
+WHERE a.movement_id = m.id;
+
+-- Anomalies about movements made outside adjustment batches cannot be expressed without the ledger.
+DELETE FROM anomalies WHERE movement_id IS NOT NULL AND adjustment_id IS NULL;
+
+ALTER TABLE anomalies DROP COLUMN IF EXISTS movement_id;
@@ -0,0 +1,18 @@
+-- Anomaly detection reads the movement ledger, which every quantity change writes, instead of
+-- the adjustment batches alone. Anomalies already flagged point at the movement their batch
+-- line recorded.
+ALTER TABLE anomalies ADD COLUMN IF NOT EXISTS movement_id UUID REFERENCES stock_movements (id) ON DELETE CASCADE;
+
+UPDATE anomalies a
+SET movement_id = m.id,
+    dedupe_key = a.kind || ':' || m.id
+FROM stock_adjustments s
+JOIN stock_movements m
+    ON m.reference = s.batch_id::text AND m.item_id = s.item_id
+    AND m.delta = s.delta AND m.quantity_after = s.quantity_after
+WHERE a.adjustment_id = s.id;
+
+ALTER TABLE anomalies DROP COLUMN IF EXISTS adjustment_id;
+
+DROP INDEX IF EXISTS idx_stock_adjustments_adjusted_at;
+CREATE INDEX IF NOT EXISTS idx_stock_movements_moved_at ON stock_movements (moved_at);
@@ -0,0 +1,163 @@
+// Package fieldcrypt encrypts individual sensitive values (secrets, contract terms, ...)
+// before they are stored, using AES-256-GCM.
+//
+// Ciphertexts are self-describing strings of the form
+//
+//	enc:v1:<key id>:<base64(nonce || sealed data)>
+//
+// so a Keyring can hold several keys at once: new values are always sealed with the
+// active key, while values sealed with older keys still open. Rotating a key means
+// adding a new one, making it active, and re-encrypting stored values for which
+// NeedsRotation reports true (Rotate does both steps for one value).
+package fieldcrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const prefix = "enc:v1:"
+
+var (
+	ErrUnknownKey       = errors.New("fieldcrypt: value was encrypted with an unknown key")
+	ErrMalformed        = errors.New("fieldcrypt: value is not a fieldcrypt ciphertext")
+	ErrDecryptionFailed = errors.New("fieldcrypt: decryption failed (wrong key or tampered value)")
+)
+
+// Keyring holds the encryption keys. It is safe for concurrent use once built.
+type Keyring struct {
+	activeID string
+	aeads    map[string]cipher.AEAD
+}
+
+// NewKeyring builds a keyring from raw 32-byte keys indexed by key ID.
+// activeID selects the key used for new encryptions.
+func NewKeyring(keys map[string][]byte, activeID string) (*Keyring, error) {
+	kr := &Keyring{activeID: activeID, aeads: make(map[string]cipher.AEAD, len(keys))}
+	for id, key := range keys {
+		if id == "" || strings.Contains(id, ":") {
+			return nil, fmt.Errorf("fieldcrypt: invalid key id %q", id)
+		}
+		if len(key) != 32 {
+			return nil, fmt.Errorf("fieldcrypt: key %q must be 32 bytes (AES-256), got %d", id, len(key))
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("fieldcrypt: key %q: %w", id, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("fieldcrypt: key %q: %w", id, err)
+		}
+		kr.aeads[id] = aead
+	}
+	if _, ok := kr.aeads[activeID]; !ok {
+		return nil, fmt.Errorf("fieldcrypt: active key %q is not in the keyring", activeID)
+	}
+	return kr, nil
+}
+
+// ParseKeyring builds a keyring from a spec such as "2025-01:<base64 key>,2024-06:<base64 key>",
+// as found in an environment variable or fetched from a KMS.
+func ParseKeyring(spec, activeID string) (*Keyring, error) {
+	keys := make(map[string][]byte)
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		id, encoded, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, fmt.Errorf("fieldcrypt: keyring entry %q must be <id>:<base64 key>", id)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("fieldcrypt: key %q is not valid base64: %w", id, err)
+		}
+		keys[id] = key
+	}
+	return NewKeyring(keys, activeID)
+}
+
+// Encrypt seals plaintext with the active key. The key ID is bound to the ciphertext as
+// additional data, so a value cannot be re-labelled to be opened with another key.
+func (k *Keyring) Encrypt(plaintext string) (string, error) {
+	aead := k.aeads[k.activeID]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("fieldcrypt: generate nonce: %w", err)
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), []byte(k.activeID))
+	return prefix + k.activeID + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt opens a value produced by Encrypt with any key of the keyring.
+func (k *Keyring) Decrypt(ciphertext string) (string, error) {
+	id, sealed, err := parse(ciphertext)
+	if err != nil {
+		return "", err
+	}
+	aead, ok := k.aeads[id]
+	if !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownKey, id)
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", ErrMalformed
+	}
+	nonce, data := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, data, []byte(id))
+	if err != nil {
+		return "", ErrDecryptionFailed
+	}
+	return string(plaintext), nil
+}
+
+// NeedsRotation reports whether ciphertext was sealed with a key other than the active one.
+func (k *Keyring) NeedsRotation(ciphertext string) bool {
+	id, _, err := parse(ciphertext)
+	return err == nil && id != k.activeID
+}
+
+// Rotate re-encrypts ciphertext with the active key. Values already sealed with it are
+// returned unchanged, so Rotate can be applied to every stored value in a background job.
+func (k *Keyring) Rotate(ciphertext string) (string, error) {
+	if !k.NeedsRotation(ciphertext) {
+		if _, _, err := parse(ciphertext); err != nil {
+			return "", err
+		}
+		return ciphertext, nil
+	}
+	plaintext, err := k.Decrypt(ciphertext)
+	if err != nil {
+		return "", err
+	}
+	return k.Encrypt(plaintext)
+}
+
+// IsEncrypted reports whether value looks like a fieldcrypt ciphertext. It helps
+// migrate columns that still hold plaintext.
+func IsEncrypted(value string) bool {
+	_, _, err := parse(value)
+	return err == nil
+}
+
+func parse(ciphertext string) (id string, sealed []byte, err error) {
+	rest, ok := strings.CutPrefix(ciphertext, prefix)
+	if !ok {
+		return "", nil, ErrMalformed
+	}
+	id, encoded, ok := strings.Cut(rest, ":")
+	if !ok || id == "" {
+		return "", nil, ErrMalformed
+	}
+	sealed, err = base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", nil, ErrMalformed
+	}
+	return id, sealed, nil
+}
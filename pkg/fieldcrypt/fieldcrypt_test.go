@@ -0,0 +1,110 @@
+package fieldcrypt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func key(b byte) []byte { return bytes.Repeat([]byte{b}, 32) }
+
+func TestEncryptDecryptRoundTrip(t *testing.T) {
+	kr, err := NewKeyring(map[string][]byte{"k1": key(1)}, "k1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ct, err := kr.Encrypt("whsec_topsecret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(ct, "enc:v1:k1:") || strings.Contains(ct, "topsecret") {
+		t.Fatalf("unexpected ciphertext %q", ct)
+	}
+	if again, _ := kr.Encrypt("whsec_topsecret"); again == ct {
+		t.Error("two encryptions of the same value must differ (random nonce)")
+	}
+	pt, err := kr.Decrypt(ct)
+	if err != nil || pt != "whsec_topsecret" {
+		t.Fatalf("Decrypt = %q, %v", pt, err)
+	}
+}
+
+func TestRotation(t *testing.T) {
+	old, _ := NewKeyring(map[string][]byte{"k1": key(1)}, "k1")
+	ct, _ := old.Encrypt("contract terms")
+
+	rotated, err := NewKeyring(map[string][]byte{"k1": key(1), "k2": key(2)}, "k2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rotated.NeedsRotation(ct) {
+		t.Fatal("value sealed with k1 should need rotation")
+	}
+	newCT, err := rotated.Rotate(ct)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rotated.NeedsRotation(newCT) || !strings.HasPrefix(newCT, "enc:v1:k2:") {
+		t.Fatalf("rotated value %q still uses the old key", newCT)
+	}
+	if same, _ := rotated.Rotate(newCT); same != newCT {
+		t.Error("rotating a current value should be a no-op")
+	}
+	if pt, _ := rotated.Decrypt(newCT); pt != "contract terms" {
+		t.Errorf("rotated value decrypts to %q", pt)
+	}
+	if _, err := old.Decrypt(newCT); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("old keyring opening a k2 value: %v, want ErrUnknownKey", err)
+	}
+}
+
+func TestTamperingIsDetected(t *testing.T) {
+	kr, _ := NewKeyring(map[string][]byte{"k1": key(1), "k2": key(2)}, "k1")
+	ct, _ := kr.Encrypt("secret")
+
+	// Re-label the ciphertext as sealed by k2: the key ID is authenticated.
+	relabelled := strings.Replace(ct, ":k1:", ":k2:", 1)
+	if _, err := kr.Decrypt(relabelled); !errors.Is(err, ErrDecryptionFailed) {
+		t.Errorf("relabelled value: %v, want ErrDecryptionFailed", err)
+	}
+
+	raw, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(ct, "enc:v1:k1:"))
+	raw[len(raw)-1] ^= 0xff
+	flipped := "enc:v1:k1:" + base64.StdEncoding.EncodeToString(raw)
+	if _, err := kr.Decrypt(flipped); !errors.Is(err, ErrDecryptionFailed) {
+		t.Errorf("flipped value: %v, want ErrDecryptionFailed", err)
+	}
+
+	for _, bad := range []string{"plaintext", "enc:v1:", "enc:v1:k1:!!!", "enc:v1:k1:AA=="} {
+		if _, err := kr.Decrypt(bad); err == nil {
+			t.Errorf("Decrypt(%q) succeeded", bad)
+		}
+	}
+	if IsEncrypted("plaintext") || !IsEncrypted(ct) {
+		t.Error("IsEncrypted misclassifies values")
+	}
+}
+
+func TestParseKeyring(t *testing.T) {
+	spec := "2025-01:" + base64.StdEncoding.EncodeToString(key(1)) + ", 2024-06:" + base64.StdEncoding.EncodeToString(key(2))
+	kr, err := ParseKeyring(spec, "2025-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kr.aeads) != 2 {
+		t.Errorf("parsed %d keys, want 2", len(kr.aeads))
+	}
+
+	for _, tc := range []struct{ spec, active string }{
+		{"k1:" + base64.StdEncoding.EncodeToString(key(1)), "missing"},     // Active key absent
+		{"k1:" + base64.StdEncoding.EncodeToString([]byte("short")), "k1"}, // Wrong key size
+		{"k1:not base64", "k1"},
+		{"nokey", "nokey"},
+	} {
+		if _, err := ParseKeyring(tc.spec, tc.active); err == nil {
+			t.Errorf("ParseKeyring(%q, %q) succeeded", tc.spec, tc.active)
+		}
+	}
+}
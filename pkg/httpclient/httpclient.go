@@ -0,0 +1,261 @@
+// Package httpclient provides the HTTP client for every outbound call the service makes
+// (webhooks, chat notifications, identity providers, connectors).
+//
+// Compared with http.DefaultClient it always has a timeout, retries transient failures
+// with exponential backoff, stops calling a destination that keeps failing (circuit
+// breaker), and records per-host metrics, published through expvar under "httpclient".
+// Requests made with a context from requestid carry the ID in the X-Request-ID header.
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"inventory-system/pkg/requestid"
+)
+
+// ErrCircuitOpen is returned without contacting the destination while its breaker is open.
+var ErrCircuitOpen = errors.New("httpclient: circuit open")
+
+// Config tunes a client. Zero values select the defaults noted on each field.
+type Config struct {
+	Timeout          time.Duration     // Per attempt, including reading the body; default 10s
+	MaxRetries       int               // Retries after the first attempt; default 2, negative disables
+	BaseBackoff      time.Duration     // Delay before the first retry, doubled each time; default 200ms
+	MaxBackoff       time.Duration     // Upper bound for a single delay; default 5s
+	BreakerThreshold int               // Consecutive failures that open a host's breaker; default 5
+	BreakerCooldown  time.Duration     // How long the breaker stays open; default 30s
+	Transport        http.RoundTripper // Default http.DefaultTransport
+}
+
+// New returns a client configured by cfg.
+func New(cfg Config) *http.Client {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 10 * time.Second
+	}
+	if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = 2
+	} else if cfg.MaxRetries < 0 {
+		cfg.MaxRetries = 0
+	}
+	if cfg.BaseBackoff <= 0 {
+		cfg.BaseBackoff = 200 * time.Millisecond
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = 5 * time.Second
+	}
+	if cfg.BreakerThreshold <= 0 {
+		cfg.BreakerThreshold = 5
+	}
+	if cfg.BreakerCooldown <= 0 {
+		cfg.BreakerCooldown = 30 * time.Second
+	}
+	if cfg.Transport == nil {
+		cfg.Transport = http.DefaultTransport
+	}
+	return &http.Client{
+		// The client-level timeout spans all attempts and backoff delays.
+		Timeout:   time.Duration(cfg.MaxRetries+1)*cfg.Timeout + time.Duration(cfg.MaxRetries)*cfg.MaxBackoff,
+		Transport: &transport{cfg: cfg, breakers: make(map[string]*breaker)},
+	}
+}
+
+type transport struct {
+	cfg      Config
+	mu       sync.Mutex
+	breakers map[string]*breaker
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Let the receiver correlate the call with the request that triggered it.
+	if id := requestid.FromContext(req.Context()); id != "" && req.Header.Get(requestid.Header) == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set(requestid.Header, id)
+	}
+
+	host := req.URL.Host
+	m := metricsFor(host)
+	b := t.breaker(host)
+
+	if !b.allow(time.Now()) {
+		m.Add("circuit_rejections", 1)
+		return nil, fmt.Errorf("%w for %s", ErrCircuitOpen, host)
+	}
+
+	// Only replay requests whose body can be rewound and which are safe to repeat.
+	retries := t.cfg.MaxRetries
+	if !replayable(req) {
+		retries = 0
+	}
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			m.Add("retries", 1)
+			if req.Body != nil && req.Body != http.NoBody {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				req = req.Clone(req.Context())
+				req.Body = body
+			}
+		}
+
+		start := time.Now()
+		resp, err := t.attempt(req)
+		m.Add("requests", 1)
+		m.Add("latency_ms_total", time.Since(start).Milliseconds())
+
+		failed := err != nil || resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+		if failed {
+			m.Add("failures", 1)
+			if b.failure(time.Now(), t.cfg.BreakerThreshold, t.cfg.BreakerCooldown) {
+				m.Add("circuit_opened", 1)
+			}
+		} else {
+			b.success()
+		}
+		if !failed || attempt >= retries || !retryable(resp, err) || !b.allow(time.Now()) {
+			return resp, err
+		}
+
+		delay := t.backoff(attempt, resp)
+		if resp != nil {
+			// Drain so the connection can be reused.
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+			resp.Body.Close()
+		}
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// attempt performs one round trip bounded by the per-attempt timeout. The timeout stays
+// armed until the caller closes the response body.
+func (t *transport) attempt(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.cfg.Timeout)
+	resp, err := t.cfg.Transport.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func (t *transport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if secs, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && secs >= 0 {
+			return min(time.Duration(secs)*time.Second, t.cfg.MaxBackoff)
+		}
+	}
+	d := min(t.cfg.BaseBackoff<<attempt, t.cfg.MaxBackoff)
+	return d/2 + rand.N(d/2+1) // Jitter so callers retrying together spread out
+}
+
+func (t *transport) breaker(host string) *breaker {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	b, ok := t.breakers[host]
+	if !ok {
+		b = &breaker{}
+		t.breakers[host] = b
+	}
+	return b
+}
+
+func replayable(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	// Non-idempotent requests opt in with an Idempotency-Key the receiver deduplicates on.
+	return req.Header.Get("Idempotency-Key") != ""
+}
+
+func retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// breaker opens after a run of consecutive failures. Once the cooldown has passed,
+// requests flow again, but a single further failure reopens it.
+type breaker struct {
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+}
+
+func (b *breaker) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return !now.Before(b.openUntil)
+}
+
+func (b *breaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+	b.openUntil = time.Time{}
+}
+
+// failure records a failed attempt and reports whether it opened the breaker.
+func (b *breaker) failure(now time.Time, threshold int, cooldown time.Duration) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	if b.failures < threshold {
+		return false
+	}
+	b.failures = threshold - 1
+	b.openUntil = now.Add(cooldown)
+	return true
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
+
+var (
+	metrics   = expvar.NewMap("httpclient")
+	metricsMu sync.Mutex
+)
+
+// metricsFor returns the counters for host, creating them on first use.
+func metricsFor(host string) *expvar.Map {
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+	if v, ok := metrics.Get(host).(*expvar.Map); ok {
+		return v
+	}
+	m := new(expvar.Map).Init()
+	metrics.Set(host, m)
+	return m
+}
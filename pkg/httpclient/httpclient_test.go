@@ -0,0 +1,137 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"inventory-system/pkg/requestid"
+)
+
+func TestRetriesTransientFailures(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c := New(Config{MaxRetries: 2, BaseBackoff: time.Millisecond})
+	resp, err := c.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || calls.Load() != 3 {
+		t.Errorf("status %d after %d calls, want 200 after 3", resp.StatusCode, calls.Load())
+	}
+
+	host := strings.TrimPrefix(srv.URL, "http://")
+	m := metrics.Get(host).(*expvar.Map)
+	if got := m.Get("retries").String(); got != "2" {
+		t.Errorf("retries metric = %s, want 2", got)
+	}
+}
+
+func TestDoesNotRetryUnsafeRequests(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	c := New(Config{MaxRetries: 3, BaseBackoff: time.Millisecond})
+	resp, err := c.Post(srv.URL, "application/json", strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if calls.Load() != 1 {
+		t.Errorf("POST without Idempotency-Key sent %d times, want 1", calls.Load())
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, srv.URL, strings.NewReader(`{}`))
+	req.Header.Set("Idempotency-Key", "abc")
+	resp, err = c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if calls.Load() != 1+4 {
+		t.Errorf("POST with Idempotency-Key sent %d times, want 4", calls.Load()-1)
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	c := New(Config{MaxRetries: -1, BreakerThreshold: 2, BreakerCooldown: time.Hour})
+	for range 2 {
+		resp, err := c.Get(srv.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	_, err := c.Get(srv.URL)
+	var uerr *url.Error
+	if !errors.As(err, &uerr) || !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("third call err = %v, want ErrCircuitOpen", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("server called %d times, want 2", calls.Load())
+	}
+}
+
+func TestPerAttemptTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer srv.Close()
+
+	c := New(Config{Timeout: 20 * time.Millisecond, MaxRetries: 1, BaseBackoff: time.Millisecond})
+	start := time.Now()
+	if _, err := c.Get(srv.URL); err == nil {
+		t.Fatal("expected a timeout error")
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("request took %v, want it bounded by the per-attempt timeout", elapsed)
+	}
+}
+
+func TestPropagatesRequestID(t *testing.T) {
+	var got string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get(requestid.Header)
+	}))
+	defer srv.Close()
+
+	req, _ := http.NewRequestWithContext(requestid.NewContext(context.Background(), "req-42"), http.MethodGet, srv.URL, nil)
+	resp, err := New(Config{}).Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if got != "req-42" {
+		t.Errorf("%s = %q, want req-42", requestid.Header, got)
+	}
+}
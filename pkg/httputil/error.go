@@ -95,3 +95,7 @@ func InternalServerError(message string) *HTTPError {
 func ConflictError(message string) *HTTPError {
     return NewHTTPErrorWithCode(http.StatusConflict, "CONFLICT", message)
 }
+
+func PreconditionRequiredError(message string) *HTTPError {
+	return NewHTTPErrorWithCode(http.StatusPreconditionRequired, "PRECONDITION_REQUIRED", message)
+}
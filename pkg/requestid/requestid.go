@@ -0,0 +1,21 @@
+// Package requestid carries the ID of the inbound request that caused some work through
+// context.Context, so logs, outbound calls and real-time events can be tied back to it.
+package requestid
+
+import "context"
+
+// Header is the HTTP header carrying the request ID, inbound and outbound.
+const Header = "X-Request-ID"
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx carrying id.
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the request ID carried by ctx, or "" if there is none.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
@@ -0,0 +1,265 @@
+// Package webhookutil verifies inbound webhooks signed with an HMAC of the request body,
+// as sent by Shopify, Stripe and most other integration providers.
+//
+// A Verifier checks the signature, rejects deliveries whose signed timestamp is outside
+// the tolerance window, and rejects replays of deliveries it has already accepted. Use it
+// directly or as Echo middleware:
+//
+//	shopify := webhookutil.NewVerifier(webhookutil.Shopify(secret))
+//	e.POST("/webhooks/shopify", handleShopify, shopify.Middleware())
+package webhookutil
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"inventory-system/pkg/httputil"
+
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	ErrMissingSignature = errors.New("webhook: missing signature")
+	ErrInvalidSignature = errors.New("webhook: signature does not match")
+	ErrStaleTimestamp   = errors.New("webhook: timestamp outside the tolerance window")
+	ErrReplayed         = errors.New("webhook: delivery already processed")
+)
+
+// Config describes how a provider signs its webhooks.
+type Config struct {
+	Secrets         [][]byte // Any of them may have signed the request (allows secret rotation)
+	SignatureHeader string
+	TimestampHeader string // Header carrying the signed Unix timestamp; optional
+
+	// ParseHeader splits the signature header into a timestamp (may be "") and candidate
+	// signatures. The default treats the whole header as a single signature.
+	ParseHeader func(header string) (timestamp string, signatures []string)
+	// SignedPayload builds the bytes that were signed. The default is the body, prefixed
+	// with "<timestamp>." when the delivery carries a timestamp.
+	SignedPayload func(timestamp string, body []byte) []byte
+	Decode        func(string) ([]byte, error) // Signature encoding; defaults to hex
+	Hash          func() hash.Hash             // Defaults to SHA-256
+
+	Tolerance time.Duration // Maximum age of a timestamped delivery; defaults to 5 minutes
+	// DeliveryIDHeader, if set, names a unique delivery ID used for replay detection on top
+	// of the signature. The ID is not signed, so it can only add replays, never hide one.
+	DeliveryIDHeader string
+	MaxBodyBytes     int64 // Defaults to 1 MiB
+}
+
+// Shopify verifies X-Shopify-Hmac-Sha256 (base64 HMAC-SHA256 of the raw body).
+// Shopify does not sign a timestamp, so signatures are remembered for a day, and retries of
+// a delivery are also recognised by X-Shopify-Webhook-Id.
+func Shopify(secret []byte) Config {
+	return Config{
+		Secrets:          [][]byte{secret},
+		SignatureHeader:  "X-Shopify-Hmac-Sha256",
+		Decode:           base64.StdEncoding.DecodeString,
+		DeliveryIDHeader: "X-Shopify-Webhook-Id",
+	}
+}
+
+// Stripe verifies Stripe-Signature ("t=<unix>,v1=<hex>[,v1=<hex>...]") over "<t>.<body>".
+func Stripe(secret []byte) Config {
+	return Config{
+		Secrets:         [][]byte{secret},
+		SignatureHeader: "Stripe-Signature",
+		ParseHeader: func(header string) (string, []string) {
+			var ts string
+			var sigs []string
+			for _, part := range strings.Split(header, ",") {
+				k, v, _ := strings.Cut(strings.TrimSpace(part), "=")
+				switch k {
+				case "t":
+					ts = v
+				case "v1":
+					sigs = append(sigs, v)
+				}
+			}
+			return ts, sigs
+		},
+	}
+}
+
+// Verifier checks webhook deliveries against a Config. It is safe for concurrent use.
+type Verifier struct {
+	cfg  Config
+	now  func() time.Time
+	seen *replayCache
+}
+
+// NewVerifier creates a Verifier, filling in defaults for unset Config fields.
+func NewVerifier(cfg Config) *Verifier {
+	if cfg.ParseHeader == nil {
+		cfg.ParseHeader = func(header string) (string, []string) { return "", []string{strings.TrimSpace(header)} }
+	}
+	if cfg.SignedPayload == nil {
+		cfg.SignedPayload = func(ts string, body []byte) []byte {
+			if ts == "" {
+				return body
+			}
+			return append([]byte(ts+"."), body...)
+		}
+	}
+	if cfg.Decode == nil {
+		cfg.Decode = hex.DecodeString
+	}
+	if cfg.Hash == nil {
+		cfg.Hash = sha256.New
+	}
+	if cfg.Tolerance <= 0 {
+		cfg.Tolerance = 5 * time.Minute
+	}
+	if cfg.MaxBodyBytes <= 0 {
+		cfg.MaxBodyBytes = 1 << 20
+	}
+	return &Verifier{cfg: cfg, now: time.Now, seen: newReplayCache()}
+}
+
+// Verify checks r and returns its body. The request body is consumed; Middleware restores it.
+// An accepted delivery is remembered at once, so any later copy of it is a replay.
+func (v *Verifier) Verify(r *http.Request) ([]byte, error) {
+	body, _, err := v.verify(r)
+	return body, err
+}
+
+// verify implements Verify, also returning the replay cache keys recorded for the delivery.
+func (v *Verifier) verify(r *http.Request) ([]byte, []string, error) {
+	header := r.Header.Get(v.cfg.SignatureHeader)
+	if header == "" {
+		return nil, nil, ErrMissingSignature
+	}
+	ts, sigs := v.cfg.ParseHeader(header)
+	if ts == "" && v.cfg.TimestampHeader != "" {
+		ts = r.Header.Get(v.cfg.TimestampHeader)
+	}
+	if len(sigs) == 0 {
+		return nil, nil, ErrMissingSignature
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, v.cfg.MaxBodyBytes+1))
+	if err != nil {
+		return nil, nil, fmt.Errorf("webhook: read body: %w", err)
+	}
+	if int64(len(body)) > v.cfg.MaxBodyBytes {
+		return nil, nil, fmt.Errorf("webhook: body exceeds %d bytes", v.cfg.MaxBodyBytes)
+	}
+
+	// Check freshness before the signature so stale deliveries are cheap to reject,
+	// but only trust the timestamp once the signature (which covers it) matches.
+	window := 24 * time.Hour // Providers without signed timestamps retry for about a day
+	if ts != "" {
+		unix, err := strconv.ParseInt(ts, 10, 64)
+		if err != nil {
+			return nil, nil, ErrStaleTimestamp
+		}
+		age := v.now().Sub(time.Unix(unix, 0))
+		if age > v.cfg.Tolerance || age < -v.cfg.Tolerance {
+			return nil, nil, ErrStaleTimestamp
+		}
+		window = 2 * v.cfg.Tolerance // Long enough to cover every acceptable timestamp
+	}
+
+	payload := v.cfg.SignedPayload(ts, body)
+	matched := ""
+	for _, secret := range v.cfg.Secrets {
+		mac := hmac.New(v.cfg.Hash, secret)
+		mac.Write(payload)
+		expected := mac.Sum(nil)
+		for _, s := range sigs {
+			got, err := v.cfg.Decode(s)
+			if err == nil && hmac.Equal(got, expected) {
+				matched = s
+			}
+		}
+	}
+	if matched == "" {
+		return nil, nil, ErrInvalidSignature
+	}
+
+	// The signature covers the body and timestamp, so it always identifies the delivery. The
+	// delivery ID is unsigned and only catches retries whose signature differs.
+	keys := []string{"signature:" + matched}
+	if v.cfg.DeliveryIDHeader != "" && r.Header.Get(v.cfg.DeliveryIDHeader) != "" {
+		keys = append(keys, "delivery:"+r.Header.Get(v.cfg.DeliveryIDHeader))
+	}
+	if v.seen.seenBefore(keys, v.now(), window) {
+		return nil, nil, ErrReplayed
+	}
+	return body, keys, nil
+}
+
+// Middleware rejects unverified deliveries with 401 (409 for replays) and passes the
+// verified request on with its body intact. A delivery the handler fails, with an error or
+// an error status, is forgotten again so that the provider's retry is processed.
+func (v *Verifier) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			body, keys, err := v.verify(c.Request())
+			if err != nil {
+				if errors.Is(err, ErrReplayed) {
+					return httputil.SendErrorResponse(c, httputil.ConflictError("Webhook delivery already processed."))
+				}
+				return httputil.SendErrorResponse(c, httputil.UnauthorizedError("Invalid webhook signature."))
+			}
+			c.Request().Body = io.NopCloser(bytes.NewReader(body))
+			if err := next(c); err != nil || c.Response().Status >= http.StatusBadRequest {
+				v.seen.forget(keys)
+				return err
+			}
+			return nil
+		}
+	}
+}
+
+// replayCache remembers the keys of accepted deliveries until their window expires.
+type replayCache struct {
+	mu      sync.Mutex
+	expires map[string]time.Time
+}
+
+func newReplayCache() *replayCache {
+	return &replayCache{expires: make(map[string]time.Time)}
+}
+
+// seenBefore reports whether any of keys is recorded and still live. If none is, it records
+// them all.
+func (r *replayCache) seenBefore(keys []string, now time.Time, window time.Duration) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for k, exp := range r.expires {
+		if now.After(exp) {
+			delete(r.expires, k)
+		}
+	}
+	for _, k := range keys {
+		if _, ok := r.expires[k]; ok {
+			return true
+		}
+	}
+	for _, k := range keys {
+		r.expires[k] = now.Add(window)
+	}
+	return false
+}
+
+// forget drops keys, so that the delivery they were recorded for is accepted again.
+func (r *replayCache) forget(keys []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, k := range keys {
+		delete(r.expires, k)
+	}
+}
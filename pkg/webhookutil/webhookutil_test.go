@@ -0,0 +1,172 @@
+package webhookutil
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+var secret = []byte("whsec_test")
+
+func sign(payload string, s []byte) []byte {
+	mac := hmac.New(sha256.New, s)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+func TestShopify(t *testing.T) {
+	v := NewVerifier(Shopify(secret))
+	body := `{"id":1}`
+
+	req := func(sig, id string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/webhooks/shopify", strings.NewReader(body))
+		r.Header.Set("X-Shopify-Hmac-Sha256", sig)
+		r.Header.Set("X-Shopify-Webhook-Id", id)
+		return r
+	}
+	good := base64.StdEncoding.EncodeToString(sign(body, secret))
+	bad := base64.StdEncoding.EncodeToString(sign(body, []byte("other")))
+
+	if got, err := v.Verify(req(good, "d1")); err != nil || string(got) != body {
+		t.Fatalf("Verify(valid) = %q, %v", got, err)
+	}
+	if _, err := v.Verify(req(good, "d1")); !errors.Is(err, ErrReplayed) {
+		t.Errorf("Verify(replay) = %v, want ErrReplayed", err)
+	}
+	// The delivery ID is unsigned: a new one does not make a replayed signature a new delivery.
+	if _, err := v.Verify(req(good, "d2")); !errors.Is(err, ErrReplayed) {
+		t.Errorf("Verify(replay with a new delivery ID) = %v, want ErrReplayed", err)
+	}
+	other := `{"id":2}`
+	r := httptest.NewRequest(http.MethodPost, "/webhooks/shopify", strings.NewReader(other))
+	r.Header.Set("X-Shopify-Hmac-Sha256", base64.StdEncoding.EncodeToString(sign(other, secret)))
+	r.Header.Set("X-Shopify-Webhook-Id", "d1")
+	if _, err := v.Verify(r); !errors.Is(err, ErrReplayed) {
+		t.Errorf("Verify(known delivery ID) = %v, want ErrReplayed", err)
+	}
+
+	// Without a signed timestamp, signatures are remembered for a day.
+	now := time.Now()
+	v.now = func() time.Time { return now.Add(23 * time.Hour) }
+	if _, err := v.Verify(req(good, "d5")); !errors.Is(err, ErrReplayed) {
+		t.Errorf("Verify(replay after 23h) = %v, want ErrReplayed", err)
+	}
+	v.now = func() time.Time { return now.Add(25 * time.Hour) }
+	if _, err := v.Verify(req(good, "d5")); err != nil {
+		t.Errorf("Verify(replay after 25h) = %v, want it accepted", err)
+	}
+	if _, err := v.Verify(req(bad, "d3")); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Verify(wrong secret) = %v, want ErrInvalidSignature", err)
+	}
+	if _, err := v.Verify(req("", "d4")); !errors.Is(err, ErrMissingSignature) {
+		t.Errorf("Verify(no signature) = %v, want ErrMissingSignature", err)
+	}
+}
+
+func TestStripeTimestamps(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	v := NewVerifier(Stripe(secret))
+	v.now = func() time.Time { return now }
+	body := `{"type":"invoice.paid"}`
+
+	req := func(ts time.Time, s []byte) *http.Request {
+		unix := strconv.FormatInt(ts.Unix(), 10)
+		sig := hex.EncodeToString(sign(unix+"."+body, s))
+		r := httptest.NewRequest(http.MethodPost, "/webhooks/billing", strings.NewReader(body))
+		// Stripe may send several v1 signatures; any one matching is enough.
+		r.Header.Set("Stripe-Signature", "t="+unix+",v1="+hex.EncodeToString(sign("x", s))+",v1="+sig)
+		return r
+	}
+
+	if _, err := v.Verify(req(now.Add(-time.Minute), secret)); err != nil {
+		t.Fatalf("Verify(fresh) = %v", err)
+	}
+	if _, err := v.Verify(req(now.Add(-time.Minute), secret)); !errors.Is(err, ErrReplayed) {
+		t.Errorf("Verify(replay) = %v, want ErrReplayed", err)
+	}
+	if _, err := v.Verify(req(now.Add(-10*time.Minute), secret)); !errors.Is(err, ErrStaleTimestamp) {
+		t.Errorf("Verify(old) = %v, want ErrStaleTimestamp", err)
+	}
+	if _, err := v.Verify(req(now.Add(10*time.Minute), secret)); !errors.Is(err, ErrStaleTimestamp) {
+		t.Errorf("Verify(future) = %v, want ErrStaleTimestamp", err)
+	}
+	if _, err := v.Verify(req(now, []byte("other"))); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Verify(wrong secret) = %v, want ErrInvalidSignature", err)
+	}
+
+	// The replay cache forgets deliveries once their timestamps could no longer be accepted.
+	now = now.Add(time.Hour)
+	if n := len(v.seen.expires); n != 1 {
+		t.Fatalf("replay cache holds %d entries, want 1", n)
+	}
+	_, _ = v.Verify(req(now, secret))
+	if n := len(v.seen.expires); n != 1 {
+		t.Errorf("replay cache holds %d entries after expiry, want 1", n)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	v := NewVerifier(Config{Secrets: [][]byte{[]byte("old"), secret}, SignatureHeader: "X-Signature"})
+	body := `{"ok":true}`
+
+	failing := true
+	e := echo.New()
+	e.POST("/hook", func(c echo.Context) error {
+		b, _ := io.ReadAll(c.Request().Body)
+		return c.String(http.StatusOK, string(b))
+	}, v.Middleware())
+	e.POST("/flaky", func(c echo.Context) error {
+		if failing {
+			return c.String(http.StatusServiceUnavailable, "try again")
+		}
+		return c.NoContent(http.StatusNoContent)
+	}, v.Middleware())
+
+	do := func(sig string) *httptest.ResponseRecorder {
+		return post(e, "/hook", sig, body)
+	}
+
+	good := hex.EncodeToString(sign(body, secret))
+	if rec := do(good); rec.Code != http.StatusOK || rec.Body.String() != body {
+		t.Errorf("valid = %d %q, want 200 with the original body", rec.Code, rec.Body)
+	}
+	if rec := do(good); rec.Code != http.StatusConflict {
+		t.Errorf("replay = %d, want 409", rec.Code)
+	}
+	if rec := do("deadbeef"); rec.Code != http.StatusUnauthorized {
+		t.Errorf("bad signature = %d, want 401", rec.Code)
+	}
+
+	// A delivery the handler fails is not remembered, so the provider's retry goes through.
+	flaky := `{"attempt":1}`
+	sig := hex.EncodeToString(sign(flaky, secret))
+	if rec := post(e, "/flaky", sig, flaky); rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("failing handler = %d, want 503", rec.Code)
+	}
+	failing = false
+	if rec := post(e, "/flaky", sig, flaky); rec.Code != http.StatusNoContent {
+		t.Errorf("retry after failure = %d, want 204", rec.Code)
+	}
+	if rec := post(e, "/flaky", sig, flaky); rec.Code != http.StatusConflict {
+		t.Errorf("replay after success = %d, want 409", rec.Code)
+	}
+}
+
+func post(e *echo.Echo, path, sig, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	r.Header.Set("X-Signature", sig)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, r)
+	return rec
+}
@@ -0,0 +1,209 @@
+// Package xlsx writes single-sheet Excel workbooks (Office Open XML) row by row, so that
+// large tables can be streamed to a client without being held in memory.
+//
+// Only what an export needs is supported: text, numbers and booleans, without styles or
+// formulas. Text is stored inline rather than in a shared string table, which would have
+// to be written after the sheet.
+//
+//	w, err := xlsx.NewWriter(res, "Items")
+//	w.WriteRow("sku", "quantity")
+//	w.WriteRow("WIDGET-1", 3)
+//	err = w.Close()
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// MIMEType is the media type of the workbooks written by Writer.
+const MIMEType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+// maxSheetName is the longest sheet name Excel accepts.
+const maxSheetName = 31
+
+// Writer writes the rows of one worksheet. It is not safe for concurrent use.
+type Writer struct {
+	zw    *zip.Writer
+	sheet *bufio.Writer
+	rows  int
+	err   error // First write error; every later call returns it
+}
+
+// NewWriter starts a workbook on w with a single sheet of the given name. Nothing is
+// complete until Close is called.
+func NewWriter(w io.Writer, sheetName string) (*Writer, error) {
+	if sheetName == "" || len(sheetName) > maxSheetName || strings.ContainsAny(sheetName, `[]:*?/\`) {
+		return nil, fmt.Errorf("xlsx: invalid sheet name %q", sheetName)
+	}
+	zw := zip.NewWriter(w)
+	parts := []struct{ name, body string }{
+		{"[Content_Types].xml", contentTypes},
+		{"_rels/.rels", rootRels},
+		{"xl/workbook.xml", fmt.Sprintf(workbook, escape(sheetName))},
+		{"xl/_rels/workbook.xml.rels", workbookRels},
+	}
+	for _, p := range parts {
+		f, err := zw.Create(p.name)
+		if err != nil {
+			return nil, fmt.Errorf("xlsx: failed to add %s: %w", p.name, err)
+		}
+		if _, err := io.WriteString(f, p.body); err != nil {
+			return nil, fmt.Errorf("xlsx: failed to write %s: %w", p.name, err)
+		}
+	}
+
+	// The sheet is the last part, so it can be written as rows come in.
+	f, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, fmt.Errorf("xlsx: failed to add sheet: %w", err)
+	}
+	sw := &Writer{zw: zw, sheet: bufio.NewWriter(f)}
+	sw.sheet.WriteString(xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	return sw, nil
+}
+
+// WriteRow appends a row. Cells may be strings, integers, floats, booleans or nil (left
+// empty); pointers to those are followed. Any other value is written as text with fmt.
+func (w *Writer) WriteRow(cells ...any) error {
+	if w.err != nil {
+		return w.err
+	}
+	w.rows++
+	var b strings.Builder
+	fmt.Fprintf(&b, `<row r="%d">`, w.rows)
+	for i, v := range cells {
+		writeCell(&b, cellRef(i, w.rows), v)
+	}
+	b.WriteString(`</row>`)
+	if _, err := w.sheet.WriteString(b.String()); err != nil {
+		w.err = fmt.Errorf("xlsx: failed to write row %d: %w", w.rows, err)
+	}
+	return w.err
+}
+
+// Flush pushes buffered rows to the underlying writer.
+func (w *Writer) Flush() error {
+	if w.err != nil {
+		return w.err
+	}
+	if err := w.sheet.Flush(); err != nil {
+		w.err = fmt.Errorf("xlsx: failed to flush rows: %w", err)
+		return w.err
+	}
+	if err := w.zw.Flush(); err != nil {
+		w.err = fmt.Errorf("xlsx: failed to flush rows: %w", err)
+	}
+	return w.err
+}
+
+// Close ends the sheet and the workbook. It does not close the underlying writer.
+func (w *Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	w.sheet.WriteString(`</sheetData></worksheet>`)
+	if err := w.sheet.Flush(); err != nil {
+		return fmt.Errorf("xlsx: failed to end sheet: %w", err)
+	}
+	if err := w.zw.Close(); err != nil {
+		return fmt.Errorf("xlsx: failed to end workbook: %w", err)
+	}
+	w.err = errors.New("xlsx: writer is closed")
+	return nil
+}
+
+// writeCell appends the cell at ref holding v.
+func writeCell(b *strings.Builder, ref string, v any) {
+	switch x := v.(type) {
+	case nil:
+		return
+	case *string:
+		if x != nil {
+			writeCell(b, ref, *x)
+		}
+		return
+	case *int:
+		if x != nil {
+			writeCell(b, ref, *x)
+		}
+		return
+	case *float64:
+		if x != nil {
+			writeCell(b, ref, *x)
+		}
+		return
+	case string:
+		writeText(b, ref, x)
+	case int:
+		writeNumber(b, ref, strconv.Itoa(x))
+	case int64:
+		writeNumber(b, ref, strconv.FormatInt(x, 10))
+	case float64:
+		if math.IsNaN(x) || math.IsInf(x, 0) { // Not representable as a number cell
+			writeText(b, ref, strconv.FormatFloat(x, 'g', -1, 64))
+			return
+		}
+		writeNumber(b, ref, strconv.FormatFloat(x, 'g', -1, 64))
+	case bool:
+		value := "0"
+		if x {
+			value = "1"
+		}
+		fmt.Fprintf(b, `<c r="%s" t="b"><v>%s</v></c>`, ref, value)
+	default:
+		writeText(b, ref, fmt.Sprint(x))
+	}
+}
+
+func writeNumber(b *strings.Builder, ref, value string) {
+	fmt.Fprintf(b, `<c r="%s"><v>%s</v></c>`, ref, value)
+}
+
+func writeText(b *strings.Builder, ref, s string) {
+	fmt.Fprintf(b, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, escape(s))
+}
+
+// escape makes s safe for XML text and attributes. Characters XML cannot hold, such as
+// most control characters, become U+FFFD.
+func escape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s)) // Cannot fail on a strings.Builder
+	return b.String()
+}
+
+// cellRef returns the A1-style reference of the zero-based column col in row.
+func cellRef(col, row int) string {
+	name := ""
+	for col++; col > 0; col = (col - 1) / 26 {
+		name = string(rune('A'+(col-1)%26)) + name
+	}
+	return name + strconv.Itoa(row)
+}
+
+const contentTypes = xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`</Types>`
+
+const rootRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+// workbook takes the escaped sheet name.
+const workbook = xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+	`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets></workbook>`
+
+const workbookRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+	`</Relationships>`
@@ -0,0 +1,96 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+)
+
+// readSheet opens a written workbook and returns its sheet XML.
+func readSheet(t *testing.T, data []byte) string {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("not a zip archive: %v", err)
+	}
+	parts := map[string]string{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(rc)
+		rc.Close()
+		if err := xml.Unmarshal(body, new(struct{})); err != nil {
+			t.Errorf("%s is not well-formed XML: %v", f.Name, err)
+		}
+		parts[f.Name] = string(body)
+	}
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels"} {
+		if _, ok := parts[name]; !ok {
+			t.Errorf("missing part %s", name)
+		}
+	}
+	return parts["xl/worksheets/sheet1.xml"]
+}
+
+func TestWriteRows(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, "Items")
+	if err != nil {
+		t.Fatal(err)
+	}
+	desc := "Tom & Jerry <3"
+	var missing *int
+	w.WriteRow("sku", "quantity", "price", "description", "threshold", "active")
+	w.WriteRow("WIDGET-1", 3, 9.5, &desc, missing, true)
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	sheet := readSheet(t, buf.Bytes())
+	for _, want := range []string{
+		`<row r="1"><c r="A1" t="inlineStr"><is><t xml:space="preserve">sku</t></is></c>`,
+		`<c r="B2"><v>3</v></c><c r="C2"><v>9.5</v></c>`,
+		`<t xml:space="preserve">Tom &amp; Jerry &lt;3</t>`,
+		`</c><c r="F2" t="b"><v>1</v></c></row>`, // Nil threshold leaves E2 out
+	} {
+		if !strings.Contains(sheet, want) {
+			t.Errorf("sheet lacks %s:\n%s", want, sheet)
+		}
+	}
+	if err := w.WriteRow("late"); err == nil {
+		t.Error("WriteRow after Close succeeded")
+	}
+}
+
+func TestControlCharactersAreReplaced(t *testing.T) {
+	var buf bytes.Buffer
+	w, _ := NewWriter(&buf, "Items")
+	w.WriteRow("bell\a")
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if sheet := readSheet(t, buf.Bytes()); !strings.Contains(sheet, "bell\uFFFD") {
+		t.Errorf("control character not replaced:\n%s", sheet)
+	}
+}
+
+func TestInvalidSheetName(t *testing.T) {
+	for _, name := range []string{"", "a/b", strings.Repeat("x", 32)} {
+		if _, err := NewWriter(io.Discard, name); err == nil {
+			t.Errorf("NewWriter accepted sheet name %q", name)
+		}
+	}
+}
+
+func TestCellRef(t *testing.T) {
+	for col, want := range map[int]string{0: "A1", 25: "Z1", 26: "AA1", 27: "AB1", 701: "ZZ1", 702: "AAA1"} {
+		if got := cellRef(col, 1); got != want {
+			t.Errorf("cellRef(%d, 1) = %s, want %s", col, got, want)
+		}
+	}
+}